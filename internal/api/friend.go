@@ -17,6 +17,7 @@ package api
 import (
 	"github.com/gin-gonic/gin"
 
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/relationext"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/protocol/relation"
 	"github.com/openimsdk/tools/a2r"
@@ -109,3 +110,35 @@ func (o *FriendApi) GetIncrementalBlacks(c *gin.Context) {
 func (o *FriendApi) GetFullFriendUserIDs(c *gin.Context) {
 	a2r.Call(relation.FriendClient.GetFullFriendUserIDs, o.Client, c)
 }
+
+func (o *FriendApi) CreateFriendGroup(c *gin.Context) {
+	a2r.Call(relationext.RelationExtClient.CreateFriendGroup, o.ExtClient, c)
+}
+
+func (o *FriendApi) SetFriendGroupName(c *gin.Context) {
+	a2r.Call(relationext.RelationExtClient.SetFriendGroupName, o.ExtClient, c)
+}
+
+func (o *FriendApi) DeleteFriendGroup(c *gin.Context) {
+	a2r.Call(relationext.RelationExtClient.DeleteFriendGroup, o.ExtClient, c)
+}
+
+func (o *FriendApi) SortFriendGroups(c *gin.Context) {
+	a2r.Call(relationext.RelationExtClient.SortFriendGroups, o.ExtClient, c)
+}
+
+func (o *FriendApi) AddFriendGroupMembers(c *gin.Context) {
+	a2r.Call(relationext.RelationExtClient.AddFriendGroupMembers, o.ExtClient, c)
+}
+
+func (o *FriendApi) RemoveFriendGroupMembers(c *gin.Context) {
+	a2r.Call(relationext.RelationExtClient.RemoveFriendGroupMembers, o.ExtClient, c)
+}
+
+func (o *FriendApi) GetFriendGroups(c *gin.Context) {
+	a2r.Call(relationext.RelationExtClient.GetFriendGroups, o.ExtClient, c)
+}
+
+func (o *FriendApi) GetIncrementalFriendGroups(c *gin.Context) {
+	a2r.Call(relationext.RelationExtClient.GetIncrementalFriendGroups, o.ExtClient, c)
+}
@@ -16,6 +16,7 @@ package api
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/protocol/group"
 	"github.com/openimsdk/tools/a2r"
@@ -156,3 +157,159 @@ func (o *GroupApi) GetFullGroupMemberUserIDs(c *gin.Context) {
 func (o *GroupApi) GetFullJoinGroupIDs(c *gin.Context) {
 	a2r.Call(group.GroupClient.GetFullJoinGroupIDs, o.Client, c)
 }
+
+func (o *GroupApi) CreateGroupRole(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.CreateGroupRole, o.ExtClient, c)
+}
+
+func (o *GroupApi) UpdateGroupRole(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.UpdateGroupRole, o.ExtClient, c)
+}
+
+func (o *GroupApi) DeleteGroupRole(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.DeleteGroupRole, o.ExtClient, c)
+}
+
+func (o *GroupApi) GetGroupRoles(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.GetGroupRoles, o.ExtClient, c)
+}
+
+func (o *GroupApi) SetGroupMemberRole(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.SetGroupMemberRole, o.ExtClient, c)
+}
+
+func (o *GroupApi) GetGroupMemberPermissions(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.GetGroupMemberPermissions, o.ExtClient, c)
+}
+
+func (o *GroupApi) CreateGroupInviteLink(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.CreateGroupInviteLink, o.ExtClient, c)
+}
+
+func (o *GroupApi) GetGroupInviteLinks(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.GetGroupInviteLinks, o.ExtClient, c)
+}
+
+func (o *GroupApi) RevokeGroupInviteLink(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.RevokeGroupInviteLink, o.ExtClient, c)
+}
+
+func (o *GroupApi) GetGroupInviteRedemptions(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.GetGroupInviteRedemptions, o.ExtClient, c)
+}
+
+func (o *GroupApi) JoinGroupByInvite(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.JoinGroupByInvite, o.ExtClient, c)
+}
+
+func (o *GroupApi) CreateCommunity(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.CreateCommunity, o.ExtClient, c)
+}
+
+func (o *GroupApi) SetCommunityInfo(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.SetCommunityInfo, o.ExtClient, c)
+}
+
+func (o *GroupApi) DismissCommunity(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.DismissCommunity, o.ExtClient, c)
+}
+
+func (o *GroupApi) GetCommunitiesInfo(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.GetCommunitiesInfo, o.ExtClient, c)
+}
+
+func (o *GroupApi) InviteToCommunity(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.InviteToCommunity, o.ExtClient, c)
+}
+
+func (o *GroupApi) KickCommunityMember(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.KickCommunityMember, o.ExtClient, c)
+}
+
+func (o *GroupApi) QuitCommunity(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.QuitCommunity, o.ExtClient, c)
+}
+
+func (o *GroupApi) SetCommunityMemberRole(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.SetCommunityMemberRole, o.ExtClient, c)
+}
+
+func (o *GroupApi) GetCommunityMembers(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.GetCommunityMembers, o.ExtClient, c)
+}
+
+func (o *GroupApi) AddCommunityGroups(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.AddCommunityGroups, o.ExtClient, c)
+}
+
+func (o *GroupApi) RemoveCommunityGroups(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.RemoveCommunityGroups, o.ExtClient, c)
+}
+
+func (o *GroupApi) GetIncrementalJoinCommunity(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.GetIncrementalJoinCommunity, o.ExtClient, c)
+}
+
+func (o *GroupApi) GetFullJoinCommunityIDs(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.GetFullJoinCommunityIDs, o.ExtClient, c)
+}
+
+func (o *GroupApi) SetGroupJoinQuestions(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.SetGroupJoinQuestions, o.ExtClient, c)
+}
+
+func (o *GroupApi) GetGroupJoinQuestions(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.GetGroupJoinQuestions, o.ExtClient, c)
+}
+
+func (o *GroupApi) JoinGroupWithAnswers(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.JoinGroupWithAnswers, o.ExtClient, c)
+}
+
+func (o *GroupApi) GetGroupApplicationAnswers(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.GetGroupApplicationAnswers, o.ExtClient, c)
+}
+
+func (o *GroupApi) BatchGroupApplicationResponse(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.BatchGroupApplicationResponse, o.ExtClient, c)
+}
+
+func (o *GroupApi) AddGroupMemberTag(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.AddGroupMemberTag, o.ExtClient, c)
+}
+
+func (o *GroupApi) RemoveGroupMemberTag(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.RemoveGroupMemberTag, o.ExtClient, c)
+}
+
+func (o *GroupApi) GetGroupTags(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.GetGroupTags, o.ExtClient, c)
+}
+
+func (o *GroupApi) GetGroupMemberTags(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.GetGroupMemberTags, o.ExtClient, c)
+}
+
+func (o *GroupApi) SetGroupQuota(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.SetGroupQuota, o.ExtClient, c)
+}
+
+func (o *GroupApi) GetGroupQuota(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.GetGroupQuota, o.ExtClient, c)
+}
+
+func (o *GroupApi) SetGroupSuccession(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.SetGroupSuccession, o.ExtClient, c)
+}
+
+func (o *GroupApi) GetGroupSuccession(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.GetGroupSuccession, o.ExtClient, c)
+}
+
+func (o *GroupApi) QuitGroupWithSuccession(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.QuitGroupWithSuccession, o.ExtClient, c)
+}
+
+func (o *GroupApi) HandOverOwnedGroups(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.HandOverOwnedGroups, o.ExtClient, c)
+}
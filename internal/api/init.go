@@ -18,6 +18,8 @@ import (
 	"context"
 	"fmt"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/tools/utils/datautil"
 	"github.com/openimsdk/tools/utils/network"
 	"net"
@@ -55,6 +57,7 @@ func Start(ctx context.Context, index int, config *Config) error {
 	if err != nil {
 		return errs.WrapMsg(err, "failed to register discovery service")
 	}
+	tenant.SetResolver(rpcclient.NewTenantResolver(client, config.Share.RpcRegisterName.User, config.Share.IMAdminUserID))
 
 	var (
 		netDone        = make(chan struct{}, 1)
@@ -21,6 +21,7 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/apistruct"
 	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/msg"
@@ -223,7 +224,7 @@ func (m *MessageApi) SendMessage(c *gin.Context) {
 	sendMsgReq.MsgData.RecvID = req.RecvID
 
 	// Attempt to send the message using the client.
-	respPb, err := m.Client.SendMsg(c, sendMsgReq)
+	respPb, err := m.ExtClient.SendMsgWithSeq(c, &msgext.SendMsgWithSeqReq{MsgData: sendMsgReq.MsgData})
 	if err != nil {
 		// Set the status to failed and respond with an error if sending fails.
 		apiresp.GinError(c, err)
@@ -367,7 +368,55 @@ func (m *MessageApi) GetActiveGroup(c *gin.Context) {
 }
 
 func (m *MessageApi) SearchMsg(c *gin.Context) {
-	a2r.Call(msg.MsgClient.SearchMessage, m.Client, c)
+	a2r.Call(msgext.MsgExtClient.SearchMsg, m.ExtClient, c)
+}
+
+func (m *MessageApi) SearchUserMsg(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.SearchUserMsg, m.ExtClient, c)
+}
+
+func (m *MessageApi) SetGroupReadReceipt(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.SetGroupReadReceipt, m.ExtClient, c)
+}
+
+func (m *MessageApi) GetGroupMsgReadState(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.GetGroupMsgReadState, m.ExtClient, c)
+}
+
+func (m *MessageApi) SetGroupSensitiveWords(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.SetGroupSensitiveWords, m.ExtClient, c)
+}
+
+func (m *MessageApi) GetGroupSensitiveWords(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.GetGroupSensitiveWords, m.ExtClient, c)
+}
+
+func (m *MessageApi) SetGroupMuteRule(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.SetGroupMuteRule, m.ExtClient, c)
+}
+
+func (m *MessageApi) GetGroupMuteRule(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.GetGroupMuteRule, m.ExtClient, c)
+}
+
+func (m *MessageApi) SearchModerationReviews(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.SearchModerationReviews, m.ExtClient, c)
+}
+
+func (m *MessageApi) SetModerationReviewStatus(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.SetModerationReviewStatus, m.ExtClient, c)
+}
+
+func (m *MessageApi) ForwardMsg(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.ForwardMsg, m.ExtClient, c)
+}
+
+func (m *MessageApi) CheckConversationSeq(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.CheckConversationSeq, m.ExtClient, c)
+}
+
+func (m *MessageApi) GetMsgsBySeqs(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.GetMsgsBySeqs, m.ExtClient, c)
 }
 
 func (m *MessageApi) GetServerTime(c *gin.Context) {
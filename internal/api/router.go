@@ -8,8 +8,10 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/tools/apiresp"
@@ -81,6 +83,12 @@ func newGinRouter(disCov discovery.SvcDiscoveryRegistry, config *Config) *gin.En
 		userRouterGroup.POST("/add_notification_account", u.AddNotificationAccount)
 		userRouterGroup.POST("/update_notification_account", u.UpdateNotificationAccountInfo)
 		userRouterGroup.POST("/search_notification_account", u.SearchNotificationAccount)
+
+		userRouterGroup.POST("/export_user_data", u.ExportUserData)
+		userRouterGroup.POST("/get_user_export", u.GetUserExport)
+		userRouterGroup.POST("/delete_user", u.DeleteUser)
+		userRouterGroup.POST("/get_user_deletion", u.GetUserDeletion)
+		userRouterGroup.POST("/set_user_disabled", u.SetUserDisabled)
 	}
 	// friend routing group
 	friendRouterGroup := r.Group("/friend")
@@ -106,6 +114,14 @@ func newGinRouter(disCov discovery.SvcDiscoveryRegistry, config *Config) *gin.En
 		friendRouterGroup.POST("/update_friends", f.UpdateFriends)
 		friendRouterGroup.POST("/get_incremental_friends", f.GetIncrementalFriends)
 		friendRouterGroup.POST("/get_full_friend_user_ids", f.GetFullFriendUserIDs)
+		friendRouterGroup.POST("/create_friend_group", f.CreateFriendGroup)
+		friendRouterGroup.POST("/set_friend_group_name", f.SetFriendGroupName)
+		friendRouterGroup.POST("/delete_friend_group", f.DeleteFriendGroup)
+		friendRouterGroup.POST("/sort_friend_groups", f.SortFriendGroups)
+		friendRouterGroup.POST("/add_friend_group_members", f.AddFriendGroupMembers)
+		friendRouterGroup.POST("/remove_friend_group_members", f.RemoveFriendGroupMembers)
+		friendRouterGroup.POST("/get_friend_groups", f.GetFriendGroups)
+		friendRouterGroup.POST("/get_incremental_friend_groups", f.GetIncrementalFriendGroups)
 	}
 	g := NewGroupApi(*groupRpc)
 	groupRouterGroup := r.Group("/group")
@@ -139,6 +155,45 @@ func newGinRouter(disCov discovery.SvcDiscoveryRegistry, config *Config) *gin.En
 		groupRouterGroup.POST("/get_incremental_group_members_batch", g.GetIncrementalGroupMemberBatch)
 		groupRouterGroup.POST("/get_full_group_member_user_ids", g.GetFullGroupMemberUserIDs)
 		groupRouterGroup.POST("/get_full_join_group_ids", g.GetFullJoinGroupIDs)
+		groupRouterGroup.POST("/create_group_role", g.CreateGroupRole)
+		groupRouterGroup.POST("/update_group_role", g.UpdateGroupRole)
+		groupRouterGroup.POST("/delete_group_role", g.DeleteGroupRole)
+		groupRouterGroup.POST("/get_group_roles", g.GetGroupRoles)
+		groupRouterGroup.POST("/set_group_member_role", g.SetGroupMemberRole)
+		groupRouterGroup.POST("/get_group_member_permissions", g.GetGroupMemberPermissions)
+		groupRouterGroup.POST("/create_group_invite_link", g.CreateGroupInviteLink)
+		groupRouterGroup.POST("/get_group_invite_links", g.GetGroupInviteLinks)
+		groupRouterGroup.POST("/revoke_group_invite_link", g.RevokeGroupInviteLink)
+		groupRouterGroup.POST("/get_group_invite_redemptions", g.GetGroupInviteRedemptions)
+		groupRouterGroup.POST("/join_group_by_invite", g.JoinGroupByInvite)
+		groupRouterGroup.POST("/create_community", g.CreateCommunity)
+		groupRouterGroup.POST("/set_community_info", g.SetCommunityInfo)
+		groupRouterGroup.POST("/dismiss_community", g.DismissCommunity)
+		groupRouterGroup.POST("/get_communities_info", g.GetCommunitiesInfo)
+		groupRouterGroup.POST("/invite_to_community", g.InviteToCommunity)
+		groupRouterGroup.POST("/kick_community_member", g.KickCommunityMember)
+		groupRouterGroup.POST("/quit_community", g.QuitCommunity)
+		groupRouterGroup.POST("/set_community_member_role", g.SetCommunityMemberRole)
+		groupRouterGroup.POST("/get_community_members", g.GetCommunityMembers)
+		groupRouterGroup.POST("/add_community_groups", g.AddCommunityGroups)
+		groupRouterGroup.POST("/remove_community_groups", g.RemoveCommunityGroups)
+		groupRouterGroup.POST("/get_incremental_join_communities", g.GetIncrementalJoinCommunity)
+		groupRouterGroup.POST("/get_full_join_community_ids", g.GetFullJoinCommunityIDs)
+		groupRouterGroup.POST("/set_group_join_questions", g.SetGroupJoinQuestions)
+		groupRouterGroup.POST("/get_group_join_questions", g.GetGroupJoinQuestions)
+		groupRouterGroup.POST("/join_group_with_answers", g.JoinGroupWithAnswers)
+		groupRouterGroup.POST("/get_group_application_answers", g.GetGroupApplicationAnswers)
+		groupRouterGroup.POST("/batch_group_application_response", g.BatchGroupApplicationResponse)
+		groupRouterGroup.POST("/add_group_member_tag", g.AddGroupMemberTag)
+		groupRouterGroup.POST("/remove_group_member_tag", g.RemoveGroupMemberTag)
+		groupRouterGroup.POST("/get_group_tags", g.GetGroupTags)
+		groupRouterGroup.POST("/get_group_member_tags", g.GetGroupMemberTags)
+		groupRouterGroup.POST("/set_group_quota", g.SetGroupQuota)
+		groupRouterGroup.POST("/get_group_quota", g.GetGroupQuota)
+		groupRouterGroup.POST("/set_group_succession", g.SetGroupSuccession)
+		groupRouterGroup.POST("/get_group_succession", g.GetGroupSuccession)
+		groupRouterGroup.POST("/quit_group_with_succession", g.QuitGroupWithSuccession)
+		groupRouterGroup.POST("/hand_over_owned_groups", g.HandOverOwnedGroups)
 	}
 	// certificate
 	authRouterGroup := r.Group("/auth")
@@ -179,6 +234,18 @@ func newGinRouter(disCov discovery.SvcDiscoveryRegistry, config *Config) *gin.En
 	{
 		msgGroup.POST("/newest_seq", m.GetSeq)
 		msgGroup.POST("/search_msg", m.SearchMsg)
+		msgGroup.POST("/search_user_msg", m.SearchUserMsg)
+		msgGroup.POST("/set_group_read_receipt", m.SetGroupReadReceipt)
+		msgGroup.POST("/get_group_msg_read_state", m.GetGroupMsgReadState)
+		msgGroup.POST("/set_group_sensitive_words", m.SetGroupSensitiveWords)
+		msgGroup.POST("/get_group_sensitive_words", m.GetGroupSensitiveWords)
+		msgGroup.POST("/set_group_mute_rule", m.SetGroupMuteRule)
+		msgGroup.POST("/get_group_mute_rule", m.GetGroupMuteRule)
+		msgGroup.POST("/search_moderation_reviews", m.SearchModerationReviews)
+		msgGroup.POST("/set_moderation_review_status", m.SetModerationReviewStatus)
+		msgGroup.POST("/forward_msg", m.ForwardMsg)
+		msgGroup.POST("/check_conversation_seq", m.CheckConversationSeq)
+		msgGroup.POST("/get_msgs_by_seqs", m.GetMsgsBySeqs)
 		msgGroup.POST("/send_msg", m.SendMessage)
 		msgGroup.POST("/send_business_notification", m.SendBusinessNotification)
 		msgGroup.POST("/pull_msg_by_seq", m.PullMsgBySeqs)
@@ -213,6 +280,16 @@ func newGinRouter(disCov discovery.SvcDiscoveryRegistry, config *Config) *gin.En
 		conversationGroup.POST("/get_owner_conversation", c.GetOwnerConversation)
 	}
 
+	tenantGroup := r.Group("/tenant")
+	{
+		t := NewTenantApi(*userRpc)
+		tenantGroup.POST("/create_tenant", t.CreateTenant)
+		tenantGroup.POST("/update_tenant", t.UpdateTenant)
+		tenantGroup.POST("/delete_tenant", t.DeleteTenant)
+		tenantGroup.POST("/get_tenant", t.GetTenant)
+		tenantGroup.POST("/get_tenants", t.GetTenants)
+	}
+
 	statisticsGroup := r.Group("/statistics")
 	{
 		statisticsGroup.POST("/user/register", u.UserRegisterCount)
@@ -229,6 +306,12 @@ func GinParseToken(authRPC *rpcclient.Auth) gin.HandlerFunc {
 		case http.MethodPost:
 			for _, wApi := range Whitelist {
 				if strings.HasPrefix(c.Request.URL.Path, wApi) {
+					// Requests without a token name their tenant in a header.
+					if err := setTenant(c, c.Request.Header.Get(tenant.Key)); err != nil {
+						apiresp.GinError(c, err)
+						c.Abort()
+						return
+					}
 					c.Next()
 					return
 				}
@@ -241,6 +324,15 @@ func GinParseToken(authRPC *rpcclient.Auth) gin.HandlerFunc {
 				c.Abort()
 				return
 			}
+			tenantID, err := authverify.TenantIDFromToken(token)
+			if err == nil {
+				err = setTenant(c, tenantID)
+			}
+			if err != nil {
+				apiresp.GinError(c, err)
+				c.Abort()
+				return
+			}
 			resp, err := authRPC.ParseToken(c, token)
 			if err != nil {
 				apiresp.GinError(c, err)
@@ -254,6 +346,20 @@ func GinParseToken(authRPC *rpcclient.Auth) gin.HandlerFunc {
 	}
 }
 
+// setTenant makes the requests of c run in the tenant, the gin context being the context of
+// the RPCs made for the request.
+func setTenant(c *gin.Context, tenantID string) error {
+	if tenantID == "" {
+		return nil
+	}
+	if err := tenant.CheckID(tenantID); err != nil {
+		return err
+	}
+	c.Set(constant.RpcCustomHeader, []string{tenant.Key})
+	c.Set(tenant.Key, []string{tenantID})
+	return nil
+}
+
 // Whitelist api not parse token
 var Whitelist = []string{
 	"/user/user_register",
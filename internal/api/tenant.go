@@ -0,0 +1,48 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/tenant"
+	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
+	"github.com/openimsdk/tools/a2r"
+)
+
+type TenantApi rpcclient.User
+
+func NewTenantApi(client rpcclient.User) TenantApi {
+	return TenantApi(client)
+}
+
+func (t *TenantApi) CreateTenant(c *gin.Context) {
+	a2r.Call(tenant.TenantClient.CreateTenant, t.TenantClient, c)
+}
+
+func (t *TenantApi) UpdateTenant(c *gin.Context) {
+	a2r.Call(tenant.TenantClient.UpdateTenant, t.TenantClient, c)
+}
+
+func (t *TenantApi) DeleteTenant(c *gin.Context) {
+	a2r.Call(tenant.TenantClient.DeleteTenant, t.TenantClient, c)
+}
+
+func (t *TenantApi) GetTenant(c *gin.Context) {
+	a2r.Call(tenant.TenantClient.GetTenant, t.TenantClient, c)
+}
+
+func (t *TenantApi) GetTenants(c *gin.Context) {
+	a2r.Call(tenant.TenantClient.GetTenants, t.TenantClient, c)
+}
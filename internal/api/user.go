@@ -16,6 +16,7 @@ package api
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/userext"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/msggateway"
@@ -235,3 +236,23 @@ func (u *UserApi) UpdateNotificationAccountInfo(c *gin.Context) {
 func (u *UserApi) SearchNotificationAccount(c *gin.Context) {
 	a2r.Call(user.UserClient.SearchNotificationAccount, u.Client, c)
 }
+
+func (u *UserApi) ExportUserData(c *gin.Context) {
+	a2r.Call(userext.UserExtClient.ExportUserData, u.ExtClient, c)
+}
+
+func (u *UserApi) GetUserExport(c *gin.Context) {
+	a2r.Call(userext.UserExtClient.GetUserExport, u.ExtClient, c)
+}
+
+func (u *UserApi) DeleteUser(c *gin.Context) {
+	a2r.Call(userext.UserExtClient.DeleteUser, u.ExtClient, c)
+}
+
+func (u *UserApi) GetUserDeletion(c *gin.Context) {
+	a2r.Call(userext.UserExtClient.GetUserDeletion, u.ExtClient, c)
+}
+
+func (u *UserApi) SetUserDisabled(c *gin.Context) {
+	a2r.Call(userext.UserExtClient.SetUserDisabled, u.ExtClient, c)
+}
@@ -17,6 +17,7 @@ package msggateway
 import (
 	"context"
 	"fmt"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"runtime/debug"
 	"sync"
 	"sync/atomic"
@@ -67,6 +68,7 @@ type Client struct {
 	PlatformID     int    `json:"platformID"`
 	IsCompress     bool   `json:"isCompress"`
 	UserID         string `json:"userID"`
+	TenantID       string `json:"tenantID"`
 	IsBackground   bool   `json:"isBackground"`
 	ctx            *UserConnContext
 	longConnServer LongConnServer
@@ -87,6 +89,7 @@ func (c *Client) ResetClient(ctx *UserConnContext, conn LongConn, longConnServer
 	c.IsCompress = ctx.GetCompression()
 	c.IsBackground = ctx.GetBackground()
 	c.UserID = ctx.GetUserID()
+	c.TenantID = ctx.TenantID
 	c.ctx = ctx
 	c.longConnServer = longConnServer
 	c.IsBackground = false
@@ -101,6 +104,11 @@ func (c *Client) ResetClient(ctx *UserConnContext, conn LongConn, longConnServer
 	c.subUserIDs = make(map[string]struct{})
 }
 
+// key identifies the user of the client among the users of all tenants.
+func (c *Client) key() string {
+	return tenant.JoinKey(c.TenantID, c.UserID)
+}
+
 func (c *Client) pingHandler(appData string) error {
 	if err := c.conn.SetReadDeadline(pongWait); err != nil {
 		return err
@@ -200,9 +208,9 @@ func (c *Client) handleMessage(message []byte) error {
 		return errs.New("exception conn userID not same to req userID", "binaryReq", binaryReq.String())
 	}
 
-	ctx := mcontext.WithMustInfoCtx(
+	ctx := tenant.WithID(mcontext.WithMustInfoCtx(
 		[]string{binaryReq.OperationID, binaryReq.SendID, constant.PlatformIDToName(c.PlatformID), c.ctx.GetConnID()},
-	)
+	), c.TenantID)
 
 	log.ZDebug(ctx, "gateway req message", "req", binaryReq.String())
 
@@ -15,7 +15,9 @@
 package msggateway
 
 import (
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -34,6 +36,8 @@ type UserConnContext struct {
 	Method     string
 	RemoteAddr string
 	ConnID     string
+	// TenantID is read from the token by ParseEssentialArgs.
+	TenantID string
 }
 
 func (c *UserConnContext) Deadline() (deadline time.Time, ok bool) {
@@ -60,6 +64,16 @@ func (c *UserConnContext) Value(key any) any {
 		return constant.PlatformIDToName(stringutil.StringToInt(c.GetPlatformID()))
 	case constant.RemoteAddr:
 		return c.RemoteAddr
+	case constant.RpcCustomHeader:
+		if c.TenantID == "" {
+			return ""
+		}
+		return []string{tenant.Key}
+	case tenant.Key:
+		if c.TenantID == "" {
+			return ""
+		}
+		return []string{c.TenantID}
 	default:
 		return ""
 	}
@@ -174,10 +188,20 @@ func (c *UserConnContext) GetBackground() bool {
 	return b
 }
 func (c *UserConnContext) ParseEssentialArgs() error {
-	_, exists := c.Query(Token)
+	token, exists := c.Query(Token)
 	if !exists {
 		return servererrs.ErrConnArgsErr.WrapMsg("token is empty")
 	}
+	tenantID, err := authverify.TenantIDFromToken(token)
+	if err != nil {
+		return err
+	}
+	if tenantID != "" {
+		if err := tenant.CheckID(tenantID); err != nil {
+			return err
+		}
+	}
+	c.TenantID = tenantID
 	_, exists = c.Query(WsUserID)
 	if !exists {
 		return servererrs.ErrConnArgsErr.WrapMsg("sendID is empty")
@@ -186,7 +210,7 @@ func (c *UserConnContext) ParseEssentialArgs() error {
 	if !exists {
 		return servererrs.ErrConnArgsErr.WrapMsg("platformID is empty")
 	}
-	_, err := strconv.Atoi(platformIDStr)
+	_, err = strconv.Atoi(platformIDStr)
 	if err != nil {
 		return servererrs.ErrConnArgsErr.WrapMsg("platformID is not int")
 
@@ -19,6 +19,7 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/startrpc"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/msggateway"
@@ -98,7 +99,7 @@ func (s *Server) GetUsersOnlineStatus(
 	}
 	var resp msggateway.GetUsersOnlineStatusResp
 	for _, userID := range req.UserIDs {
-		clients, ok := s.LongConnServer.GetUserAllCons(userID)
+		clients, ok := s.LongConnServer.GetUserAllCons(tenant.WithKey(ctx, userID))
 		if !ok {
 			continue
 		}
@@ -132,7 +133,7 @@ func (s *Server) OnlineBatchPushOneMsg(ctx context.Context, req *msggateway.Onli
 }
 
 func (s *Server) pushToUser(ctx context.Context, userID string, msgData *sdkws.MsgData) *msggateway.SingleMsgToUserResults {
-	clients, ok := s.LongConnServer.GetUserAllCons(userID)
+	clients, ok := s.LongConnServer.GetUserAllCons(tenant.WithKey(ctx, userID))
 	if !ok {
 		log.ZDebug(ctx, "push user not online", "userID", userID)
 		return &msggateway.SingleMsgToUserResults{
@@ -225,7 +226,7 @@ func (s *Server) KickUserOffline(
 	req *msggateway.KickUserOfflineReq,
 ) (*msggateway.KickUserOfflineResp, error) {
 	for _, v := range req.KickUserIDList {
-		clients, _, ok := s.LongConnServer.GetUserPlatformCons(v, int(req.PlatformID))
+		clients, _, ok := s.LongConnServer.GetUserPlatformCons(tenant.WithKey(ctx, v), int(req.PlatformID))
 		if !ok {
 			log.ZDebug(ctx, "conn not exist", "userID", v, "platformID", req.PlatformID)
 			continue
@@ -244,13 +245,15 @@ func (s *Server) KickUserOffline(
 }
 
 func (s *Server) MultiTerminalLoginCheck(ctx context.Context, req *msggateway.MultiTerminalLoginCheckReq) (*msggateway.MultiTerminalLoginCheckResp, error) {
-	if oldClients, userOK, clientOK := s.LongConnServer.GetUserPlatformCons(req.UserID, int(req.PlatformID)); userOK {
+	if oldClients, userOK, clientOK := s.LongConnServer.GetUserPlatformCons(tenant.WithKey(ctx, req.UserID), int(req.PlatformID)); userOK {
 		tempUserCtx := newTempContext()
 		tempUserCtx.SetToken(req.Token)
 		tempUserCtx.SetOperationID(mcontext.GetOperationID(ctx))
+		tempUserCtx.TenantID = tenant.ID(ctx)
 		client := &Client{}
 		client.ctx = tempUserCtx
 		client.UserID = req.UserID
+		client.TenantID = tempUserCtx.TenantID
 		client.PlatformID = int(req.PlatformID)
 		i := &kickHandler{
 			clientOK:   clientOK,
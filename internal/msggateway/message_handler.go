@@ -20,8 +20,8 @@ import (
 
 	"github.com/go-playground/validator/v10"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
-	"github.com/openimsdk/protocol/msg"
 	"github.com/openimsdk/protocol/push"
 	"github.com/openimsdk/protocol/sdkws"
 	"github.com/openimsdk/tools/discovery"
@@ -146,8 +146,9 @@ func (g GrpcHandler) SendMessage(ctx context.Context, data *Req) ([]byte, error)
 		return nil, errs.WrapMsg(err, "SendMessage: message data validation failed", "action", "validate", "dataType", "MsgData")
 	}
 
-	req := msg.SendMsgReq{MsgData: &msgData}
-	resp, err := g.msgRpcClient.SendMsg(ctx, &req)
+	// The response decodes as a SendMsgResp, with the seq of the first send for a resend.
+	req := msgext.SendMsgWithSeqReq{MsgData: &msgData}
+	resp, err := g.msgRpcClient.ExtClient.SendMsgWithSeq(ctx, &req)
 	if err != nil {
 		return nil, err
 	}
@@ -6,6 +6,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	pbuser "github.com/openimsdk/protocol/user"
 	"github.com/openimsdk/tools/log"
 	"github.com/openimsdk/tools/mcontext"
@@ -87,8 +88,18 @@ func (ws *WsServer) ChangeOnlineStatus(concurrent int) {
 		opIdCtx := mcontext.SetOperationID(context.Background(), operationIDPrefix+strconv.FormatInt(count.Add(1), 10))
 		ctx, cancel := context.WithTimeout(opIdCtx, time.Second*5)
 		defer cancel()
-		if _, err := ws.userClient.Client.SetUserOnlineStatus(ctx, req); err != nil {
-			log.ZError(ctx, "update user online status", err)
+		// The states are keyed by user key, they are sent to each tenant separately.
+		tenantStatus := make(map[string][]*pbuser.UserOnlineStatus)
+		for _, status := range req.Status {
+			tenantID, userID := tenant.SplitKey(status.UserID)
+			status.UserID = userID
+			tenantStatus[tenantID] = append(tenantStatus[tenantID], status)
+		}
+		for tenantID, status := range tenantStatus {
+			ctx := tenant.WithID(ctx, tenantID)
+			if _, err := ws.userClient.Client.SetUserOnlineStatus(ctx, &pbuser.SetUserOnlineStatusReq{Status: status}); err != nil {
+				log.ZError(ctx, "update user online status", err)
+			}
 		}
 	}
 
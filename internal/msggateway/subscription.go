@@ -2,6 +2,7 @@ package msggateway
 
 import (
 	"context"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/protocol/sdkws"
 	"github.com/openimsdk/tools/log"
 	"github.com/openimsdk/tools/utils/datautil"
@@ -10,7 +11,7 @@ import (
 )
 
 func (ws *WsServer) subscriberUserOnlineStatusChanges(ctx context.Context, userID string, platformIDs []int32) {
-	if ws.clients.RecvSubChange(userID, platformIDs) {
+	if ws.clients.RecvSubChange(tenant.WithKey(ctx, userID), platformIDs) {
 		log.ZDebug(ctx, "gateway receive subscription message and go back online", "userID", userID, "platformIDs", platformIDs)
 	} else {
 		log.ZDebug(ctx, "gateway ignore user online status changes", "userID", userID, "platformIDs", platformIDs)
@@ -53,7 +54,7 @@ type subClient struct {
 
 type Subscription struct {
 	lock    sync.RWMutex
-	userIDs map[string]*subClient // subscribe to the user's client connection
+	userIDs map[string]*subClient // subscribe to the user's client connection, by user key
 }
 
 func (s *Subscription) DelClient(client *Client) {
@@ -70,21 +71,22 @@ func (s *Subscription) DelClient(client *Client) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 	for _, userID := range userIDs {
-		sub, ok := s.userIDs[userID]
+		userKey := tenant.JoinKey(client.TenantID, userID)
+		sub, ok := s.userIDs[userKey]
 		if !ok {
 			continue
 		}
 		delete(sub.clients, addr)
 		if len(sub.clients) == 0 {
-			delete(s.userIDs, userID)
+			delete(s.userIDs, userKey)
 		}
 	}
 }
 
-func (s *Subscription) GetClient(userID string) []*Client {
+func (s *Subscription) GetClient(userKey string) []*Client {
 	s.lock.RLock()
 	defer s.lock.RUnlock()
-	cs, ok := s.userIDs[userID]
+	cs, ok := s.userIDs[userKey]
 	if !ok {
 		return nil
 	}
@@ -127,27 +129,29 @@ func (s *Subscription) Sub(client *Client, addUserIDs, delUserIDs []string) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 	for userID := range del {
-		sub, ok := s.userIDs[userID]
+		userKey := tenant.JoinKey(client.TenantID, userID)
+		sub, ok := s.userIDs[userKey]
 		if !ok {
 			continue
 		}
 		delete(sub.clients, addr)
 		if len(sub.clients) == 0 {
-			delete(s.userIDs, userID)
+			delete(s.userIDs, userKey)
 		}
 	}
 	for userID := range add {
-		sub, ok := s.userIDs[userID]
+		userKey := tenant.JoinKey(client.TenantID, userID)
+		sub, ok := s.userIDs[userKey]
 		if !ok {
 			sub = &subClient{clients: make(map[string]*Client)}
-			s.userIDs[userID] = sub
+			s.userIDs[userKey] = sub
 		}
 		sub.clients[addr] = client
 	}
 }
 
 func (ws *WsServer) pushUserIDOnlineStatus(ctx context.Context, userID string, platformIDs []int32) {
-	clients := ws.subscription.GetClient(userID)
+	clients := ws.subscription.GetClient(tenant.WithKey(ctx, userID))
 	if len(clients) == 0 {
 		return
 	}
@@ -6,6 +6,8 @@ import (
 	"time"
 )
 
+// UserMap holds the connected clients by user key, tenant.JoinKey of the tenant and user ID,
+// so that users of different tenants never share an entry.
 type UserMap interface {
 	GetAll(userID string) ([]*Client, bool)
 	Get(userID string, platformID int) ([]*Client, bool, bool)
@@ -128,7 +130,7 @@ func (u *userMap) Set(userID string, client *Client) {
 		}
 		u.data[userID] = result
 	}
-	u.push(client.UserID, result, nil)
+	u.push(userID, result, nil)
 }
 
 func (u *userMap) DeleteClients(userID string, clients []*Client) (isDeleteUser bool) {
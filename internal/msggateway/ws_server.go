@@ -17,6 +17,7 @@ package msggateway
 import (
 	"context"
 	"fmt"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/webhook"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpccache"
 	pbAuth "github.com/openimsdk/protocol/auth"
@@ -252,9 +253,9 @@ func (ws *WsServer) registerClient(client *Client) {
 		clientOK   bool
 		oldClients []*Client
 	)
-	oldClients, userOK, clientOK = ws.clients.Get(client.UserID, client.PlatformID)
+	oldClients, userOK, clientOK = ws.clients.Get(client.key(), client.PlatformID)
 	if !userOK {
-		ws.clients.Set(client.UserID, client)
+		ws.clients.Set(client.key(), client)
 		log.ZDebug(client.ctx, "user not exist", "userID", client.UserID, "platformID", client.PlatformID)
 		prommetrics.OnlineUserGauge.Add(1)
 		ws.onlineUserNum.Add(1)
@@ -263,13 +264,13 @@ func (ws *WsServer) registerClient(client *Client) {
 		ws.multiTerminalLoginChecker(clientOK, oldClients, client)
 		log.ZDebug(client.ctx, "user exist", "userID", client.UserID, "platformID", client.PlatformID)
 		if clientOK {
-			ws.clients.Set(client.UserID, client)
+			ws.clients.Set(client.key(), client)
 			// There is already a connection to the platform
 			log.ZInfo(client.ctx, "repeat login", "userID", client.UserID, "platformID",
 				client.PlatformID, "old remote addr", getRemoteAdders(oldClients))
 			ws.onlineUserConnNum.Add(1)
 		} else {
-			ws.clients.Set(client.UserID, client)
+			ws.clients.Set(client.key(), client)
 			ws.onlineUserConnNum.Add(1)
 		}
 	}
@@ -316,7 +317,7 @@ func getRemoteAdders(client []*Client) string {
 }
 
 func (ws *WsServer) KickUserConn(client *Client) error {
-	ws.clients.DeleteClients(client.UserID, []*Client{client})
+	ws.clients.DeleteClients(client.key(), []*Client{client})
 	return client.KickOnlineMessage()
 }
 
@@ -332,17 +333,17 @@ func (ws *WsServer) multiTerminalLoginChecker(clientOK bool, oldClients []*Clien
 		if !clientOK {
 			return
 		}
-		ws.clients.DeleteClients(newClient.UserID, oldClients)
+		ws.clients.DeleteClients(newClient.key(), oldClients)
 		for _, c := range oldClients {
 			err := c.KickOnlineMessage()
 			if err != nil {
 				log.ZWarn(c.ctx, "KickOnlineMessage", err)
 			}
 		}
-		ctx := mcontext.WithMustInfoCtx(
+		ctx := tenant.WithID(mcontext.WithMustInfoCtx(
 			[]string{newClient.ctx.GetOperationID(), newClient.ctx.GetUserID(),
 				constant.PlatformIDToName(newClient.PlatformID), newClient.ctx.GetConnID()},
-		)
+		), newClient.TenantID)
 		if _, err := ws.authClient.InvalidateToken(ctx, newClient.token, newClient.UserID, newClient.PlatformID); err != nil {
 			log.ZWarn(newClient.ctx, "InvalidateToken err", err, "userID", newClient.UserID,
 				"platformID", newClient.PlatformID)
@@ -352,7 +353,7 @@ func (ws *WsServer) multiTerminalLoginChecker(clientOK bool, oldClients []*Clien
 
 func (ws *WsServer) unregisterClient(client *Client) {
 	defer ws.clientPool.Put(client)
-	isDeleteUser := ws.clients.DeleteClients(client.UserID, []*Client{client})
+	isDeleteUser := ws.clients.DeleteClients(client.key(), []*Client{client})
 	if isDeleteUser {
 		ws.onlineUserNum.Add(-1)
 		prommetrics.OnlineUserGauge.Dec()
@@ -0,0 +1,124 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgtransfer
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/mq"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/msgindex"
+	pbmsg "github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"google.golang.org/protobuf/proto"
+)
+
+// ReplayDeadLetter writes the batches parked in the to-mongo dead-letter topic back to
+// the message database. It stops once no message arrived for idle, or at the first batch
+// that still cannot be written, which stays uncommitted for the next run. It returns the
+// number of replayed batches.
+func ReplayDeadLetter(ctx context.Context, config *Config, idle time.Duration) (int, error) {
+	if config.KafkaConfig.ToMongoDeadLetterTopic == "" {
+		return 0, errs.ErrArgs.WrapMsg("toMongoDeadLetterTopic is not configured")
+	}
+	dbb, msgDatabase, mqBuilder, err := newMsgDatabase(ctx, config)
+	if err != nil {
+		return 0, err
+	}
+	indexer, err := msgindex.NewMessageIndexer(&config.SearchConfig, dbb.MongoDB())
+	if err != nil {
+		return 0, err
+	}
+	mc := newMongoPersister(&config.MsgTransfer, &config.SearchConfig, msgDatabase, indexer)
+	defer mc.closeIndex()
+	if mc.indexBatches != nil {
+		if err := mc.indexBatches.Start(); err != nil {
+			return 0, err
+		}
+	}
+	consumer, err := mqBuilder.GetTopicConsumer(config.KafkaConfig.ToMongoDeadLetterTopic,
+		config.KafkaConfig.ToMongoDeadLetterGroupID, mq.WithOldest())
+	if err != nil {
+		return 0, err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var (
+		replayed atomic.Int64
+		activity = make(chan struct{}, 1)
+		failed   = make(chan error, 1)
+		done     = make(chan error, 1)
+	)
+	replay := func(sessCtx context.Context, msg *mq.Message) error {
+		select {
+		case activity <- struct{}{}:
+		default:
+		}
+		msgCtx := msg.Context()
+		var msgFromMQ pbmsg.MsgDataToMongoByMQ
+		if err := proto.Unmarshal(msg.Value, &msgFromMQ); err != nil || len(msgFromMQ.MsgData) == 0 {
+			log.ZError(msgCtx, "skip invalid dead-letter msg", err, "key", msg.Key)
+			msg.Mark()
+			return nil
+		}
+		if err := mc.persist(msgCtx, sessCtx, &msgFromMQ); err != nil {
+			select {
+			case failed <- err:
+			default:
+			}
+			return err
+		}
+		replayed.Add(1)
+		log.ZInfo(msgCtx, "dead-letter msg replayed", "conversationID", msgFromMQ.ConversationID,
+			"firstSeq", msgFromMQ.MsgData[0].Seq, "lastSeq", msgFromMQ.LastSeq)
+		msg.Mark()
+		return nil
+	}
+	go func() {
+		done <- consumer.Subscribe(ctx, replay)
+	}()
+	timer := time.NewTimer(idle)
+	defer timer.Stop()
+	var replayErr error
+loop:
+	for {
+		select {
+		case <-activity:
+			if !timer.Stop() {
+				<-timer.C
+			}
+			timer.Reset(idle)
+		case <-timer.C:
+			break loop
+		case replayErr = <-failed:
+			break loop
+		case err := <-done:
+			if err != nil && ctx.Err() == nil {
+				replayErr = err
+			}
+			break loop
+		case <-ctx.Done():
+			replayErr = ctx.Err()
+			break loop
+		}
+	}
+	cancel()
+	if err := consumer.Close(); err != nil {
+		log.ZWarn(ctx, "close dead-letter consumer failed", err)
+	}
+	return int(replayed.Load()), replayErr
+}
@@ -18,10 +18,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/mq"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/redis"
-	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database/mgo"
-	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/dbbuild"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/msgindex"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/tools/db/redisutil"
 	"github.com/openimsdk/tools/utils/datautil"
 	"net/http"
@@ -56,20 +58,19 @@ type Config struct {
 	MsgTransfer    config.MsgTransfer
 	RedisConfig    config.Redis
 	MongodbConfig  config.Mongo
+	DatabaseConfig config.Database
 	KafkaConfig    config.Kafka
+	MQConfig       config.MQ
 	Share          config.Share
 	WebhooksConfig config.Webhooks
+	SearchConfig   config.Search
 	Discovery      config.Discovery
 }
 
 func Start(ctx context.Context, index int, config *Config) error {
 	log.CInfo(ctx, "MSG-TRANSFER server is initializing", "prometheusPorts",
 		config.MsgTransfer.Prometheus.Ports, "index", index)
-	mgocli, err := mongoutil.NewMongoDB(ctx, config.MongodbConfig.Build())
-	if err != nil {
-		return err
-	}
-	rdb, err := redisutil.NewRedisClient(ctx, config.RedisConfig.Build())
+	dbb, msgDatabase, mqBuilder, err := newMsgDatabase(ctx, config)
 	if err != nil {
 		return err
 	}
@@ -79,40 +80,61 @@ func Start(ctx context.Context, index int, config *Config) error {
 	}
 	client.AddOption(mw.GrpcClient(), grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithDefaultServiceConfig(fmt.Sprintf(`{"LoadBalancingPolicy": "%s"}`, "round_robin")))
-	msgModel := redis.NewMsgCache(rdb)
-	msgDocModel, err := mgo.NewMsgMongo(mgocli.GetDB())
+	tenant.SetResolver(rpcclient.NewTenantResolver(client, config.Share.RpcRegisterName.User, config.Share.IMAdminUserID))
+	conversationRpcClient := rpcclient.NewConversationRpcClient(client, config.Share.RpcRegisterName.Conversation)
+	groupRpcClient := rpcclient.NewGroupRpcClient(client, config.Share.RpcRegisterName.Group)
+	historyCH, err := NewOnlineHistoryRedisConsumerHandler(&config.KafkaConfig, mqBuilder, msgDatabase, &conversationRpcClient, &groupRpcClient)
 	if err != nil {
 		return err
 	}
-	seqConversation, err := mgo.NewSeqConversationMongo(mgocli.GetDB())
+	indexer, err := msgindex.NewMessageIndexer(&config.SearchConfig, dbb.MongoDB())
 	if err != nil {
 		return err
 	}
-	seqConversationCache := redis.NewSeqConversationCacheRedis(rdb, seqConversation)
-	seqUser, err := mgo.NewSeqUserMongo(mgocli.GetDB())
+	historyMongoCH, err := NewOnlineHistoryMongoConsumerHandler(&config.KafkaConfig, mqBuilder, &config.MsgTransfer, &config.SearchConfig, msgDatabase, indexer)
 	if err != nil {
 		return err
 	}
-	seqUserCache := redis.NewSeqUserCacheRedis(rdb, seqUser)
-	msgDatabase, err := controller.NewCommonMsgDatabase(msgDocModel, msgModel, seqUserCache, seqConversationCache, &config.KafkaConfig)
+	msgTransfer := &MsgTransfer{
+		historyCH:      historyCH,
+		historyMongoCH: historyMongoCH,
+	}
+	return msgTransfer.Start(index, config)
+}
+
+func newMsgDatabase(ctx context.Context, config *Config) (dbbuild.Builder, controller.CommonMsgDatabase, mq.Builder, error) {
+	dbb, err := dbbuild.NewBuilder(ctx, &config.DatabaseConfig, &config.MongodbConfig)
 	if err != nil {
-		return err
+		return nil, nil, nil, err
 	}
-	conversationRpcClient := rpcclient.NewConversationRpcClient(client, config.Share.RpcRegisterName.Conversation)
-	groupRpcClient := rpcclient.NewGroupRpcClient(client, config.Share.RpcRegisterName.Group)
-	historyCH, err := NewOnlineHistoryRedisConsumerHandler(&config.KafkaConfig, msgDatabase, &conversationRpcClient, &groupRpcClient)
+	rdb, err := redisutil.NewRedisClient(ctx, config.RedisConfig.Build())
 	if err != nil {
-		return err
+		return nil, nil, nil, err
 	}
-	historyMongoCH, err := NewOnlineHistoryMongoConsumerHandler(&config.KafkaConfig, msgDatabase)
+	msgModel := redis.NewMsgCache(rdb)
+	msgDocModel, err := dbb.Msg()
 	if err != nil {
-		return err
+		return nil, nil, nil, err
 	}
-	msgTransfer := &MsgTransfer{
-		historyCH:      historyCH,
-		historyMongoCH: historyMongoCH,
+	seqConversation, err := dbb.SeqConversation()
+	if err != nil {
+		return nil, nil, nil, err
 	}
-	return msgTransfer.Start(index, config)
+	seqConversationCache := redis.NewSeqConversationCacheRedis(rdb, seqConversation)
+	seqUser, err := dbb.SeqUser()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	seqUserCache := redis.NewSeqUserCacheRedis(rdb, seqUser)
+	mqBuilder, err := mq.NewBuilder(&config.MQConfig, &config.KafkaConfig, rdb)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	msgDatabase, err := controller.NewCommonMsgDatabase(msgDocModel, msgModel, seqUserCache, seqConversationCache, &config.KafkaConfig, mqBuilder, nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return dbb, msgDatabase, mqBuilder, nil
 }
 
 func (m *MsgTransfer) Start(index int, config *Config) error {
@@ -122,12 +144,17 @@ func (m *MsgTransfer) Start(index int, config *Config) error {
 		netErr  error
 	)
 
-	go m.historyCH.historyConsumerGroup.RegisterHandleAndConsumer(m.ctx, m.historyCH)
-	go m.historyMongoCH.historyConsumerGroup.RegisterHandleAndConsumer(m.ctx, m.historyMongoCH)
+	go m.historyCH.historyConsumerGroup.Subscribe(m.ctx, m.historyCH.consume)
+	go m.historyMongoCH.historyConsumerGroup.Subscribe(m.ctx, m.historyMongoCH.consume)
 	err := m.historyCH.redisMessageBatches.Start()
 	if err != nil {
 		return err
 	}
+	if m.historyMongoCH.indexBatches != nil {
+		if err := m.historyMongoCH.indexBatches.Start(); err != nil {
+			return err
+		}
+	}
 
 	if config.MsgTransfer.Prometheus.Enable {
 		go func() {
@@ -150,17 +177,19 @@ func (m *MsgTransfer) Start(index int, config *Config) error {
 	select {
 	case <-sigs:
 		program.SIGTERMExit()
-		// graceful close kafka client.
+		// graceful close mq client.
 		m.cancel()
 		m.historyCH.redisMessageBatches.Close()
 		m.historyCH.historyConsumerGroup.Close()
 		m.historyMongoCH.historyConsumerGroup.Close()
+		m.historyMongoCH.closeIndex()
 		return nil
 	case <-netDone:
 		m.cancel()
 		m.historyCH.redisMessageBatches.Close()
 		m.historyCH.historyConsumerGroup.Close()
 		m.historyMongoCH.historyConsumerGroup.Close()
+		m.historyMongoCH.closeIndex()
 		close(netDone)
 		return netErr
 	}
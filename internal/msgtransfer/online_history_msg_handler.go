@@ -17,9 +17,9 @@ package msgtransfer
 import (
 	"context"
 	"errors"
-	"github.com/IBM/sarama"
 	"github.com/go-redis/redis"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/mq"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
 	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
@@ -29,11 +29,8 @@ import (
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/log"
 	"github.com/openimsdk/tools/mcontext"
-	"github.com/openimsdk/tools/mq/kafka"
 	"github.com/openimsdk/tools/utils/stringutil"
 	"google.golang.org/protobuf/proto"
-	"strconv"
-	"strings"
 	"time"
 )
 
@@ -51,25 +48,25 @@ type ContextMsg struct {
 }
 
 type OnlineHistoryRedisConsumerHandler struct {
-	historyConsumerGroup *kafka.MConsumerGroup
+	historyConsumerGroup mq.Consumer
 
-	redisMessageBatches *batcher.Batcher[sarama.ConsumerMessage]
+	redisMessageBatches *batcher.Batcher[mq.Message]
 
 	msgDatabase           controller.CommonMsgDatabase
 	conversationRpcClient *rpcclient.ConversationRpcClient
 	groupRpcClient        *rpcclient.GroupRpcClient
 }
 
-func NewOnlineHistoryRedisConsumerHandler(kafkaConf *config.Kafka, database controller.CommonMsgDatabase,
+func NewOnlineHistoryRedisConsumerHandler(kafkaConf *config.Kafka, mqBuilder mq.Builder, database controller.CommonMsgDatabase,
 	conversationRpcClient *rpcclient.ConversationRpcClient, groupRpcClient *rpcclient.GroupRpcClient) (*OnlineHistoryRedisConsumerHandler, error) {
-	historyConsumerGroup, err := kafka.NewMConsumerGroup(kafkaConf.Build(), kafkaConf.ToRedisGroupID, []string{kafkaConf.ToRedisTopic}, false)
+	historyConsumerGroup, err := mqBuilder.GetTopicConsumer(kafkaConf.ToRedisTopic, kafkaConf.ToRedisGroupID, mq.WithManualCommit())
 	if err != nil {
 		return nil, err
 	}
 	var och OnlineHistoryRedisConsumerHandler
 	och.msgDatabase = database
 
-	b := batcher.New[sarama.ConsumerMessage](
+	b := batcher.New[mq.Message](
 		batcher.WithSize(size),
 		batcher.WithWorker(worker),
 		batcher.WithInterval(interval),
@@ -81,17 +78,20 @@ func NewOnlineHistoryRedisConsumerHandler(kafkaConf *config.Kafka, database cont
 		hashCode := stringutil.GetHashCode(key)
 		return int(hashCode) % och.redisMessageBatches.Worker()
 	}
-	b.Key = func(consumerMessage *sarama.ConsumerMessage) string {
-		return string(consumerMessage.Key)
+	b.Key = func(consumerMessage *mq.Message) string {
+		return consumerMessage.Key
 	}
 	b.Do = och.do
+	b.OnComplete = func(lastMessage *mq.Message, totalCount int) {
+		lastMessage.Mark()
+	}
 	och.redisMessageBatches = b
 	och.conversationRpcClient = conversationRpcClient
 	och.groupRpcClient = groupRpcClient
 	och.historyConsumerGroup = historyConsumerGroup
 	return &och, err
 }
-func (och *OnlineHistoryRedisConsumerHandler) do(ctx context.Context, channelID int, val *batcher.Msg[sarama.ConsumerMessage]) {
+func (och *OnlineHistoryRedisConsumerHandler) do(ctx context.Context, channelID int, val *batcher.Msg[mq.Message]) {
 	ctx = mcontext.WithTriggerIDContext(ctx, val.TriggerID())
 	ctxMessages := och.parseConsumerMessages(ctx, val.Val())
 	ctx = withAggregationCtx(ctx, ctxMessages)
@@ -110,7 +110,7 @@ func (och *OnlineHistoryRedisConsumerHandler) do(ctx context.Context, channelID
 	och.handleNotification(ctx, val.Key(), conversationIDNotification, storageNotificationList, notStorageNotificationList)
 }
 
-func (och *OnlineHistoryRedisConsumerHandler) parseConsumerMessages(ctx context.Context, consumerMessages []*sarama.ConsumerMessage) []*ContextMsg {
+func (och *OnlineHistoryRedisConsumerHandler) parseConsumerMessages(ctx context.Context, consumerMessages []*mq.Message) []*ContextMsg {
 	var ctxMessages []*ContextMsg
 	for i := 0; i < len(consumerMessages); i++ {
 		ctxMsg := &ContextMsg{}
@@ -120,16 +120,10 @@ func (och *OnlineHistoryRedisConsumerHandler) parseConsumerMessages(ctx context.
 			log.ZWarn(ctx, "msg_transfer Unmarshal msg err", err, string(consumerMessages[i].Value))
 			continue
 		}
-		var arr []string
-		for i, header := range consumerMessages[i].Headers {
-			arr = append(arr, strconv.Itoa(i), string(header.Key), string(header.Value))
-		}
-		log.ZDebug(ctx, "consumer.kafka.GetContextWithMQHeader", "len", len(consumerMessages[i].Headers),
-			"header", strings.Join(arr, ", "))
-		ctxMsg.ctx = kafka.GetContextWithMQHeader(consumerMessages[i].Headers)
+		ctxMsg.ctx = consumerMessages[i].Context()
 		ctxMsg.message = msgFromMQ
 		log.ZDebug(ctx, "message parse finish", "message", msgFromMQ, "key",
-			string(consumerMessages[i].Key))
+			consumerMessages[i].Key)
 		ctxMessages = append(ctxMessages, ctxMsg)
 	}
 	return ctxMessages
@@ -181,6 +175,7 @@ func (och *OnlineHistoryRedisConsumerHandler) categorizeMessageLists(totalMsgs [
 
 func (och *OnlineHistoryRedisConsumerHandler) handleMsg(ctx context.Context, key, conversationID string, storageList, notStorageList []*ContextMsg) {
 	och.toPushTopic(ctx, key, conversationID, notStorageList)
+	storageList = och.dropSentMsgs(ctx, storageList)
 	var storageMessageList []*sdkws.MsgData
 	for _, msg := range storageList {
 		storageMessageList = append(storageMessageList, msg.message)
@@ -226,8 +221,51 @@ func (och *OnlineHistoryRedisConsumerHandler) handleMsg(ctx context.Context, key
 			log.ZError(ctx, "Msg To MongoDB MQ error", err, "conversationID",
 				conversationID, "storageList", storageMessageList, "lastSeq", lastSeq)
 		}
-		och.toPushTopic(ctx, key, conversationID, storageList)
+		pushErr := och.toPushTopic(ctx, key, conversationID, storageList)
+		// The seqs make a redelivered batch be dropped, so they are only recorded once the
+		// batch is on its way to MongoDB and push; otherwise a redelivery sends it again.
+		if err == nil && pushErr == nil {
+			if err := och.msgDatabase.SetSendMsgSeqs(ctx, storageMessageList); err != nil {
+				log.ZWarn(ctx, "record send msg seqs failed", err, "conversationID", conversationID)
+			}
+		}
+	}
+}
+
+// dropSentMsgs removes messages whose ClientMsgID already got a seq inside the idempotency
+// window of the msg rpc, which happens when the MQ delivers a stored batch again, and
+// repeated ClientMsgIDs of one sender within the batch.
+func (och *OnlineHistoryRedisConsumerHandler) dropSentMsgs(ctx context.Context, msgs []*ContextMsg) []*ContextMsg {
+	if len(msgs) == 0 {
+		return msgs
+	}
+	data := make([]*sdkws.MsgData, 0, len(msgs))
+	for _, msg := range msgs {
+		data = append(data, msg.message)
+	}
+	seqs, err := och.msgDatabase.GetSendMsgSeqs(ctx, data)
+	if err != nil {
+		log.ZWarn(ctx, "get send msg seqs failed", err)
+		seqs = make([]int64, len(msgs))
+	}
+	seen := make(map[string]struct{}, len(msgs))
+	res := make([]*ContextMsg, 0, len(msgs))
+	for i, msg := range msgs {
+		if seqs[i] > 0 {
+			log.ZWarn(msg.ctx, "drop duplicate msg", nil, "clientMsgID", msg.message.ClientMsgID, "seq", seqs[i])
+			continue
+		}
+		if msg.message.ClientMsgID != "" {
+			key := msg.message.SendID + ":" + msg.message.ClientMsgID
+			if _, ok := seen[key]; ok {
+				log.ZWarn(msg.ctx, "drop duplicate msg in batch", nil, "clientMsgID", msg.message.ClientMsgID)
+				continue
+			}
+			seen[key] = struct{}{}
+		}
+		res = append(res, msg)
 	}
+	return res
 }
 
 func (och *OnlineHistoryRedisConsumerHandler) handleNotification(ctx context.Context, key, conversationID string,
@@ -254,10 +292,15 @@ func (och *OnlineHistoryRedisConsumerHandler) handleNotification(ctx context.Con
 	}
 }
 
-func (och *OnlineHistoryRedisConsumerHandler) toPushTopic(_ context.Context, key, conversationID string, msgs []*ContextMsg) {
+// toPushTopic sends the messages to the push topic and returns the last error.
+func (och *OnlineHistoryRedisConsumerHandler) toPushTopic(_ context.Context, key, conversationID string, msgs []*ContextMsg) error {
+	var err error
 	for _, v := range msgs {
-		och.msgDatabase.MsgToPushMQ(v.ctx, key, conversationID, v.message)
+		if e := och.msgDatabase.MsgToPushMQ(v.ctx, key, conversationID, v.message); e != nil {
+			err = e
+		}
 	}
+	return err
 }
 
 func withAggregationCtx(ctx context.Context, values []*ContextMsg) context.Context {
@@ -274,35 +317,12 @@ func withAggregationCtx(ctx context.Context, values []*ContextMsg) context.Conte
 	return mcontext.SetOperationID(ctx, allMessageOperationID)
 }
 
-func (och *OnlineHistoryRedisConsumerHandler) Setup(_ sarama.ConsumerGroupSession) error { return nil }
-func (och *OnlineHistoryRedisConsumerHandler) Cleanup(_ sarama.ConsumerGroupSession) error {
-	return nil
-}
-
-func (och *OnlineHistoryRedisConsumerHandler) ConsumeClaim(session sarama.ConsumerGroupSession,
-	claim sarama.ConsumerGroupClaim) error { // a instance in the consumer group
-	log.ZInfo(context.Background(), "online new session msg come", "highWaterMarkOffset",
-		claim.HighWaterMarkOffset(), "topic", claim.Topic(), "partition", claim.Partition())
-	och.redisMessageBatches.OnComplete = func(lastMessage *sarama.ConsumerMessage, totalCount int) {
-		session.MarkMessage(lastMessage, "")
-		session.Commit()
+func (och *OnlineHistoryRedisConsumerHandler) consume(ctx context.Context, msg *mq.Message) error {
+	if len(msg.Value) == 0 {
+		return nil
 	}
-	for {
-		select {
-		case msg, ok := <-claim.Messages():
-			if !ok {
-				return nil
-			}
-
-			if len(msg.Value) == 0 {
-				continue
-			}
-			err := och.redisMessageBatches.Put(context.Background(), msg)
-			if err != nil {
-				log.ZWarn(context.Background(), "put msg to  error", err, "msg", msg)
-			}
-		case <-session.Context().Done():
-			return nil
-		}
+	if err := och.redisMessageBatches.Put(ctx, msg); err != nil {
+		log.ZWarn(ctx, "put msg to  error", err, "key", msg.Key)
 	}
+	return nil
 }
@@ -16,98 +16,251 @@ package msgtransfer
 
 import (
 	"context"
+	"time"
 
-	"github.com/IBM/sarama"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/mq"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/msgindex"
+	"github.com/openimsdk/open-im-server/v3/pkg/tools/batcher"
 	pbmsg "github.com/openimsdk/protocol/msg"
 	"github.com/openimsdk/tools/log"
-	"github.com/openimsdk/tools/mq/kafka"
+	"github.com/openimsdk/tools/utils/stringutil"
 	"google.golang.org/protobuf/proto"
 )
 
+const defaultRetryInterval = 200 * time.Millisecond
+
 type OnlineHistoryMongoConsumerHandler struct {
-	historyConsumerGroup *kafka.MConsumerGroup
+	historyConsumerGroup mq.Consumer
 	msgDatabase          controller.CommonMsgDatabase
+	// indexer is nil when the message search index is disabled.
+	indexer      msgindex.MessageIndexer
+	indexBatches *batcher.Batcher[msgindex.Document]
+	// deadLetter is nil when no dead-letter topic is configured, failing batches then block
+	// their partition until they are written.
+	deadLetter mq.Producer
+	retry      retryPolicy
+}
+
+// retryPolicy bounds the attempts to write one batch to MongoDB.
+type retryPolicy struct {
+	maxRetries  int
+	interval    time.Duration
+	maxInterval time.Duration
+}
+
+func newRetryPolicy(conf *config.MsgTransfer) retryPolicy {
+	r := retryPolicy{
+		maxRetries:  conf.ToMongo.MaxRetries,
+		interval:    time.Duration(conf.ToMongo.RetryInterval) * time.Millisecond,
+		maxInterval: time.Duration(conf.ToMongo.MaxRetryInterval) * time.Millisecond,
+	}
+	if r.interval <= 0 {
+		r.interval = defaultRetryInterval
+	}
+	if r.maxInterval < r.interval {
+		r.maxInterval = r.interval
+	}
+	return r
 }
 
-func NewOnlineHistoryMongoConsumerHandler(kafkaConf *config.Kafka, database controller.CommonMsgDatabase) (*OnlineHistoryMongoConsumerHandler, error) {
-	historyConsumerGroup, err := kafka.NewMConsumerGroup(kafkaConf.Build(), kafkaConf.ToMongoGroupID, []string{kafkaConf.ToMongoTopic}, true)
+// wait sleeps before the given retry and reports false when ctx ends first.
+func (r retryPolicy) wait(ctx context.Context, retry int) bool {
+	interval := r.interval
+	for i := 1; i < retry && interval < r.maxInterval; i++ {
+		interval *= 2
+	}
+	timer := time.NewTimer(min(interval, r.maxInterval))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+func NewOnlineHistoryMongoConsumerHandler(kafkaConf *config.Kafka, mqBuilder mq.Builder, transferConf *config.MsgTransfer, searchConf *config.Search,
+	database controller.CommonMsgDatabase, indexer msgindex.MessageIndexer) (*OnlineHistoryMongoConsumerHandler, error) {
+	historyConsumerGroup, err := mqBuilder.GetTopicConsumer(kafkaConf.ToMongoTopic, kafkaConf.ToMongoGroupID)
 	if err != nil {
 		return nil, err
 	}
+	mc := newMongoPersister(transferConf, searchConf, database, indexer)
+	mc.historyConsumerGroup = historyConsumerGroup
+	if kafkaConf.ToMongoDeadLetterTopic != "" {
+		mc.deadLetter, err = mqBuilder.GetTopicProducer(kafkaConf.ToMongoDeadLetterTopic)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return mc, nil
+}
 
+// newMongoPersister creates a handler without a consumer group, shared by the online
+// consumer and the dead-letter replay.
+func newMongoPersister(transferConf *config.MsgTransfer, searchConf *config.Search, database controller.CommonMsgDatabase,
+	indexer msgindex.MessageIndexer) *OnlineHistoryMongoConsumerHandler {
 	mc := &OnlineHistoryMongoConsumerHandler{
-		historyConsumerGroup: historyConsumerGroup,
-		msgDatabase:          database,
+		msgDatabase: database,
+		indexer:     indexer,
+		retry:       newRetryPolicy(transferConf),
+	}
+	if indexer != nil {
+		size := searchConf.BatchSize
+		if size <= 0 {
+			size = batcher.DefaultSize
+		}
+		b := batcher.New[msgindex.Document](batcher.WithSize(size), batcher.WithInterval(interval))
+		b.Sharding = func(key string) int {
+			hashCode := stringutil.GetHashCode(key)
+			return int(hashCode) % b.Worker()
+		}
+		b.Key = func(doc *msgindex.Document) string {
+			return doc.ConversationID
+		}
+		b.Do = mc.index
+		mc.indexBatches = b
+	}
+	return mc
+}
+
+// index writes a batch of one conversation to the search index. Failures only make the
+// messages unsearchable, so they are logged and not retried.
+func (mc *OnlineHistoryMongoConsumerHandler) index(ctx context.Context, channelID int, val *batcher.Msg[msgindex.Document]) {
+	if err := mc.indexer.Index(ctx, val.Val()); err != nil {
+		log.ZError(ctx, "index msg failed", err, "conversationID", val.Key(), "len", len(val.Val()))
 	}
-	return mc, nil
 }
 
-func (mc *OnlineHistoryMongoConsumerHandler) handleChatWs2Mongo(ctx context.Context, cMsg *sarama.ConsumerMessage, key string, session sarama.ConsumerGroupSession) {
-	msg := cMsg.Value
+func (mc *OnlineHistoryMongoConsumerHandler) putIndex(ctx context.Context, msgFromMQ *pbmsg.MsgDataToMongoByMQ) {
+	if mc.indexBatches == nil {
+		return
+	}
+	for _, msg := range msgFromMQ.MsgData {
+		doc := msgindex.NewDocument(msgFromMQ.ConversationID, msg)
+		if doc == nil || doc.Text == "" {
+			continue
+		}
+		if err := mc.indexBatches.Put(ctx, doc); err != nil {
+			log.ZWarn(ctx, "put index doc failed", err, "conversationID", msgFromMQ.ConversationID, "seq", msg.Seq)
+		}
+	}
+}
+
+// closeIndex flushes the pending index batches and releases the indexer.
+func (mc *OnlineHistoryMongoConsumerHandler) closeIndex() {
+	if mc.indexer == nil {
+		return
+	}
+	mc.indexBatches.Close()
+	if err := mc.indexer.Close(); err != nil {
+		log.ZWarn(context.Background(), "close msg indexer failed", err)
+	}
+}
+
+// handleChatWs2Mongo persists one batch and reports whether its offset may be committed.
+// A batch is only removed from the Redis cache once it is in MongoDB; batches that keep
+// failing are parked in the dead-letter topic, or retried until they are written when there
+// is none. When the session ends first the batch is left uncommitted and consumed again
+// after the rebalance.
+func (mc *OnlineHistoryMongoConsumerHandler) handleChatWs2Mongo(sessCtx context.Context, cMsg *mq.Message) bool {
+	ctx, key, msg := cMsg.Context(), cMsg.Key, cMsg.Value
 	msgFromMQ := pbmsg.MsgDataToMongoByMQ{}
 	err := proto.Unmarshal(msg, &msgFromMQ)
 	if err != nil {
 		log.ZError(ctx, "unmarshall failed", err, "key", key, "len", len(msg))
-		return
+		return true
 	}
 	if len(msgFromMQ.MsgData) == 0 {
-		log.ZError(ctx, "msgFromMQ.MsgData is empty", nil, "cMsg", cMsg)
-		return
+		log.ZError(ctx, "msgFromMQ.MsgData is empty", nil, "key", key)
+		return true
 	}
 	log.ZInfo(ctx, "mongo consumer recv msg", "msgs", msgFromMQ.String())
-	err = mc.msgDatabase.BatchInsertChat2DB(ctx, msgFromMQ.ConversationID, msgFromMQ.MsgData, msgFromMQ.LastSeq)
-	if err != nil {
-		log.ZError(
-			ctx,
-			"single data insert to mongo err",
-			err,
-			"msg",
-			msgFromMQ.MsgData,
-			"conversationID",
-			msgFromMQ.ConversationID,
-		)
-		prommetrics.MsgInsertMongoFailedCounter.Inc()
-	} else {
-		prommetrics.MsgInsertMongoSuccessCounter.Inc()
-	}
-	var seqs []int64
+	err = mc.persist(ctx, sessCtx, &msgFromMQ)
+	if err == nil {
+		return true
+	}
+	if sessCtx.Err() != nil {
+		return false
+	}
+	if mc.deadLetter == nil {
+		return mc.persistBlocking(ctx, sessCtx, &msgFromMQ)
+	}
+	for retry := 1; ; retry++ {
+		err := mc.deadLetter.SendMessage(ctx, key, &msgFromMQ)
+		if err == nil {
+			prommetrics.MsgToMongoDeadLetterCounter.Inc()
+			log.ZWarn(ctx, "msg sent to the to-mongo dead-letter topic", nil, "conversationID", msgFromMQ.ConversationID,
+				"firstSeq", msgFromMQ.MsgData[0].Seq, "lastSeq", msgFromMQ.LastSeq)
+			return true
+		}
+		log.ZError(ctx, "send msg to dead-letter topic failed", err, "conversationID", msgFromMQ.ConversationID, "retry", retry)
+		if !mc.retry.wait(sessCtx, retry) {
+			return false
+		}
+	}
+}
+
+// persistBlocking keeps retrying a batch that could not be written, holding back the rest of
+// its partition, until it is written or the session ends.
+func (mc *OnlineHistoryMongoConsumerHandler) persistBlocking(ctx context.Context, sessCtx context.Context, msgFromMQ *pbmsg.MsgDataToMongoByMQ) bool {
+	for retry := 1; ; retry++ {
+		log.ZError(ctx, "msg not written to mongo and no dead-letter topic, blocking the partition", nil,
+			"conversationID", msgFromMQ.ConversationID, "firstSeq", msgFromMQ.MsgData[0].Seq, "lastSeq", msgFromMQ.LastSeq, "retry", retry)
+		if !mc.retry.wait(sessCtx, mc.retry.maxRetries+retry) {
+			return false
+		}
+		if err := mc.persist(ctx, sessCtx, msgFromMQ); err == nil {
+			return true
+		}
+		if sessCtx.Err() != nil {
+			return false
+		}
+	}
+}
+
+// persist writes a batch to MongoDB with bounded retries, then drops it from the Redis
+// cache and indexes it. sessCtx aborts the retries.
+func (mc *OnlineHistoryMongoConsumerHandler) persist(ctx context.Context, sessCtx context.Context, msgFromMQ *pbmsg.MsgDataToMongoByMQ) error {
+	for retry := 0; ; retry++ {
+		err := mc.msgDatabase.BatchInsertChat2DB(ctx, msgFromMQ.ConversationID, msgFromMQ.MsgData, msgFromMQ.LastSeq)
+		if err == nil {
+			break
+		}
+		if retry >= mc.retry.maxRetries {
+			log.ZError(ctx, "single data insert to mongo err", err, "msg", msgFromMQ.MsgData,
+				"conversationID", msgFromMQ.ConversationID, "retries", retry)
+			prommetrics.MsgInsertMongoFailedCounter.Inc()
+			return err
+		}
+		log.ZWarn(ctx, "insert msg to mongo failed, retrying", err, "conversationID", msgFromMQ.ConversationID, "retry", retry+1)
+		if !mc.retry.wait(sessCtx, retry+1) {
+			return err
+		}
+	}
+	prommetrics.MsgInsertMongoSuccessCounter.Inc()
+	mc.putIndex(ctx, msgFromMQ)
+	seqs := make([]int64, 0, len(msgFromMQ.MsgData))
 	for _, msg := range msgFromMQ.MsgData {
 		seqs = append(seqs, msg.Seq)
 	}
-	err = mc.msgDatabase.DeleteMessagesFromCache(ctx, msgFromMQ.ConversationID, seqs)
-	if err != nil {
-		log.ZError(
-			ctx,
-			"remove cache msg from redis err",
-			err,
-			"msg",
-			msgFromMQ.MsgData,
-			"conversationID",
-			msgFromMQ.ConversationID,
-		)
+	if err := mc.msgDatabase.DeleteMessagesFromCache(ctx, msgFromMQ.ConversationID, seqs); err != nil {
+		log.ZError(ctx, "remove cache msg from redis err", err, "msg", msgFromMQ.MsgData, "conversationID", msgFromMQ.ConversationID)
 	}
+	return nil
 }
 
-func (*OnlineHistoryMongoConsumerHandler) Setup(_ sarama.ConsumerGroupSession) error   { return nil }
-func (*OnlineHistoryMongoConsumerHandler) Cleanup(_ sarama.ConsumerGroupSession) error { return nil }
-
-func (mc *OnlineHistoryMongoConsumerHandler) ConsumeClaim(
-	sess sarama.ConsumerGroupSession,
-	claim sarama.ConsumerGroupClaim,
-) error { // an instance in the consumer group
-	log.ZDebug(context.Background(), "online new session msg come", "highWaterMarkOffset",
-		claim.HighWaterMarkOffset(), "topic", claim.Topic(), "partition", claim.Partition())
-	for msg := range claim.Messages() {
-		ctx := mc.historyConsumerGroup.GetContextFromMsg(msg)
-		if len(msg.Value) != 0 {
-			mc.handleChatWs2Mongo(ctx, msg, string(msg.Key), sess)
-		} else {
-			log.ZError(ctx, "mongo msg get from kafka but is nil", nil, "conversationID", msg.Key)
-		}
-		sess.MarkMessage(msg, "")
+// consume stops the partition without committing when a batch was neither persisted
+// nor parked, which only happens once ctx is done.
+func (mc *OnlineHistoryMongoConsumerHandler) consume(ctx context.Context, msg *mq.Message) error {
+	if len(msg.Value) == 0 {
+		log.ZError(msg.Context(), "mongo msg get from mq but is nil", nil, "conversationID", msg.Key)
+	} else if !mc.handleChatWs2Mongo(ctx, msg) {
+		return ctx.Err()
 	}
+	msg.Mark()
 	return nil
 }
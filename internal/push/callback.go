@@ -23,6 +23,7 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/log"
 	"github.com/openimsdk/tools/mcontext"
 	"github.com/openimsdk/tools/utils/datautil"
 )
@@ -48,7 +49,7 @@ func (c *ConsumerHandler) webhookBeforeOfflinePush(ctx context.Context, before *
 			GroupID:         msg.GroupID,
 			ContentType:     msg.ContentType,
 			SessionType:     msg.SessionType,
-			AtUserIDs:       msg.AtUserIDList,
+			AtUserIDs:       c.atUserIDs(ctx, msg),
 			Content:         GetContent(msg),
 		}
 
@@ -88,7 +89,7 @@ func (c *ConsumerHandler) webhookBeforeOnlinePush(ctx context.Context, before *c
 			GroupID:     msg.GroupID,
 			ContentType: msg.ContentType,
 			SessionType: msg.SessionType,
-			AtUserIDs:   msg.AtUserIDList,
+			AtUserIDs:   c.atUserIDs(ctx, msg),
 			Content:     GetContent(msg),
 		}
 		resp := &callbackstruct.CallbackBeforePushResp{}
@@ -122,7 +123,7 @@ func (c *ConsumerHandler) webhookBeforeGroupOnlinePush(
 			GroupID:     groupID,
 			ContentType: msg.ContentType,
 			SessionType: msg.SessionType,
-			AtUserIDs:   msg.AtUserIDList,
+			AtUserIDs:   c.atUserIDs(ctx, msg),
 			Content:     GetContent(msg),
 			Seq:         msg.Seq,
 		}
@@ -137,6 +138,20 @@ func (c *ConsumerHandler) webhookBeforeGroupOnlinePush(
 	})
 }
 
+// atUserIDs returns the mentioned user IDs of msg with group member tag references
+// expanded to the tagged members, so webhooks choosing recipients see real users.
+func (c *ConsumerHandler) atUserIDs(ctx context.Context, msg *sdkws.MsgData) []string {
+	if msg.GroupID == "" || len(msg.AtUserIDList) == 0 {
+		return msg.AtUserIDList
+	}
+	userIDs, err := c.groupRpcClient.ExpandAtUserIDs(ctx, msg.GroupID, msg.AtUserIDList)
+	if err != nil {
+		log.ZWarn(ctx, "expand at user ids failed", err, "groupID", msg.GroupID, "atUserIDList", msg.AtUserIDList)
+		return msg.AtUserIDList
+	}
+	return userIDs
+}
+
 func GetContent(msg *sdkws.MsgData) string {
 	if msg.ContentType >= constant.NotificationBegin && msg.ContentType <= constant.NotificationEnd {
 		var notification sdkws.NotificationElem
@@ -0,0 +1,107 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package push
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/log"
+	"golang.org/x/sync/errgroup"
+)
+
+const defaultChannelPageSize = 1000
+
+// Push2Channel pushes a message of a channel group page by page over the subscriber shards,
+// so the full member list of a large channel is never loaded at once.
+func (c *ConsumerHandler) Push2Channel(ctx context.Context, groupID string, msg *sdkws.MsgData) error {
+	pageSize := c.config.RpcConfig.Channel.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultChannelPageSize
+	}
+	concurrency := c.config.RpcConfig.Channel.Concurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(concurrency)
+	for shard := int32(0); shard < groupext.ChannelSubscriberShards; shard++ {
+		shard := shard
+		g.Go(func() error {
+			return c.pushChannelShard(gctx, groupID, shard, int32(pageSize), msg)
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return err
+	}
+	// members who quit or were kicked are no longer subscribed but still get the notification
+	var userIDs []string
+	if err := c.groupNotificationHandler(ctx, groupID, &userIDs, msg); err != nil {
+		return err
+	}
+	if len(userIDs) == 0 {
+		return nil
+	}
+	return c.pushChannelUsers(ctx, groupID, msg, userIDs)
+}
+
+func (c *ConsumerHandler) pushChannelShard(ctx context.Context, groupID string, shard int32, pageSize int32, msg *sdkws.MsgData) error {
+	req := &groupext.GetChannelSubscribersReq{GroupID: groupID, Shard: shard, Count: pageSize}
+	for {
+		resp, err := c.groupRpcClient.ExtClient.GetChannelSubscribers(ctx, req)
+		if err != nil {
+			return err
+		}
+		if len(resp.UserIDs) > 0 {
+			if err := c.pushChannelUsers(ctx, groupID, msg, resp.UserIDs); err != nil {
+				return err
+			}
+		}
+		if int32(len(resp.UserIDs)) < pageSize {
+			return nil
+		}
+		req.AfterUserID = resp.UserIDs[len(resp.UserIDs)-1]
+	}
+}
+
+func (c *ConsumerHandler) pushChannelUsers(ctx context.Context, groupID string, msg *sdkws.MsgData, userIDs []string) error {
+	wsResults, err := c.GetConnsAndOnlinePush(ctx, msg, userIDs)
+	if err != nil {
+		return err
+	}
+	if !c.config.RpcConfig.Channel.OfflinePush || !c.shouldPushOffline(ctx, msg) {
+		return nil
+	}
+	needOfflinePushUserIDs := c.onlinePusher.GetOnlinePushFailedUserIDs(ctx, msg, wsResults, &userIDs)
+	needOfflinePushUserIDs, err = c.filterGroupMessageOfflinePush(ctx, groupID, msg, needOfflinePushUserIDs)
+	if err != nil {
+		return err
+	}
+	if len(needOfflinePushUserIDs) == 0 {
+		return nil
+	}
+	var offlinePushUserIDs []string
+	if err := c.webhookBeforeOfflinePush(ctx, &c.config.WebhooksConfig.BeforeOfflinePush, needOfflinePushUserIDs, msg, &offlinePushUserIDs); err != nil {
+		return err
+	}
+	if len(offlinePushUserIDs) > 0 {
+		needOfflinePushUserIDs = offlinePushUserIDs
+	}
+	if err := c.offlinePushMsg(ctx, msg, needOfflinePushUserIDs); err != nil {
+		log.ZWarn(ctx, "offlinePushMsg failed", err, "groupID", groupID, "msg", msg)
+	}
+	return nil
+}
@@ -24,6 +24,7 @@ type Config struct {
 	RedisConfig        config.Redis
 	MongodbConfig      config.Mongo
 	KafkaConfig        config.Kafka
+	MQConfig           config.MQ
 	NotificationConfig config.Notification
 	Share              config.Share
 	WebhooksConfig     config.Webhooks
@@ -67,6 +68,6 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 		offlinePusher: offlinePusher,
 		pushCh:        consumer,
 	})
-	go consumer.pushConsumerGroup.RegisterHandleAndConsumer(ctx, consumer)
+	go consumer.pushConsumerGroup.Subscribe(ctx, consumer.consume)
 	return nil
 }
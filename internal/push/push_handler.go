@@ -17,12 +17,14 @@ package push
 import (
 	"context"
 	"encoding/json"
-	"github.com/IBM/sarama"
 	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush"
 	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/options"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/mq"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/webhook"
 	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpccache"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/open-im-server/v3/pkg/util/conversationutil"
@@ -34,7 +36,6 @@ import (
 	"github.com/openimsdk/tools/discovery"
 	"github.com/openimsdk/tools/log"
 	"github.com/openimsdk/tools/mcontext"
-	"github.com/openimsdk/tools/mq/kafka"
 	"github.com/openimsdk/tools/utils/datautil"
 	"github.com/openimsdk/tools/utils/jsonutil"
 	"github.com/openimsdk/tools/utils/timeutil"
@@ -43,7 +44,7 @@ import (
 )
 
 type ConsumerHandler struct {
-	pushConsumerGroup      *kafka.MConsumerGroup
+	pushConsumerGroup      mq.Consumer
 	offlinePusher          offlinepush.OfflinePusher
 	onlinePusher           OnlinePusher
 	onlineCache            *rpccache.OnlineCache
@@ -60,8 +61,11 @@ func NewConsumerHandler(config *Config, offlinePusher offlinepush.OfflinePusher,
 	client discovery.SvcDiscoveryRegistry) (*ConsumerHandler, error) {
 	var consumerHandler ConsumerHandler
 	var err error
-	consumerHandler.pushConsumerGroup, err = kafka.NewMConsumerGroup(config.KafkaConfig.Build(), config.KafkaConfig.ToPushGroupID,
-		[]string{config.KafkaConfig.ToPushTopic}, true)
+	mqBuilder, err := mq.NewBuilder(&config.MQConfig, &config.KafkaConfig, rdb)
+	if err != nil {
+		return nil, err
+	}
+	consumerHandler.pushConsumerGroup, err = mqBuilder.GetTopicConsumer(config.KafkaConfig.ToPushTopic, config.KafkaConfig.ToPushGroupID)
 	if err != nil {
 		return nil, err
 	}
@@ -114,16 +118,9 @@ func (c *ConsumerHandler) handleMs2PsChat(ctx context.Context, msg []byte) {
 	}
 }
 
-func (*ConsumerHandler) Setup(sarama.ConsumerGroupSession) error { return nil }
-
-func (*ConsumerHandler) Cleanup(sarama.ConsumerGroupSession) error { return nil }
-
-func (c *ConsumerHandler) ConsumeClaim(sess sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
-	for msg := range claim.Messages() {
-		ctx := c.pushConsumerGroup.GetContextFromMsg(msg)
-		c.handleMs2PsChat(ctx, msg.Value)
-		sess.MarkMessage(msg, "")
-	}
+func (c *ConsumerHandler) consume(_ context.Context, msg *mq.Message) error {
+	c.handleMs2PsChat(msg.Context(), msg.Value)
+	msg.Mark()
 	return nil
 }
 
@@ -222,6 +219,15 @@ func (c *ConsumerHandler) Push2Group(ctx context.Context, groupID string, msg *s
 		&pushToUserIDs); err != nil {
 		return err
 	}
+	if len(pushToUserIDs) == 0 {
+		group, err := c.groupLocalCache.GetGroupInfo(ctx, groupID)
+		if err != nil {
+			return err
+		}
+		if group.GroupType == groupext.ChannelGroup {
+			return c.Push2Channel(ctx, groupID, msg)
+		}
+	}
 
 	err = c.groupMessagesHandler(ctx, groupID, &pushToUserIDs, msg)
 	if err != nil {
@@ -273,43 +279,50 @@ func (c *ConsumerHandler) groupMessagesHandler(ctx context.Context, groupID stri
 		if err != nil {
 			return err
 		}
-		switch msg.ContentType {
-		case constant.MemberQuitNotification:
-			var tips sdkws.MemberQuitTips
-			if unmarshalNotificationElem(msg.Content, &tips) != nil {
-				return err
-			}
-			if err = c.DeleteMemberAndSetConversationSeq(ctx, groupID, []string{tips.QuitUser.UserID}); err != nil {
-				log.ZError(ctx, "MemberQuitNotification DeleteMemberAndSetConversationSeq", err, "groupID", groupID, "userID", tips.QuitUser.UserID)
-			}
-			*pushToUserIDs = append(*pushToUserIDs, tips.QuitUser.UserID)
-		case constant.MemberKickedNotification:
-			var tips sdkws.MemberKickedTips
+		return c.groupNotificationHandler(ctx, groupID, pushToUserIDs, msg)
+	}
+	return err
+}
+
+// groupNotificationHandler adds the members leaving the group by msg to pushToUserIDs and
+// clears their conversation, or clears the members of a dismissed group.
+func (c *ConsumerHandler) groupNotificationHandler(ctx context.Context, groupID string, pushToUserIDs *[]string, msg *sdkws.MsgData) (err error) {
+	switch msg.ContentType {
+	case constant.MemberQuitNotification:
+		var tips sdkws.MemberQuitTips
+		if unmarshalNotificationElem(msg.Content, &tips) != nil {
+			return err
+		}
+		if err = c.DeleteMemberAndSetConversationSeq(ctx, groupID, []string{tips.QuitUser.UserID}); err != nil {
+			log.ZError(ctx, "MemberQuitNotification DeleteMemberAndSetConversationSeq", err, "groupID", groupID, "userID", tips.QuitUser.UserID)
+		}
+		*pushToUserIDs = append(*pushToUserIDs, tips.QuitUser.UserID)
+	case constant.MemberKickedNotification:
+		var tips sdkws.MemberKickedTips
+		if unmarshalNotificationElem(msg.Content, &tips) != nil {
+			return err
+		}
+		kickedUsers := datautil.Slice(tips.KickedUserList, func(e *sdkws.GroupMemberFullInfo) string { return e.UserID })
+		if err = c.DeleteMemberAndSetConversationSeq(ctx, groupID, kickedUsers); err != nil {
+			log.ZError(ctx, "MemberKickedNotification DeleteMemberAndSetConversationSeq", err, "groupID", groupID, "userIDs", kickedUsers)
+		}
+
+		*pushToUserIDs = append(*pushToUserIDs, kickedUsers...)
+	case constant.GroupDismissedNotification:
+		if msgprocessor.IsNotification(msgprocessor.GetConversationIDByMsg(msg)) {
+			var tips sdkws.GroupDismissedTips
 			if unmarshalNotificationElem(msg.Content, &tips) != nil {
 				return err
 			}
-			kickedUsers := datautil.Slice(tips.KickedUserList, func(e *sdkws.GroupMemberFullInfo) string { return e.UserID })
-			if err = c.DeleteMemberAndSetConversationSeq(ctx, groupID, kickedUsers); err != nil {
-				log.ZError(ctx, "MemberKickedNotification DeleteMemberAndSetConversationSeq", err, "groupID", groupID, "userIDs", kickedUsers)
+			log.ZInfo(ctx, "GroupDismissedNotificationInfo****", "groupID", groupID, "num", len(*pushToUserIDs), "list", pushToUserIDs)
+			if adminUserIDs, err := tenant.AdminUserIDs(ctx, c.config.Share.IMAdminUserID); err == nil && len(adminUserIDs) > 0 {
+				ctx = mcontext.WithOpUserIDContext(ctx, adminUserIDs[0])
 			}
-
-			*pushToUserIDs = append(*pushToUserIDs, kickedUsers...)
-		case constant.GroupDismissedNotification:
-			if msgprocessor.IsNotification(msgprocessor.GetConversationIDByMsg(msg)) {
-				var tips sdkws.GroupDismissedTips
-				if unmarshalNotificationElem(msg.Content, &tips) != nil {
-					return err
-				}
-				log.ZInfo(ctx, "GroupDismissedNotificationInfo****", "groupID", groupID, "num", len(*pushToUserIDs), "list", pushToUserIDs)
-				if len(c.config.Share.IMAdminUserID) > 0 {
-					ctx = mcontext.WithOpUserIDContext(ctx, c.config.Share.IMAdminUserID[0])
+			defer func(groupID string) {
+				if err = c.groupRpcClient.DismissGroup(ctx, groupID); err != nil {
+					log.ZError(ctx, "DismissGroup Notification clear members", err, "groupID", groupID)
 				}
-				defer func(groupID string) {
-					if err = c.groupRpcClient.DismissGroup(ctx, groupID); err != nil {
-						log.ZError(ctx, "DismissGroup Notification clear members", err, "groupID", groupID)
-					}
-				}(groupID)
-			}
+			}(groupID)
 		}
 	}
 	return err
@@ -26,6 +26,7 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	pbauth "github.com/openimsdk/protocol/auth"
 	"github.com/openimsdk/protocol/constant"
@@ -72,12 +73,19 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 
 func (s *authServer) UserToken(ctx context.Context, req *pbauth.UserTokenReq) (*pbauth.UserTokenResp, error) {
 	resp := pbauth.UserTokenResp{}
-	if req.Secret != s.config.Share.Secret {
+	secret, err := tenant.Secret(ctx, s.config.Share.Secret)
+	if err != nil {
+		return nil, err
+	}
+	if req.Secret != secret {
 		return nil, errs.ErrNoPermission.WrapMsg("secret invalid")
 	}
 	if _, err := s.userRpcClient.GetUserInfo(ctx, req.UserID); err != nil {
 		return nil, err
 	}
+	if err := s.userRpcClient.CheckUserEnabled(ctx, req.UserID); err != nil {
+		return nil, err
+	}
 	token, err := s.authDatabase.CreateToken(ctx, req.UserID, int(req.PlatformID))
 	if err != nil {
 		return nil, err
@@ -94,12 +102,15 @@ func (s *authServer) GetUserToken(ctx context.Context, req *pbauth.GetUserTokenR
 	}
 	resp := pbauth.GetUserTokenResp{}
 
-	if authverify.IsManagerUserID(req.UserID, s.config.Share.IMAdminUserID) {
+	if authverify.IsManagerUserID(ctx, req.UserID, s.config.Share.IMAdminUserID) {
 		return nil, errs.ErrNoPermission.WrapMsg("don't get Admin token")
 	}
 	if _, err := s.userRpcClient.GetUserInfo(ctx, req.UserID); err != nil {
 		return nil, err
 	}
+	if err := s.userRpcClient.CheckUserEnabled(ctx, req.UserID); err != nil {
+		return nil, err
+	}
 	token, err := s.authDatabase.CreateToken(ctx, req.UserID, int(req.PlatformID))
 	if err != nil {
 		return nil, err
@@ -110,7 +121,19 @@ func (s *authServer) GetUserToken(ctx context.Context, req *pbauth.GetUserTokenR
 }
 
 func (s *authServer) parseToken(ctx context.Context, tokensString string) (claims *tokenverify.Claims, err error) {
-	claims, err = tokenverify.GetClaimFromToken(tokensString, authverify.Secret(s.config.Share.Secret))
+	// A token is only valid in the tenant it was issued for.
+	tenantID, err := authverify.TenantIDFromToken(tokensString)
+	if err != nil {
+		return nil, err
+	}
+	if tenantID != tenant.ID(ctx) {
+		return nil, errs.ErrTokenUnknown.WrapMsg("token belongs to another tenant")
+	}
+	secret, err := tenant.Secret(ctx, s.config.Share.Secret)
+	if err != nil {
+		return nil, err
+	}
+	claims, err = tokenverify.GetClaimFromToken(tokensString, authverify.Secret(secret))
 	if err != nil {
 		return nil, errs.Wrap(err)
 	}
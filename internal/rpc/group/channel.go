@@ -0,0 +1,36 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package group
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
+)
+
+const maxChannelSubscriberPage = 5000
+
+// GetChannelSubscribers is only called by other services, like GetGroupMemberUserIDs.
+func (s *groupServer) GetChannelSubscribers(ctx context.Context, req *groupext.GetChannelSubscribersReq) (*groupext.GetChannelSubscribersResp, error) {
+	count := int(req.Count)
+	if count > maxChannelSubscriberPage {
+		count = maxChannelSubscriberPage
+	}
+	userIDs, err := s.db.FindChannelSubscriberIDs(ctx, req.GroupID, req.Shard, req.AfterUserID, count)
+	if err != nil {
+		return nil, err
+	}
+	return &groupext.GetChannelSubscribersResp{UserIDs: userIDs}, nil
+}
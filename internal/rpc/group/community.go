@@ -0,0 +1,666 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package group
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/internal/rpc/incrversion"
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
+	"github.com/openimsdk/open-im-server/v3/pkg/util/hashutil"
+	"github.com/openimsdk/protocol/constant"
+	pbgroup "github.com/openimsdk/protocol/group"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func communityDB2PB(community *model.Community, groupIDs []string) *groupext.CommunityInfo {
+	return &groupext.CommunityInfo{
+		CommunityID:         community.CommunityID,
+		Name:                community.Name,
+		FaceURL:             community.FaceURL,
+		Introduction:        community.Introduction,
+		OwnerUserID:         community.OwnerUserID,
+		AnnouncementGroupID: community.AnnouncementGroupID,
+		GroupIDs:            groupIDs,
+		Ex:                  community.Ex,
+		CreateTime:          community.CreateTime.UnixMilli(),
+	}
+}
+
+func communityMemberDB2PB(member *model.CommunityMember) *groupext.CommunityMember {
+	return &groupext.CommunityMember{
+		CommunityID:   member.CommunityID,
+		UserID:        member.UserID,
+		RoleLevel:     member.RoleLevel,
+		InviterUserID: member.InviterUserID,
+		JoinTime:      member.JoinTime.UnixMilli(),
+	}
+}
+
+func isCommunityAdmin(member *model.CommunityMember) bool {
+	return member.RoleLevel == constant.GroupOwner || member.RoleLevel == constant.GroupAdmin
+}
+
+// checkCommunityAdmin checks that the op user is the owner or an admin of the community. It
+// returns the op user, nil for app admins.
+func (s *groupServer) checkCommunityAdmin(ctx context.Context, communityID string) (*model.CommunityMember, error) {
+	if authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
+		return nil, nil
+	}
+	member, err := s.communityDB.TakeMember(ctx, communityID, mcontext.GetOpUserID(ctx))
+	if err != nil {
+		if s.IsNotFound(err) {
+			return nil, errs.ErrNoPermission.WrapMsg("opUserID not in community")
+		}
+		return nil, err
+	}
+	if !isCommunityAdmin(member) {
+		return nil, errs.ErrNoPermission.WrapMsg("opUserID is not community admin")
+	}
+	return member, nil
+}
+
+// isGroupCommunityAdmin reports whether the op user is the owner or an admin of the community
+// of the group, who manage the group like its owner.
+func (s *groupServer) isGroupCommunityAdmin(ctx context.Context, groupID string) (bool, error) {
+	communityID, err := s.communityDB.GetGroupCommunityID(ctx, groupID)
+	if err != nil || communityID == "" {
+		return false, err
+	}
+	member, err := s.communityDB.TakeMember(ctx, communityID, mcontext.GetOpUserID(ctx))
+	if err != nil {
+		if s.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return isCommunityAdmin(member), nil
+}
+
+// checkCommunityMembers checks that userIDs are members of the community of the group, when
+// the group belongs to one.
+func (s *groupServer) checkCommunityMembers(ctx context.Context, groupID string, userIDs []string) error {
+	communityID, err := s.communityDB.GetGroupCommunityID(ctx, groupID)
+	if err != nil || communityID == "" {
+		return err
+	}
+	return s.checkInCommunity(ctx, communityID, userIDs)
+}
+
+// checkInCommunity checks that userIDs are members of the community.
+func (s *groupServer) checkInCommunity(ctx context.Context, communityID string, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	members, err := s.communityDB.FindMembers(ctx, communityID, userIDs)
+	if err != nil {
+		return err
+	}
+	memberUserIDs := datautil.Slice(members, func(e *model.CommunityMember) string { return e.UserID })
+	if ids := datautil.Single(datautil.Distinct(userIDs), memberUserIDs); len(ids) > 0 {
+		return servererrs.ErrNotInCommunity.WrapMsg("not in community " + communityID + ": " + strings.Join(ids, ","))
+	}
+	return nil
+}
+
+func (s *groupServer) getCommunitiesInfo(ctx context.Context, communityIDs []string) ([]*groupext.CommunityInfo, error) {
+	communities, err := s.communityDB.FindCommunity(ctx, communityIDs)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*groupext.CommunityInfo, 0, len(communities))
+	for _, community := range communities {
+		groupIDs, err := s.communityDB.FindGroupIDs(ctx, community.CommunityID)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, communityDB2PB(community, groupIDs))
+	}
+	return res, nil
+}
+
+// joinAnnouncementGroup adds the users not in it yet to the announcement group of a community,
+// within the quotas like any other join.
+func (s *groupServer) joinAnnouncementGroup(ctx context.Context, groupID string, users []*sdkws.UserInfo) error {
+	userIDs := datautil.Slice(users, func(e *sdkws.UserInfo) string { return e.UserID })
+	members, err := s.db.FindGroupMembers(ctx, groupID, userIDs)
+	if err != nil {
+		return err
+	}
+	joined := datautil.SliceSet(datautil.Slice(members, func(e *model.GroupMember) string { return e.UserID }))
+	users = datautil.Filter(users, func(user *sdkws.UserInfo) (*sdkws.UserInfo, bool) {
+		_, ok := joined[user.UserID]
+		return user, !ok
+	})
+	if len(users) == 0 {
+		return nil
+	}
+	userIDs = datautil.Slice(users, func(e *sdkws.UserInfo) string { return e.UserID })
+	group, err := s.db.TakeGroup(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	quota := s.newQuotaReservation()
+	defer quota.release(ctx)
+	if err := s.checkGroupMemberQuota(ctx, quota, group, len(users)); err != nil {
+		return err
+	}
+	if err := s.checkJoinGroupQuota(ctx, quota, users); err != nil {
+		return err
+	}
+	opUserID := mcontext.GetOpUserID(ctx)
+	groupMembers := make([]*model.GroupMember, 0, len(userIDs))
+	for _, userID := range userIDs {
+		groupMembers = append(groupMembers, &model.GroupMember{
+			GroupID:        groupID,
+			UserID:         userID,
+			RoleLevel:      constant.GroupOrdinaryUsers,
+			OperatorUserID: opUserID,
+			InviterUserID:  opUserID,
+			JoinSource:     constant.JoinByInvitation,
+			JoinTime:       time.Now(),
+			MuteEndTime:    time.UnixMilli(0),
+		})
+	}
+	if err := s.db.CreateGroup(ctx, nil, groupMembers); err != nil {
+		return err
+	}
+	return s.notification.MemberEnterNotification(ctx, groupID, userIDs...)
+}
+
+// removeGroupMembers removes the users leaving a community from a group of the community. The
+// owner of the group keeps it.
+func (s *groupServer) removeGroupMembers(ctx context.Context, groupID string, userIDs []string) error {
+	group, err := s.db.TakeGroup(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	if group.Status == constant.GroupStatusDismissed {
+		return nil
+	}
+	members, err := s.db.FindGroupMembers(ctx, groupID, userIDs)
+	if err != nil {
+		return err
+	}
+	var removed []*model.GroupMember
+	for _, member := range members {
+		if member.RoleLevel == constant.GroupOwner {
+			log.ZWarn(ctx, "group owner left the community and keeps the group", nil, "groupID", groupID, "userID", member.UserID)
+			continue
+		}
+		removed = append(removed, member)
+	}
+	if len(removed) == 0 {
+		return nil
+	}
+	owner, err := s.db.TakeGroupOwner(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	num, err := s.db.FindGroupMemberNum(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	if err := s.PopulateGroupMember(ctx, removed...); err != nil {
+		return err
+	}
+	removedUserIDs := datautil.Slice(removed, func(e *model.GroupMember) string { return e.UserID })
+	if err := s.db.DeleteGroupMember(ctx, groupID, removedUserIDs); err != nil {
+		return err
+	}
+	s.notification.MemberKickedNotification(ctx, &sdkws.MemberKickedTips{
+		Group:          s.groupDB2PB(group, owner.UserID, num-uint32(len(removed))),
+		KickedUserList: datautil.Slice(removed, convert.Db2PbGroupMember),
+	})
+	return s.deleteMemberAndSetConversationSeq(ctx, groupID, removedUserIDs)
+}
+
+// removeCommunityMembers removes users from the groups of the community and then from the
+// community. The users stay members until they left every group, so a failed removal is
+// completed by repeating it.
+func (s *groupServer) removeCommunityMembers(ctx context.Context, community *model.Community, userIDs []string) error {
+	groupIDs, err := s.communityDB.FindGroupIDs(ctx, community.CommunityID)
+	if err != nil {
+		return err
+	}
+	for _, groupID := range groupIDs {
+		if err := s.removeGroupMembers(ctx, groupID, userIDs); err != nil {
+			return err
+		}
+	}
+	return s.communityDB.DeleteMembers(ctx, community.CommunityID, userIDs)
+}
+
+func (s *groupServer) CreateCommunity(ctx context.Context, req *groupext.CreateCommunityReq) (*groupext.CreateCommunityResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.OwnerUserID, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	// CreateGroup checks the users and creates the announcement group with every member in it.
+	group, err := s.CreateGroup(ctx, &pbgroup.CreateGroupReq{
+		GroupInfo: &sdkws.GroupInfo{
+			GroupName:        req.Name,
+			FaceURL:          req.FaceURL,
+			Introduction:     req.Introduction,
+			GroupType:        groupext.ChannelGroup,
+			NeedVerification: constant.Directly,
+		},
+		OwnerUserID:   req.OwnerUserID,
+		AdminUserIDs:  req.AdminUserIDs,
+		MemberUserIDs: req.MemberUserIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	opUserID := mcontext.GetOpUserID(ctx)
+	community := &model.Community{
+		CommunityID:         primitive.NewObjectID().Hex(),
+		Name:                req.Name,
+		FaceURL:             req.FaceURL,
+		Introduction:        req.Introduction,
+		OwnerUserID:         req.OwnerUserID,
+		AnnouncementGroupID: group.GroupInfo.GroupID,
+		CreatorUserID:       opUserID,
+		Ex:                  req.Ex,
+		CreateTime:          now,
+	}
+	var members []*model.CommunityMember
+	join := func(userID string, roleLevel int32) {
+		members = append(members, &model.CommunityMember{
+			CommunityID:   community.CommunityID,
+			UserID:        userID,
+			RoleLevel:     roleLevel,
+			InviterUserID: opUserID,
+			JoinTime:      now,
+		})
+	}
+	join(req.OwnerUserID, constant.GroupOwner)
+	for _, userID := range req.AdminUserIDs {
+		join(userID, constant.GroupAdmin)
+	}
+	for _, userID := range req.MemberUserIDs {
+		join(userID, constant.GroupOrdinaryUsers)
+	}
+	groups := []*model.CommunityGroup{{CommunityID: community.CommunityID, GroupID: community.AnnouncementGroupID, CreateTime: now}}
+	if err := s.communityDB.CreateCommunity(ctx, community, members, groups); err != nil {
+		// The announcement group would otherwise be left without a community.
+		dismiss := &pbgroup.DismissGroupReq{GroupID: group.GroupInfo.GroupID, DeleteMember: true}
+		if _, dismissErr := s.DismissGroup(context.WithoutCancel(ctx), dismiss); dismissErr != nil {
+			log.ZError(ctx, "dismiss announcement group of failed community", dismissErr, "groupID", dismiss.GroupID)
+		}
+		return nil, err
+	}
+	return &groupext.CreateCommunityResp{Community: communityDB2PB(community, []string{community.AnnouncementGroupID})}, nil
+}
+
+func (s *groupServer) SetCommunityInfo(ctx context.Context, req *groupext.SetCommunityInfoReq) (*groupext.SetCommunityInfoResp, error) {
+	if _, err := s.communityDB.TakeCommunity(ctx, req.CommunityID); err != nil {
+		return nil, err
+	}
+	if _, err := s.checkCommunityAdmin(ctx, req.CommunityID); err != nil {
+		return nil, err
+	}
+	data := make(map[string]any)
+	if req.Name != nil {
+		data["name"] = req.Name.Value
+	}
+	if req.FaceURL != nil {
+		data["face_url"] = req.FaceURL.Value
+	}
+	if req.Introduction != nil {
+		data["introduction"] = req.Introduction.Value
+	}
+	if req.Ex != nil {
+		data["ex"] = req.Ex.Value
+	}
+	if err := s.communityDB.UpdateCommunity(ctx, req.CommunityID, data); err != nil {
+		return nil, err
+	}
+	return &groupext.SetCommunityInfoResp{}, nil
+}
+
+func (s *groupServer) DismissCommunity(ctx context.Context, req *groupext.DismissCommunityReq) (*groupext.DismissCommunityResp, error) {
+	community, err := s.communityDB.TakeCommunity(ctx, req.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	if !authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) && community.OwnerUserID != mcontext.GetOpUserID(ctx) {
+		return nil, errs.ErrNoPermission.WrapMsg("not community owner")
+	}
+	if err := s.communityDB.DismissCommunity(ctx, req.CommunityID); err != nil {
+		return nil, err
+	}
+	owner, err := s.db.TakeGroupOwner(ctx, community.AnnouncementGroupID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.dismissGroup(ctx, &pbgroup.DismissGroupReq{GroupID: community.AnnouncementGroupID}, owner); err != nil {
+		return nil, err
+	}
+	return &groupext.DismissCommunityResp{}, nil
+}
+
+func (s *groupServer) GetCommunitiesInfo(ctx context.Context, req *groupext.GetCommunitiesInfoReq) (*groupext.GetCommunitiesInfoResp, error) {
+	communities, err := s.getCommunitiesInfo(ctx, datautil.Distinct(req.CommunityIDs))
+	if err != nil {
+		return nil, err
+	}
+	return &groupext.GetCommunitiesInfoResp{Communities: communities}, nil
+}
+
+func (s *groupServer) InviteToCommunity(ctx context.Context, req *groupext.InviteToCommunityReq) (*groupext.InviteToCommunityResp, error) {
+	if datautil.Duplicate(req.UserIDs) {
+		return nil, errs.ErrArgs.WrapMsg("userIDs duplicate")
+	}
+	community, err := s.communityDB.TakeCommunity(ctx, req.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.checkCommunityAdmin(ctx, req.CommunityID); err != nil {
+		return nil, err
+	}
+	userMap, err := s.user.GetUsersInfoMap(ctx, req.UserIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(userMap) != len(req.UserIDs) {
+		return nil, servererrs.ErrUserIDNotFound.WrapMsg("user not found")
+	}
+	existing, err := s.communityDB.FindMembers(ctx, req.CommunityID, req.UserIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) > 0 {
+		ids := datautil.Slice(existing, func(e *model.CommunityMember) string { return e.UserID })
+		return nil, errs.ErrArgs.WrapMsg("already in community " + strings.Join(ids, ","))
+	}
+	opUserID := mcontext.GetOpUserID(ctx)
+	members := make([]*model.CommunityMember, 0, len(req.UserIDs))
+	for _, userID := range req.UserIDs {
+		members = append(members, &model.CommunityMember{
+			CommunityID:   req.CommunityID,
+			UserID:        userID,
+			RoleLevel:     constant.GroupOrdinaryUsers,
+			InviterUserID: opUserID,
+			JoinTime:      time.Now(),
+		})
+	}
+	// joining the announcement group first leaves nothing to undo when its quotas refuse the
+	// users, and a retry skips the users already in it
+	if err := s.joinAnnouncementGroup(ctx, community.AnnouncementGroupID, datautil.Values(userMap)); err != nil {
+		return nil, err
+	}
+	if err := s.communityDB.AddMembers(ctx, members); err != nil {
+		return nil, err
+	}
+	return &groupext.InviteToCommunityResp{}, nil
+}
+
+func (s *groupServer) KickCommunityMember(ctx context.Context, req *groupext.KickCommunityMemberReq) (*groupext.KickCommunityMemberResp, error) {
+	if datautil.Duplicate(req.UserIDs) {
+		return nil, errs.ErrArgs.WrapMsg("userIDs duplicate")
+	}
+	community, err := s.communityDB.TakeCommunity(ctx, req.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	op, err := s.checkCommunityAdmin(ctx, req.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	if datautil.Contain(mcontext.GetOpUserID(ctx), req.UserIDs...) {
+		return nil, errs.ErrArgs.WrapMsg("opUserID in userIDs")
+	}
+	if datautil.Contain(community.OwnerUserID, req.UserIDs...) {
+		return nil, errs.ErrArgs.WrapMsg("community owner can not be kicked")
+	}
+	members, err := s.communityDB.FindMembers(ctx, req.CommunityID, req.UserIDs)
+	if err != nil {
+		return nil, err
+	}
+	memberUserIDs := datautil.Slice(members, func(e *model.CommunityMember) string { return e.UserID })
+	if ids := datautil.Single(req.UserIDs, memberUserIDs); len(ids) > 0 {
+		return nil, servererrs.ErrUserIDNotFound.WrapMsg("not in community " + strings.Join(ids, ","))
+	}
+	if op != nil && op.RoleLevel != constant.GroupOwner {
+		for _, member := range members {
+			if member.RoleLevel == constant.GroupAdmin {
+				return nil, errs.ErrNoPermission.WrapMsg("community admin can not kick admin " + member.UserID)
+			}
+		}
+	}
+	if err := s.removeCommunityMembers(ctx, community, req.UserIDs); err != nil {
+		return nil, err
+	}
+	return &groupext.KickCommunityMemberResp{}, nil
+}
+
+func (s *groupServer) QuitCommunity(ctx context.Context, req *groupext.QuitCommunityReq) (*groupext.QuitCommunityResp, error) {
+	if req.UserID == "" {
+		req.UserID = mcontext.GetOpUserID(ctx)
+	} else if err := authverify.CheckAccessV3(ctx, req.UserID, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	community, err := s.communityDB.TakeCommunity(ctx, req.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	if community.OwnerUserID == req.UserID {
+		return nil, errs.ErrNoPermission.WrapMsg("community owner can't quit")
+	}
+	if _, err := s.communityDB.TakeMember(ctx, req.CommunityID, req.UserID); err != nil {
+		return nil, err
+	}
+	if err := s.removeCommunityMembers(ctx, community, []string{req.UserID}); err != nil {
+		return nil, err
+	}
+	return &groupext.QuitCommunityResp{}, nil
+}
+
+func (s *groupServer) SetCommunityMemberRole(ctx context.Context, req *groupext.SetCommunityMemberRoleReq) (*groupext.SetCommunityMemberRoleResp, error) {
+	community, err := s.communityDB.TakeCommunity(ctx, req.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	if !authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) && community.OwnerUserID != mcontext.GetOpUserID(ctx) {
+		return nil, errs.ErrNoPermission.WrapMsg("not community owner")
+	}
+	if req.UserID == community.OwnerUserID {
+		return nil, errs.ErrArgs.WrapMsg("the role of the community owner can not be changed")
+	}
+	member, err := s.communityDB.TakeMember(ctx, req.CommunityID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if member.RoleLevel == req.RoleLevel {
+		return &groupext.SetCommunityMemberRoleResp{}, nil
+	}
+	if err := s.communityDB.UpdateMemberRoleLevel(ctx, req.CommunityID, req.UserID, req.RoleLevel); err != nil {
+		return nil, err
+	}
+	// the admins of the community are the admins of its announcement group, where they publish
+	if err := s.db.UpdateGroupMember(ctx, community.AnnouncementGroupID, req.UserID, map[string]any{"role_level": req.RoleLevel}); err != nil {
+		return nil, err
+	}
+	if req.RoleLevel == constant.GroupAdmin {
+		s.notification.GroupMemberSetToAdminNotification(ctx, community.AnnouncementGroupID, req.UserID)
+	} else {
+		s.notification.GroupMemberSetToOrdinaryUserNotification(ctx, community.AnnouncementGroupID, req.UserID)
+	}
+	return &groupext.SetCommunityMemberRoleResp{}, nil
+}
+
+func (s *groupServer) GetCommunityMembers(ctx context.Context, req *groupext.GetCommunityMembersReq) (*groupext.GetCommunityMembersResp, error) {
+	if !authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
+		if _, err := s.communityDB.TakeMember(ctx, req.CommunityID, mcontext.GetOpUserID(ctx)); err != nil {
+			return nil, err
+		}
+	}
+	total, members, err := s.communityDB.PageMembers(ctx, req.CommunityID, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &groupext.GetCommunityMembersResp{Total: total, Members: datautil.Slice(members, communityMemberDB2PB)}, nil
+}
+
+func (s *groupServer) AddCommunityGroups(ctx context.Context, req *groupext.AddCommunityGroupsReq) (*groupext.AddCommunityGroupsResp, error) {
+	if datautil.Duplicate(req.GroupIDs) {
+		return nil, errs.ErrArgs.WrapMsg("groupIDs duplicate")
+	}
+	if _, err := s.communityDB.TakeCommunity(ctx, req.CommunityID); err != nil {
+		return nil, err
+	}
+	if _, err := s.checkCommunityAdmin(ctx, req.CommunityID); err != nil {
+		return nil, err
+	}
+	groups, err := s.db.FindGroup(ctx, req.GroupIDs)
+	if err != nil {
+		return nil, err
+	}
+	if ids := datautil.Single(req.GroupIDs, datautil.Slice(groups, func(e *model.Group) string { return e.GroupID })); len(ids) > 0 {
+		return nil, servererrs.ErrGroupIDNotFound.WrapMsg(strings.Join(ids, ","))
+	}
+	isAppManager := authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID)
+	now := time.Now()
+	links := make([]*model.CommunityGroup, 0, len(groups))
+	for _, group := range groups {
+		if group.Status == constant.GroupStatusDismissed {
+			return nil, servererrs.ErrDismissedAlready.WrapMsg(group.GroupID)
+		}
+		communityID, err := s.communityDB.GetGroupCommunityID(ctx, group.GroupID)
+		if err != nil {
+			return nil, err
+		}
+		if communityID != "" {
+			return nil, errs.ErrArgs.WrapMsg("group already in community " + group.GroupID)
+		}
+		if !isAppManager {
+			owner, err := s.db.TakeGroupOwner(ctx, group.GroupID)
+			if err != nil {
+				return nil, err
+			}
+			if owner.UserID != mcontext.GetOpUserID(ctx) {
+				return nil, errs.ErrNoPermission.WrapMsg("not group owner " + group.GroupID)
+			}
+		}
+		// the groups of a community only hold its members
+		memberUserIDs, err := s.db.FindGroupMemberUserID(ctx, group.GroupID)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.checkInCommunity(ctx, req.CommunityID, memberUserIDs); err != nil {
+			return nil, err
+		}
+		links = append(links, &model.CommunityGroup{CommunityID: req.CommunityID, GroupID: group.GroupID, CreateTime: now})
+	}
+	if err := s.communityDB.AddGroups(ctx, links); err != nil {
+		return nil, err
+	}
+	return &groupext.AddCommunityGroupsResp{}, nil
+}
+
+func (s *groupServer) RemoveCommunityGroups(ctx context.Context, req *groupext.RemoveCommunityGroupsReq) (*groupext.RemoveCommunityGroupsResp, error) {
+	community, err := s.communityDB.TakeCommunity(ctx, req.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	if datautil.Contain(community.AnnouncementGroupID, req.GroupIDs...) {
+		return nil, errs.ErrArgs.WrapMsg("the announcement group can not be removed")
+	}
+	groupIDs, err := s.communityDB.FindGroupIDs(ctx, req.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	if ids := datautil.Single(datautil.Distinct(req.GroupIDs), groupIDs); len(ids) > 0 {
+		return nil, errs.ErrArgs.WrapMsg("group not in community " + strings.Join(ids, ","))
+	}
+	// the owner of a group may take it out of the community, the admins of the community any group
+	if _, err := s.checkCommunityAdmin(ctx, req.CommunityID); err != nil {
+		for _, groupID := range req.GroupIDs {
+			owner, err2 := s.db.TakeGroupOwner(ctx, groupID)
+			if err2 != nil {
+				return nil, err2
+			}
+			if owner.UserID != mcontext.GetOpUserID(ctx) {
+				return nil, err
+			}
+		}
+	}
+	if err := s.communityDB.RemoveGroups(ctx, req.CommunityID, datautil.Distinct(req.GroupIDs)); err != nil {
+		return nil, err
+	}
+	return &groupext.RemoveCommunityGroupsResp{}, nil
+}
+
+func (s *groupServer) GetFullJoinCommunityIDs(ctx context.Context, req *groupext.GetFullJoinCommunityIDsReq) (*groupext.GetFullJoinCommunityIDsResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	vl, err := s.communityDB.FindMaxJoinCommunityVersionCache(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	communityIDs, err := s.communityDB.FindJoinCommunityIDs(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	idHash := hashutil.IdHash(communityIDs)
+	if req.IdHash == idHash {
+		communityIDs = nil
+	}
+	return &groupext.GetFullJoinCommunityIDsResp{
+		VersionID:    vl.ID.Hex(),
+		Version:      idHash,
+		Equal:        req.IdHash == idHash,
+		CommunityIDs: communityIDs,
+	}, nil
+}
+
+func (s *groupServer) GetIncrementalJoinCommunity(ctx context.Context, req *groupext.GetIncrementalJoinCommunityReq) (*groupext.GetIncrementalJoinCommunityResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	opt := incrversion.Option[*groupext.CommunityInfo, groupext.GetIncrementalJoinCommunityResp]{
+		Ctx:             ctx,
+		VersionKey:      req.UserID,
+		VersionID:       req.VersionID,
+		VersionNumber:   req.Version,
+		Version:         s.communityDB.FindJoinIncrVersion,
+		CacheMaxVersion: s.communityDB.FindMaxJoinCommunityVersionCache,
+		Find:            s.getCommunitiesInfo,
+		Resp: func(version *model.VersionLog, delIDs []string, insertList, updateList []*groupext.CommunityInfo, full bool) *groupext.GetIncrementalJoinCommunityResp {
+			return &groupext.GetIncrementalJoinCommunityResp{
+				VersionID: version.ID.Hex(),
+				Version:   uint64(version.Version),
+				Full:      full,
+				Delete:    delIDs,
+				Insert:    insertList,
+				Update:    updateList,
+			}
+		},
+	}
+	return opt.Build()
+}
@@ -25,7 +25,7 @@ import (
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/common"
-	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database/mgo"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/dbbuild"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/webhook"
 	"github.com/openimsdk/open-im-server/v3/pkg/localcache"
@@ -34,8 +34,10 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/callbackstruct"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/redis"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
 	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient/grouphash"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient/notification"
@@ -44,7 +46,6 @@ import (
 	pbgroup "github.com/openimsdk/protocol/group"
 	"github.com/openimsdk/protocol/sdkws"
 	"github.com/openimsdk/protocol/wrapperspb"
-	"github.com/openimsdk/tools/db/mongoutil"
 	"github.com/openimsdk/tools/db/redisutil"
 	"github.com/openimsdk/tools/discovery"
 	"github.com/openimsdk/tools/errs"
@@ -58,6 +59,14 @@ import (
 
 type groupServer struct {
 	db                    controller.GroupDatabase
+	roleDB                controller.GroupRoleDatabase
+	inviteDB              controller.GroupInviteDatabase
+	joinDB                controller.GroupJoinDatabase
+	tagDB                 controller.GroupMemberTagDatabase
+	quotaDB               controller.GroupQuotaDatabase
+	successionDB          controller.GroupSuccessionDatabase
+	activityDB            controller.UserActivityDatabase
+	communityDB           controller.CommunityDatabase
 	user                  rpcclient.UserRpcClient
 	notification          *GroupNotificationSender
 	conversationRpcClient rpcclient.ConversationRpcClient
@@ -70,6 +79,7 @@ type Config struct {
 	RpcConfig          config.Group
 	RedisConfig        config.Redis
 	MongodbConfig      config.Mongo
+	DatabaseConfig     config.Database
 	NotificationConfig config.Notification
 	Share              config.Share
 	WebhooksConfig     config.Webhooks
@@ -78,7 +88,7 @@ type Config struct {
 }
 
 func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryRegistry, server *grpc.Server) error {
-	mgocli, err := mongoutil.NewMongoDB(ctx, config.MongodbConfig.Build())
+	dbb, err := dbbuild.NewBuilder(ctx, &config.DatabaseConfig, &config.MongodbConfig)
 	if err != nil {
 		return err
 	}
@@ -86,15 +96,71 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	if err != nil {
 		return err
 	}
-	groupDB, err := mgo.NewGroupMongo(mgocli.GetDB())
+	groupDB, err := dbb.Group()
 	if err != nil {
 		return err
 	}
-	groupMemberDB, err := mgo.NewGroupMember(mgocli.GetDB())
+	groupMemberDB, err := dbb.GroupMember()
 	if err != nil {
 		return err
 	}
-	groupRequestDB, err := mgo.NewGroupRequestMgo(mgocli.GetDB())
+	groupRequestDB, err := dbb.GroupRequest()
+	if err != nil {
+		return err
+	}
+	groupRoleDB, err := dbb.GroupRole()
+	if err != nil {
+		return err
+	}
+	channelSubscriberDB, err := dbb.ChannelSubscriber()
+	if err != nil {
+		return err
+	}
+	groupInviteLinkDB, err := dbb.GroupInviteLink()
+	if err != nil {
+		return err
+	}
+	groupInviteRedemptionDB, err := dbb.GroupInviteRedemption()
+	if err != nil {
+		return err
+	}
+	groupJoinQuestionDB, err := dbb.GroupJoinQuestion()
+	if err != nil {
+		return err
+	}
+	groupRequestAnswerDB, err := dbb.GroupRequestAnswer()
+	if err != nil {
+		return err
+	}
+	groupMemberTagDB, err := dbb.GroupMemberTag()
+	if err != nil {
+		return err
+	}
+	groupQuotaDB, err := dbb.GroupQuota()
+	if err != nil {
+		return err
+	}
+	groupSuccessionDB, err := dbb.GroupSuccession()
+	if err != nil {
+		return err
+	}
+	userActivityDB, err := dbb.UserActivity()
+	if err != nil {
+		return err
+	}
+	userDB, err := dbb.User()
+	if err != nil {
+		return err
+	}
+	communityDB, err := dbb.Community()
+	if err != nil {
+		return err
+	}
+	communityMemberDB, err := dbb.CommunityMember()
+	if err != nil {
+		return err
+	}
+	communityGroupDB, err := dbb.CommunityGroup()
 	if err != nil {
 		return err
 	}
@@ -102,8 +168,17 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	msgRpcClient := rpcclient.NewMessageRpcClient(client, config.Share.RpcRegisterName.Msg)
 	conversationRpcClient := rpcclient.NewConversationRpcClient(client, config.Share.RpcRegisterName.Conversation)
 	var gs groupServer
-	database := controller.NewGroupDatabase(rdb, &config.LocalCacheConfig, groupDB, groupMemberDB, groupRequestDB, mgocli.GetTx(), grouphash.NewGroupHashFromGroupServer(&gs))
+	database := controller.NewGroupDatabase(rdb, &config.LocalCacheConfig, groupDB, groupMemberDB, groupRequestDB, channelSubscriberDB, dbb.Tx(), grouphash.NewGroupHashFromGroupServer(&gs))
 	gs.db = database
+	gs.roleDB = controller.NewGroupRoleDatabase(redis.NewGroupRoleCacheRedis(rdb, groupRoleDB))
+	gs.inviteDB = controller.NewGroupInviteDatabase(groupInviteLinkDB, groupInviteRedemptionDB)
+	gs.joinDB = controller.NewGroupJoinDatabase(groupJoinQuestionDB, groupRequestAnswerDB, groupRequestDB)
+	gs.tagDB = controller.NewGroupMemberTagDatabase(groupMemberTagDB)
+	gs.quotaDB = controller.NewGroupQuotaDatabase(groupQuotaDB, redis.NewGroupQuotaCacheRedis(rdb))
+	gs.successionDB = controller.NewGroupSuccessionDatabase(groupSuccessionDB)
+	gs.activityDB = controller.NewUserActivityDatabase(userActivityDB, userDB)
+	gs.communityDB = controller.NewCommunityDatabase(communityDB, communityMemberDB, communityGroupDB,
+		redis.NewCommunityCacheRedis(rdb, communityMemberDB, communityGroupDB), dbb.Tx())
 	gs.user = userRpcClient
 	gs.notification = NewGroupNotificationSender(
 		database,
@@ -125,6 +200,7 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	gs.config = config
 	gs.webhookClient = webhook.NewWebhookClient(config.WebhooksConfig.URL)
 	pbgroup.RegisterGroupServer(server, &gs)
+	groupext.RegisterGroupExtServer(server, &gs)
 	return nil
 }
 
@@ -154,19 +230,6 @@ func (s *groupServer) NotificationUserInfoUpdate(ctx context.Context, req *pbgro
 	return &pbgroup.NotificationUserInfoUpdateResp{}, nil
 }
 
-func (s *groupServer) CheckGroupAdmin(ctx context.Context, groupID string) error {
-	if !authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
-		groupMember, err := s.db.TakeGroupMember(ctx, groupID, mcontext.GetOpUserID(ctx))
-		if err != nil {
-			return err
-		}
-		if !(groupMember.RoleLevel == constant.GroupOwner || groupMember.RoleLevel == constant.GroupAdmin) {
-			return errs.ErrNoPermission.WrapMsg("no group owner or admin")
-		}
-	}
-	return nil
-}
-
 func (s *groupServer) GetPublicUserInfoMap(ctx context.Context, userIDs []string, complete bool) (map[string]*sdkws.PublicUserInfo, error) {
 	if len(userIDs) == 0 {
 		return map[string]*sdkws.PublicUserInfo{}, nil
@@ -214,8 +277,8 @@ func (s *groupServer) GenGroupID(ctx context.Context, groupID *string) error {
 }
 
 func (s *groupServer) CreateGroup(ctx context.Context, req *pbgroup.CreateGroupReq) (*pbgroup.CreateGroupResp, error) {
-	if req.GroupInfo.GroupType != constant.WorkingGroup {
-		return nil, errs.ErrArgs.WrapMsg(fmt.Sprintf("group type only supports %d", constant.WorkingGroup))
+	if req.GroupInfo.GroupType != constant.WorkingGroup && req.GroupInfo.GroupType != groupext.ChannelGroup {
+		return nil, errs.ErrArgs.WrapMsg(fmt.Sprintf("group type only supports %d and %d", constant.WorkingGroup, groupext.ChannelGroup))
 	}
 	if req.OwnerUserID == "" {
 		return nil, errs.ErrArgs.WrapMsg("no group owner")
@@ -242,6 +305,17 @@ func (s *groupServer) CreateGroup(ctx context.Context, req *pbgroup.CreateGroupR
 	if len(userMap) != len(userIDs) {
 		return nil, servererrs.ErrUserIDNotFound.WrapMsg("user not found")
 	}
+	if maxMembers := groupTypeMemberQuota(&s.config.RpcConfig.Quota, req.GroupInfo.GroupType); maxMembers > 0 && len(userIDs) > maxMembers {
+		return nil, servererrs.ErrGroupMemberLimit.WrapMsg(fmt.Sprintf("group can have %d members", maxMembers))
+	}
+	quota := s.newQuotaReservation()
+	defer quota.release(ctx)
+	if err := s.checkCreateGroupQuota(ctx, quota, userMap[req.OwnerUserID]); err != nil {
+		return nil, err
+	}
+	if err := s.checkJoinGroupQuota(ctx, quota, datautil.Values(userMap)); err != nil {
+		return nil, err
+	}
 
 	if err := s.webhookBeforeCreateGroup(ctx, &s.config.WebhooksConfig.BeforeCreateGroup, req); err != nil && err != servererrs.ErrCallbackContinue {
 		return nil, err
@@ -377,6 +451,9 @@ func (s *groupServer) InviteUserToGroup(ctx context.Context, req *pbgroup.Invite
 	if group.Status == constant.GroupStatusDismissed {
 		return nil, servererrs.ErrDismissedAlready.WrapMsg("group dismissed checking group status found it dismissed")
 	}
+	if err := s.checkCommunityMembers(ctx, req.GroupID, req.InvitedUserIDs); err != nil {
+		return nil, err
+	}
 
 	userMap, err := s.user.GetUsersInfoMap(ctx, req.InvitedUserIDs)
 	if err != nil {
@@ -406,8 +483,12 @@ func (s *groupServer) InviteUserToGroup(ctx context.Context, req *pbgroup.Invite
 	}
 
 	if group.NeedVerification == constant.AllNeedVerification {
-		if !authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
-			if !(groupMember.RoleLevel == constant.GroupOwner || groupMember.RoleLevel == constant.GroupAdmin) {
+		if groupMember != nil {
+			roles, err := s.roleDB.GetGroupRoles(ctx, req.GroupID)
+			if err != nil {
+				return nil, err
+			}
+			if authverify.CheckGroupPermission(groupPermMember(roles, groupMember), authverify.GroupPermApproveApplication) != nil {
 				var requests []*model.GroupRequest
 				for _, userID := range req.InvitedUserIDs {
 					requests = append(requests, &model.GroupRequest{
@@ -434,6 +515,14 @@ func (s *groupServer) InviteUserToGroup(ctx context.Context, req *pbgroup.Invite
 			}
 		}
 	}
+	quota := s.newQuotaReservation()
+	defer quota.release(ctx)
+	if err := s.checkGroupMemberQuota(ctx, quota, group, len(req.InvitedUserIDs)); err != nil {
+		return nil, err
+	}
+	if err := s.checkJoinGroupQuota(ctx, quota, datautil.Values(userMap)); err != nil {
+		return nil, err
+	}
 	var groupMembers []*model.GroupMember
 	for _, userID := range req.InvitedUserIDs {
 		member := &model.GroupMember{
@@ -557,29 +646,13 @@ func (s *groupServer) KickGroupMember(ctx context.Context, req *pbgroup.KickGrou
 	for i, member := range members {
 		memberMap[member.UserID] = members[i]
 	}
-	isAppManagerUid := authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID)
-	opMember := memberMap[opUserID]
 	for _, userID := range req.KickedUserIDs {
-		member, ok := memberMap[userID]
-		if !ok {
+		if _, ok := memberMap[userID]; !ok {
 			return nil, servererrs.ErrUserIDNotFound.WrapMsg(userID)
 		}
-		if !isAppManagerUid {
-			if opMember == nil {
-				return nil, errs.ErrNoPermission.WrapMsg("opUserID no in group")
-			}
-			switch opMember.RoleLevel {
-			case constant.GroupOwner:
-			case constant.GroupAdmin:
-				if member.RoleLevel == constant.GroupOwner || member.RoleLevel == constant.GroupAdmin {
-					return nil, errs.ErrNoPermission.WrapMsg("group admins cannot remove the group owner and other admins")
-				}
-			case constant.GroupOrdinaryUsers:
-				return nil, errs.ErrNoPermission.WrapMsg("opUserID no permission")
-			default:
-				return nil, errs.ErrNoPermission.WrapMsg("opUserID roleLevel unknown")
-			}
-		}
+	}
+	if _, err := s.checkGroupPermission(ctx, req.GroupID, authverify.GroupPermKickMember, req.KickedUserIDs...); err != nil {
+		return nil, err
 	}
 	num, err := s.db.FindGroupMemberNum(ctx, req.GroupID)
 	if err != nil {
@@ -724,6 +797,9 @@ func (s *groupServer) GetGroupApplicationList(ctx context.Context, req *pbgroup.
 		}
 		return convert.Db2PbGroupRequest(e, userMap[e.UserID], convert.Db2PbGroupInfo(groupMap[e.GroupID], ownerUserID, groupMemberNumMap[e.GroupID]))
 	})
+	if err := s.attachJoinAnswers(ctx, resp.GroupRequests); err != nil {
+		return nil, err
+	}
 	return resp, nil
 }
 
@@ -775,14 +851,8 @@ func (s *groupServer) GroupApplicationResponse(ctx context.Context, req *pbgroup
 	if !datautil.Contain(req.HandleResult, constant.GroupResponseAgree, constant.GroupResponseRefuse) {
 		return nil, errs.ErrArgs.WrapMsg("HandleResult unknown")
 	}
-	if !authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
-		groupMember, err := s.db.TakeGroupMember(ctx, req.GroupID, mcontext.GetOpUserID(ctx))
-		if err != nil {
-			return nil, err
-		}
-		if !(groupMember.RoleLevel == constant.GroupOwner || groupMember.RoleLevel == constant.GroupAdmin) {
-			return nil, errs.ErrNoPermission.WrapMsg("no group owner or admin")
-		}
+	if _, err := s.checkGroupPermission(ctx, req.GroupID, authverify.GroupPermApproveApplication); err != nil {
+		return nil, err
 	}
 	group, err := s.db.TakeGroup(ctx, req.GroupID)
 	if err != nil {
@@ -795,17 +865,32 @@ func (s *groupServer) GroupApplicationResponse(ctx context.Context, req *pbgroup
 	if groupRequest.HandleResult != 0 {
 		return nil, servererrs.ErrGroupRequestHandled.WrapMsg("group request already processed")
 	}
+	if before, ok := s.applicationExpireBefore(time.Now()); ok && groupRequest.ReqTime.Before(before) {
+		return nil, servererrs.ErrGroupRequestHandled.WrapMsg("group request expired")
+	}
 	var inGroup bool
 	if _, err := s.db.TakeGroupMember(ctx, req.GroupID, req.FromUserID); err == nil {
 		inGroup = true // Already in group
 	} else if !s.IsNotFound(err) {
 		return nil, err
 	}
-	if _, err := s.user.GetPublicUserInfo(ctx, req.FromUserID); err != nil {
+	user, err := s.user.GetUserInfo(ctx, req.FromUserID)
+	if err != nil {
 		return nil, err
 	}
 	var member *model.GroupMember
 	if (!inGroup) && req.HandleResult == constant.GroupResponseAgree {
+		if err := s.checkCommunityMembers(ctx, req.GroupID, []string{req.FromUserID}); err != nil {
+			return nil, err
+		}
+		quota := s.newQuotaReservation()
+		defer quota.release(ctx)
+		if err := s.checkGroupMemberQuota(ctx, quota, group, 1); err != nil {
+			return nil, err
+		}
+		if err := s.checkJoinGroupQuota(ctx, quota, []*sdkws.UserInfo{user}); err != nil {
+			return nil, err
+		}
 		member = &model.GroupMember{
 			GroupID:        req.GroupID,
 			UserID:         req.FromUserID,
@@ -845,16 +930,43 @@ func (s *groupServer) GroupApplicationResponse(ctx context.Context, req *pbgroup
 }
 
 func (s *groupServer) JoinGroup(ctx context.Context, req *pbgroup.JoinGroupReq) (*pbgroup.JoinGroupResp, error) {
+	if token := groupInviteToken(req.Ex); token != "" {
+		if req.InviterUserID == "" {
+			req.InviterUserID = mcontext.GetOpUserID(ctx)
+		}
+		if _, _, err := s.redeemInvite(ctx, token, req.GroupID, req, nil); err != nil {
+			return nil, err
+		}
+		return &pbgroup.JoinGroupResp{}, nil
+	}
+	if _, err := s.joinGroup(ctx, req, model.GroupInviteApprovalDefault, nil, ""); err != nil {
+		return nil, err
+	}
+	return &pbgroup.JoinGroupResp{}, nil
+}
+
+// joinGroup adds req.InviterUserID to the group, or makes a join request with the answers to
+// the questions of the group when the group or approval asks for verification. It reports
+// whether the user joined. inviterUserID is recorded as the inviter, for direct joins the
+// user joining when empty.
+func (s *groupServer) joinGroup(ctx context.Context, req *pbgroup.JoinGroupReq, approval int32, answers []*groupext.GroupJoinAnswer, inviterUserID string) (bool, error) {
 	user, err := s.user.GetUserInfo(ctx, req.InviterUserID)
 	if err != nil {
-		return nil, err
+		return false, err
 	}
 	group, err := s.db.TakeGroup(ctx, req.GroupID)
 	if err != nil {
-		return nil, err
+		return false, err
 	}
 	if group.Status == constant.GroupStatusDismissed {
-		return nil, servererrs.ErrDismissedAlready.Wrap()
+		return false, servererrs.ErrDismissedAlready.Wrap()
+	}
+	if err := s.checkCommunityMembers(ctx, req.GroupID, []string{req.InviterUserID}); err != nil {
+		return false, err
+	}
+	joinAnswers, err := s.checkJoinAnswers(ctx, req.GroupID, answers)
+	if err != nil {
+		return false, err
 	}
 
 	reqCall := &callbackstruct.CallbackJoinGroupReq{
@@ -863,60 +975,90 @@ func (s *groupServer) JoinGroup(ctx context.Context, req *pbgroup.JoinGroupReq)
 		ApplyID:    req.InviterUserID,
 		ReqMessage: req.ReqMessage,
 		Ex:         req.Ex,
+		Answers:    datautil.Slice(joinAnswers, groupJoinAnswerDB2PB),
 	}
 
 	if err := s.webhookBeforeApplyJoinGroup(ctx, &s.config.WebhooksConfig.BeforeApplyJoinGroup, reqCall); err != nil && err != servererrs.ErrCallbackContinue {
-		return nil, err
+		return false, err
 	}
 
 	_, err = s.db.TakeGroupMember(ctx, req.GroupID, req.InviterUserID)
 	if err == nil {
-		return nil, errs.ErrArgs.Wrap()
+		return false, errs.ErrArgs.Wrap()
 	} else if !s.IsNotFound(err) && errs.Unwrap(err) != errs.ErrRecordNotFound {
-		return nil, err
-	}
-	log.ZDebug(ctx, "JoinGroup.groupInfo", "group", group, "eq", group.NeedVerification == constant.Directly)
-	if group.NeedVerification == constant.Directly {
+		return false, err
+	}
+	log.ZDebug(ctx, "JoinGroup.groupInfo", "group", group, "eq", group.NeedVerification == constant.Directly, "approval", approval)
+	directly := group.NeedVerification == constant.Directly
+	switch approval {
+	case model.GroupInviteApprovalRequired:
+		directly = false
+	case model.GroupInviteApprovalSkipped:
+		directly = true
+	}
+	if directly {
+		memberInviterUserID := inviterUserID
+		if memberInviterUserID == "" {
+			memberInviterUserID = req.InviterUserID
+		}
+		quota := s.newQuotaReservation()
+		defer quota.release(ctx)
+		if err := s.checkGroupMemberQuota(ctx, quota, group, 1); err != nil {
+			return false, err
+		}
+		if err := s.checkJoinGroupQuota(ctx, quota, []*sdkws.UserInfo{user}); err != nil {
+			return false, err
+		}
 		groupMember := &model.GroupMember{
 			GroupID:        group.GroupID,
 			UserID:         user.UserID,
 			RoleLevel:      constant.GroupOrdinaryUsers,
+			JoinSource:     req.JoinSource,
 			OperatorUserID: mcontext.GetOpUserID(ctx),
-			InviterUserID:  req.InviterUserID,
+			InviterUserID:  memberInviterUserID,
 			JoinTime:       time.Now(),
 			MuteEndTime:    time.UnixMilli(0),
 		}
 
 		if err := s.webhookBeforeMembersJoinGroup(ctx, &s.config.WebhooksConfig.BeforeMemberJoinGroup, []*model.GroupMember{groupMember}, group.GroupID, group.Ex); err != nil && err != servererrs.ErrCallbackContinue {
-			return nil, err
+			return false, err
 		}
 
 		if err := s.db.CreateGroup(ctx, nil, []*model.GroupMember{groupMember}); err != nil {
-			return nil, err
+			return false, err
 		}
 
 		if err = s.notification.MemberEnterNotification(ctx, req.GroupID, req.InviterUserID); err != nil {
-			return nil, err
+			return false, err
 		}
 		s.webhookAfterJoinGroup(ctx, &s.config.WebhooksConfig.AfterJoinGroup, req)
 
-		return &pbgroup.JoinGroupResp{}, nil
+		return true, nil
 	}
 
 	groupRequest := model.GroupRequest{
-		UserID:      req.InviterUserID,
-		ReqMsg:      req.ReqMessage,
-		GroupID:     req.GroupID,
-		JoinSource:  req.JoinSource,
-		ReqTime:     time.Now(),
-		HandledTime: time.Unix(0, 0),
-		Ex:          req.Ex,
+		UserID:        req.InviterUserID,
+		ReqMsg:        req.ReqMessage,
+		GroupID:       req.GroupID,
+		JoinSource:    req.JoinSource,
+		ReqTime:       time.Now(),
+		HandledTime:   time.Unix(0, 0),
+		InviterUserID: inviterUserID,
+		Ex:            req.Ex,
 	}
 	if err = s.db.CreateGroupRequest(ctx, []*model.GroupRequest{&groupRequest}); err != nil {
-		return nil, err
+		return false, err
+	}
+	if err := s.joinDB.SetAnswers(ctx, &model.GroupRequestAnswers{
+		GroupID:    req.GroupID,
+		UserID:     req.InviterUserID,
+		Answers:    joinAnswers,
+		CreateTime: groupRequest.ReqTime,
+	}); err != nil {
+		return false, err
 	}
 	s.notification.JoinGroupApplicationNotification(ctx, req)
-	return &pbgroup.JoinGroupResp{}, nil
+	return false, nil
 }
 
 func (s *groupServer) QuitGroup(ctx context.Context, req *pbgroup.QuitGroupReq) (*pbgroup.QuitGroupResp, error) {
@@ -963,13 +1105,13 @@ func (s *groupServer) SetGroupInfo(ctx context.Context, req *pbgroup.SetGroupInf
 	var opMember *model.GroupMember
 	if !authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
 		var err error
+		if _, err := s.checkGroupPermission(ctx, req.GroupInfoForSet.GroupID, groupInfoPermission(req.GroupInfoForSet)); err != nil {
+			return nil, err
+		}
 		opMember, err = s.db.TakeGroupMember(ctx, req.GroupInfoForSet.GroupID, mcontext.GetOpUserID(ctx))
 		if err != nil {
 			return nil, err
 		}
-		if !(opMember.RoleLevel == constant.GroupOwner || opMember.RoleLevel == constant.GroupAdmin) {
-			return nil, errs.ErrNoPermission.WrapMsg("no group owner or admin")
-		}
 		if err := s.PopulateGroupMember(ctx, opMember); err != nil {
 			return nil, err
 		}
@@ -1214,28 +1356,58 @@ func (s *groupServer) DismissGroup(ctx context.Context, req *pbgroup.DismissGrou
 	if err != nil {
 		return nil, err
 	}
-	if !authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
-		if owner.UserID != mcontext.GetOpUserID(ctx) {
+	if !authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) && owner.UserID != mcontext.GetOpUserID(ctx) {
+		communityAdmin, err := s.isGroupCommunityAdmin(ctx, req.GroupID)
+		if err != nil {
+			return nil, err
+		}
+		if !communityAdmin {
 			return nil, errs.ErrNoPermission.WrapMsg("not group owner")
 		}
 	}
-	if err := s.PopulateGroupMember(ctx, owner); err != nil {
+	communityID, err := s.communityDB.GetGroupCommunityID(ctx, req.GroupID)
+	if err != nil {
 		return nil, err
 	}
+	if communityID != "" {
+		community, err := s.communityDB.TakeCommunity(ctx, communityID)
+		if err != nil {
+			return nil, err
+		}
+		if community.AnnouncementGroupID == req.GroupID {
+			return nil, errs.ErrArgs.WrapMsg("the announcement group is dismissed with its community")
+		}
+	}
+	if err := s.dismissGroup(ctx, req, owner); err != nil {
+		return nil, err
+	}
+	if communityID != "" {
+		if err := s.communityDB.RemoveGroups(ctx, communityID, []string{req.GroupID}); err != nil {
+			return nil, err
+		}
+	}
+	return &pbgroup.DismissGroupResp{}, nil
+}
+
+// dismissGroup dismisses the group without checking the op user.
+func (s *groupServer) dismissGroup(ctx context.Context, req *pbgroup.DismissGroupReq, owner *model.GroupMember) error {
+	if err := s.PopulateGroupMember(ctx, owner); err != nil {
+		return err
+	}
 	group, err := s.db.TakeGroup(ctx, req.GroupID)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	if !req.DeleteMember && group.Status == constant.GroupStatusDismissed {
-		return nil, servererrs.ErrDismissedAlready.WrapMsg("group status is dismissed")
+		return servererrs.ErrDismissedAlready.WrapMsg("group status is dismissed")
 	}
 	if err := s.db.DismissGroup(ctx, req.GroupID, req.DeleteMember); err != nil {
-		return nil, err
+		return err
 	}
 	if !req.DeleteMember {
 		num, err := s.db.FindGroupMemberNum(ctx, req.GroupID)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		tips := &sdkws.GroupDismissedTips{
 			Group:  s.groupDB2PB(group, owner.UserID, num),
@@ -1248,7 +1420,7 @@ func (s *groupServer) DismissGroup(ctx context.Context, req *pbgroup.DismissGrou
 	}
 	membersID, err := s.db.FindGroupMemberUserID(ctx, group.GroupID)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	cbReq := &callbackstruct.CallbackDisMissGroupReq{
 		GroupID:   req.GroupID,
@@ -1259,7 +1431,7 @@ func (s *groupServer) DismissGroup(ctx context.Context, req *pbgroup.DismissGrou
 
 	s.webhookAfterDismissGroup(ctx, &s.config.WebhooksConfig.AfterDismissGroup, cbReq)
 
-	return &pbgroup.DismissGroupResp{}, nil
+	return nil
 }
 
 func (s *groupServer) MuteGroupMember(ctx context.Context, req *pbgroup.MuteGroupMemberReq) (*pbgroup.MuteGroupMemberResp, error) {
@@ -1270,23 +1442,8 @@ func (s *groupServer) MuteGroupMember(ctx context.Context, req *pbgroup.MuteGrou
 	if err := s.PopulateGroupMember(ctx, member); err != nil {
 		return nil, err
 	}
-	if !authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
-		opMember, err := s.db.TakeGroupMember(ctx, req.GroupID, mcontext.GetOpUserID(ctx))
-		if err != nil {
-			return nil, err
-		}
-		switch member.RoleLevel {
-		case constant.GroupOwner:
-			return nil, errs.ErrNoPermission.WrapMsg("set group owner mute")
-		case constant.GroupAdmin:
-			if opMember.RoleLevel != constant.GroupOwner {
-				return nil, errs.ErrNoPermission.WrapMsg("set group admin mute")
-			}
-		case constant.GroupOrdinaryUsers:
-			if !(opMember.RoleLevel == constant.GroupAdmin || opMember.RoleLevel == constant.GroupOwner) {
-				return nil, errs.ErrNoPermission.WrapMsg("set group ordinary users mute")
-			}
-		}
+	if _, err := s.checkGroupPermission(ctx, req.GroupID, authverify.GroupPermMuteMember, req.UserID); err != nil {
+		return nil, err
 	}
 	data := UpdateGroupMemberMutedTimeMap(time.Now().Add(time.Second * time.Duration(req.MutedSeconds)))
 	if err := s.db.UpdateGroupMember(ctx, member.GroupID, member.UserID, data); err != nil {
@@ -1304,23 +1461,8 @@ func (s *groupServer) CancelMuteGroupMember(ctx context.Context, req *pbgroup.Ca
 	if err := s.PopulateGroupMember(ctx, member); err != nil {
 		return nil, err
 	}
-	if !authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
-		opMember, err := s.db.TakeGroupMember(ctx, req.GroupID, mcontext.GetOpUserID(ctx))
-		if err != nil {
-			return nil, err
-		}
-		switch member.RoleLevel {
-		case constant.GroupOwner:
-			return nil, errs.ErrNoPermission.WrapMsg("set group owner mute")
-		case constant.GroupAdmin:
-			if opMember.RoleLevel != constant.GroupOwner {
-				return nil, errs.ErrNoPermission.WrapMsg("set group admin mute")
-			}
-		case constant.GroupOrdinaryUsers:
-			if !(opMember.RoleLevel == constant.GroupAdmin || opMember.RoleLevel == constant.GroupOwner) {
-				return nil, errs.ErrNoPermission.WrapMsg("set group ordinary users mute")
-			}
-		}
+	if _, err := s.checkGroupPermission(ctx, req.GroupID, authverify.GroupPermMuteMember, req.UserID); err != nil {
+		return nil, err
 	}
 	data := UpdateGroupMemberMutedTimeMap(time.Unix(0, 0))
 	if err := s.db.UpdateGroupMember(ctx, member.GroupID, member.UserID, data); err != nil {
@@ -1331,7 +1473,7 @@ func (s *groupServer) CancelMuteGroupMember(ctx context.Context, req *pbgroup.Ca
 }
 
 func (s *groupServer) MuteGroup(ctx context.Context, req *pbgroup.MuteGroupReq) (*pbgroup.MuteGroupResp, error) {
-	if err := s.CheckGroupAdmin(ctx, req.GroupID); err != nil {
+	if _, err := s.checkGroupPermission(ctx, req.GroupID, authverify.GroupPermMuteGroup); err != nil {
 		return nil, err
 	}
 	if err := s.db.UpdateGroup(ctx, req.GroupID, UpdateGroupStatusMap(constant.GroupStatusMuted)); err != nil {
@@ -1342,7 +1484,7 @@ func (s *groupServer) MuteGroup(ctx context.Context, req *pbgroup.MuteGroupReq)
 }
 
 func (s *groupServer) CancelMuteGroup(ctx context.Context, req *pbgroup.CancelMuteGroupReq) (*pbgroup.CancelMuteGroupResp, error) {
-	if err := s.CheckGroupAdmin(ctx, req.GroupID); err != nil {
+	if _, err := s.checkGroupPermission(ctx, req.GroupID, authverify.GroupPermMuteGroup); err != nil {
 		return nil, err
 	}
 	if err := s.db.UpdateGroup(ctx, req.GroupID, UpdateGroupStatusMap(constant.GroupOk)); err != nil {
@@ -1404,30 +1546,25 @@ func (s *groupServer) SetGroupMemberInfo(ctx context.Context, req *pbgroup.SetGr
 		switch len(userIDs) - len(dbMembers) {
 		case 0:
 			if !isAppManagerUid {
-				roleLevel := dbMembers[opUserIndex].RoleLevel
-				if roleLevel != constant.GroupOwner {
-					switch roleLevel {
-					case constant.GroupAdmin:
-						for _, member := range dbMembers {
-							if member.RoleLevel == constant.GroupOwner {
-								return nil, errs.ErrNoPermission.WrapMsg("admin can not change group owner")
-							}
-							if member.RoleLevel == constant.GroupAdmin && member.UserID != opUserID {
-								return nil, errs.ErrNoPermission.WrapMsg("admin can not change other group admin")
-							}
-						}
-					case constant.GroupOrdinaryUsers:
-						for _, member := range dbMembers {
-							if !(member.RoleLevel == constant.GroupOrdinaryUsers && member.UserID == opUserID) {
-								return nil, errs.ErrNoPermission.WrapMsg("ordinary users can not change other role level")
-							}
-						}
-					default:
-						for _, member := range dbMembers {
-							if member.RoleLevel >= roleLevel {
-								return nil, errs.ErrNoPermission.WrapMsg("can not change higher role level")
-							}
-						}
+				roles, err := s.roleDB.GetGroupRoles(ctx, groupID)
+				if err != nil {
+					return nil, err
+				}
+				permMembers := make(map[string]*authverify.GroupMember, len(dbMembers))
+				for _, member := range dbMembers {
+					permMembers[member.UserID] = groupPermMember(roles, member)
+				}
+				opMember := permMembers[opUserID]
+				for _, member := range members {
+					if member.RoleLevel != nil && member.RoleLevel.Value > opMember.RoleLevel {
+						return nil, errs.ErrNoPermission.WrapMsg("can not set a role level above your own")
+					}
+					// Members edit their own info and may step down without a permission.
+					if member.UserID == opUserID {
+						continue
+					}
+					if err := authverify.CheckGroupPermission(opMember, groupMemberInfoPermission(member), permMembers[member.UserID]); err != nil {
+						return nil, err
 					}
 				}
 			}
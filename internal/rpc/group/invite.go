@@ -0,0 +1,246 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package group
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
+	"github.com/openimsdk/protocol/constant"
+	pbgroup "github.com/openimsdk/protocol/group"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// genInviteToken returns a random token that can not be guessed from other tokens.
+func genInviteToken() (string, error) {
+	data := make([]byte, 16)
+	if _, err := rand.Read(data); err != nil {
+		return "", errs.Wrap(err)
+	}
+	return hex.EncodeToString(data), nil
+}
+
+func groupInviteLinkDB2PB(link *model.GroupInviteLink) *groupext.GroupInviteLink {
+	var expireTime int64
+	if !link.ExpireTime.IsZero() {
+		expireTime = link.ExpireTime.UnixMilli()
+	}
+	return &groupext.GroupInviteLink{
+		Token:         link.Token,
+		GroupID:       link.GroupID,
+		CreatorUserID: link.CreatorUserID,
+		ExpireTime:    expireTime,
+		MaxUses:       link.MaxUses,
+		UsedCount:     link.UsedCount,
+		Approval:      link.Approval,
+		JoinSource:    link.JoinSource,
+		Revoked:       link.Revoked,
+		Ex:            link.Ex,
+		CreateTime:    link.CreateTime.UnixMilli(),
+	}
+}
+
+func groupInviteRedemptionDB2PB(redemption *model.GroupInviteRedemption) *groupext.GroupInviteRedemption {
+	return &groupext.GroupInviteRedemption{
+		Token:      redemption.Token,
+		GroupID:    redemption.GroupID,
+		UserID:     redemption.UserID,
+		JoinSource: redemption.JoinSource,
+		Result:     redemption.Result,
+		CreateTime: redemption.CreateTime.UnixMilli(),
+	}
+}
+
+// takeGroupInviteLink returns the link of the group by its token.
+func (s *groupServer) takeGroupInviteLink(ctx context.Context, groupID string, token string) (*model.GroupInviteLink, error) {
+	link, err := s.inviteDB.TakeLink(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if link.GroupID != groupID {
+		return nil, errs.ErrRecordNotFound.WrapMsg("group invite link not found", "token", token)
+	}
+	return link, nil
+}
+
+func (s *groupServer) CreateGroupInviteLink(ctx context.Context, req *groupext.CreateGroupInviteLinkReq) (*groupext.CreateGroupInviteLinkResp, error) {
+	if _, err := s.checkGroupPermission(ctx, req.GroupID, authverify.GroupPermApproveApplication); err != nil {
+		return nil, err
+	}
+	group, err := s.db.TakeGroup(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	if group.Status == constant.GroupStatusDismissed {
+		return nil, servererrs.ErrDismissedAlready.Wrap()
+	}
+	now := time.Now()
+	var expireTime time.Time
+	if req.ExpireTime > 0 {
+		expireTime = time.UnixMilli(req.ExpireTime)
+		if !expireTime.After(now) {
+			return nil, errs.ErrArgs.WrapMsg("expireTime is in the past")
+		}
+	}
+	token, err := genInviteToken()
+	if err != nil {
+		return nil, err
+	}
+	joinSource := req.JoinSource
+	if joinSource == 0 {
+		joinSource = constant.JoinByQRCode
+	}
+	link := &model.GroupInviteLink{
+		Token:         token,
+		GroupID:       req.GroupID,
+		CreatorUserID: mcontext.GetOpUserID(ctx),
+		ExpireTime:    expireTime,
+		MaxUses:       req.MaxUses,
+		Approval:      req.Approval,
+		JoinSource:    joinSource,
+		Ex:            req.Ex,
+		CreateTime:    now,
+	}
+	if err := s.inviteDB.CreateLink(ctx, link); err != nil {
+		return nil, err
+	}
+	return &groupext.CreateGroupInviteLinkResp{Link: groupInviteLinkDB2PB(link)}, nil
+}
+
+func (s *groupServer) GetGroupInviteLinks(ctx context.Context, req *groupext.GetGroupInviteLinksReq) (*groupext.GetGroupInviteLinksResp, error) {
+	if _, err := s.checkGroupPermission(ctx, req.GroupID, authverify.GroupPermApproveApplication); err != nil {
+		return nil, err
+	}
+	total, links, err := s.inviteDB.FindGroupLinks(ctx, req.GroupID, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &groupext.GetGroupInviteLinksResp{Total: total, Links: datautil.Slice(links, groupInviteLinkDB2PB)}, nil
+}
+
+func (s *groupServer) RevokeGroupInviteLink(ctx context.Context, req *groupext.RevokeGroupInviteLinkReq) (*groupext.RevokeGroupInviteLinkResp, error) {
+	if _, err := s.checkGroupPermission(ctx, req.GroupID, authverify.GroupPermApproveApplication); err != nil {
+		return nil, err
+	}
+	if _, err := s.takeGroupInviteLink(ctx, req.GroupID, req.Token); err != nil {
+		return nil, err
+	}
+	if err := s.inviteDB.RevokeLink(ctx, req.Token); err != nil {
+		return nil, err
+	}
+	return &groupext.RevokeGroupInviteLinkResp{}, nil
+}
+
+func (s *groupServer) GetGroupInviteRedemptions(ctx context.Context, req *groupext.GetGroupInviteRedemptionsReq) (*groupext.GetGroupInviteRedemptionsResp, error) {
+	if _, err := s.checkGroupPermission(ctx, req.GroupID, authverify.GroupPermApproveApplication); err != nil {
+		return nil, err
+	}
+	total, redemptions, err := s.inviteDB.FindRedemptions(ctx, req.GroupID, req.Token, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &groupext.GetGroupInviteRedemptionsResp{Total: total, Redemptions: datautil.Slice(redemptions, groupInviteRedemptionDB2PB)}, nil
+}
+
+func (s *groupServer) JoinGroupByInvite(ctx context.Context, req *groupext.JoinGroupByInviteReq) (*groupext.JoinGroupByInviteResp, error) {
+	link, result, err := s.redeemInvite(ctx, req.Token, "", &pbgroup.JoinGroupReq{
+		ReqMessage:    req.ReqMessage,
+		InviterUserID: mcontext.GetOpUserID(ctx),
+		Ex:            req.Ex,
+	}, req.Answers)
+	if err != nil {
+		return nil, err
+	}
+	return &groupext.JoinGroupByInviteResp{GroupID: link.GroupID, Result: result}, nil
+}
+
+// groupInviteToken returns the invite token JoinGroup redeems, carried in the ex of the
+// request as {"inviteToken": "..."} because the upstream request has no field for it.
+func groupInviteToken(ex string) string {
+	if ex == "" {
+		return ""
+	}
+	var v struct {
+		InviteToken string `json:"inviteToken"`
+	}
+	if err := json.Unmarshal([]byte(ex), &v); err != nil {
+		return ""
+	}
+	return v.InviteToken
+}
+
+// redeemInvite joins req.InviterUserID, the user joining as in JoinGroup, to the group of
+// the link with its verification and join source, recording the creator of the link as the
+// inviter. groupID, when set, must be the group of the link.
+func (s *groupServer) redeemInvite(ctx context.Context, token string, groupID string, req *pbgroup.JoinGroupReq, answers []*groupext.GroupJoinAnswer) (*model.GroupInviteLink, int32, error) {
+	link, err := s.inviteDB.TakeLink(ctx, token)
+	if err != nil {
+		if s.IsNotFound(err) {
+			return nil, 0, errs.ErrArgs.WrapMsg("invalid group invite link")
+		}
+		return nil, 0, err
+	}
+	if groupID != "" && groupID != link.GroupID {
+		return nil, 0, errs.ErrArgs.WrapMsg("group invite link is for another group", "groupID", groupID)
+	}
+	now := time.Now()
+	ok, err := s.inviteDB.UseLink(ctx, link.Token, now)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !ok {
+		return nil, 0, errs.ErrArgs.WrapMsg("group invite link is revoked, expired or used up")
+	}
+	userID := req.InviterUserID
+	joined, err := s.joinGroup(ctx, &pbgroup.JoinGroupReq{
+		GroupID:       link.GroupID,
+		ReqMessage:    req.ReqMessage,
+		JoinSource:    link.JoinSource,
+		InviterUserID: userID,
+		Ex:            req.Ex,
+	}, link.Approval, answers, link.CreatorUserID)
+	if err != nil {
+		if err := s.inviteDB.ReleaseLink(ctx, link.Token); err != nil {
+			log.ZError(ctx, "release group invite link failed", err, "token", link.Token)
+		}
+		return nil, 0, err
+	}
+	result := int32(model.GroupInviteRedeemApplied)
+	if joined {
+		result = model.GroupInviteRedeemJoined
+	}
+	redemption := &model.GroupInviteRedemption{
+		Token:      link.Token,
+		GroupID:    link.GroupID,
+		UserID:     userID,
+		JoinSource: link.JoinSource,
+		Result:     result,
+		CreateTime: now,
+	}
+	if err := s.inviteDB.CreateRedemption(ctx, redemption); err != nil {
+		return nil, 0, err
+	}
+	return link, result, nil
+}
@@ -0,0 +1,31 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package group
+
+import "testing"
+
+func TestGroupInviteToken(t *testing.T) {
+	for ex, want := range map[string]string{
+		"":                            "",
+		"plain ex":                    "",
+		`{"inviteToken":"abc"}`:       "abc",
+		`{"inviteToken":"abc","a":1}`: "abc",
+		`{"other":"abc"}`:             "",
+	} {
+		if got := groupInviteToken(ex); got != want {
+			t.Errorf("groupInviteToken(%q) = %q, want %q", ex, got, want)
+		}
+	}
+}
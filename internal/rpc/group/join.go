@@ -0,0 +1,291 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package group
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
+	pbgroup "github.com/openimsdk/protocol/group"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// expireGroupApplicationBatch is how many expired join requests are loaded at once.
+const expireGroupApplicationBatch = 500
+
+// joinAnswersExKey is the key of the answers to the join questions in the ex of the
+// applications GetGroupApplicationList returns, as the upstream message has no field for them.
+const joinAnswersExKey = "joinAnswers"
+
+func groupJoinQuestionDB2PB(question model.GroupJoinQuestion) *groupext.GroupJoinQuestion {
+	return &groupext.GroupJoinQuestion{
+		QuestionID: question.QuestionID,
+		Question:   question.Question,
+		Required:   question.Required,
+	}
+}
+
+func groupJoinAnswerDB2PB(answer model.GroupJoinAnswer) *groupext.GroupJoinAnswer {
+	return &groupext.GroupJoinAnswer{
+		QuestionID: answer.QuestionID,
+		Question:   answer.Question,
+		Answer:     answer.Answer,
+	}
+}
+
+// applicationExpireBefore returns the time before which pending join requests are expired at
+// now, false when they never expire.
+func (s *groupServer) applicationExpireBefore(now time.Time) (time.Time, bool) {
+	if s.config.RpcConfig.ApplicationExpireHours <= 0 {
+		return time.Time{}, false
+	}
+	return now.Add(-time.Hour * time.Duration(s.config.RpcConfig.ApplicationExpireHours)), true
+}
+
+// checkJoinAnswers checks the answers to the questions of the group.
+func (s *groupServer) checkJoinAnswers(ctx context.Context, groupID string, answers []*groupext.GroupJoinAnswer) ([]model.GroupJoinAnswer, error) {
+	questions, err := s.joinDB.TakeQuestions(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	return matchJoinAnswers(questions.Questions, answers)
+}
+
+// matchJoinAnswers returns the answers in the order of the questions, with the questions as
+// they are asked. Every required question must be answered, and only those of the group. A
+// required question left unanswered, as by a plain JoinGroup, is ErrJoinAnswersRequired so
+// clients know to ask the questions.
+func matchJoinAnswers(questions []model.GroupJoinQuestion, answers []*groupext.GroupJoinAnswer) ([]model.GroupJoinAnswer, error) {
+	answerMap := make(map[string]string, len(answers))
+	for _, answer := range answers {
+		if _, ok := answerMap[answer.QuestionID]; ok {
+			return nil, errs.ErrArgs.WrapMsg("question answered twice " + answer.QuestionID)
+		}
+		answerMap[answer.QuestionID] = answer.Answer
+	}
+	res := make([]model.GroupJoinAnswer, 0, len(answers))
+	for _, question := range questions {
+		answer := answerMap[question.QuestionID]
+		delete(answerMap, question.QuestionID)
+		if answer == "" {
+			if question.Required {
+				return nil, servererrs.ErrJoinAnswersRequired.WrapMsg("required question not answered " + question.QuestionID)
+			}
+			continue
+		}
+		res = append(res, model.GroupJoinAnswer{QuestionID: question.QuestionID, Question: question.Question, Answer: answer})
+	}
+	for questionID := range answerMap {
+		return nil, errs.ErrArgs.WrapMsg("unknown question " + questionID)
+	}
+	return res, nil
+}
+
+// withJoinAnswersEx adds the answers to ex under joinAnswersExKey. An ex that is not a JSON
+// object is kept under "ex".
+func withJoinAnswersEx(ex string, answers []model.GroupJoinAnswer) (string, error) {
+	obj := make(map[string]json.RawMessage)
+	if ex != "" {
+		if err := json.Unmarshal([]byte(ex), &obj); err != nil || obj == nil {
+			raw, err := json.Marshal(ex)
+			if err != nil {
+				return "", errs.Wrap(err)
+			}
+			obj = map[string]json.RawMessage{"ex": raw}
+		}
+	}
+	raw, err := json.Marshal(datautil.Slice(answers, groupJoinAnswerDB2PB))
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	obj[joinAnswersExKey] = raw
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	return string(data), nil
+}
+
+// attachJoinAnswers adds the answers of the applications that have some to their ex.
+func (s *groupServer) attachJoinAnswers(ctx context.Context, requests []*sdkws.GroupRequest) error {
+	userIDs := make(map[string][]string)
+	for _, request := range requests {
+		groupID := request.GroupInfo.GetGroupID()
+		userIDs[groupID] = append(userIDs[groupID], request.UserInfo.GetUserID())
+	}
+	type applicant struct{ groupID, userID string }
+	answerMap := make(map[applicant][]model.GroupJoinAnswer)
+	for groupID, ids := range userIDs {
+		answers, err := s.joinDB.FindAnswers(ctx, groupID, datautil.Distinct(ids))
+		if err != nil {
+			return err
+		}
+		for _, answer := range answers {
+			answerMap[applicant{answer.GroupID, answer.UserID}] = answer.Answers
+		}
+	}
+	for _, request := range requests {
+		answers := answerMap[applicant{request.GroupInfo.GetGroupID(), request.UserInfo.GetUserID()}]
+		if len(answers) == 0 {
+			continue
+		}
+		ex, err := withJoinAnswersEx(request.Ex, answers)
+		if err != nil {
+			return err
+		}
+		request.Ex = ex
+	}
+	return nil
+}
+
+func (s *groupServer) SetGroupJoinQuestions(ctx context.Context, req *groupext.SetGroupJoinQuestionsReq) (*groupext.SetGroupJoinQuestionsResp, error) {
+	if _, err := s.db.TakeGroup(ctx, req.GroupID); err != nil {
+		return nil, err
+	}
+	if _, err := s.checkGroupPermission(ctx, req.GroupID, authverify.GroupPermApproveApplication); err != nil {
+		return nil, err
+	}
+	questions := &model.GroupJoinQuestions{
+		GroupID:    req.GroupID,
+		Questions:  make([]model.GroupJoinQuestion, 0, len(req.Questions)),
+		UpdateTime: time.Now(),
+	}
+	questionIDs := make(map[string]struct{}, len(req.Questions))
+	for _, question := range req.Questions {
+		if question.QuestionID == "" {
+			question.QuestionID = primitive.NewObjectID().Hex()
+		}
+		if _, ok := questionIDs[question.QuestionID]; ok {
+			return nil, errs.ErrArgs.WrapMsg("questionID duplicate " + question.QuestionID)
+		}
+		questionIDs[question.QuestionID] = struct{}{}
+		questions.Questions = append(questions.Questions, model.GroupJoinQuestion{
+			QuestionID: question.QuestionID,
+			Question:   question.Question,
+			Required:   question.Required,
+		})
+	}
+	if err := s.joinDB.SetQuestions(ctx, questions); err != nil {
+		return nil, err
+	}
+	return &groupext.SetGroupJoinQuestionsResp{}, nil
+}
+
+func (s *groupServer) GetGroupJoinQuestions(ctx context.Context, req *groupext.GetGroupJoinQuestionsReq) (*groupext.GetGroupJoinQuestionsResp, error) {
+	questions, err := s.joinDB.TakeQuestions(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	return &groupext.GetGroupJoinQuestionsResp{Questions: datautil.Slice(questions.Questions, groupJoinQuestionDB2PB)}, nil
+}
+
+func (s *groupServer) JoinGroupWithAnswers(ctx context.Context, req *groupext.JoinGroupWithAnswersReq) (*groupext.JoinGroupWithAnswersResp, error) {
+	joined, err := s.joinGroup(ctx, &pbgroup.JoinGroupReq{
+		GroupID:       req.GroupID,
+		ReqMessage:    req.ReqMessage,
+		JoinSource:    req.JoinSource,
+		InviterUserID: req.InviterUserID,
+		Ex:            req.Ex,
+	}, model.GroupInviteApprovalDefault, req.Answers, "")
+	if err != nil {
+		return nil, err
+	}
+	return &groupext.JoinGroupWithAnswersResp{Joined: joined}, nil
+}
+
+func (s *groupServer) GetGroupApplicationAnswers(ctx context.Context, req *groupext.GetGroupApplicationAnswersReq) (*groupext.GetGroupApplicationAnswersResp, error) {
+	// applicants read their own answers
+	if !(len(req.UserIDs) == 1 && req.UserIDs[0] == mcontext.GetOpUserID(ctx)) {
+		if _, err := s.checkGroupPermission(ctx, req.GroupID, authverify.GroupPermApproveApplication); err != nil {
+			return nil, err
+		}
+	}
+	answers, err := s.joinDB.FindAnswers(ctx, req.GroupID, datautil.Distinct(req.UserIDs))
+	if err != nil {
+		return nil, err
+	}
+	return &groupext.GetGroupApplicationAnswersResp{
+		Applications: datautil.Slice(answers, func(e *model.GroupRequestAnswers) *groupext.GroupApplicationAnswers {
+			return &groupext.GroupApplicationAnswers{
+				GroupID:    e.GroupID,
+				UserID:     e.UserID,
+				Answers:    datautil.Slice(e.Answers, groupJoinAnswerDB2PB),
+				CreateTime: e.CreateTime.UnixMilli(),
+			}
+		}),
+	}, nil
+}
+
+func (s *groupServer) BatchGroupApplicationResponse(ctx context.Context, req *groupext.BatchGroupApplicationResponseReq) (*groupext.BatchGroupApplicationResponseResp, error) {
+	if _, err := s.checkGroupPermission(ctx, req.GroupID, authverify.GroupPermApproveApplication); err != nil {
+		return nil, err
+	}
+	var failedUserIDs []string
+	for _, userID := range datautil.Distinct(req.FromUserIDs) {
+		_, err := s.GroupApplicationResponse(ctx, &pbgroup.GroupApplicationResponseReq{
+			GroupID:      req.GroupID,
+			FromUserID:   userID,
+			HandledMsg:   req.HandledMsg,
+			HandleResult: req.HandleResult,
+		})
+		if err != nil {
+			log.ZWarn(ctx, "handle group application failed", err, "groupID", req.GroupID, "fromUserID", userID)
+			failedUserIDs = append(failedUserIDs, userID)
+		}
+	}
+	return &groupext.BatchGroupApplicationResponseResp{FailedUserIDs: failedUserIDs}, nil
+}
+
+func (s *groupServer) ExpireGroupApplications(ctx context.Context, req *groupext.ExpireGroupApplicationsReq) (*groupext.ExpireGroupApplicationsResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	before, ok := s.applicationExpireBefore(now)
+	if !ok {
+		return &groupext.ExpireGroupApplicationsResp{}, nil
+	}
+	var count int64
+	for {
+		requests, err := s.joinDB.FindExpiredRequests(ctx, before, expireGroupApplicationBatch)
+		if err != nil {
+			return nil, err
+		}
+		for _, request := range requests {
+			expired, err := s.joinDB.ExpireRequest(ctx, request.GroupID, request.UserID, before, now)
+			if err != nil {
+				return nil, err
+			}
+			if expired {
+				count++
+				s.notification.GroupApplicationExpiredNotification(ctx, request, now)
+			}
+		}
+		if len(requests) < expireGroupApplicationBatch {
+			break
+		}
+	}
+	return &groupext.ExpireGroupApplicationsResp{Count: count}, nil
+}
@@ -0,0 +1,105 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package group
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
+)
+
+func TestMatchJoinAnswers(t *testing.T) {
+	questions := []model.GroupJoinQuestion{
+		{QuestionID: "why", Question: "Why join?", Required: true},
+		{QuestionID: "from", Question: "Where are you from?"},
+	}
+	answer := func(questionID, answer string) *groupext.GroupJoinAnswer {
+		return &groupext.GroupJoinAnswer{QuestionID: questionID, Answer: answer}
+	}
+	tests := []struct {
+		name    string
+		answers []*groupext.GroupJoinAnswer
+		want    []model.GroupJoinAnswer
+		wantErr bool
+	}{
+		{
+			name:    "all answered in any order",
+			answers: []*groupext.GroupJoinAnswer{answer("from", "Earth"), answer("why", "Go")},
+			want: []model.GroupJoinAnswer{
+				{QuestionID: "why", Question: "Why join?", Answer: "Go"},
+				{QuestionID: "from", Question: "Where are you from?", Answer: "Earth"},
+			},
+		},
+		{
+			name:    "optional skipped",
+			answers: []*groupext.GroupJoinAnswer{answer("why", "Go"), answer("from", "")},
+			want:    []model.GroupJoinAnswer{{QuestionID: "why", Question: "Why join?", Answer: "Go"}},
+		},
+		{name: "required missing", answers: []*groupext.GroupJoinAnswer{answer("from", "Earth")}, wantErr: true},
+		{name: "required empty", answers: []*groupext.GroupJoinAnswer{answer("why", "")}, wantErr: true},
+		{name: "unknown question", answers: []*groupext.GroupJoinAnswer{answer("why", "Go"), answer("age", "42")}, wantErr: true},
+		{name: "answered twice", answers: []*groupext.GroupJoinAnswer{answer("why", "Go"), answer("why", "Rust")}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := matchJoinAnswers(questions, tt.answers)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("matchJoinAnswers() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("matchJoinAnswers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if got, err := matchJoinAnswers(nil, nil); err != nil || len(got) != 0 {
+		t.Errorf("matchJoinAnswers() without questions = %v, %v", got, err)
+	}
+	if _, err := matchJoinAnswers(questions, nil); !servererrs.ErrJoinAnswersRequired.Is(err) {
+		t.Errorf("matchJoinAnswers() without answers error = %v, want ErrJoinAnswersRequired", err)
+	}
+}
+
+func TestWithJoinAnswersEx(t *testing.T) {
+	answers := []model.GroupJoinAnswer{{QuestionID: "why", Question: "Why join?", Answer: "Go"}}
+	for ex, want := range map[string]string{
+		"":            "",
+		`{"a":1}`:     `1`,
+		"plain words": `"plain words"`,
+	} {
+		got, err := withJoinAnswersEx(ex, answers)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal([]byte(got), &obj); err != nil {
+			t.Fatalf("withJoinAnswersEx(%q) = %q is not an object", ex, got)
+		}
+		var pb []*groupext.GroupJoinAnswer
+		if err := json.Unmarshal(obj[joinAnswersExKey], &pb); err != nil || len(pb) != 1 || pb[0].Answer != "Go" {
+			t.Errorf("withJoinAnswersEx(%q) answers = %s", ex, obj[joinAnswersExKey])
+		}
+		key := "ex"
+		if ex != "" && ex[0] == '{' {
+			key = "a"
+		}
+		if want != "" && string(obj[key]) != want {
+			t.Errorf("withJoinAnswersEx(%q) lost the ex: %q", ex, got)
+		}
+	}
+}
@@ -17,11 +17,14 @@ package group
 import (
 	"context"
 	"fmt"
+	"time"
+
 	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/versionctx"
 	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient/notification"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
@@ -231,7 +234,7 @@ func (g *GroupNotificationSender) fillOpUser(ctx context.Context, opUser **sdkws
 	}
 	userID := mcontext.GetOpUserID(ctx)
 	if groupID != "" {
-		if authverify.IsManagerUserID(userID, g.config.Share.IMAdminUserID) {
+		if authverify.IsManagerUserID(ctx, userID, g.config.Share.IMAdminUserID) {
 			*opUser = &sdkws.GroupMemberFullInfo{
 				GroupID:        groupID,
 				UserID:         userID,
@@ -742,3 +745,43 @@ func (g *GroupNotificationSender) GroupMemberSetToOrdinaryUserNotification(ctx c
 	g.setVersion(ctx, &tips.GroupMemberVersion, &tips.GroupMemberVersionID, database.GroupMemberVersionName, tips.Group.GroupID)
 	g.Notification(ctx, mcontext.GetOpUserID(ctx), group.GroupID, constant.GroupMemberSetToOrdinaryUserNotification, tips)
 }
+
+func (g *GroupNotificationSender) GroupRoleChangedNotification(ctx context.Context, groupID string, role *groupext.GroupRole, changeType int32) {
+	var err error
+	defer func() {
+		if err != nil {
+			log.ZError(ctx, stringutil.GetFuncName(1)+" failed", err)
+		}
+	}()
+	var group *sdkws.GroupInfo
+	group, err = g.getGroupInfo(ctx, groupID)
+	if err != nil {
+		return
+	}
+	tips := &groupext.GroupRoleChangedTips{Group: group, Role: role, ChangeType: changeType, OperationTime: time.Now().UnixMilli()}
+	if err = g.fillOpUser(ctx, &tips.OpUser, groupID); err != nil {
+		return
+	}
+	g.Notification(ctx, mcontext.GetOpUserID(ctx), groupID, groupext.GroupRoleChangedNotification, tips)
+}
+
+func (g *GroupNotificationSender) GroupApplicationExpiredNotification(ctx context.Context, request *model.GroupRequest, expireTime time.Time) {
+	var err error
+	defer func() {
+		if err != nil {
+			log.ZError(ctx, stringutil.GetFuncName(1)+" failed", err)
+		}
+	}()
+	var group *sdkws.GroupInfo
+	group, err = g.getGroupInfo(ctx, request.GroupID)
+	if err != nil {
+		return
+	}
+	tips := &groupext.GroupApplicationExpiredTips{
+		Group:      group,
+		ReqMsg:     request.ReqMsg,
+		ReqTime:    request.ReqTime.UnixMilli(),
+		ExpireTime: expireTime.UnixMilli(),
+	}
+	g.Notification(ctx, mcontext.GetOpUserID(ctx), request.UserID, groupext.GroupApplicationExpiredNotification, tips)
+}
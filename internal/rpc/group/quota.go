@@ -0,0 +1,228 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package group
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/log"
+)
+
+// userGroupQuota returns how many groups a user of appMangerLevel can own and join, 0 for
+// no limit.
+func userGroupQuota(quota *config.GroupQuota, appMangerLevel int32) (maxCreated int, maxJoined int) {
+	maxCreated, maxJoined = quota.MaxCreatedGroups, quota.MaxJoinedGroups
+	for _, level := range quota.Levels {
+		if level.AppMangerLevel != appMangerLevel {
+			continue
+		}
+		if level.MaxCreatedGroups != nil {
+			maxCreated = *level.MaxCreatedGroups
+		}
+		if level.MaxJoinedGroups != nil {
+			maxJoined = *level.MaxJoinedGroups
+		}
+	}
+	return maxCreated, maxJoined
+}
+
+// groupTypeMemberQuota returns the configured member quota of groupType, 0 for no limit.
+func groupTypeMemberQuota(quota *config.GroupQuota, groupType int32) int {
+	if groupType == groupext.ChannelGroup {
+		return quota.MaxChannelMembers
+	}
+	return quota.MaxWorkingGroupMembers
+}
+
+// groupMemberQuota returns the member quota of the group and whether it was set for the group.
+func (s *groupServer) groupMemberQuota(ctx context.Context, group *model.Group) (int, bool, error) {
+	quota, err := s.quotaDB.TakeGroupQuota(ctx, group.GroupID)
+	if err != nil {
+		return 0, false, err
+	}
+	if quota != nil {
+		return int(quota.MaxMemberCount), true, nil
+	}
+	return groupTypeMemberQuota(&s.config.RpcConfig.Quota, group.GroupType), false, nil
+}
+
+// quotaReservation holds the slots a write reserved while checking its quotas. Every check
+// counts the slots reserved by the writes in flight besides the stored ones, so concurrent
+// writes can not pass the same free slot; release gives the slots back once the write is
+// stored or failed.
+type quotaReservation struct {
+	db    controller.GroupQuotaDatabase
+	slots map[string]int64
+}
+
+func (s *groupServer) newQuotaReservation() *quotaReservation {
+	return &quotaReservation{db: s.quotaDB, slots: make(map[string]int64)}
+}
+
+// reserve takes n slots of key and reports whether they fit in limit next to the used ones
+// and those reserved by other writes.
+func (r *quotaReservation) reserve(ctx context.Context, key string, n int64, limit int, used func() (int, error)) (bool, error) {
+	reserved, err := r.db.ReserveQuota(ctx, key, n)
+	if err != nil {
+		return false, err
+	}
+	r.slots[key] += n
+	count, err := used()
+	if err != nil {
+		return false, err
+	}
+	return int64(count)+reserved <= int64(limit), nil
+}
+
+func (r *quotaReservation) release(ctx context.Context) {
+	ctx = context.WithoutCancel(ctx)
+	for key, n := range r.slots {
+		if err := r.db.ReleaseQuota(ctx, key, n); err != nil {
+			log.ZWarn(ctx, "release group quota failed", err, "key", key, "n", n)
+		}
+	}
+	r.slots = make(map[string]int64)
+}
+
+// checkCreateGroupQuota reserves one more group owned by the owner.
+func (s *groupServer) checkCreateGroupQuota(ctx context.Context, quota *quotaReservation, owner *sdkws.UserInfo) error {
+	maxCreated, _ := userGroupQuota(&s.config.RpcConfig.Quota, owner.AppMangerLevel)
+	if maxCreated <= 0 {
+		return nil
+	}
+	var owned int
+	ok, err := quota.reserve(ctx, cachekey.GetUserCreateQuotaKey(owner.UserID), 1, maxCreated, func() (int, error) {
+		groupIDs, err := s.db.FindJoinGroupID(ctx, owner.UserID)
+		if err != nil {
+			return 0, err
+		}
+		members, err := s.db.FindGroupMemberUser(ctx, groupIDs, owner.UserID)
+		if err != nil {
+			return 0, err
+		}
+		for _, member := range members {
+			if member.RoleLevel == constant.GroupOwner {
+				owned++
+			}
+		}
+		return owned, nil
+	})
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return servererrs.ErrGroupCreateLimit.WrapMsg(fmt.Sprintf("user %s owns %d groups", owner.UserID, owned))
+	}
+	return nil
+}
+
+// checkJoinGroupQuota reserves one more group for each of the users.
+func (s *groupServer) checkJoinGroupQuota(ctx context.Context, quota *quotaReservation, users []*sdkws.UserInfo) error {
+	for _, user := range users {
+		_, maxJoined := userGroupQuota(&s.config.RpcConfig.Quota, user.AppMangerLevel)
+		if maxJoined <= 0 {
+			continue
+		}
+		var joined int
+		ok, err := quota.reserve(ctx, cachekey.GetUserJoinQuotaKey(user.UserID), 1, maxJoined, func() (int, error) {
+			groupIDs, err := s.db.FindJoinGroupID(ctx, user.UserID)
+			joined = len(groupIDs)
+			return joined, err
+		})
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return servererrs.ErrGroupJoinLimit.WrapMsg(fmt.Sprintf("user %s is in %d groups", user.UserID, joined))
+		}
+	}
+	return nil
+}
+
+// checkGroupMemberQuota reserves count more members of the group.
+func (s *groupServer) checkGroupMemberQuota(ctx context.Context, quota *quotaReservation, group *model.Group, count int) error {
+	maxMembers, _, err := s.groupMemberQuota(ctx, group)
+	if err != nil {
+		return err
+	}
+	if maxMembers <= 0 {
+		return nil
+	}
+	ok, err := quota.reserve(ctx, cachekey.GetGroupMemberQuotaKey(group.GroupID), int64(count), maxMembers, func() (int, error) {
+		memberCount, err := s.db.FindGroupMemberNum(ctx, group.GroupID)
+		return int(memberCount), err
+	})
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return servererrs.ErrGroupMemberLimit.WrapMsg(fmt.Sprintf("group %s can have %d members", group.GroupID, maxMembers))
+	}
+	return nil
+}
+
+func (s *groupServer) SetGroupQuota(ctx context.Context, req *groupext.SetGroupQuotaReq) (*groupext.SetGroupQuotaResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if _, err := s.db.TakeGroup(ctx, req.GroupID); err != nil {
+		return nil, err
+	}
+	if req.MaxMemberCount < 0 {
+		if err := s.quotaDB.DeleteGroupQuota(ctx, req.GroupID); err != nil {
+			return nil, err
+		}
+		return &groupext.SetGroupQuotaResp{}, nil
+	}
+	quota := &model.GroupQuota{
+		GroupID:        req.GroupID,
+		MaxMemberCount: req.MaxMemberCount,
+		UpdateTime:     time.Now(),
+	}
+	if err := s.quotaDB.SetGroupQuota(ctx, quota); err != nil {
+		return nil, err
+	}
+	return &groupext.SetGroupQuotaResp{}, nil
+}
+
+func (s *groupServer) GetGroupQuota(ctx context.Context, req *groupext.GetGroupQuotaReq) (*groupext.GetGroupQuotaResp, error) {
+	if err := s.checkGroupMember(ctx, req.GroupID); err != nil {
+		return nil, err
+	}
+	group, err := s.db.TakeGroup(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	maxMembers, custom, err := s.groupMemberQuota(ctx, group)
+	if err != nil {
+		return nil, err
+	}
+	memberCount, err := s.db.FindGroupMemberNum(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	return &groupext.GetGroupQuotaResp{MaxMemberCount: int32(maxMembers), Custom: custom, MemberCount: memberCount}, nil
+}
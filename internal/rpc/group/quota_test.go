@@ -0,0 +1,109 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package group
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
+	"github.com/openimsdk/protocol/constant"
+)
+
+func TestUserGroupQuota(t *testing.T) {
+	unlimited := 0
+	quota := &config.GroupQuota{
+		MaxCreatedGroups: 5,
+		MaxJoinedGroups:  100,
+		Levels: []config.GroupLevelQuota{
+			{AppMangerLevel: constant.AppAdmin, MaxCreatedGroups: &unlimited},
+		},
+	}
+	if created, joined := userGroupQuota(quota, constant.AppOrdinaryUsers); created != 5 || joined != 100 {
+		t.Errorf("userGroupQuota() of ordinary users = %d, %d", created, joined)
+	}
+	if created, joined := userGroupQuota(quota, constant.AppAdmin); created != 0 || joined != 100 {
+		t.Errorf("userGroupQuota() of app admins = %d, %d", created, joined)
+	}
+}
+
+func TestGroupTypeMemberQuota(t *testing.T) {
+	quota := &config.GroupQuota{MaxWorkingGroupMembers: 2000, MaxChannelMembers: 100000}
+	if n := groupTypeMemberQuota(quota, constant.WorkingGroup); n != 2000 {
+		t.Errorf("groupTypeMemberQuota() of working groups = %d", n)
+	}
+	if n := groupTypeMemberQuota(quota, groupext.ChannelGroup); n != 100000 {
+		t.Errorf("groupTypeMemberQuota() of channels = %d", n)
+	}
+}
+
+// memoryQuotaDB keeps the quota reservations in memory.
+type memoryQuotaDB struct {
+	controller.GroupQuotaDatabase
+	mu       sync.Mutex
+	reserved map[string]int64
+}
+
+func (m *memoryQuotaDB) ReserveQuota(_ context.Context, key string, n int64) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.reserved[key] += n
+	return m.reserved[key], nil
+}
+
+func (m *memoryQuotaDB) ReleaseQuota(_ context.Context, key string, n int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.reserved[key] -= n
+	return nil
+}
+
+func TestQuotaReservation(t *testing.T) {
+	db := &memoryQuotaDB{reserved: make(map[string]int64)}
+	ctx := context.Background()
+	// members join concurrently, each stored member taking the slot reserved for it
+	const limit = 10
+	var (
+		stored atomic.Int64
+		wg     sync.WaitGroup
+	)
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			quota := &quotaReservation{db: db, slots: make(map[string]int64)}
+			defer quota.release(ctx)
+			ok, err := quota.reserve(ctx, "g", 1, limit, func() (int, error) { return int(stored.Load()), nil })
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if ok {
+				stored.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	if n := stored.Load(); n == 0 || n > limit {
+		t.Errorf("%d members stored with a quota of %d", n, limit)
+	}
+	if db.reserved["g"] != 0 {
+		t.Errorf("%d slots still reserved", db.reserved["g"])
+	}
+}
@@ -0,0 +1,331 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package group
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/common"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
+	"github.com/openimsdk/protocol/constant"
+	pbgroup "github.com/openimsdk/protocol/group"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const maxGroupRoles = 32
+
+// groupPermMember returns what authorizing group operations needs to know about member.
+// A custom role that no longer exists grants nothing.
+func groupPermMember(roles *model.GroupRoles, member *model.GroupMember) *authverify.GroupMember {
+	m := &authverify.GroupMember{UserID: member.UserID, RoleLevel: member.RoleLevel}
+	if role := roles.Role(member.RoleID); member.RoleID != "" && role != nil {
+		m.RoleID = role.RoleID
+		m.Permissions = authverify.GroupPermission(role.Permissions)
+	}
+	return m
+}
+
+// groupPermMembers returns the members of userIDs in the group, keyed by user ID. Users not in
+// the group are left out.
+func (s *groupServer) groupPermMembers(ctx context.Context, groupID string, userIDs []string) (map[string]*authverify.GroupMember, error) {
+	members, err := s.db.FindGroupMembers(ctx, groupID, datautil.Distinct(userIDs))
+	if err != nil {
+		return nil, err
+	}
+	roles, err := s.roleDB.GetGroupRoles(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[string]*authverify.GroupMember, len(members))
+	for _, member := range members {
+		res[member.UserID] = groupPermMember(roles, member)
+	}
+	return res, nil
+}
+
+// checkGroupPermission checks that the op user has perm in the group and outranks the members
+// of targetUserIDs. It returns the op user, nil for app admins who may do everything.
+func (s *groupServer) checkGroupPermission(ctx context.Context, groupID string, perm authverify.GroupPermission, targetUserIDs ...string) (*authverify.GroupMember, error) {
+	if authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
+		return nil, nil
+	}
+	opUserID := mcontext.GetOpUserID(ctx)
+	members, err := s.groupPermMembers(ctx, groupID, append([]string{opUserID}, targetUserIDs...))
+	if err != nil {
+		return nil, err
+	}
+	targets := make([]*authverify.GroupMember, 0, len(targetUserIDs))
+	for _, userID := range targetUserIDs {
+		target, ok := members[userID]
+		if !ok {
+			return nil, servererrs.ErrUserIDNotFound.WrapMsg(userID)
+		}
+		targets = append(targets, target)
+	}
+	op, ok := members[opUserID]
+	if !ok {
+		err = errs.ErrNoPermission.WrapMsg("opUserID not in group")
+	} else {
+		err = authverify.CheckGroupPermission(op, perm, targets...)
+	}
+	if err != nil {
+		// the admins of the community of the group manage it like its owner
+		if communityAdmin, err2 := s.isGroupCommunityAdmin(ctx, groupID); err2 != nil {
+			return nil, err2
+		} else if !communityAdmin {
+			return nil, err
+		}
+		for _, target := range targets {
+			if target.RoleLevel == constant.GroupOwner {
+				return nil, errs.ErrNoPermission.WrapMsg("the group owner can not be managed by community admins")
+			}
+		}
+		return nil, nil
+	}
+	return op, nil
+}
+
+// checkGroupMember allows app admins and the members of the group.
+func (s *groupServer) checkGroupMember(ctx context.Context, groupID string) error {
+	if authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
+		return nil
+	}
+	_, err := s.db.TakeGroupMember(ctx, groupID, mcontext.GetOpUserID(ctx))
+	return err
+}
+
+func groupRoleDB2PB(role *model.GroupRole) *groupext.GroupRole {
+	return &groupext.GroupRole{
+		RoleID:      role.RoleID,
+		Name:        role.Name,
+		Permissions: role.Permissions,
+		Ex:          role.Ex,
+		CreateTime:  role.CreateTime.UnixMilli(),
+	}
+}
+
+func (s *groupServer) CreateGroupRole(ctx context.Context, req *groupext.CreateGroupRoleReq) (*groupext.CreateGroupRoleResp, error) {
+	op, err := s.checkGroupPermission(ctx, req.GroupID, authverify.GroupPermManageRoles)
+	if err != nil {
+		return nil, err
+	}
+	if err := authverify.CheckGroupGrant(op, authverify.GroupPermission(req.Permissions)); err != nil {
+		return nil, err
+	}
+	roles, err := s.roleDB.GetGroupRoles(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	if len(roles.Roles) >= maxGroupRoles {
+		return nil, errs.ErrArgs.WrapMsg("too many group roles", "max", maxGroupRoles)
+	}
+	role := &model.GroupRole{
+		RoleID:      primitive.NewObjectID().Hex(),
+		Name:        req.Name,
+		Permissions: req.Permissions,
+		Ex:          req.Ex,
+		CreateTime:  time.Now(),
+	}
+	roles.Roles = append(roles.Roles, role)
+	roles.UpdateTime = time.Now()
+	if err := s.roleDB.SetGroupRoles(ctx, roles); err != nil {
+		return nil, err
+	}
+	pbRole := groupRoleDB2PB(role)
+	s.notification.GroupRoleChangedNotification(ctx, req.GroupID, pbRole, groupext.GroupRoleCreated)
+	return &groupext.CreateGroupRoleResp{Role: pbRole}, nil
+}
+
+func (s *groupServer) UpdateGroupRole(ctx context.Context, req *groupext.UpdateGroupRoleReq) (*groupext.UpdateGroupRoleResp, error) {
+	op, err := s.checkGroupPermission(ctx, req.GroupID, authverify.GroupPermManageRoles)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := s.roleDB.GetGroupRoles(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	role := roles.Role(req.RoleID)
+	if role == nil {
+		return nil, errs.ErrRecordNotFound.WrapMsg("group role not found", "roleID", req.RoleID)
+	}
+	if req.Permissions != nil {
+		// Granting and revoking are both limited to the permissions of the op user.
+		if err := authverify.CheckGroupGrant(op, authverify.GroupPermission(req.Permissions.Value|role.Permissions)); err != nil {
+			return nil, err
+		}
+		role.Permissions = req.Permissions.Value
+	}
+	if req.Name != nil {
+		role.Name = req.Name.Value
+	}
+	if req.Ex != nil {
+		role.Ex = req.Ex.Value
+	}
+	roles.UpdateTime = time.Now()
+	if err := s.roleDB.SetGroupRoles(ctx, roles); err != nil {
+		return nil, err
+	}
+	s.notification.GroupRoleChangedNotification(ctx, req.GroupID, groupRoleDB2PB(role), groupext.GroupRoleUpdated)
+	return &groupext.UpdateGroupRoleResp{}, nil
+}
+
+func (s *groupServer) DeleteGroupRole(ctx context.Context, req *groupext.DeleteGroupRoleReq) (*groupext.DeleteGroupRoleResp, error) {
+	op, err := s.checkGroupPermission(ctx, req.GroupID, authverify.GroupPermManageRoles)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := s.roleDB.GetGroupRoles(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	role := roles.Role(req.RoleID)
+	if role == nil {
+		return nil, errs.ErrRecordNotFound.WrapMsg("group role not found", "roleID", req.RoleID)
+	}
+	if err := authverify.CheckGroupGrant(op, authverify.GroupPermission(role.Permissions)); err != nil {
+		return nil, err
+	}
+	roles.Roles = datautil.Filter(roles.Roles, func(e *model.GroupRole) (*model.GroupRole, bool) {
+		return e, e.RoleID != req.RoleID
+	})
+	roles.UpdateTime = time.Now()
+	if err := s.roleDB.SetGroupRoles(ctx, roles); err != nil {
+		return nil, err
+	}
+	// A deleted role grants nothing, so a failure below only leaves stale role IDs behind.
+	userIDs, err := s.db.FindGroupRoleIDMemberIDs(ctx, req.GroupID, req.RoleID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.setGroupMemberRole(ctx, req.GroupID, userIDs, ""); err != nil {
+		return nil, err
+	}
+	s.notification.GroupRoleChangedNotification(ctx, req.GroupID, groupRoleDB2PB(role), groupext.GroupRoleDeleted)
+	return &groupext.DeleteGroupRoleResp{}, nil
+}
+
+func (s *groupServer) GetGroupRoles(ctx context.Context, req *groupext.GetGroupRolesReq) (*groupext.GetGroupRolesResp, error) {
+	if err := s.checkGroupMember(ctx, req.GroupID); err != nil {
+		return nil, err
+	}
+	roles, err := s.roleDB.GetGroupRoles(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	return &groupext.GetGroupRolesResp{Roles: datautil.Slice(roles.Roles, groupRoleDB2PB)}, nil
+}
+
+func (s *groupServer) SetGroupMemberRole(ctx context.Context, req *groupext.SetGroupMemberRoleReq) (*groupext.SetGroupMemberRoleResp, error) {
+	if datautil.Duplicate(req.UserIDs) {
+		return nil, errs.ErrArgs.WrapMsg("userIDs duplicate")
+	}
+	op, err := s.checkGroupPermission(ctx, req.GroupID, authverify.GroupPermSetMemberRole, req.UserIDs...)
+	if err != nil {
+		return nil, err
+	}
+	if req.RoleID != "" {
+		roles, err := s.roleDB.GetGroupRoles(ctx, req.GroupID)
+		if err != nil {
+			return nil, err
+		}
+		role := roles.Role(req.RoleID)
+		if role == nil {
+			return nil, errs.ErrRecordNotFound.WrapMsg("group role not found", "roleID", req.RoleID)
+		}
+		if err := authverify.CheckGroupGrant(op, authverify.GroupPermission(role.Permissions)); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.setGroupMemberRole(ctx, req.GroupID, req.UserIDs, req.RoleID); err != nil {
+		return nil, err
+	}
+	return &groupext.SetGroupMemberRoleResp{}, nil
+}
+
+func (s *groupServer) setGroupMemberRole(ctx context.Context, groupID string, userIDs []string, roleID string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	data := make([]*common.BatchUpdateGroupMember, 0, len(userIDs))
+	for _, userID := range userIDs {
+		data = append(data, &common.BatchUpdateGroupMember{GroupID: groupID, UserID: userID, Map: map[string]any{"role_id": roleID}})
+	}
+	if err := s.db.UpdateGroupMembers(ctx, data); err != nil {
+		return err
+	}
+	for _, userID := range userIDs {
+		s.notification.GroupMemberInfoSetNotification(ctx, groupID, userID)
+	}
+	return nil
+}
+
+func (s *groupServer) GetGroupMemberPermissions(ctx context.Context, req *groupext.GetGroupMemberPermissionsReq) (*groupext.GetGroupMemberPermissionsResp, error) {
+	if err := s.checkGroupMember(ctx, req.GroupID); err != nil {
+		return nil, err
+	}
+	members, err := s.groupPermMembers(ctx, req.GroupID, req.UserIDs)
+	if err != nil {
+		return nil, err
+	}
+	resp := &groupext.GetGroupMemberPermissionsResp{Members: make([]*groupext.GroupMemberPermission, 0, len(members))}
+	for _, userID := range datautil.Distinct(req.UserIDs) {
+		member, ok := members[userID]
+		if !ok {
+			continue
+		}
+		resp.Members = append(resp.Members, &groupext.GroupMemberPermission{
+			UserID:      member.UserID,
+			RoleLevel:   member.RoleLevel,
+			RoleID:      member.RoleID,
+			Permissions: int64(member.Permission()),
+		})
+	}
+	return resp, nil
+}
+
+// groupMemberInfoPermission returns the permissions needed to set member on another member.
+func groupMemberInfoPermission(member *pbgroup.SetGroupMemberInfo) authverify.GroupPermission {
+	var perm authverify.GroupPermission
+	if member.Nickname != nil || member.FaceURL != nil || member.Ex != nil {
+		perm |= authverify.GroupPermSetMemberInfo
+	}
+	if member.RoleLevel != nil || perm == 0 {
+		perm |= authverify.GroupPermSetMemberRole
+	}
+	return perm
+}
+
+// groupInfoPermission returns the permissions needed to set info on a group, changing only
+// the notification needs the notification permission alone.
+func groupInfoPermission(info *sdkws.GroupInfoForSet) authverify.GroupPermission {
+	var perm authverify.GroupPermission
+	if info.Notification != "" {
+		perm |= authverify.GroupPermSetNotification
+	}
+	if info.GroupName != "" || info.Introduction != "" || info.FaceURL != "" || info.Ex != nil ||
+		info.NeedVerification != nil || info.LookMemberInfo != nil || info.ApplyMemberFriend != nil || perm == 0 {
+		perm |= authverify.GroupPermSetGroupInfo
+	}
+	return perm
+}
@@ -0,0 +1,340 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package group
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
+	"github.com/openimsdk/protocol/constant"
+	pbgroup "github.com/openimsdk/protocol/group"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// inactiveOwnerBatch is how many group owners are loaded at once.
+const inactiveOwnerBatch = 500
+
+// groupSuccessor returns who takes over the group from ownerUserID under policy, "" when the
+// group is dismissed. members are the candidates, at least the admins and the earliest
+// ordinary member.
+func groupSuccessor(policy int32, successorUserID string, ownerUserID string, members []*model.GroupMember) string {
+	oldest := func(adminOnly bool) string {
+		var res *model.GroupMember
+		for _, member := range members {
+			if member.UserID == ownerUserID || (adminOnly && member.RoleLevel != constant.GroupAdmin) {
+				continue
+			}
+			if res == nil || member.JoinTime.Before(res.JoinTime) {
+				res = member
+			}
+		}
+		if res == nil {
+			return ""
+		}
+		return res.UserID
+	}
+	switch policy {
+	case groupext.SuccessionDismiss:
+		return ""
+	case groupext.SuccessionOldestMember:
+		return oldest(false)
+	case groupext.SuccessionDesignated:
+		if successorUserID != ownerUserID && slices.ContainsFunc(members, func(e *model.GroupMember) bool { return e.UserID == successorUserID }) {
+			return successorUserID
+		}
+	}
+	if userID := oldest(true); userID != "" {
+		return userID
+	}
+	return oldest(false)
+}
+
+// successionPolicy returns the succession policy of the group and its designated successor.
+func (s *groupServer) successionPolicy(ctx context.Context, groupID string) (int32, string, error) {
+	succession, err := s.successionDB.TakeGroupSuccession(ctx, groupID)
+	if err != nil {
+		return 0, "", err
+	}
+	if succession != nil {
+		return succession.Policy, succession.SuccessorUserID, nil
+	}
+	return s.config.RpcConfig.Succession.Policy, "", nil
+}
+
+// findGroupSuccessor returns who takes over the group from its owner, "" when it is dismissed.
+func (s *groupServer) findGroupSuccessor(ctx context.Context, groupID string, ownerUserID string) (string, error) {
+	policy, successorUserID, err := s.successionPolicy(ctx, groupID)
+	if err != nil {
+		return "", err
+	}
+	if policy == groupext.SuccessionDismiss {
+		return "", nil
+	}
+	admins, err := s.db.FindGroupMemberRoleLevels(ctx, groupID, []int32{constant.GroupAdmin})
+	if err != nil {
+		return "", err
+	}
+	// Members are sorted by role level, then join time, so the owner and the admins come
+	// before the earliest ordinary member.
+	_, members, err := s.db.PageGetGroupMember(ctx, groupID, &sdkws.RequestPagination{PageNumber: 1, ShowNumber: int32(len(admins) + 2)})
+	if err != nil {
+		return "", err
+	}
+	if policy == groupext.SuccessionDesignated && successorUserID != "" {
+		successors, err := s.db.FindGroupMembers(ctx, groupID, []string{successorUserID})
+		if err != nil {
+			return "", err
+		}
+		members = append(members, successors...)
+	}
+	return groupSuccessor(policy, successorUserID, ownerUserID, members), nil
+}
+
+// handOverGroup transfers the group from its owner by the succession policy, or dismisses it,
+// and returns the new owner, "" when the group was dismissed.
+func (s *groupServer) handOverGroup(ctx context.Context, groupID string, ownerUserID string) (string, error) {
+	successor, err := s.findGroupSuccessor(ctx, groupID, ownerUserID)
+	if err != nil {
+		return "", err
+	}
+	if successor == "" {
+		if _, err := s.DismissGroup(ctx, &pbgroup.DismissGroupReq{GroupID: groupID}); err != nil {
+			return "", err
+		}
+		return "", nil
+	}
+	req := &pbgroup.TransferGroupOwnerReq{GroupID: groupID, OldOwnerUserID: ownerUserID, NewOwnerUserID: successor}
+	if _, err := s.TransferGroupOwner(ctx, req); err != nil {
+		return "", err
+	}
+	return successor, nil
+}
+
+// handOverOwnedGroups hands over every group the user owns that is not dismissed.
+func (s *groupServer) handOverOwnedGroups(ctx context.Context, userID string) (transferred int64, dismissed int64, err error) {
+	groupIDs, err := s.db.FindUserManagedGroupID(ctx, userID)
+	if err != nil || len(groupIDs) == 0 {
+		return 0, 0, err
+	}
+	members, err := s.db.FindGroupMemberUser(ctx, groupIDs, userID)
+	if err != nil {
+		return 0, 0, err
+	}
+	ownedGroupIDs := make([]string, 0, len(members))
+	for _, member := range members {
+		if member.RoleLevel == constant.GroupOwner {
+			ownedGroupIDs = append(ownedGroupIDs, member.GroupID)
+		}
+	}
+	if len(ownedGroupIDs) == 0 {
+		return 0, 0, nil
+	}
+	groups, err := s.db.FindGroup(ctx, ownedGroupIDs)
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, group := range groups {
+		if group.Status == constant.GroupStatusDismissed {
+			continue
+		}
+		newOwnerUserID, err := s.handOverGroup(ctx, group.GroupID, userID)
+		if err != nil {
+			return transferred, dismissed, err
+		}
+		if newOwnerUserID == "" {
+			dismissed++
+		} else {
+			transferred++
+		}
+		log.ZInfo(ctx, "group handed over", "groupID", group.GroupID, "ownerUserID", userID, "newOwnerUserID", newOwnerUserID)
+	}
+	return transferred, dismissed, nil
+}
+
+func (s *groupServer) SetGroupSuccession(ctx context.Context, req *groupext.SetGroupSuccessionReq) (*groupext.SetGroupSuccessionResp, error) {
+	groupID := req.Succession.GroupID
+	owner, err := s.db.TakeGroupOwner(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	if !authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) && owner.UserID != mcontext.GetOpUserID(ctx) {
+		return nil, errs.ErrNoPermission.WrapMsg("not group owner")
+	}
+	if req.Succession.Policy == 0 {
+		if err := s.successionDB.DeleteGroupSuccession(ctx, groupID); err != nil {
+			return nil, err
+		}
+		return &groupext.SetGroupSuccessionResp{}, nil
+	}
+	succession := &model.GroupSuccession{
+		GroupID:    groupID,
+		Policy:     req.Succession.Policy,
+		UpdateTime: time.Now(),
+	}
+	if succession.Policy == groupext.SuccessionDesignated {
+		if req.Succession.SuccessorUserID == owner.UserID {
+			return nil, errs.ErrArgs.WrapMsg("the owner can't succeed itself")
+		}
+		if _, err := s.db.TakeGroupMember(ctx, groupID, req.Succession.SuccessorUserID); err != nil {
+			return nil, err
+		}
+		succession.SuccessorUserID = req.Succession.SuccessorUserID
+	}
+	if err := s.successionDB.SetGroupSuccession(ctx, succession); err != nil {
+		return nil, err
+	}
+	return &groupext.SetGroupSuccessionResp{}, nil
+}
+
+func (s *groupServer) GetGroupSuccession(ctx context.Context, req *groupext.GetGroupSuccessionReq) (*groupext.GetGroupSuccessionResp, error) {
+	if err := s.checkGroupMember(ctx, req.GroupID); err != nil {
+		return nil, err
+	}
+	owner, err := s.db.TakeGroupOwner(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &groupext.GetGroupSuccessionResp{Succession: &groupext.GroupSuccession{GroupID: req.GroupID}}
+	succession, err := s.successionDB.TakeGroupSuccession(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	if succession != nil {
+		resp.Succession.Policy = succession.Policy
+		resp.Succession.SuccessorUserID = succession.SuccessorUserID
+	}
+	resp.SuccessorUserID, err = s.findGroupSuccessor(ctx, req.GroupID, owner.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *groupServer) QuitGroupWithSuccession(ctx context.Context, req *groupext.QuitGroupWithSuccessionReq) (*groupext.QuitGroupWithSuccessionResp, error) {
+	if req.UserID == "" {
+		req.UserID = mcontext.GetOpUserID(ctx)
+	} else {
+		if err := authverify.CheckAccessV3(ctx, req.UserID, s.config.Share.IMAdminUserID); err != nil {
+			return nil, err
+		}
+	}
+	member, err := s.db.TakeGroupMember(ctx, req.GroupID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &groupext.QuitGroupWithSuccessionResp{}
+	if member.RoleLevel == constant.GroupOwner {
+		resp.NewOwnerUserID, err = s.handOverGroup(ctx, req.GroupID, req.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if resp.NewOwnerUserID == "" {
+			resp.Dismissed = true
+			return resp, nil
+		}
+	}
+	if _, err := s.QuitGroup(ctx, &pbgroup.QuitGroupReq{GroupID: req.GroupID, UserID: req.UserID}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *groupServer) HandOverOwnedGroups(ctx context.Context, req *groupext.HandOverOwnedGroupsReq) (*groupext.HandOverOwnedGroupsResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	transferred, dismissed, err := s.handOverOwnedGroups(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &groupext.HandOverOwnedGroupsResp{Transferred: transferred, Dismissed: dismissed}, nil
+}
+
+func (s *groupServer) SucceedInactiveGroupOwners(ctx context.Context, req *groupext.SucceedInactiveGroupOwnersReq) (*groupext.SucceedInactiveGroupOwnersResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	resp := &groupext.SucceedInactiveGroupOwnersResp{}
+	days := s.config.RpcConfig.Succession.OwnerInactiveDays
+	if days <= 0 {
+		return resp, nil
+	}
+	before := time.Now().AddDate(0, 0, -days)
+	var afterGroupID string
+	for {
+		owners, err := s.db.FindGroupOwners(ctx, afterGroupID, inactiveOwnerBatch)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.handOverInactiveOwners(ctx, owners, before, resp); err != nil {
+			return nil, err
+		}
+		if len(owners) < inactiveOwnerBatch {
+			break
+		}
+		afterGroupID = owners[len(owners)-1].GroupID
+	}
+	return resp, nil
+}
+
+// handOverInactiveOwners hands over the groups whose owners were active last before the time.
+// A group failing to be handed over is skipped until the next sweep.
+func (s *groupServer) handOverInactiveOwners(ctx context.Context, owners []*model.GroupMember, before time.Time, resp *groupext.SucceedInactiveGroupOwnersResp) error {
+	if len(owners) == 0 {
+		return nil
+	}
+	inactiveUserIDs, err := s.activityDB.FindInactiveUserIDs(ctx, datautil.Slice(owners, func(e *model.GroupMember) string { return e.UserID }), before)
+	if err != nil || len(inactiveUserIDs) == 0 {
+		return err
+	}
+	inactive := datautil.SliceSet(inactiveUserIDs)
+	owners = datautil.Filter(owners, func(e *model.GroupMember) (*model.GroupMember, bool) {
+		_, ok := inactive[e.UserID]
+		return e, ok
+	})
+	groups, err := s.db.FindGroup(ctx, datautil.Slice(owners, func(e *model.GroupMember) string { return e.GroupID }))
+	if err != nil {
+		return err
+	}
+	dismissedGroups := make(map[string]bool, len(groups))
+	for _, group := range groups {
+		dismissedGroups[group.GroupID] = group.Status == constant.GroupStatusDismissed
+	}
+	for _, owner := range owners {
+		if dismissed, ok := dismissedGroups[owner.GroupID]; !ok || dismissed {
+			continue
+		}
+		newOwnerUserID, err := s.handOverGroup(ctx, owner.GroupID, owner.UserID)
+		if err != nil {
+			log.ZWarn(ctx, "hand over group of inactive owner failed", err, "groupID", owner.GroupID, "ownerUserID", owner.UserID)
+			continue
+		}
+		if newOwnerUserID == "" {
+			resp.Dismissed++
+		} else {
+			resp.Transferred++
+		}
+		log.ZInfo(ctx, "group handed over", "groupID", owner.GroupID, "ownerUserID", owner.UserID, "newOwnerUserID", newOwnerUserID)
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package group
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
+	"github.com/openimsdk/protocol/constant"
+)
+
+func TestGroupSuccessor(t *testing.T) {
+	now := time.Now()
+	members := []*model.GroupMember{
+		{UserID: "owner", RoleLevel: constant.GroupOwner, JoinTime: now.Add(-4 * time.Hour)},
+		{UserID: "admin2", RoleLevel: constant.GroupAdmin, JoinTime: now.Add(-time.Hour)},
+		{UserID: "admin1", RoleLevel: constant.GroupAdmin, JoinTime: now.Add(-2 * time.Hour)},
+		{UserID: "member1", RoleLevel: constant.GroupOrdinaryUsers, JoinTime: now.Add(-3 * time.Hour)},
+		{UserID: "member2", RoleLevel: constant.GroupOrdinaryUsers, JoinTime: now},
+	}
+	tests := []struct {
+		name            string
+		policy          int32
+		successorUserID string
+		members         []*model.GroupMember
+		want            string
+	}{
+		{"oldest admin", groupext.SuccessionOldestAdmin, "", members, "admin1"},
+		{"oldest member", groupext.SuccessionOldestMember, "", members, "member1"},
+		{"designated", groupext.SuccessionDesignated, "member2", members, "member2"},
+		{"designated left", groupext.SuccessionDesignated, "gone", members, "admin1"},
+		{"designated owner", groupext.SuccessionDesignated, "owner", members, "admin1"},
+		{"dismiss", groupext.SuccessionDismiss, "", members, ""},
+		{"no admin", groupext.SuccessionOldestAdmin, "", []*model.GroupMember{members[0], members[4], members[3]}, "member1"},
+		{"owner alone", groupext.SuccessionOldestAdmin, "", members[:1], ""},
+	}
+	for _, tt := range tests {
+		if got := groupSuccessor(tt.policy, tt.successorUserID, "owner", tt.members); got != tt.want {
+			t.Errorf("groupSuccessor() %s = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package group
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+func (s *groupServer) AddGroupMemberTag(ctx context.Context, req *groupext.AddGroupMemberTagReq) (*groupext.AddGroupMemberTagResp, error) {
+	if datautil.Duplicate(req.UserIDs) {
+		return nil, errs.ErrArgs.WrapMsg("userIDs duplicate")
+	}
+	if _, err := s.checkGroupPermission(ctx, req.GroupID, authverify.GroupPermSetMemberInfo); err != nil {
+		return nil, err
+	}
+	members, err := s.db.FindGroupMembers(ctx, req.GroupID, req.UserIDs)
+	if err != nil {
+		return nil, err
+	}
+	memberUserIDs := datautil.Slice(members, func(e *model.GroupMember) string { return e.UserID })
+	if ids := datautil.Single(req.UserIDs, memberUserIDs); len(ids) > 0 {
+		return nil, servererrs.ErrUserIDNotFound.WrapMsg("not in group " + strings.Join(ids, ","))
+	}
+	now := time.Now()
+	tags := make([]*model.GroupMemberTag, 0, len(req.UserIDs))
+	for _, userID := range req.UserIDs {
+		tags = append(tags, &model.GroupMemberTag{GroupID: req.GroupID, Tag: req.Tag, UserID: userID, CreateTime: now})
+	}
+	if err := s.tagDB.AddTags(ctx, tags); err != nil {
+		return nil, err
+	}
+	return &groupext.AddGroupMemberTagResp{}, nil
+}
+
+func (s *groupServer) RemoveGroupMemberTag(ctx context.Context, req *groupext.RemoveGroupMemberTagReq) (*groupext.RemoveGroupMemberTagResp, error) {
+	if _, err := s.checkGroupPermission(ctx, req.GroupID, authverify.GroupPermSetMemberInfo); err != nil {
+		return nil, err
+	}
+	var userIDs []string
+	if len(req.UserIDs) > 0 {
+		userIDs = req.UserIDs
+	}
+	if err := s.tagDB.DeleteTags(ctx, req.GroupID, req.Tag, userIDs); err != nil {
+		return nil, err
+	}
+	return &groupext.RemoveGroupMemberTagResp{}, nil
+}
+
+func (s *groupServer) GetGroupTags(ctx context.Context, req *groupext.GetGroupTagsReq) (*groupext.GetGroupTagsResp, error) {
+	if err := s.checkGroupMember(ctx, req.GroupID); err != nil {
+		return nil, err
+	}
+	tags, err := s.tagDB.FindGroupTags(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	return &groupext.GetGroupTagsResp{Tags: tags}, nil
+}
+
+func (s *groupServer) GetGroupMemberTags(ctx context.Context, req *groupext.GetGroupMemberTagsReq) (*groupext.GetGroupMemberTagsResp, error) {
+	if err := s.checkGroupMember(ctx, req.GroupID); err != nil {
+		return nil, err
+	}
+	userIDs := datautil.Distinct(req.UserIDs)
+	tags, err := s.tagDB.FindMemberTags(ctx, req.GroupID, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	tagMap := make(map[string][]string)
+	for _, tag := range tags {
+		tagMap[tag.UserID] = append(tagMap[tag.UserID], tag.Tag)
+	}
+	members := make([]*groupext.GroupMemberTags, 0, len(userIDs))
+	for _, userID := range userIDs {
+		members = append(members, &groupext.GroupMemberTags{UserID: userID, Tags: tagMap[userID]})
+	}
+	return &groupext.GetGroupMemberTagsResp{Members: members}, nil
+}
+
+// GetTaggedMemberUserIDs is only called by other services. Tagged users who left the group
+// are not returned.
+func (s *groupServer) GetTaggedMemberUserIDs(ctx context.Context, req *groupext.GetTaggedMemberUserIDsReq) (*groupext.GetTaggedMemberUserIDsResp, error) {
+	userIDs, err := s.tagDB.FindTaggedUserIDs(ctx, req.GroupID, datautil.Distinct(req.Tags))
+	if err != nil {
+		return nil, err
+	}
+	if len(userIDs) == 0 {
+		return &groupext.GetTaggedMemberUserIDsResp{}, nil
+	}
+	groupMembers, err := s.db.FindGroupMembers(ctx, req.GroupID, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	members := datautil.SliceSetAny(groupMembers, func(e *model.GroupMember) string { return e.UserID })
+	userIDs = datautil.Filter(userIDs, func(userID string) (string, bool) {
+		_, ok := members[userID]
+		return userID, ok
+	})
+	return &groupext.GetTaggedMemberUserIDsResp{UserIDs: userIDs}, nil
+}
@@ -0,0 +1,92 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/redis"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/dbbuild"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/objectstore"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	redisv9 "github.com/redis/go-redis/v9"
+)
+
+const archiveBatchSize = 100
+
+func newMsgArchive(ctx context.Context, config *Config, dbb dbbuild.Builder, rdb redisv9.UniversalClient, msgDocModel database.Msg) (controller.MsgArchiveDatabase, error) {
+	minioConf := config.MinioConfig.Build()
+	// The archive is transferred by this service, so presigned URLs must use the internal address.
+	minioConf.SignEndpoint = ""
+	o, _, err := objectstore.New(ctx, &config.ThirdConfig, minioConf, rdb)
+	if err != nil {
+		return nil, err
+	}
+	msgArchive, err := dbb.MsgArchive()
+	if err != nil {
+		return nil, err
+	}
+	expire := time.Duration(config.RpcConfig.Archive.CacheExpire) * time.Second
+	if expire <= 0 {
+		expire = time.Hour
+	}
+	return controller.NewMsgArchiveDatabase(msgArchive, msgDocModel, redis.NewMsgArchiveCacheRedis(rdb, expire), o), nil
+}
+
+func (m *msgServer) ArchiveMsg(ctx context.Context, req *msgext.ArchiveMsgReq) (*msgext.ArchiveMsgResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	archive := m.config.RpcConfig.Archive
+	ts := req.Timestamp
+	if ts == 0 {
+		if !archive.Enable || archive.AfterDays <= 0 {
+			return &msgext.ArchiveMsgResp{}, nil
+		}
+		ts = time.Now().Add(-time.Hour * 24 * time.Duration(archive.AfterDays)).UnixMilli()
+	} else if !archive.Enable {
+		return nil, errs.ErrArgs.WrapMsg("msg archive is disabled")
+	} else if ts > time.Now().UnixMilli() {
+		return nil, errs.ErrArgs.WrapMsg("request millisecond timestamp error")
+	}
+	var (
+		docNum int64
+		start  = time.Now()
+	)
+	for {
+		docs, err := m.MsgDatabase.GetArchivableMsg(ctx, ts, archiveBatchSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, doc := range docs {
+			if err := m.MsgDatabase.ArchiveMsgDoc(ctx, doc); err != nil {
+				log.ZError(ctx, "archive msg failed", err, "docID", doc.DocID, "docNum", docNum, "cost", time.Since(start))
+				return nil, err
+			}
+			docNum++
+		}
+		if len(docs) < archiveBatchSize {
+			break
+		}
+	}
+	log.ZInfo(ctx, "archive msg", "timestamp", ts, "docNum", docNum, "cost", time.Since(start))
+	return &msgext.ArchiveMsgResp{DocNum: docNum}, nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	pbconversation "github.com/openimsdk/protocol/conversation"
 	"github.com/openimsdk/protocol/msg"
 	"github.com/openimsdk/protocol/wrapperspb"
@@ -67,6 +68,19 @@ func (m *msgServer) ClearMsg(ctx context.Context, req *msg.ClearMsgReq) (_ *msg.
 		return nil, err
 	}
 
+	// archived documents are only dropped once all of their messages are outdated
+	for {
+		n, err := m.MsgDatabase.DeleteArchivedMsgBefore(ctx, req.Timestamp, 1000)
+		if err != nil {
+			log.ZError(ctx, "clear archived msg failed", err, "docNum", docNum, "cost", time.Since(start))
+			return nil, err
+		}
+		if n == 0 {
+			break
+		}
+		docNum += n
+	}
+
 	log.ZInfo(ctx, "clearing message", "docNum", docNum, "msgNum", msgNum, "cost", time.Since(start))
 
 	return &msg.ClearMsgResp{}, nil
@@ -86,7 +100,7 @@ func (m *msgServer) DestructMsgs(ctx context.Context, req *msg.DestructMsgsReq)
 
 		errg.Go(func() error {
 			for _, conversation := range batch {
-				handleCtx := mcontext.NewCtx(stringutil.GetSelfFuncName() + "-" + idutil.OperationIDGenerator() + "-" + conversation.ConversationID + "-" + conversation.OwnerUserID)
+				handleCtx := tenant.Inherit(mcontext.NewCtx(stringutil.GetSelfFuncName()+"-"+idutil.OperationIDGenerator()+"-"+conversation.ConversationID+"-"+conversation.OwnerUserID), ctx)
 				log.ZDebug(handleCtx, "User MsgsDestruct",
 					"conversationID", conversation.ConversationID,
 					"ownerUserID", conversation.OwnerUserID,
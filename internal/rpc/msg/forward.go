@@ -0,0 +1,372 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/msgindex"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/conversation"
+	pbmsg "github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/openimsdk/tools/utils/idutil"
+	"github.com/openimsdk/tools/utils/timeutil"
+)
+
+const (
+	defaultMergedTitle = "Chat history"
+	// mergedAbstractNum is how many lines of the merged messages are shown as its preview.
+	mergedAbstractNum = 4
+)
+
+// forwardElemKeys maps the forwardable content types to the key of their element in a
+// merged message, which follows the client SDK message layout.
+var forwardElemKeys = map[int32]string{
+	constant.Text:         "textElem",
+	constant.Picture:      "pictureElem",
+	constant.Voice:        "soundElem",
+	constant.Video:        "videoElem",
+	constant.File:         "fileElem",
+	constant.AtText:       "atTextElem",
+	constant.Merger:       "mergeElem",
+	constant.Card:         "cardElem",
+	constant.Location:     "locationElem",
+	constant.Custom:       "customElem",
+	constant.Quote:        "quoteElem",
+	constant.AdvancedText: "advancedTextElem",
+}
+
+// forwardedFrom is stored under the "forwardedFrom" key of the AttachedInfo of a forwarded copy.
+type forwardedFrom struct {
+	ConversationID string `json:"conversationID"`
+	Seq            int64  `json:"seq"`
+	SendID         string `json:"sendID"`
+	SenderNickname string `json:"senderNickname"`
+	ClientMsgID    string `json:"clientMsgID"`
+	SendTime       int64  `json:"sendTime"`
+}
+
+// mergedFrom lists, under the "mergedFrom" key of the AttachedInfo of a merged forward, the
+// messages bundled from each source conversation.
+type mergedFrom struct {
+	ConversationID string  `json:"conversationID"`
+	Seqs           []int64 `json:"seqs"`
+}
+
+type forwardSource struct {
+	conversationID string
+	msg            *sdkws.MsgData
+}
+
+// mergedMsg is one entry of a merged message.
+type mergedMsg struct {
+	ClientMsgID      string          `json:"clientMsgID"`
+	ServerMsgID      string          `json:"serverMsgID"`
+	CreateTime       int64           `json:"createTime"`
+	SendTime         int64           `json:"sendTime"`
+	SessionType      int32           `json:"sessionType"`
+	SendID           string          `json:"sendID"`
+	RecvID           string          `json:"recvID"`
+	MsgFrom          int32           `json:"msgFrom"`
+	ContentType      int32           `json:"contentType"`
+	SenderPlatformID int32           `json:"senderPlatformID"`
+	SenderNickname   string          `json:"senderNickname"`
+	SenderFaceURL    string          `json:"senderFaceUrl"`
+	GroupID          string          `json:"groupID"`
+	Content          string          `json:"content"`
+	Seq              int64           `json:"seq"`
+	Status           int32           `json:"status"`
+	Ex               string          `json:"ex"`
+	Elem             json.RawMessage `json:"-"`
+}
+
+func (m *mergedMsg) MarshalJSON() ([]byte, error) {
+	type plain mergedMsg
+	data, err := json.Marshal((*plain)(m))
+	if err != nil || len(m.Elem) == 0 {
+		return data, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	fields[forwardElemKeys[m.ContentType]] = m.Elem
+	return json.Marshal(fields)
+}
+
+type mergeElem struct {
+	Title        string       `json:"title"`
+	AbstractList []string     `json:"abstractList"`
+	MultiMessage []*mergedMsg `json:"multiMessage"`
+}
+
+func (m *msgServer) ForwardMsg(ctx context.Context, req *msgext.ForwardMsgReq) (*msgext.ForwardMsgResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	conf := &m.config.RpcConfig.Forward
+	var count int
+	for _, source := range req.Sources {
+		count += len(source.Seqs)
+	}
+	maxMsgs := conf.MaxMsgs
+	if req.Merged {
+		maxMsgs = conf.MaxMergedMsgs
+	}
+	if maxMsgs > 0 && count > maxMsgs {
+		return nil, errs.ErrArgs.WrapMsg("too many messages to forward", "count", count, "max", maxMsgs)
+	}
+	targetIDs := datautil.Distinct(req.TargetConversationIDs)
+	if conf.MaxTargets > 0 && len(targetIDs) > conf.MaxTargets {
+		return nil, errs.ErrArgs.WrapMsg("too many target conversations", "count", len(targetIDs), "max", conf.MaxTargets)
+	}
+	sources, err := m.getForwardSources(ctx, req.UserID, req.Sources)
+	if err != nil {
+		return nil, err
+	}
+	targets, err := m.ConversationLocalCache.GetConversations(ctx, req.UserID, targetIDs)
+	if err != nil {
+		return nil, err
+	}
+	targetMap := datautil.SliceToMap(targets, func(c *conversation.Conversation) string { return c.ConversationID })
+	sender, err := m.UserLocalCache.GetUserInfo(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	var contents []*sdkws.MsgData
+	if req.Merged {
+		merged, err := newMergedContent(req.Title, sources)
+		if err != nil {
+			return nil, err
+		}
+		contents = []*sdkws.MsgData{merged}
+	} else {
+		contents = datautil.Slice(sources, newForwardContent)
+	}
+	resp := &msgext.ForwardMsgResp{Targets: make([]*msgext.ForwardTarget, 0, len(targetIDs))}
+	for _, targetID := range targetIDs {
+		result := m.forwardToTarget(ctx, sender, targetMap[targetID], targetID, contents)
+		resp.Targets = append(resp.Targets, result)
+		resp.Results = append(resp.Results, result.Msgs...)
+		if result.ErrCode != 0 {
+			resp.FailedConversationIDs = append(resp.FailedConversationIDs, targetID)
+		}
+	}
+	return resp, nil
+}
+
+// forwardToTarget sends the contents to one target conversation, target is nil when it is
+// not a conversation of the user. A failure stops the target, the copies sent before it are
+// reported along with the error.
+func (m *msgServer) forwardToTarget(ctx context.Context, sender *sdkws.UserInfo, target *conversation.Conversation, targetID string, contents []*sdkws.MsgData) *msgext.ForwardTarget {
+	result := &msgext.ForwardTarget{ConversationID: targetID}
+	if target == nil || (target.ConversationType != constant.SingleChatType && target.ConversationType != constant.ReadGroupChatType) {
+		setForwardError(result, errs.ErrArgs.WrapMsg("cannot forward to the conversation", "conversationID", targetID))
+		return result
+	}
+	for _, content := range contents {
+		sendResp, err := m.SendMsg(ctx, &pbmsg.SendMsgReq{MsgData: newForwardMsg(ctx, sender, target, content)})
+		if err != nil {
+			log.ZWarn(ctx, "forward msg failed", err, "conversationID", targetID)
+			setForwardError(result, err)
+			return result
+		}
+		// A nil response means the receiver does not accept messages.
+		if sendResp == nil {
+			continue
+		}
+		result.Msgs = append(result.Msgs, &msgext.ForwardedMsg{
+			ConversationID: targetID,
+			ClientMsgID:    sendResp.ClientMsgID,
+			ServerMsgID:    sendResp.ServerMsgID,
+			SendTime:       sendResp.SendTime,
+		})
+	}
+	return result
+}
+
+func setForwardError(result *msgext.ForwardTarget, err error) {
+	if codeErr, ok := errs.Unwrap(err).(errs.CodeError); ok {
+		result.ErrCode, result.ErrMsg = int32(codeErr.Code()), codeErr.Msg()
+		return
+	}
+	result.ErrCode, result.ErrMsg = int32(errs.ServerInternalError), err.Error()
+}
+
+// getForwardSources loads the requested messages in request order. Every message must be
+// visible to userID, revoked or deleted messages and notifications fail the request.
+func (m *msgServer) getForwardSources(ctx context.Context, userID string, sources []*msgext.ForwardSource) ([]*forwardSource, error) {
+	conversationIDs := datautil.Distinct(datautil.Slice(sources, func(s *msgext.ForwardSource) string { return s.ConversationID }))
+	conversations, err := m.ConversationLocalCache.GetConversations(ctx, userID, conversationIDs)
+	if err != nil {
+		return nil, err
+	}
+	conversationMap := datautil.SliceToMap(conversations, func(c *conversation.Conversation) string { return c.ConversationID })
+	minSeqs, err := m.MsgDatabase.GetUserMinSeqs(ctx, userID, conversationIDs)
+	if err != nil {
+		return nil, err
+	}
+	var res []*forwardSource
+	for _, source := range sources {
+		c, ok := conversationMap[source.ConversationID]
+		if !ok {
+			return nil, errs.ErrNoPermission.WrapMsg("not a conversation of the user", "conversationID", source.ConversationID)
+		}
+		seqs := datautil.Distinct(source.Seqs)
+		for _, seq := range seqs {
+			if seq < minSeqs[source.ConversationID] || (c.MaxSeq > 0 && seq > c.MaxSeq) {
+				return nil, errs.ErrRecordNotFound.WrapMsg("msg not visible to the user", "conversationID", source.ConversationID, "seq", seq)
+			}
+		}
+		msgs, err := m.MsgDatabase.FindMsgBySeqs(ctx, userID, source.ConversationID, seqs)
+		if err != nil {
+			return nil, err
+		}
+		msgMap := datautil.SliceToMap(msgs, func(msg *sdkws.MsgData) int64 { return msg.Seq })
+		for _, seq := range seqs {
+			msg, ok := msgMap[seq]
+			// Deleted messages come back without content.
+			if !ok || len(msg.Content) == 0 || msg.Status == constant.MsgDeleted || msg.ContentType == constant.MsgRevokeNotification {
+				return nil, errs.ErrRecordNotFound.WrapMsg("msg not found, revoked or deleted", "conversationID", source.ConversationID, "seq", seq)
+			}
+			if _, ok := forwardElemKeys[msg.ContentType]; !ok {
+				return nil, errs.ErrArgs.WrapMsg("msg cannot be forwarded", "conversationID", source.ConversationID, "seq", seq, "contentType", msg.ContentType)
+			}
+			res = append(res, &forwardSource{conversationID: source.ConversationID, msg: msg})
+		}
+	}
+	return res, nil
+}
+
+// newForwardContent copies the content of a single message. Mentions and quotes refer to
+// the source conversation, so they are forwarded as plain text.
+func newForwardContent(source *forwardSource) *sdkws.MsgData {
+	msg := source.msg
+	content := &sdkws.MsgData{ContentType: msg.ContentType, Content: msg.Content}
+	if msg.ContentType == constant.AtText || msg.ContentType == constant.Quote {
+		var elem struct {
+			Text string `json:"text"`
+		}
+		_ = json.Unmarshal(msg.Content, &elem)
+		content.ContentType = constant.Text
+		content.Content, _ = json.Marshal(map[string]string{"content": elem.Text})
+	}
+	attachedInfo, _ := json.Marshal(map[string]any{"forwardedFrom": &forwardedFrom{
+		ConversationID: source.conversationID,
+		Seq:            msg.Seq,
+		SendID:         msg.SendID,
+		SenderNickname: msg.SenderNickname,
+		ClientMsgID:    msg.ClientMsgID,
+		SendTime:       msg.SendTime,
+	}})
+	content.AttachedInfo = string(attachedInfo)
+	return content
+}
+
+func newMergedContent(title string, sources []*forwardSource) (*sdkws.MsgData, error) {
+	if title == "" {
+		title = defaultMergedTitle
+	}
+	elem := &mergeElem{Title: title, MultiMessage: make([]*mergedMsg, 0, len(sources))}
+	var from []*mergedFrom
+	for _, source := range sources {
+		msg := source.msg
+		if len(from) == 0 || from[len(from)-1].ConversationID != source.conversationID {
+			from = append(from, &mergedFrom{ConversationID: source.conversationID})
+		}
+		from[len(from)-1].Seqs = append(from[len(from)-1].Seqs, msg.Seq)
+		elem.MultiMessage = append(elem.MultiMessage, &mergedMsg{
+			ClientMsgID:      msg.ClientMsgID,
+			ServerMsgID:      msg.ServerMsgID,
+			CreateTime:       msg.CreateTime,
+			SendTime:         msg.SendTime,
+			SessionType:      msg.SessionType,
+			SendID:           msg.SendID,
+			RecvID:           msg.RecvID,
+			MsgFrom:          msg.MsgFrom,
+			ContentType:      msg.ContentType,
+			SenderPlatformID: msg.SenderPlatformID,
+			SenderNickname:   msg.SenderNickname,
+			SenderFaceURL:    msg.SenderFaceURL,
+			GroupID:          msg.GroupID,
+			Content:          string(msg.Content),
+			Seq:              msg.Seq,
+			Status:           msg.Status,
+			Ex:               msg.Ex,
+			Elem:             mergedElem(msg.Content),
+		})
+		if len(elem.AbstractList) < mergedAbstractNum {
+			elem.AbstractList = append(elem.AbstractList, msg.SenderNickname+": "+abstractText(msg))
+		}
+	}
+	content, err := json.Marshal(elem)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	attachedInfo, err := json.Marshal(map[string]any{"mergedFrom": from})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &sdkws.MsgData{ContentType: constant.Merger, Content: content, AttachedInfo: string(attachedInfo)}, nil
+}
+
+func mergedElem(content []byte) json.RawMessage {
+	if json.Valid(content) {
+		return content
+	}
+	// Plain text messages sent without the JSON envelope.
+	elem, _ := json.Marshal(map[string]string{"content": string(content)})
+	return elem
+}
+
+func abstractText(msg *sdkws.MsgData) string {
+	if text := msgindex.ExtractText(msg.ContentType, string(msg.Content)); text != "" {
+		return text
+	}
+	key := forwardElemKeys[msg.ContentType]
+	return "[" + strings.TrimSuffix(key, "Elem") + "]"
+}
+
+func newForwardMsg(ctx context.Context, sender *sdkws.UserInfo, target *conversation.Conversation, content *sdkws.MsgData) *sdkws.MsgData {
+	msg := &sdkws.MsgData{
+		SendID:           sender.UserID,
+		ClientMsgID:      idutil.GetMsgIDByMD5(sender.UserID),
+		SenderPlatformID: int32(constant.PlatformNameToID(mcontext.GetOpUserPlatform(ctx))),
+		SenderNickname:   sender.Nickname,
+		SenderFaceURL:    sender.FaceURL,
+		SessionType:      target.ConversationType,
+		MsgFrom:          constant.UserMsgType,
+		ContentType:      content.ContentType,
+		Content:          content.Content,
+		CreateTime:       timeutil.GetCurrentTimestampByMill(),
+		Options:          make(map[string]bool),
+		AttachedInfo:     content.AttachedInfo,
+	}
+	if target.ConversationType == constant.ReadGroupChatType {
+		msg.GroupID = target.GroupID
+	} else {
+		msg.RecvID = target.UserID
+	}
+	return msg
+}
@@ -0,0 +1,48 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+)
+
+func TestNewMergedContentProvenance(t *testing.T) {
+	text := func(seq int64) *sdkws.MsgData {
+		return &sdkws.MsgData{Seq: seq, ContentType: constant.Text, Content: []byte(`{"content":"hi"}`)}
+	}
+	content, err := newMergedContent("", []*forwardSource{
+		{conversationID: "si_a_b", msg: text(3)},
+		{conversationID: "si_a_b", msg: text(5)},
+		{conversationID: "sg_g1", msg: text(9)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var info struct {
+		MergedFrom []mergedFrom `json:"mergedFrom"`
+	}
+	if err := json.Unmarshal([]byte(content.AttachedInfo), &info); err != nil {
+		t.Fatal(err)
+	}
+	want := []mergedFrom{{ConversationID: "si_a_b", Seqs: []int64{3, 5}}, {ConversationID: "sg_g1", Seqs: []int64{9}}}
+	if !reflect.DeepEqual(info.MergedFrom, want) {
+		t.Errorf("mergedFrom = %+v, want %+v", info.MergedFrom, want)
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/log"
+)
+
+// claimSend reserves the ClientMsgID of a user message for the idempotency window. For a
+// resend of an enqueued message it returns the response of the first send. finish must be
+// called with the result of the send: a failed send releases the claim so the SDK can retry.
+// Redis errors only disable the check for this message.
+func (m *msgServer) claimSend(ctx context.Context, msg *sdkws.MsgData) (sent *msgext.SendMsgWithSeqResp, finish func(error), err error) {
+	finish = func(error) {}
+	window := time.Duration(m.config.RpcConfig.Idempotency.Window) * time.Second
+	if window <= 0 || msg.ClientMsgID == "" {
+		return nil, finish, nil
+	}
+	if msg.SessionType != constant.SingleChatType && msg.SessionType != constant.ReadGroupChatType {
+		return nil, finish, nil
+	}
+	record, err := m.MsgDatabase.ClaimSendMsg(ctx, msg.SendID, msg.ClientMsgID,
+		&cache.SendMsgRecord{ServerMsgID: msg.ServerMsgID, SendTime: msg.SendTime}, window)
+	if err != nil {
+		log.ZWarn(ctx, "claim send msg failed, sending without idempotency check", err, "clientMsgID", msg.ClientMsgID)
+		return nil, finish, nil
+	}
+	if record != nil {
+		if !record.Done {
+			return nil, nil, servererrs.ErrMsgSending.WrapMsg("the first send of this clientMsgID is in progress", "clientMsgID", msg.ClientMsgID)
+		}
+		log.ZInfo(ctx, "duplicate send msg", "clientMsgID", msg.ClientMsgID, "serverMsgID", record.ServerMsgID, "seq", record.Seq)
+		return &msgext.SendMsgWithSeqResp{
+			ServerMsgID: record.ServerMsgID,
+			ClientMsgID: msg.ClientMsgID,
+			SendTime:    record.SendTime,
+			Seq:         record.Seq,
+		}, nil, nil
+	}
+	finish = func(err error) {
+		if err != nil {
+			if err := m.MsgDatabase.ReleaseSendMsg(ctx, msg.SendID, msg.ClientMsgID); err != nil {
+				log.ZWarn(ctx, "release send msg failed", err, "clientMsgID", msg.ClientMsgID)
+			}
+			return
+		}
+		if err := m.MsgDatabase.FinishSendMsg(ctx, msg.SendID, msg.ClientMsgID); err != nil {
+			log.ZWarn(ctx, "finish send msg failed", err, "clientMsgID", msg.ClientMsgID)
+		}
+	}
+	return nil, finish, nil
+}
@@ -0,0 +1,49 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	pbmsg "github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/protocol/sdkws"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestSendMsgWithSeqWireCompatible(t *testing.T) {
+	data, err := proto.Marshal(&pbmsg.SendMsgReq{MsgData: &sdkws.MsgData{ClientMsgID: "c"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var req msgext.SendMsgWithSeqReq
+	if err := proto.Unmarshal(data, &req); err != nil {
+		t.Fatal(err)
+	}
+	if req.MsgData.GetClientMsgID() != "c" {
+		t.Errorf("unexpected req %v", &req)
+	}
+	data, err = proto.Marshal(&msgext.SendMsgWithSeqResp{ServerMsgID: "s", ClientMsgID: "c", SendTime: 1, Seq: 42})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var resp pbmsg.SendMsgResp
+	if err := proto.Unmarshal(data, &resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.ServerMsgID != "s" || resp.ClientMsgID != "c" || resp.SendTime != 1 {
+		t.Errorf("unexpected resp %v", &resp)
+	}
+}
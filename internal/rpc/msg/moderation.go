@@ -0,0 +1,336 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"regexp"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/moderation"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"github.com/openimsdk/protocol/constant"
+	pbmsg "github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	stageKeyword   = "keyword"
+	stageGroupWord = "groupWord"
+	stageLink      = "link"
+	stageWebhook   = "webhook"
+)
+
+// newModerationChain builds the configured chain, nil when moderation is disabled.
+func (m *msgServer) newModerationChain() (*moderation.Chain, error) {
+	conf := &m.config.ModerationConfig
+	if !conf.Enable {
+		return nil, nil
+	}
+	names := conf.Stages
+	// The webhooks were the only moderation before the chain existed, never drop them silently.
+	if !datautil.Contain(stageWebhook, names...) {
+		names = append(names, stageWebhook)
+	}
+	stages := make([]moderation.Stage, 0, len(names))
+	for _, name := range datautil.Distinct(names) {
+		switch name {
+		case stageKeyword:
+			stage, err := moderation.NewKeywordStage(conf.Keyword.RulesFile, time.Duration(conf.Keyword.ReloadInterval)*time.Second)
+			if err != nil {
+				return nil, err
+			}
+			stages = append(stages, stage)
+		case stageGroupWord:
+			action, err := moderation.ParseAction(conf.GroupWord.DefaultAction)
+			if err != nil {
+				return nil, err
+			}
+			stages = append(stages, &groupWordStage{m: m, defaultAction: action})
+		case stageLink:
+			if len(conf.Link.Allowlist) == 0 {
+				continue
+			}
+			action, err := moderation.ParseAction(conf.Link.Action)
+			if err != nil {
+				return nil, err
+			}
+			stages = append(stages, moderation.NewLinkStage(conf.Link.Allowlist, action))
+		case stageWebhook:
+			stages = append(stages, &webhookStage{m: m})
+		default:
+			return nil, errs.ErrArgs.WrapMsg("unknown moderation stage", "stage", name)
+		}
+	}
+	maskRune, _ := utf8.DecodeRuneInString(conf.MaskChar)
+	if maskRune == utf8.RuneError {
+		maskRune = 0
+	}
+	return moderation.NewChain(maskRune, stages...), nil
+}
+
+// moderate runs a message through the moderation chain, or only through the before-send
+// webhooks when moderation is disabled, and returns the verdicts that flag it for review.
+func (m *msgServer) moderate(ctx context.Context, req *pbmsg.SendMsgReq) ([]*moderation.Verdict, error) {
+	if m.moderationChain == nil {
+		return nil, m.webhookBeforeSend(ctx, req)
+	}
+	res, err := m.moderationChain.Run(ctx, req.MsgData)
+	if err != nil {
+		return nil, err
+	}
+	if v := res.Blocked(); v != nil {
+		return nil, servererrs.ErrMsgBlocked.WrapMsg("message blocked by moderation", "stage", v.Stage, "rule", v.Rule)
+	}
+	return res.Flagged(), nil
+}
+
+func (m *msgServer) webhookBeforeSend(ctx context.Context, req *pbmsg.SendMsgReq) error {
+	switch req.MsgData.SessionType {
+	case constant.SingleChatType:
+		if err := m.webhookBeforeSendSingleMsg(ctx, &m.config.WebhooksConfig.BeforeSendSingleMsg, req); err != nil {
+			return err
+		}
+	case constant.ReadGroupChatType:
+		if err := m.webhookBeforeSendGroupMsg(ctx, &m.config.WebhooksConfig.BeforeSendGroupMsg, req); err != nil {
+			return err
+		}
+	}
+	return m.webhookBeforeMsgModify(ctx, &m.config.WebhooksConfig.BeforeMsgModify, req)
+}
+
+// addModerationReview queues a delivered message for review. The message is already on
+// its way, so failures are only logged.
+func (m *msgServer) addModerationReview(ctx context.Context, msg *sdkws.MsgData, verdicts []*moderation.Verdict) {
+	if len(verdicts) == 0 {
+		return
+	}
+	review := &model.ModerationReview{
+		ReviewID:       primitive.NewObjectID().Hex(),
+		ConversationID: msgprocessor.GetConversationIDByMsg(msg),
+		ClientMsgID:    msg.ClientMsgID,
+		ServerMsgID:    msg.ServerMsgID,
+		SendID:         msg.SendID,
+		RecvID:         msg.RecvID,
+		GroupID:        msg.GroupID,
+		SessionType:    msg.SessionType,
+		ContentType:    msg.ContentType,
+		Content:        string(msg.Content),
+		SendTime:       msg.SendTime,
+		Verdicts: datautil.Slice(verdicts, func(v *moderation.Verdict) model.ModerationVerdict {
+			return model.ModerationVerdict{Stage: v.Stage, Rule: v.Rule, Reason: v.Reason}
+		}),
+		Status:     model.ModerationReviewPending,
+		CreateTime: time.Now(),
+	}
+	if err := m.ModerationDatabase.CreateReviews(ctx, []*model.ModerationReview{review}); err != nil {
+		log.ZError(ctx, "add moderation review failed", err, "clientMsgID", msg.ClientMsgID)
+	}
+}
+
+// groupWordStage applies the sensitive words a group set for itself.
+type groupWordStage struct {
+	m             *msgServer
+	defaultAction moderation.Action
+	compiled      sync.Map // groupID -> *groupWords
+}
+
+type groupWords struct {
+	updateTime time.Time
+	re         *regexp.Regexp
+}
+
+func (g *groupWordStage) Name() string {
+	return stageGroupWord
+}
+
+func (g *groupWordStage) Moderate(ctx context.Context, msg *moderation.Message) ([]*moderation.Verdict, error) {
+	if msg.Data.SessionType != constant.ReadGroupChatType || len(msg.Texts) == 0 {
+		return nil, nil
+	}
+	word, err := g.m.ModerationDatabase.GetGroupSensitiveWord(ctx, msg.Data.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	if len(word.Words) == 0 {
+		return nil, nil
+	}
+	action := g.defaultAction
+	if word.Action != "" {
+		if action, err = moderation.ParseAction(word.Action); err != nil {
+			return nil, err
+		}
+	}
+	var re *regexp.Regexp
+	if v, ok := g.compiled.Load(word.GroupID); ok && v.(*groupWords).updateTime.Equal(word.UpdateTime) {
+		re = v.(*groupWords).re
+	} else {
+		if re, err = moderation.CompileWords(word.Words); err != nil {
+			return nil, err
+		}
+		g.compiled.Store(word.GroupID, &groupWords{updateTime: word.UpdateTime, re: re})
+	}
+	return moderation.MatchWords(msg, "group sensitive words", re, action), nil
+}
+
+// webhookStage runs the before-send webhooks as one stage of the chain.
+type webhookStage struct {
+	m *msgServer
+}
+
+func (w *webhookStage) Name() string {
+	return stageWebhook
+}
+
+func (w *webhookStage) Moderate(ctx context.Context, msg *moderation.Message) ([]*moderation.Verdict, error) {
+	return nil, w.m.webhookBeforeSend(ctx, &pbmsg.SendMsgReq{MsgData: msg.Data})
+}
+
+// checkGroupPermission checks with the group service that the op user has perm in the group
+// and outranks the members of targetUserIDs, users no longer in the group being outranked by
+// everyone. App admins may do everything.
+func (m *msgServer) checkGroupPermission(ctx context.Context, groupID string, perm authverify.GroupPermission, targetUserIDs ...string) error {
+	if authverify.IsAppManagerUid(ctx, m.config.Share.IMAdminUserID) {
+		return nil
+	}
+	opUserID := mcontext.GetOpUserID(ctx)
+	resp, err := m.Group.ExtClient.GetGroupMemberPermissions(ctx, &groupext.GetGroupMemberPermissionsReq{
+		GroupID: groupID,
+		UserIDs: append([]string{opUserID}, targetUserIDs...),
+	})
+	if err != nil {
+		return err
+	}
+	members := make(map[string]*authverify.GroupMember, len(resp.Members))
+	for _, member := range resp.Members {
+		members[member.UserID] = &authverify.GroupMember{
+			UserID:      member.UserID,
+			RoleLevel:   member.RoleLevel,
+			RoleID:      member.RoleID,
+			Permissions: authverify.GroupPermission(member.Permissions),
+		}
+	}
+	op, ok := members[opUserID]
+	if !ok {
+		return errs.ErrNoPermission.WrapMsg("opUserID not in group")
+	}
+	targets := make([]*authverify.GroupMember, 0, len(targetUserIDs))
+	for _, userID := range targetUserIDs {
+		if target, ok := members[userID]; ok {
+			targets = append(targets, target)
+		} else {
+			targets = append(targets, &authverify.GroupMember{UserID: userID})
+		}
+	}
+	return authverify.CheckGroupPermission(op, perm, targets...)
+}
+
+func (m *msgServer) SetGroupSensitiveWords(ctx context.Context, req *msgext.SetGroupSensitiveWordsReq) (*msgext.SetGroupSensitiveWordsResp, error) {
+	if err := m.checkGroupPermission(ctx, req.GroupID, authverify.GroupPermModerate); err != nil {
+		return nil, err
+	}
+	words := datautil.Distinct(req.Words)
+	if max := m.config.ModerationConfig.GroupWord.MaxWords; max > 0 && len(words) > max {
+		return nil, errs.ErrArgs.WrapMsg("too many sensitive words", "count", len(words), "max", max)
+	}
+	if _, err := moderation.CompileWords(words); err != nil {
+		return nil, err
+	}
+	err := m.ModerationDatabase.SetGroupSensitiveWord(ctx, &model.GroupSensitiveWord{
+		GroupID:    req.GroupID,
+		Words:      words,
+		Action:     req.Action,
+		UpdateTime: time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &msgext.SetGroupSensitiveWordsResp{}, nil
+}
+
+func (m *msgServer) GetGroupSensitiveWords(ctx context.Context, req *msgext.GetGroupSensitiveWordsReq) (*msgext.GetGroupSensitiveWordsResp, error) {
+	if err := m.checkGroupPermission(ctx, req.GroupID, authverify.GroupPermModerate); err != nil {
+		return nil, err
+	}
+	word, err := m.ModerationDatabase.GetGroupSensitiveWord(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	return &msgext.GetGroupSensitiveWordsResp{Words: word.Words, Action: word.Action}, nil
+}
+
+func (m *msgServer) SearchModerationReviews(ctx context.Context, req *msgext.SearchModerationReviewsReq) (*msgext.SearchModerationReviewsResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	total, reviews, err := m.ModerationDatabase.SearchReviews(ctx, req.Status, req.SendID, req.GroupID, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &msgext.SearchModerationReviewsResp{
+		Total:   total,
+		Reviews: datautil.Slice(reviews, convertModerationReview),
+	}, nil
+}
+
+func (m *msgServer) SetModerationReviewStatus(ctx context.Context, req *msgext.SetModerationReviewStatusReq) (*msgext.SetModerationReviewStatusResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	count, err := m.ModerationDatabase.SetReviewStatus(ctx, datautil.Distinct(req.ReviewIDs), req.Status, mcontext.GetOpUserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &msgext.SetModerationReviewStatusResp{Count: count}, nil
+}
+
+func convertModerationReview(review *model.ModerationReview) *msgext.ModerationReview {
+	var reviewTime int64
+	if !review.ReviewTime.IsZero() {
+		reviewTime = review.ReviewTime.UnixMilli()
+	}
+	return &msgext.ModerationReview{
+		ReviewID:       review.ReviewID,
+		ConversationID: review.ConversationID,
+		ClientMsgID:    review.ClientMsgID,
+		ServerMsgID:    review.ServerMsgID,
+		SendID:         review.SendID,
+		RecvID:         review.RecvID,
+		GroupID:        review.GroupID,
+		SessionType:    review.SessionType,
+		ContentType:    review.ContentType,
+		Content:        review.Content,
+		SendTime:       review.SendTime,
+		Verdicts: datautil.Slice(review.Verdicts, func(v model.ModerationVerdict) *msgext.ModerationVerdict {
+			return &msgext.ModerationVerdict{Stage: v.Stage, Rule: v.Rule, Reason: v.Reason}
+		}),
+		Status:     review.Status,
+		Reviewer:   review.Reviewer,
+		CreateTime: review.CreateTime.UnixMilli(),
+		ReviewTime: reviewTime,
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+const maxGroupMuteSchedules = 16
+
+var locations sync.Map
+
+// loadLocation returns the time zone of name, UTC for an empty name.
+func loadLocation(name string) (*time.Location, error) {
+	if loc, ok := locations.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, errs.ErrArgs.WrapMsg("unknown time zone", "timeZone", name)
+	}
+	locations.Store(name, loc)
+	return loc, nil
+}
+
+// inMuteSchedule reports whether now falls in one of the schedules, a window that ends
+// on the next day belonging to the weekday it starts on.
+func inMuteSchedule(schedules []model.GroupMuteSchedule, loc *time.Location, now time.Time) bool {
+	now = now.In(loc)
+	minute := int32(now.Hour()*60 + now.Minute())
+	today := int32(now.Weekday())
+	yesterday := (today + 6) % 7
+	onDay := func(s model.GroupMuteSchedule, weekday int32) bool {
+		return len(s.Weekdays) == 0 || datautil.Contain(weekday, s.Weekdays...)
+	}
+	for _, s := range schedules {
+		if s.StartMinute < s.EndMinute {
+			if minute >= s.StartMinute && minute < s.EndMinute && onDay(s, today) {
+				return true
+			}
+			continue
+		}
+		if minute >= s.StartMinute && onDay(s, today) {
+			return true
+		}
+		if minute < s.EndMinute && onDay(s, yesterday) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkGroupMuteRule applies the scheduled mutes and the slow mode of the group to a message
+// of an ordinary member. Redis errors of the slow mode only skip it for this message.
+func (m *msgServer) checkGroupMuteRule(ctx context.Context, groupID string, userID string) error {
+	rule, err := m.GroupMuteRuleDatabase.GetGroupMuteRule(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	if len(rule.Schedules) > 0 {
+		loc, err := loadLocation(rule.TimeZone)
+		if err != nil {
+			return err
+		}
+		if inMuteSchedule(rule.Schedules, loc, time.Now()) {
+			return servererrs.ErrMutedGroup.WrapMsg("group is in a scheduled mute")
+		}
+	}
+	if rule.SlowModeInterval > 0 {
+		interval := time.Duration(rule.SlowModeInterval) * time.Second
+		ok, err := m.GroupMuteRuleDatabase.TakeSlowModeSend(ctx, groupID, userID, interval)
+		if err != nil {
+			log.ZWarn(ctx, "take slow mode send failed, sending without slow mode", err, "groupID", groupID)
+			return nil
+		}
+		if !ok {
+			return servererrs.ErrSlowModeGroup.WrapMsg("group is in slow mode", "interval", rule.SlowModeInterval)
+		}
+	}
+	return nil
+}
+
+func (m *msgServer) SetGroupMuteRule(ctx context.Context, req *msgext.SetGroupMuteRuleReq) (*msgext.SetGroupMuteRuleResp, error) {
+	if err := m.checkGroupPermission(ctx, req.GroupID, authverify.GroupPermMuteGroup); err != nil {
+		return nil, err
+	}
+	if len(req.Schedules) > maxGroupMuteSchedules {
+		return nil, errs.ErrArgs.WrapMsg("too many mute schedules", "max", maxGroupMuteSchedules)
+	}
+	if _, err := loadLocation(req.TimeZone); err != nil {
+		return nil, err
+	}
+	rule := &model.GroupMuteRule{
+		GroupID:          req.GroupID,
+		SlowModeInterval: req.SlowModeInterval,
+		TimeZone:         req.TimeZone,
+		Schedules: datautil.Slice(req.Schedules, func(s *msgext.GroupMuteSchedule) model.GroupMuteSchedule {
+			return model.GroupMuteSchedule{StartMinute: s.StartMinute, EndMinute: s.EndMinute, Weekdays: datautil.Distinct(s.Weekdays)}
+		}),
+		UpdateTime: time.Now(),
+	}
+	if err := m.GroupMuteRuleDatabase.SetGroupMuteRule(ctx, rule); err != nil {
+		return nil, err
+	}
+	return &msgext.SetGroupMuteRuleResp{}, nil
+}
+
+func (m *msgServer) GetGroupMuteRule(ctx context.Context, req *msgext.GetGroupMuteRuleReq) (*msgext.GetGroupMuteRuleResp, error) {
+	// Every member may read the rule, to tell the user how long to wait.
+	if err := m.checkGroupPermission(ctx, req.GroupID, 0); err != nil {
+		return nil, err
+	}
+	rule, err := m.GroupMuteRuleDatabase.GetGroupMuteRule(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	return &msgext.GetGroupMuteRuleResp{
+		SlowModeInterval: rule.SlowModeInterval,
+		TimeZone:         rule.TimeZone,
+		Schedules: datautil.Slice(rule.Schedules, func(s model.GroupMuteSchedule) *msgext.GroupMuteSchedule {
+			return &msgext.GroupMuteSchedule{StartMinute: s.StartMinute, EndMinute: s.EndMinute, Weekdays: s.Weekdays}
+		}),
+	}, nil
+}
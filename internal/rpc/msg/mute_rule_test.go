@@ -0,0 +1,57 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+func TestInMuteSchedule(t *testing.T) {
+	loc := time.FixedZone("UTC+8", 8*3600)
+	nightly := model.GroupMuteSchedule{StartMinute: 23 * 60, EndMinute: 7 * 60}
+	// Friday nights only, 2024-06-07 is a Friday.
+	friday := model.GroupMuteSchedule{StartMinute: 23 * 60, EndMinute: 7 * 60, Weekdays: []int32{5}}
+	lunch := model.GroupMuteSchedule{StartMinute: 12 * 60, EndMinute: 13 * 60}
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2024, 6, day, hour, minute, 0, 0, loc).UTC()
+	}
+	tests := []struct {
+		name     string
+		schedule model.GroupMuteSchedule
+		now      time.Time
+		muted    bool
+	}{
+		{"before nightly", nightly, at(7, 22, 59), false},
+		{"nightly start", nightly, at(7, 23, 0), true},
+		{"nightly after midnight", nightly, at(8, 6, 59), true},
+		{"nightly end", nightly, at(8, 7, 0), false},
+		{"friday night", friday, at(7, 23, 30), true},
+		{"saturday morning", friday, at(8, 3, 0), true},
+		{"saturday night", friday, at(8, 23, 30), false},
+		{"friday morning", friday, at(7, 3, 0), false},
+		{"lunch", lunch, at(7, 12, 30), true},
+		{"after lunch", lunch, at(7, 13, 0), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if muted := inMuteSchedule([]model.GroupMuteSchedule{tt.schedule}, loc, tt.now); muted != tt.muted {
+				t.Errorf("inMuteSchedule() = %v, want %v", muted, tt.muted)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/conversation"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+)
+
+// maxReadReceiptPushSeqs bounds how many of the newly read messages are reported to their
+// senders when a member catches up on a group.
+const maxReadReceiptPushSeqs = 100
+
+func (m *msgServer) SetGroupReadReceipt(ctx context.Context, req *msgext.SetGroupReadReceiptReq) (*msgext.SetGroupReadReceiptResp, error) {
+	if err := m.checkGroupPermission(ctx, req.GroupID, authverify.GroupPermModerate); err != nil {
+		return nil, err
+	}
+	if req.Enable {
+		groupInfo, err := m.GroupLocalCache.GetGroupInfo(ctx, req.GroupID)
+		if err != nil {
+			return nil, err
+		}
+		if max := m.config.RpcConfig.GroupReadReceipt.MaxMemberCount; max > 0 && int(groupInfo.MemberCount) > max {
+			return nil, errs.ErrArgs.WrapMsg("group is too large for read receipts", "memberCount", groupInfo.MemberCount, "max", max)
+		}
+	}
+	if err := m.ReadReceiptDatabase.SetGroupReadReceipt(ctx, req.GroupID, req.Enable); err != nil {
+		return nil, err
+	}
+	return &msgext.SetGroupReadReceiptResp{}, nil
+}
+
+func (m *msgServer) GetGroupMsgReadState(ctx context.Context, req *msgext.GetGroupMsgReadStateReq) (*msgext.GetGroupMsgReadStateResp, error) {
+	memberIDs, err := m.GroupLocalCache.GetGroupMemberIDs(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	if !authverify.IsAppManagerUid(ctx, m.config.Share.IMAdminUserID) {
+		opUserID := mcontext.GetOpUserID(ctx)
+		var isMember bool
+		for _, memberID := range memberIDs {
+			if memberID == opUserID {
+				isMember = true
+				break
+			}
+		}
+		if !isMember {
+			return nil, errs.ErrNoPermission.WrapMsg("not a group member")
+		}
+	}
+	enabled, err := m.ReadReceiptDatabase.IsGroupReadReceiptEnabled(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	if !enabled {
+		return nil, errs.ErrArgs.WrapMsg("read receipts are not enabled for the group")
+	}
+	if max := m.config.RpcConfig.GroupReadReceipt.MaxMemberCount; max > 0 && len(memberIDs) > max {
+		return nil, errs.ErrArgs.WrapMsg("group is too large for read receipts", "memberCount", len(memberIDs), "max", max)
+	}
+	conversationID := msgprocessor.GetConversationIDBySessionType(constant.ReadGroupChatType, req.GroupID)
+	msgs, err := m.MsgDatabase.FindMsgBySeqs(ctx, "", conversationID, []int64{req.Seq})
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 || msgs[0].SendID == "" {
+		return nil, errs.ErrRecordNotFound.WrapMsg("msg not found", "seq", req.Seq)
+	}
+	hasReadSeqs, err := m.ReadReceiptDatabase.GetUsersHasReadSeq(ctx, conversationID, memberIDs)
+	if err != nil {
+		return nil, err
+	}
+	minSeqs, err := m.ReadReceiptDatabase.GetUsersMinSeq(ctx, conversationID, memberIDs)
+	if err != nil {
+		return nil, err
+	}
+	resp := &msgext.GetGroupMsgReadStateResp{}
+	for _, memberID := range memberIDs {
+		// Members who joined after the message never saw it.
+		if memberID == msgs[0].SendID || minSeqs[memberID] > req.Seq {
+			continue
+		}
+		if hasReadSeqs[memberID] >= req.Seq {
+			resp.ReadUserIDs = append(resp.ReadUserIDs, memberID)
+		} else {
+			resp.UnreadUserIDs = append(resp.UnreadUserIDs, memberID)
+		}
+	}
+	return resp, nil
+}
+
+// groupReadReceiptAfterRead reports the messages userID has just read in a group
+// conversation to their senders. seqs are the read messages, nil for all of (fromSeq, toSeq].
+// The receipts are pushed in the background, off the request path.
+func (m *msgServer) groupReadReceiptAfterRead(ctx context.Context, conversation *conversation.Conversation, userID string, seqs []int64, fromSeq, toSeq int64) {
+	if conversation.ConversationType != constant.ReadGroupChatType {
+		return
+	}
+	if seqs == nil {
+		seqs = readReceiptSeqs(fromSeq, toSeq)
+	} else if len(seqs) > maxReadReceiptPushSeqs {
+		seqs = seqs[len(seqs)-maxReadReceiptPushSeqs:]
+	}
+	if len(seqs) == 0 {
+		return
+	}
+	noCancelCtx := context.WithoutCancel(ctx)
+	err := m.readReceiptQueue.PushCtx(ctx, func() {
+		m.pushGroupReadReceipt(noCancelCtx, conversation.GroupID, conversation.ConversationID, userID, seqs, toSeq)
+	})
+	if err != nil {
+		log.ZWarn(ctx, "push group read receipt timeout", err, "conversationID", conversation.ConversationID)
+	}
+}
+
+// readReceiptSeqs returns the seqs of (fromSeq, toSeq], the last maxReadReceiptPushSeqs
+// of them at most.
+func readReceiptSeqs(fromSeq, toSeq int64) []int64 {
+	if toSeq <= fromSeq {
+		return nil
+	}
+	begin := fromSeq + 1
+	if toSeq-begin+1 > maxReadReceiptPushSeqs {
+		begin = toSeq - maxReadReceiptPushSeqs + 1
+	}
+	seqs := make([]int64, 0, toSeq-begin+1)
+	for seq := begin; seq <= toSeq; seq++ {
+		seqs = append(seqs, seq)
+	}
+	return seqs
+}
+
+// pushGroupReadReceipt tells the senders of the messages of seqs that userID has read them,
+// when the group has read receipts enabled.
+func (m *msgServer) pushGroupReadReceipt(ctx context.Context, groupID, conversationID, userID string, seqs []int64, hasReadSeq int64) {
+	enabled, err := m.ReadReceiptDatabase.IsGroupReadReceiptEnabled(ctx, groupID)
+	if err != nil {
+		log.ZWarn(ctx, "get group read receipt failed", err, "groupID", groupID)
+		return
+	}
+	if !enabled {
+		return
+	}
+	if max := m.config.RpcConfig.GroupReadReceipt.MaxMemberCount; max > 0 {
+		groupInfo, err := m.GroupLocalCache.GetGroupInfo(ctx, groupID)
+		if err != nil {
+			log.ZWarn(ctx, "get group info failed", err, "groupID", groupID)
+			return
+		}
+		if int(groupInfo.MemberCount) > max {
+			return
+		}
+	}
+	msgs, err := m.MsgDatabase.FindMsgBySeqs(ctx, userID, conversationID, seqs)
+	if err != nil {
+		log.ZWarn(ctx, "find read msgs failed", err, "conversationID", conversationID, "seqs", seqs)
+		return
+	}
+	senderSeqs := make(map[string][]int64)
+	for _, msg := range msgs {
+		if msg.SendID == "" || msg.SendID == userID {
+			continue
+		}
+		senderSeqs[msg.SendID] = append(senderSeqs[msg.SendID], msg.Seq)
+	}
+	for sendID, seqs := range senderSeqs {
+		m.sendMarkAsReadNotification(ctx, conversationID, constant.SingleChatType, userID, sendID, seqs, hasReadSeq)
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReadReceiptSeqs(t *testing.T) {
+	if seqs := readReceiptSeqs(3, 6); !reflect.DeepEqual(seqs, []int64{4, 5, 6}) {
+		t.Errorf("readReceiptSeqs(3, 6) = %v", seqs)
+	}
+	if seqs := readReceiptSeqs(6, 6); seqs != nil {
+		t.Errorf("readReceiptSeqs(6, 6) = %v", seqs)
+	}
+	seqs := readReceiptSeqs(0, 1000)
+	if len(seqs) != maxReadReceiptPushSeqs || seqs[0] != 1000-maxReadReceiptPushSeqs+1 || seqs[len(seqs)-1] != 1000 {
+		t.Errorf("readReceiptSeqs(0, 1000) = %d seqs from %d", len(seqs), seqs[0])
+	}
+}
@@ -75,14 +75,8 @@ func (m *msgServer) RevokeMsg(ctx context.Context, req *msg.RevokeMsgReq) (*msg.
 				return nil, err
 			}
 			if req.UserID != msgs[0].SendID {
-				switch members[req.UserID].RoleLevel {
-				case constant.GroupOwner:
-				case constant.GroupAdmin:
-					if members[msgs[0].SendID].RoleLevel != constant.GroupOrdinaryUsers {
-						return nil, errs.ErrNoPermission.WrapMsg("no permission")
-					}
-				default:
-					return nil, errs.ErrNoPermission.WrapMsg("no permission")
+				if err := m.checkGroupPermission(ctx, msgs[0].GroupID, authverify.GroupPermRevokeMsg, msgs[0].SendID); err != nil {
+					return nil, err
 				}
 			}
 			if member := members[req.UserID]; member != nil {
@@ -102,11 +96,12 @@ func (m *msgServer) RevokeMsg(ctx context.Context, req *msg.RevokeMsgReq) (*msg.
 	if err != nil {
 		return nil, err
 	}
+	m.deleteIndex(ctx, req.ConversationID, []int64{req.Seq})
 	revokerUserID := mcontext.GetOpUserID(ctx)
 	var flag bool
 
 	if len(m.config.Share.IMAdminUserID) > 0 {
-		flag = datautil.Contain(revokerUserID, m.config.Share.IMAdminUserID...)
+		flag = authverify.IsManagerUserID(ctx, revokerUserID, m.config.Share.IMAdminUserID)
 	}
 	tips := sdkws.RevokeMsgTips{
 		RevokerUserID:  revokerUserID,
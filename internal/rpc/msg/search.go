@@ -0,0 +1,221 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/msgindex"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/conversation"
+	"github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+func (m *msgServer) SearchMsg(ctx context.Context, req *msgext.SearchMsgReq) (*msgext.SearchMsgResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	keyword := msgindex.ParseKeyword(req.Keyword)
+	if m.indexer == nil {
+		if !keyword.IsEmpty() || req.Cursor != "" || req.StartTime != 0 || req.EndTime != 0 || req.ConversationID != "" {
+			return nil, errs.ErrArgs.WrapMsg("message search index is disabled, only sendID, recvID, contentType, sendTime and sessionType are supported")
+		}
+		resp, err := m.SearchMessage(ctx, &msg.SearchMessageReq{
+			SendID:      req.SendID,
+			RecvID:      req.RecvID,
+			ContentType: req.ContentType,
+			SendTime:    req.SendTime,
+			SessionType: req.SessionType,
+			Pagination:  req.Pagination,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &msgext.SearchMsgResp{ChatLogs: resp.ChatLogs, ChatLogsNum: resp.ChatLogsNum}, nil
+	}
+	query := &msgindex.Query{
+		Keyword:     keyword,
+		SendID:      req.SendID,
+		RecvID:      req.RecvID,
+		SessionType: req.SessionType,
+		ContentType: req.ContentType,
+		StartTime:   req.StartTime,
+		EndTime:     req.EndTime,
+		Cursor:      req.Cursor,
+		Limit:       int(req.Count),
+	}
+	if req.ConversationID != "" {
+		query.ConversationIDs = []string{req.ConversationID}
+	}
+	if req.SendTime != "" && req.StartTime == 0 && req.EndTime == 0 {
+		sendTime, err := time.Parse(time.DateOnly, req.SendTime)
+		if err != nil {
+			return nil, errs.ErrArgs.WrapMsg("invalid sendTime", "req", req.SendTime, "format", time.DateOnly, "cause", err.Error())
+		}
+		query.StartTime = sendTime.UnixMilli()
+		query.EndTime = sendTime.Add(time.Hour * 24).UnixMilli()
+	}
+	if req.Cursor == "" && req.Pagination != nil && req.Pagination.ShowNumber > 0 {
+		if req.Count == 0 {
+			query.Limit = int(req.Pagination.ShowNumber)
+		}
+		if req.Pagination.PageNumber > 1 {
+			query.Offset = int(req.Pagination.PageNumber-1) * int(req.Pagination.ShowNumber)
+		}
+	}
+	res, err := m.indexer.Search(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	msgs, err := m.getIndexHits(ctx, "", res.Hits)
+	if err != nil {
+		return nil, err
+	}
+	chatLogs, err := m.toChatLogs(ctx, datautil.Slice(msgs, func(msg *msgext.SearchedMsg) *sdkws.MsgData { return msg.Msg }))
+	if err != nil {
+		return nil, err
+	}
+	return &msgext.SearchMsgResp{ChatLogs: chatLogs, ChatLogsNum: int32(res.Total), NextCursor: res.NextCursor}, nil
+}
+
+func (m *msgServer) SearchUserMsg(ctx context.Context, req *msgext.SearchUserMsgReq) (*msgext.SearchUserMsgResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if m.indexer == nil {
+		return nil, errs.ErrArgs.WrapMsg("message search index is disabled")
+	}
+	conversationIDs := req.ConversationIDs
+	if len(conversationIDs) == 0 {
+		var err error
+		conversationIDs, err = m.ConversationLocalCache.GetConversationIDs(ctx, req.UserID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	conversationIDs = datautil.Filter(datautil.Distinct(conversationIDs), func(conversationID string) (string, bool) {
+		return conversationID, !msgprocessor.IsNotification(conversationID)
+	})
+	resp := &msgext.SearchUserMsgResp{}
+	if len(conversationIDs) == 0 {
+		return resp, nil
+	}
+	// Conversations the user does not own are silently left out.
+	conversations, err := m.ConversationLocalCache.GetConversations(ctx, req.UserID, conversationIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(conversations) == 0 {
+		return resp, nil
+	}
+	conversationIDs = datautil.Slice(conversations, func(c *conversation.Conversation) string { return c.ConversationID })
+	// The user's min seq hides cleared history and, unless EnableHistoryForNewMembers is set,
+	// the group history from before the user joined. The conversation's max seq hides
+	// messages sent after the user left a group.
+	minSeqs, err := m.MsgDatabase.GetUserMinSeqs(ctx, req.UserID, conversationIDs)
+	if err != nil {
+		return nil, err
+	}
+	seqRanges := make(map[string]msgindex.SeqRange, len(conversations))
+	for _, c := range conversations {
+		seqRanges[c.ConversationID] = msgindex.SeqRange{Min: minSeqs[c.ConversationID], Max: c.MaxSeq}
+	}
+	res, err := m.indexer.Search(ctx, &msgindex.Query{
+		Keyword:         msgindex.ParseKeyword(req.Keyword),
+		ConversationIDs: conversationIDs,
+		SeqRanges:       seqRanges,
+		SendID:          req.SendID,
+		ContentType:     req.ContentType,
+		StartTime:       req.StartTime,
+		EndTime:         req.EndTime,
+		Cursor:          req.Cursor,
+		Limit:           int(req.Count),
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Messages the user deleted through DelList come back without content and are dropped here.
+	if resp.Msgs, err = m.getIndexHits(ctx, req.UserID, res.Hits); err != nil {
+		return nil, err
+	}
+	resp.Total = int32(res.Total)
+	resp.NextCursor = res.NextCursor
+	return resp, nil
+}
+
+func (m *msgServer) GetMsgsBySeqs(ctx context.Context, req *msgext.GetMsgsBySeqsReq) (*msgext.GetMsgsBySeqsResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	msgs, err := m.MsgDatabase.FindMsgBySeqs(ctx, "", req.ConversationID, datautil.Distinct(req.Seqs))
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(msgs, func(i, j int) bool { return msgs[i].Seq < msgs[j].Seq })
+	return &msgext.GetMsgsBySeqsResp{Msgs: msgs}, nil
+}
+
+// getIndexHits loads the messages referenced by index hits in hit order. Hits whose message
+// has since been revoked or deleted for userID are dropped, the index catches up lazily.
+func (m *msgServer) getIndexHits(ctx context.Context, userID string, hits []*msgindex.Hit) ([]*msgext.SearchedMsg, error) {
+	seqs := make(map[string][]int64)
+	for _, hit := range hits {
+		seqs[hit.ConversationID] = append(seqs[hit.ConversationID], hit.Seq)
+	}
+	type key struct {
+		conversationID string
+		seq            int64
+	}
+	found := make(map[key]*sdkws.MsgData, len(hits))
+	for conversationID, conversationSeqs := range seqs {
+		msgs, err := m.MsgDatabase.FindMsgBySeqs(ctx, userID, conversationID, conversationSeqs)
+		if err != nil {
+			return nil, err
+		}
+		for _, msg := range msgs {
+			if len(msg.Content) == 0 || msg.Status == constant.MsgDeleted || msg.ContentType == constant.MsgRevokeNotification {
+				continue
+			}
+			found[key{conversationID: conversationID, seq: msg.Seq}] = msg
+		}
+	}
+	res := make([]*msgext.SearchedMsg, 0, len(found))
+	for _, hit := range hits {
+		if msg, ok := found[key{conversationID: hit.ConversationID, seq: hit.Seq}]; ok {
+			res = append(res, &msgext.SearchedMsg{ConversationID: hit.ConversationID, Msg: msg})
+		}
+	}
+	return res, nil
+}
+
+// deleteIndex removes messages from the search index. The index is only a projection,
+// so failures are logged and never fail the request.
+func (m *msgServer) deleteIndex(ctx context.Context, conversationID string, seqs []int64) {
+	if m.indexer == nil {
+		return
+	}
+	if err := m.indexer.Delete(ctx, conversationID, seqs); err != nil {
+		log.ZWarn(ctx, "delete msg index failed", err, "conversationID", conversationID, "seqs", seqs)
+	}
+}
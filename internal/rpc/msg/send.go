@@ -18,7 +18,9 @@ import (
 	"context"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
 	"github.com/openimsdk/open-im-server/v3/pkg/util/conversationutil"
 	"github.com/openimsdk/protocol/constant"
 	pbconversation "github.com/openimsdk/protocol/conversation"
@@ -33,20 +35,47 @@ import (
 )
 
 func (m *msgServer) SendMsg(ctx context.Context, req *pbmsg.SendMsgReq) (*pbmsg.SendMsgResp, error) {
-	if req.MsgData != nil {
-		m.encapsulateMsgData(req.MsgData)
-		switch req.MsgData.SessionType {
-		case constant.SingleChatType:
-			return m.sendMsgSingleChat(ctx, req)
-		case constant.NotificationChatType:
-			return m.sendMsgNotification(ctx, req)
-		case constant.ReadGroupChatType:
-			return m.sendMsgGroupChat(ctx, req)
-		default:
-			return nil, errs.ErrArgs.WrapMsg("unknown sessionType")
-		}
+	resp, err := m.sendMsg(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pbmsg.SendMsgResp{ServerMsgID: resp.ServerMsgID, ClientMsgID: resp.ClientMsgID, SendTime: resp.SendTime}, nil
+}
+
+func (m *msgServer) SendMsgWithSeq(ctx context.Context, req *msgext.SendMsgWithSeqReq) (*msgext.SendMsgWithSeqResp, error) {
+	return m.sendMsg(ctx, &pbmsg.SendMsgReq{MsgData: req.MsgData})
+}
+
+func (m *msgServer) sendMsg(ctx context.Context, req *pbmsg.SendMsgReq) (resp *msgext.SendMsgWithSeqResp, err error) {
+	if req.MsgData == nil {
+		return nil, errs.ErrArgs.WrapMsg("msgData is nil")
+	}
+	m.encapsulateMsgData(req.MsgData)
+	sent, finish, err := m.claimSend(ctx, req.MsgData)
+	if err != nil {
+		return nil, err
+	}
+	if sent != nil {
+		return sent, nil
 	}
-	return nil, errs.ErrArgs.WrapMsg("msgData is nil")
+	defer func() {
+		finish(err)
+	}()
+	var sendResp *pbmsg.SendMsgResp
+	switch req.MsgData.SessionType {
+	case constant.SingleChatType:
+		sendResp, err = m.sendMsgSingleChat(ctx, req)
+	case constant.NotificationChatType:
+		sendResp, err = m.sendMsgNotification(ctx, req)
+	case constant.ReadGroupChatType:
+		sendResp, err = m.sendMsgGroupChat(ctx, req)
+	default:
+		err = errs.ErrArgs.WrapMsg("unknown sessionType")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &msgext.SendMsgWithSeqResp{ServerMsgID: sendResp.ServerMsgID, ClientMsgID: sendResp.ClientMsgID, SendTime: sendResp.SendTime}, nil
 }
 
 func (m *msgServer) sendMsgGroupChat(ctx context.Context, req *pbmsg.SendMsgReq) (resp *pbmsg.SendMsgResp, err error) {
@@ -55,16 +84,15 @@ func (m *msgServer) sendMsgGroupChat(ctx context.Context, req *pbmsg.SendMsgReq)
 		return nil, err
 	}
 
-	if err = m.webhookBeforeSendGroupMsg(ctx, &m.config.WebhooksConfig.BeforeSendGroupMsg, req); err != nil {
-		return nil, err
-	}
-	if err := m.webhookBeforeMsgModify(ctx, &m.config.WebhooksConfig.BeforeMsgModify, req); err != nil {
+	flagged, err := m.moderate(ctx, req)
+	if err != nil {
 		return nil, err
 	}
 	err = m.MsgDatabase.MsgToMQ(ctx, conversationutil.GenConversationUniqueKeyForGroup(req.MsgData.GroupID), req.MsgData)
 	if err != nil {
 		return nil, err
 	}
+	m.addModerationReview(ctx, req.MsgData, flagged)
 	if req.MsgData.ContentType == constant.AtText {
 		go m.setConversationAtInfo(ctx, req.MsgData)
 	}
@@ -80,21 +108,25 @@ func (m *msgServer) sendMsgGroupChat(ctx context.Context, req *pbmsg.SendMsgReq)
 
 func (m *msgServer) setConversationAtInfo(nctx context.Context, msg *sdkws.MsgData) {
 	log.ZDebug(nctx, "setConversationAtInfo", "msg", msg)
-	ctx := mcontext.NewCtx("@@@" + mcontext.GetOperationID(nctx))
+	ctx := tenant.Inherit(mcontext.NewCtx("@@@"+mcontext.GetOperationID(nctx)), nctx)
 	var atUserID []string
 	conversation := &pbconversation.ConversationReq{
 		ConversationID:   msgprocessor.GetConversationIDByMsg(msg),
 		ConversationType: msg.SessionType,
 		GroupID:          msg.GroupID,
 	}
-	tagAll := datautil.Contain(constant.AtAllString, msg.AtUserIDList...)
+	atUserIDList, err := m.Group.ExpandAtUserIDs(ctx, msg.GroupID, msg.AtUserIDList)
+	if err != nil {
+		log.ZWarn(ctx, "ExpandAtUserIDs", err, "groupID", msg.GroupID, "atUserIDList", msg.AtUserIDList)
+	}
+	tagAll := datautil.Contain(constant.AtAllString, atUserIDList...)
 	if tagAll {
 		memberUserIDList, err := m.GroupLocalCache.GetGroupMemberIDs(ctx, msg.GroupID)
 		if err != nil {
 			log.ZWarn(ctx, "GetGroupMemberIDs", err)
 			return
 		}
-		atUserID = stringutil.DifferenceString([]string{constant.AtAllString}, msg.AtUserIDList)
+		atUserID = stringutil.DifferenceString([]string{constant.AtAllString}, atUserIDList)
 		if len(atUserID) == 0 { // just @everyone
 			conversation.GroupAtType = &wrapperspb.Int32Value{Value: constant.AtAll}
 		} else { // @Everyone and @other people
@@ -113,9 +145,9 @@ func (m *msgServer) setConversationAtInfo(nctx context.Context, msg *sdkws.MsgDa
 		return
 	}
 	conversation.GroupAtType = &wrapperspb.Int32Value{Value: constant.AtMe}
-	err := m.Conversation.SetConversations(ctx, msg.AtUserIDList, conversation)
+	err = m.Conversation.SetConversations(ctx, atUserIDList, conversation)
 	if err != nil {
-		log.ZWarn(ctx, "SetConversations", err, msg.AtUserIDList, conversation)
+		log.ZWarn(ctx, "SetConversations", err, atUserIDList, conversation)
 	}
 }
 
@@ -153,10 +185,8 @@ func (m *msgServer) sendMsgSingleChat(ctx context.Context, req *pbmsg.SendMsgReq
 		prommetrics.SingleChatMsgProcessFailedCounter.Inc()
 		return nil, nil
 	} else {
-		if err = m.webhookBeforeSendSingleMsg(ctx, &m.config.WebhooksConfig.BeforeSendSingleMsg, req); err != nil {
-			return nil, err
-		}
-		if err := m.webhookBeforeMsgModify(ctx, &m.config.WebhooksConfig.BeforeMsgModify, req); err != nil {
+		flagged, err := m.moderate(ctx, req)
+		if err != nil {
 			return nil, err
 		}
 
@@ -164,6 +194,7 @@ func (m *msgServer) sendMsgSingleChat(ctx context.Context, req *pbmsg.SendMsgReq
 			prommetrics.SingleChatMsgProcessFailedCounter.Inc()
 			return nil, err
 		}
+		m.addModerationReview(ctx, req.MsgData, flagged)
 		m.webhookAfterSendSingleMsg(ctx, &m.config.WebhooksConfig.AfterSendSingleMsg, req)
 		prommetrics.SingleChatMsgProcessSuccessCounter.Inc()
 		return &pbmsg.SendMsgResp{
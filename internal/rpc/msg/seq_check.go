@@ -0,0 +1,104 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+const defaultSeqCheckCount = 100
+
+func (m *msgServer) CheckConversationSeq(ctx context.Context, req *msgext.CheckConversationSeqReq) (*msgext.CheckConversationSeqResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	resp := &msgext.CheckConversationSeqResp{Summary: &msgext.SeqCheckSummary{}}
+	conversationIDs := datautil.Distinct(req.ConversationIDs)
+	if len(conversationIDs) == 0 {
+		count := int(req.Count)
+		if count == 0 {
+			count = defaultSeqCheckCount
+		}
+		var err error
+		conversationIDs, err = m.MsgDatabase.FindSeqConversationIDs(ctx, req.Cursor, count)
+		if err != nil {
+			return nil, err
+		}
+		if len(conversationIDs) == count {
+			resp.NextCursor = conversationIDs[len(conversationIDs)-1]
+		}
+	}
+	opt := controller.SeqCheckOption{Repair: req.Repair, CheckMissing: req.CheckMissing}
+	start := time.Now()
+	for _, conversationID := range conversationIDs {
+		report := m.checkConversationSeq(ctx, conversationID, opt, resp.Summary)
+		if req.OnlyIssues && len(report.Issues) == 0 && report.MissingSeqNum == 0 && report.Error == "" {
+			continue
+		}
+		resp.Reports = append(resp.Reports, report)
+	}
+	log.ZInfo(ctx, "check conversation seq", "repair", req.Repair, "summary", resp.Summary, "nextCursor", resp.NextCursor, "cost", time.Since(start))
+	return resp, nil
+}
+
+// checkConversationSeq checks one conversation and adds it to summary. Failures are reported
+// in the result so that a scan goes on with the next conversation.
+func (m *msgServer) checkConversationSeq(ctx context.Context, conversationID string, opt controller.SeqCheckOption, summary *msgext.SeqCheckSummary) *msgext.ConversationSeqReport {
+	summary.ConversationNum++
+	res, err := m.MsgDatabase.CheckConversationSeq(ctx, conversationID, opt)
+	if err != nil {
+		log.ZWarn(ctx, "check conversation seq failed", err, "conversationID", conversationID, "repair", opt.Repair)
+		summary.FailedNum++
+		return &msgext.ConversationSeqReport{ConversationID: conversationID, Error: err.Error()}
+	}
+	report := &msgext.ConversationSeqReport{
+		ConversationID: conversationID,
+		MinSeqMsg:      res.MinSeqMsg,
+		MaxSeqMsg:      res.MaxSeqMsg,
+		MinSeqCache:    res.MinSeqCache,
+		MaxSeqCache:    res.MaxSeqCache,
+		LastSeqCache:   res.LastSeqCache,
+		MinSeqDB:       res.MinSeqDB,
+		MaxSeqDB:       res.MaxSeqDB,
+		UserNum:        int32(len(res.Users)),
+		MissingSeqNum:  res.MissingSeqNum,
+		MissingSeqs:    res.MissingSeqs,
+	}
+	for _, issue := range res.Issues {
+		report.Issues = append(report.Issues, &msgext.SeqIssue{
+			Type:     issue.Type,
+			UserID:   issue.UserID,
+			Seq:      issue.Seq,
+			Expected: issue.Expected,
+			Repaired: issue.Repaired,
+		})
+		if issue.Repaired {
+			summary.RepairedNum++
+		}
+	}
+	if len(res.Issues) > 0 {
+		summary.InconsistentNum++
+		summary.IssueNum += int32(len(res.Issues))
+	}
+	summary.MissingSeqNum += res.MissingSeqNum
+	return report
+}
@@ -18,20 +18,25 @@ import (
 	"context"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/moderation"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/mq"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/redis"
-	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database/mgo"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/dbbuild"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/msgindex"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/webhook"
 	"github.com/openimsdk/protocol/sdkws"
-	"github.com/openimsdk/tools/db/mongoutil"
 	"github.com/openimsdk/tools/db/redisutil"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpccache"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/conversation"
 	"github.com/openimsdk/protocol/msg"
 	"github.com/openimsdk/tools/discovery"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/mq/memamq"
 	"google.golang.org/grpc"
 )
 
@@ -44,7 +49,11 @@ type (
 	msgServer struct {
 		RegisterCenter         discovery.SvcDiscoveryRegistry   // Service discovery registry for service registration.
 		MsgDatabase            controller.CommonMsgDatabase     // Interface for message database operations.
+		ReadReceiptDatabase    controller.ReadReceiptDatabase   // Interface for group read receipt operations.
+		ModerationDatabase     controller.ModerationDatabase    // Interface for group sensitive words and moderation reviews.
+		GroupMuteRuleDatabase  controller.GroupMuteRuleDatabase // Interface for group slow mode and mute schedules.
 		Conversation           *rpcclient.ConversationRpcClient // RPC client for conversation service.
+		Group                  *rpcclient.GroupRpcClient        // RPC client for group service.
 		UserLocalCache         *rpccache.UserLocalCache         // Local cache for user data.
 		FriendLocalCache       *rpccache.FriendLocalCache       // Local cache for friend data.
 		GroupLocalCache        *rpccache.GroupLocalCache        // Local cache for group data.
@@ -54,17 +63,26 @@ type (
 		msgNotificationSender  *MsgNotificationSender           // RPC client for sending msg notifications.
 		config                 *Config                          // Global configuration settings.
 		webhookClient          *webhook.Client
+		indexer                msgindex.MessageIndexer // Message search index, nil when disabled.
+		moderationChain        *moderation.Chain       // Moderation chain, nil when disabled.
+		readReceiptQueue       *memamq.MemoryQueue     // Queue pushing group read receipts off the request path.
 	}
 
 	Config struct {
 		RpcConfig          config.Msg
 		RedisConfig        config.Redis
 		MongodbConfig      config.Mongo
+		DatabaseConfig     config.Database
 		KafkaConfig        config.Kafka
+		MQConfig           config.MQ
 		NotificationConfig config.Notification
 		Share              config.Share
 		WebhooksConfig     config.Webhooks
 		LocalCacheConfig   config.LocalCache
+		SearchConfig       config.Search
+		ModerationConfig   config.Moderation
+		ThirdConfig        config.Third
+		MinioConfig        config.Minio
 		Discovery          config.Discovery
 	}
 )
@@ -75,7 +93,7 @@ func (m *msgServer) addInterceptorHandler(interceptorFunc ...MessageInterceptorF
 }
 
 func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryRegistry, server *grpc.Server) error {
-	mgocli, err := mongoutil.NewMongoDB(ctx, config.MongodbConfig.Build())
+	dbb, err := dbbuild.NewBuilder(ctx, &config.DatabaseConfig, &config.MongodbConfig)
 	if err != nil {
 		return err
 	}
@@ -83,7 +101,7 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	if err != nil {
 		return err
 	}
-	msgDocModel, err := mgo.NewMsgMongo(mgocli.GetDB())
+	msgDocModel, err := dbb.Msg()
 	if err != nil {
 		return err
 	}
@@ -92,23 +110,64 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	userRpcClient := rpcclient.NewUserRpcClient(client, config.Share.RpcRegisterName.User, config.Share.IMAdminUserID)
 	groupRpcClient := rpcclient.NewGroupRpcClient(client, config.Share.RpcRegisterName.Group)
 	friendRpcClient := rpcclient.NewFriendRpcClient(client, config.Share.RpcRegisterName.Friend)
-	seqConversation, err := mgo.NewSeqConversationMongo(mgocli.GetDB())
+	seqConversation, err := dbb.SeqConversation()
 	if err != nil {
 		return err
 	}
 	seqConversationCache := redis.NewSeqConversationCacheRedis(rdb, seqConversation)
-	seqUser, err := mgo.NewSeqUserMongo(mgocli.GetDB())
+	seqUser, err := dbb.SeqUser()
 	if err != nil {
 		return err
 	}
 	seqUserCache := redis.NewSeqUserCacheRedis(rdb, seqUser)
-	msgDatabase, err := controller.NewCommonMsgDatabase(msgDocModel, msgModel, seqUserCache, seqConversationCache, &config.KafkaConfig)
+	mqBuilder, err := mq.NewBuilder(&config.MQConfig, &config.KafkaConfig, rdb)
+	if err != nil {
+		return err
+	}
+	var msgArchive controller.MsgArchiveDatabase
+	if config.RpcConfig.Archive.Enable {
+		if msgArchive, err = newMsgArchive(ctx, config, dbb, rdb, msgDocModel); err != nil {
+			return err
+		}
+	}
+	msgDatabase, err := controller.NewCommonMsgDatabase(msgDocModel, msgModel, seqUserCache, seqConversationCache, &config.KafkaConfig, mqBuilder, msgArchive)
+	if err != nil {
+		return err
+	}
+	groupReadReceipt, err := dbb.GroupReadReceipt()
+	if err != nil {
+		return err
+	}
+	readReceiptDatabase := controller.NewReadReceiptDatabase(redis.NewGroupReadReceiptCacheRedis(rdb, groupReadReceipt), seqUserCache)
+	groupSensitiveWord, err := dbb.GroupSensitiveWord()
+	if err != nil {
+		return err
+	}
+	moderationReview, err := dbb.ModerationReview()
+	if err != nil {
+		return err
+	}
+	moderationDatabase := controller.NewModerationDatabase(redis.NewGroupSensitiveWordCacheRedis(rdb, groupSensitiveWord), moderationReview)
+	groupMuteRule, err := dbb.GroupMuteRule()
+	if err != nil {
+		return err
+	}
+	// Every process searches an embedded index of its own, so replicas could answer differently.
+	if msgindex.IsEmbedded(&config.SearchConfig) && len(config.RpcConfig.RPC.Ports) > 1 {
+		return errs.New("the embedded search backend supports a single openim-rpc-msg instance, use the mongo backend",
+			"ports", config.RpcConfig.RPC.Ports).Wrap()
+	}
+	indexer, err := msgindex.NewMessageIndexer(&config.SearchConfig, dbb.MongoDB())
 	if err != nil {
 		return err
 	}
 	s := &msgServer{
 		Conversation:           &conversationClient,
+		Group:                  &groupRpcClient,
 		MsgDatabase:            msgDatabase,
+		ReadReceiptDatabase:    readReceiptDatabase,
+		ModerationDatabase:     moderationDatabase,
+		GroupMuteRuleDatabase:  controller.NewGroupMuteRuleDatabase(redis.NewGroupMuteRuleCacheRedis(rdb, groupMuteRule)),
 		RegisterCenter:         client,
 		UserLocalCache:         rpccache.NewUserLocalCache(userRpcClient, &config.LocalCacheConfig, rdb),
 		GroupLocalCache:        rpccache.NewGroupLocalCache(groupRpcClient, &config.LocalCacheConfig, rdb),
@@ -116,12 +175,19 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 		FriendLocalCache:       rpccache.NewFriendLocalCache(friendRpcClient, &config.LocalCacheConfig, rdb),
 		config:                 config,
 		webhookClient:          webhook.NewWebhookClient(config.WebhooksConfig.URL),
+		indexer:                indexer,
+		readReceiptQueue:       memamq.NewMemoryQueue(16, 1024*8),
+	}
+
+	if s.moderationChain, err = s.newModerationChain(); err != nil {
+		return err
 	}
 
 	s.notificationSender = rpcclient.NewNotificationSender(&config.NotificationConfig, rpcclient.WithLocalSendMsg(s.SendMsg))
 	s.msgNotificationSender = NewMsgNotificationSender(config, rpcclient.WithLocalSendMsg(s.SendMsg))
 
 	msg.RegisterMsgServer(server, s)
+	msgext.RegisterMsgExtServer(server, s)
 
 	return nil
 }
@@ -117,7 +117,17 @@ func (m *msgServer) SearchMessage(ctx context.Context, req *msg.SearchMessageReq
 		return nil, err
 	}
 
+	if resp.ChatLogs, err = m.toChatLogs(ctx, chatLogs); err != nil {
+		return nil, err
+	}
+	resp.ChatLogsNum = int32(total)
+	return resp, nil
+}
+
+// toChatLogs fills in the sender, receiver and group details of messages for the admin message search.
+func (m *msgServer) toChatLogs(ctx context.Context, chatLogs []*sdkws.MsgData) ([]*msg.ChatLog, error) {
 	var (
+		res      = make([]*msg.ChatLog, 0, len(chatLogs))
 		sendIDs  []string
 		recvIDs  []string
 		groupIDs []string
@@ -192,10 +202,9 @@ func (m *msgServer) SearchMessage(ctx context.Context, req *msg.SearchMessageReq
 			pbchatLog.GroupOwner = groupInfo.OwnerUserID
 			pbchatLog.GroupType = groupInfo.GroupType
 		}
-		resp.ChatLogs = append(resp.ChatLogs, pbchatLog)
+		res = append(res, pbchatLog)
 	}
-	resp.ChatLogsNum = int32(total)
-	return resp, nil
+	return res, nil
 }
 
 func (m *msgServer) GetServerTime(ctx context.Context, _ *msg.GetServerTimeReq) (*msg.GetServerTimeResp, error) {
@@ -16,7 +16,9 @@ package msg
 
 import (
 	"context"
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
 	"github.com/openimsdk/tools/utils/datautil"
 	"github.com/openimsdk/tools/utils/encrypt"
 	"github.com/openimsdk/tools/utils/timeutil"
@@ -52,7 +54,7 @@ type MessageRevoked struct {
 func (m *msgServer) messageVerification(ctx context.Context, data *msg.SendMsgReq) error {
 	switch data.MsgData.SessionType {
 	case constant.SingleChatType:
-		if datautil.Contain(data.MsgData.SendID, m.config.Share.IMAdminUserID...) {
+		if authverify.IsManagerUserID(ctx, data.MsgData.SendID, m.config.Share.IMAdminUserID) {
 			return nil
 		}
 		if data.MsgData.ContentType <= constant.NotificationEnd &&
@@ -90,7 +92,7 @@ func (m *msgServer) messageVerification(ctx context.Context, data *msg.SendMsgRe
 			return nil
 		}
 
-		if datautil.Contain(data.MsgData.SendID, m.config.Share.IMAdminUserID...) {
+		if authverify.IsManagerUserID(ctx, data.MsgData.SendID, m.config.Share.IMAdminUserID) {
 			return nil
 		}
 		if data.MsgData.ContentType <= constant.NotificationEnd &&
@@ -115,12 +117,20 @@ func (m *msgServer) messageVerification(ctx context.Context, data *msg.SendMsgRe
 		if groupMemberInfo.RoleLevel == constant.GroupOwner {
 			return nil
 		} else {
+			if groupInfo.GroupType == groupext.ChannelGroup && groupMemberInfo.RoleLevel != constant.GroupAdmin {
+				if err := m.checkChannelPublisher(ctx, data.MsgData.GroupID, data.MsgData.SendID); err != nil {
+					return err
+				}
+			}
 			if groupMemberInfo.MuteEndTime >= time.Now().UnixMilli() {
 				return servererrs.ErrMutedInGroup.Wrap()
 			}
 			if groupInfo.Status == constant.GroupStatusMuted && groupMemberInfo.RoleLevel != constant.GroupAdmin {
 				return servererrs.ErrMutedGroup.Wrap()
 			}
+			if groupMemberInfo.RoleLevel != constant.GroupAdmin {
+				return m.checkGroupMuteRule(ctx, data.MsgData.GroupID, data.MsgData.SendID)
+			}
 		}
 		return nil
 	default:
@@ -128,6 +138,24 @@ func (m *msgServer) messageVerification(ctx context.Context, data *msg.SendMsgRe
 	}
 }
 
+// checkChannelPublisher allows members other than the owner and admins to post in a
+// channel only when their role grants GroupPermPublish.
+func (m *msgServer) checkChannelPublisher(ctx context.Context, groupID string, userID string) error {
+	resp, err := m.Group.ExtClient.GetGroupMemberPermissions(ctx, &groupext.GetGroupMemberPermissionsReq{
+		GroupID: groupID,
+		UserIDs: []string{userID},
+	})
+	if err != nil {
+		return err
+	}
+	for _, member := range resp.Members {
+		if member.UserID == userID && authverify.GroupPermission(member.Permissions)&authverify.GroupPermPublish != 0 {
+			return nil
+		}
+	}
+	return servererrs.ErrChannelReadOnly.Wrap()
+}
+
 func (m *msgServer) encapsulateMsgData(msg *sdkws.MsgData) {
 	msg.ServerMsgID = GetMsgID(msg.SendID)
 	if msg.SendTime == 0 {
@@ -21,7 +21,7 @@ import (
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/redis"
-	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database/mgo"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/dbbuild"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/webhook"
 	"github.com/openimsdk/open-im-server/v3/pkg/localcache"
@@ -31,11 +31,11 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/relationext"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/relation"
 	"github.com/openimsdk/protocol/sdkws"
-	"github.com/openimsdk/tools/db/mongoutil"
 	"github.com/openimsdk/tools/discovery"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/utils/datautil"
@@ -44,6 +44,7 @@ import (
 
 type friendServer struct {
 	db                    controller.FriendDatabase
+	friendGroupDB         controller.FriendGroupDatabase
 	blackDatabase         controller.BlackDatabase
 	userRpcClient         *rpcclient.UserRpcClient
 	notificationSender    *FriendNotificationSender
@@ -55,9 +56,10 @@ type friendServer struct {
 }
 
 type Config struct {
-	RpcConfig     config.Friend
-	RedisConfig   config.Redis
-	MongodbConfig config.Mongo
+	RpcConfig      config.Friend
+	RedisConfig    config.Redis
+	MongodbConfig  config.Mongo
+	DatabaseConfig config.Database
 	// ZookeeperConfig    config.ZooKeeper
 	NotificationConfig config.Notification
 	Share              config.Share
@@ -67,7 +69,7 @@ type Config struct {
 }
 
 func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryRegistry, server *grpc.Server) error {
-	mgocli, err := mongoutil.NewMongoDB(ctx, config.MongodbConfig.Build())
+	dbb, err := dbbuild.NewBuilder(ctx, &config.DatabaseConfig, &config.MongodbConfig)
 	if err != nil {
 		return err
 	}
@@ -76,17 +78,27 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 		return err
 	}
 
-	friendMongoDB, err := mgo.NewFriendMongo(mgocli.GetDB())
+	friendMongoDB, err := dbb.Friend()
 	if err != nil {
 		return err
 	}
 
-	friendRequestMongoDB, err := mgo.NewFriendRequestMongo(mgocli.GetDB())
+	friendRequestMongoDB, err := dbb.FriendRequest()
 	if err != nil {
 		return err
 	}
 
-	blackMongoDB, err := mgo.NewBlackMongo(mgocli.GetDB())
+	blackMongoDB, err := dbb.Black()
+	if err != nil {
+		return err
+	}
+
+	friendGroupDB, err := dbb.FriendGroup()
+	if err != nil {
+		return err
+	}
+
+	friendGroupMemberDB, err := dbb.FriendGroupMember()
 	if err != nil {
 		return err
 	}
@@ -103,14 +115,17 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	)
 	localcache.InitLocalCache(&config.LocalCacheConfig)
 
+	friendCache := redis.NewFriendCacheRedis(rdb, &config.LocalCacheConfig, friendMongoDB, redis.GetRocksCacheOptions())
+
 	// Register Friend server with refactored MongoDB and Redis integrations
-	relation.RegisterFriendServer(server, &friendServer{
+	s := &friendServer{
 		db: controller.NewFriendDatabase(
 			friendMongoDB,
 			friendRequestMongoDB,
-			redis.NewFriendCacheRedis(rdb, &config.LocalCacheConfig, friendMongoDB, redis.GetRocksCacheOptions()),
-			mgocli.GetTx(),
+			friendCache,
+			dbb.Tx(),
 		),
+		friendGroupDB: controller.NewFriendGroupDatabase(friendGroupDB, friendGroupMemberDB, friendMongoDB, friendCache, dbb.Tx()),
 		blackDatabase: controller.NewBlackDatabase(
 			blackMongoDB,
 			redis.NewBlackCacheRedis(rdb, &config.LocalCacheConfig, blackMongoDB, redis.GetRocksCacheOptions()),
@@ -122,7 +137,9 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 		config:                config,
 		webhookClient:         webhook.NewWebhookClient(config.WebhooksConfig.URL),
 		queue:                 memamq.NewMemoryQueue(128, 1024*8),
-	})
+	}
+	relation.RegisterFriendServer(server, s)
+	relationext.RegisterRelationExtServer(server, s)
 	return nil
 }
 
@@ -236,6 +253,11 @@ func (s *friendServer) DeleteFriend(ctx context.Context, req *relation.DeleteFri
 	if err != nil {
 		return nil, err
 	}
+	// Take the friend out of the groups first, so a failure leaves the friendship in place
+	// and the request can be retried instead of stranding the friend in the groups.
+	if err := s.friendGroupDB.RemoveFriendsFromGroups(ctx, req.OwnerUserID, []string{req.FriendUserID}); err != nil {
+		return nil, err
+	}
 	if err := s.db.Delete(ctx, req.OwnerUserID, []string{req.FriendUserID}); err != nil {
 		return nil, err
 	}
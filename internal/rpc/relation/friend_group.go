@@ -0,0 +1,278 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relation
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/internal/rpc/incrversion"
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/relationext"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func friendGroupDB2PB(group *model.FriendGroup, friendUserIDs []string) *relationext.FriendGroup {
+	return &relationext.FriendGroup{
+		GroupID:       group.GroupID,
+		Name:          group.Name,
+		Order:         group.SortOrder,
+		FriendUserIDs: friendUserIDs,
+		CreateTime:    group.CreateTime.UnixMilli(),
+	}
+}
+
+// friendGroupVersionLog keeps the friend groups of the friend version log, with the group IDs
+// as their elements.
+func friendGroupVersionLog(vl *model.VersionLog) *model.VersionLog {
+	logs := make([]model.VersionLogElem, 0, len(vl.Logs))
+	for _, elem := range vl.Logs {
+		groupID, ok := model.FriendGroupVersionGroupID(elem.EID)
+		if !ok {
+			vl.LogLen--
+			continue
+		}
+		elem.EID = groupID
+		logs = append(logs, elem)
+	}
+	vl.Logs = logs
+	return vl
+}
+
+// checkFriendGroupOrder checks that groupIDs lists every group once.
+func checkFriendGroupOrder(groups []*model.FriendGroup, groupIDs []string) error {
+	if len(groupIDs) != len(groups) {
+		return errs.ErrArgs.WrapMsg("groupIDs must list every friend group")
+	}
+	exist := datautil.SliceSetAny(groups, func(e *model.FriendGroup) string { return e.GroupID })
+	seen := make(map[string]struct{}, len(groupIDs))
+	for _, groupID := range groupIDs {
+		if _, ok := exist[groupID]; !ok {
+			return errs.ErrRecordNotFound.WrapMsg("friend group not found", "groupID", groupID)
+		}
+		if _, ok := seen[groupID]; ok {
+			return errs.ErrArgs.WrapMsg("groupID repeated", "groupID", groupID)
+		}
+		seen[groupID] = struct{}{}
+	}
+	return nil
+}
+
+// findFriendGroups returns the groups of the owner with their friends, all of them when
+// groupIDs is nil.
+func (s *friendServer) findFriendGroups(ctx context.Context, ownerUserID string, groupIDs []string) ([]*relationext.FriendGroup, error) {
+	groups, err := s.friendGroupDB.FindFriendGroups(ctx, ownerUserID, groupIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(groups) == 0 {
+		return nil, nil
+	}
+	members, err := s.friendGroupDB.FindFriendGroupMembers(ctx, ownerUserID, datautil.Slice(groups, func(e *model.FriendGroup) string { return e.GroupID }))
+	if err != nil {
+		return nil, err
+	}
+	friendUserIDs := make(map[string][]string)
+	for _, member := range members {
+		friendUserIDs[member.GroupID] = append(friendUserIDs[member.GroupID], member.FriendUserID)
+	}
+	return datautil.Slice(groups, func(e *model.FriendGroup) *relationext.FriendGroup {
+		return friendGroupDB2PB(e, friendUserIDs[e.GroupID])
+	}), nil
+}
+
+func (s *friendServer) takeFriendGroup(ctx context.Context, ownerUserID string, groupID string) (*model.FriendGroup, error) {
+	groups, err := s.friendGroupDB.FindFriendGroups(ctx, ownerUserID, []string{groupID})
+	if err != nil {
+		return nil, err
+	}
+	if len(groups) == 0 {
+		return nil, errs.ErrRecordNotFound.WrapMsg("friend group not found", "groupID", groupID)
+	}
+	return groups[0], nil
+}
+
+func (s *friendServer) CreateFriendGroup(ctx context.Context, req *relationext.CreateFriendGroupReq) (*relationext.CreateFriendGroupResp, error) {
+	if err := s.userRpcClient.Access(ctx, req.OwnerUserID); err != nil {
+		return nil, err
+	}
+	groups, err := s.friendGroupDB.FindFriendGroups(ctx, req.OwnerUserID, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(groups) >= relationext.MaxFriendGroups {
+		return nil, errs.ErrArgs.WrapMsg("too many friend groups")
+	}
+	var sortOrder int32
+	for _, group := range groups {
+		if group.Name == req.Name {
+			return nil, errs.ErrArgs.WrapMsg("friend group name exists", "name", req.Name)
+		}
+		sortOrder = max(sortOrder, group.SortOrder+1)
+	}
+	group := &model.FriendGroup{
+		OwnerUserID: req.OwnerUserID,
+		GroupID:     primitive.NewObjectID().Hex(),
+		Name:        req.Name,
+		SortOrder:   sortOrder,
+		CreateTime:  time.Now(),
+	}
+	if err := s.friendGroupDB.CreateFriendGroup(ctx, group); err != nil {
+		return nil, err
+	}
+	s.notificationSender.FriendGroupsChangedNotification(ctx, req.OwnerUserID)
+	return &relationext.CreateFriendGroupResp{Group: friendGroupDB2PB(group, nil)}, nil
+}
+
+func (s *friendServer) SetFriendGroupName(ctx context.Context, req *relationext.SetFriendGroupNameReq) (*relationext.SetFriendGroupNameResp, error) {
+	if err := s.userRpcClient.Access(ctx, req.OwnerUserID); err != nil {
+		return nil, err
+	}
+	groups, err := s.friendGroupDB.FindFriendGroups(ctx, req.OwnerUserID, nil)
+	if err != nil {
+		return nil, err
+	}
+	var found bool
+	for _, group := range groups {
+		if group.GroupID == req.GroupID {
+			found = true
+		} else if group.Name == req.Name {
+			return nil, errs.ErrArgs.WrapMsg("friend group name exists", "name", req.Name)
+		}
+	}
+	if !found {
+		return nil, errs.ErrRecordNotFound.WrapMsg("friend group not found", "groupID", req.GroupID)
+	}
+	if err := s.friendGroupDB.SetFriendGroupName(ctx, req.OwnerUserID, req.GroupID, req.Name); err != nil {
+		return nil, err
+	}
+	s.notificationSender.FriendGroupsChangedNotification(ctx, req.OwnerUserID)
+	return &relationext.SetFriendGroupNameResp{}, nil
+}
+
+func (s *friendServer) DeleteFriendGroup(ctx context.Context, req *relationext.DeleteFriendGroupReq) (*relationext.DeleteFriendGroupResp, error) {
+	if err := s.userRpcClient.Access(ctx, req.OwnerUserID); err != nil {
+		return nil, err
+	}
+	if _, err := s.takeFriendGroup(ctx, req.OwnerUserID, req.GroupID); err != nil {
+		return nil, err
+	}
+	if err := s.friendGroupDB.DeleteFriendGroup(ctx, req.OwnerUserID, req.GroupID); err != nil {
+		return nil, err
+	}
+	s.notificationSender.FriendGroupsChangedNotification(ctx, req.OwnerUserID)
+	return &relationext.DeleteFriendGroupResp{}, nil
+}
+
+func (s *friendServer) SortFriendGroups(ctx context.Context, req *relationext.SortFriendGroupsReq) (*relationext.SortFriendGroupsResp, error) {
+	if err := s.userRpcClient.Access(ctx, req.OwnerUserID); err != nil {
+		return nil, err
+	}
+	groups, err := s.friendGroupDB.FindFriendGroups(ctx, req.OwnerUserID, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkFriendGroupOrder(groups, req.GroupIDs); err != nil {
+		return nil, err
+	}
+	if len(req.GroupIDs) == 0 {
+		return &relationext.SortFriendGroupsResp{}, nil
+	}
+	if err := s.friendGroupDB.SortFriendGroups(ctx, req.OwnerUserID, req.GroupIDs); err != nil {
+		return nil, err
+	}
+	s.notificationSender.FriendGroupsChangedNotification(ctx, req.OwnerUserID)
+	return &relationext.SortFriendGroupsResp{}, nil
+}
+
+func (s *friendServer) AddFriendGroupMembers(ctx context.Context, req *relationext.AddFriendGroupMembersReq) (*relationext.AddFriendGroupMembersResp, error) {
+	if err := s.userRpcClient.Access(ctx, req.OwnerUserID); err != nil {
+		return nil, err
+	}
+	if _, err := s.takeFriendGroup(ctx, req.OwnerUserID, req.GroupID); err != nil {
+		return nil, err
+	}
+	friendUserIDs := datautil.Distinct(req.FriendUserIDs)
+	if _, err := s.db.FindFriendsWithError(ctx, req.OwnerUserID, friendUserIDs); err != nil {
+		return nil, err
+	}
+	if err := s.friendGroupDB.AddFriendGroupMembers(ctx, req.OwnerUserID, req.GroupID, friendUserIDs); err != nil {
+		return nil, err
+	}
+	s.notificationSender.FriendGroupsChangedNotification(ctx, req.OwnerUserID)
+	return &relationext.AddFriendGroupMembersResp{}, nil
+}
+
+func (s *friendServer) RemoveFriendGroupMembers(ctx context.Context, req *relationext.RemoveFriendGroupMembersReq) (*relationext.RemoveFriendGroupMembersResp, error) {
+	if err := s.userRpcClient.Access(ctx, req.OwnerUserID); err != nil {
+		return nil, err
+	}
+	if _, err := s.takeFriendGroup(ctx, req.OwnerUserID, req.GroupID); err != nil {
+		return nil, err
+	}
+	if err := s.friendGroupDB.RemoveFriendGroupMembers(ctx, req.OwnerUserID, req.GroupID, datautil.Distinct(req.FriendUserIDs)); err != nil {
+		return nil, err
+	}
+	s.notificationSender.FriendGroupsChangedNotification(ctx, req.OwnerUserID)
+	return &relationext.RemoveFriendGroupMembersResp{}, nil
+}
+
+func (s *friendServer) GetFriendGroups(ctx context.Context, req *relationext.GetFriendGroupsReq) (*relationext.GetFriendGroupsResp, error) {
+	if err := s.userRpcClient.Access(ctx, req.OwnerUserID); err != nil {
+		return nil, err
+	}
+	groups, err := s.findFriendGroups(ctx, req.OwnerUserID, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &relationext.GetFriendGroupsResp{Groups: groups}, nil
+}
+
+func (s *friendServer) GetIncrementalFriendGroups(ctx context.Context, req *relationext.GetIncrementalFriendGroupsReq) (*relationext.GetIncrementalFriendGroupsResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	opt := incrversion.Option[*relationext.FriendGroup, relationext.GetIncrementalFriendGroupsResp]{
+		Ctx:           ctx,
+		VersionKey:    req.UserID,
+		VersionID:     req.VersionID,
+		VersionNumber: req.Version,
+		Version: func(ctx context.Context, ownerUserID string, version uint, limit int) (*model.VersionLog, error) {
+			vl, err := s.db.FindFriendIncrVersion(ctx, ownerUserID, version, limit)
+			if err != nil {
+				return nil, err
+			}
+			return friendGroupVersionLog(vl), nil
+		},
+		CacheMaxVersion: s.db.FindMaxFriendVersionCache,
+		Find: func(ctx context.Context, ids []string) ([]*relationext.FriendGroup, error) {
+			return s.findFriendGroups(ctx, req.UserID, ids)
+		},
+		Resp: func(version *model.VersionLog, deleteIds []string, insertList, updateList []*relationext.FriendGroup, full bool) *relationext.GetIncrementalFriendGroupsResp {
+			return &relationext.GetIncrementalFriendGroupsResp{
+				VersionID: version.ID.Hex(),
+				Version:   uint64(version.Version),
+				Full:      full,
+				Delete:    deleteIds,
+				Insert:    insertList,
+				Update:    updateList,
+			}
+		},
+	}
+	return opt.Build()
+}
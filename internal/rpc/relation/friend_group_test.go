@@ -0,0 +1,54 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relation
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+func TestFriendGroupVersionLog(t *testing.T) {
+	vl := &model.VersionLog{
+		Logs: []model.VersionLogElem{
+			{EID: "u1", State: model.VersionStateInsert, Version: 1},
+			{EID: model.FriendGroupVersionID("g1"), State: model.VersionStateInsert, Version: 2},
+			{EID: model.VersionSortChangeID, State: model.VersionStateUpdate, Version: 3},
+			{EID: model.FriendGroupVersionID("g2"), State: model.VersionStateDelete, Version: 4},
+		},
+		LogLen: 4,
+	}
+	vl = friendGroupVersionLog(vl)
+	want := []model.VersionLogElem{
+		{EID: "g1", State: model.VersionStateInsert, Version: 2},
+		{EID: "g2", State: model.VersionStateDelete, Version: 4},
+	}
+	if !reflect.DeepEqual(vl.Logs, want) || vl.LogLen != 2 {
+		t.Errorf("friendGroupVersionLog() = %v, %d", vl.Logs, vl.LogLen)
+	}
+}
+
+func TestCheckFriendGroupOrder(t *testing.T) {
+	groups := []*model.FriendGroup{{GroupID: "g1"}, {GroupID: "g2"}}
+	if err := checkFriendGroupOrder(groups, []string{"g2", "g1"}); err != nil {
+		t.Errorf("checkFriendGroupOrder() = %v", err)
+	}
+	for _, groupIDs := range [][]string{{"g1"}, {"g1", "g1"}, {"g1", "g3"}} {
+		if err := checkFriendGroupOrder(groups, groupIDs); err == nil {
+			t.Errorf("checkFriendGroupOrder(%v) = nil", groupIDs)
+		}
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/relationext"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient/notification"
 	"github.com/openimsdk/protocol/constant"
@@ -253,3 +254,9 @@ func (f *FriendNotificationSender) FriendInfoUpdatedNotification(ctx context.Con
 	tips := sdkws.UserInfoUpdatedTips{UserID: changedUserID}
 	f.Notification(ctx, mcontext.GetOpUserID(ctx), needNotifiedUserID, constant.FriendInfoUpdatedNotification, &tips)
 }
+
+func (f *FriendNotificationSender) FriendGroupsChangedNotification(ctx context.Context, ownerUserID string) {
+	tips := relationext.FriendGroupsChangedTips{UserID: ownerUserID}
+	f.setVersion(ctx, &tips.FriendVersion, &tips.FriendVersionID, database.FriendVersionName, ownerUserID)
+	f.Notification(ctx, ownerUserID, ownerUserID, relationext.FriendGroupsChangedNotification, &tips)
+}
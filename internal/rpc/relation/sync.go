@@ -80,6 +80,10 @@ func (s *friendServer) GetIncrementalFriends(ctx context.Context, req *relation.
 					sortVersion = uint64(elem.Version)
 					return true
 				}
+				if _, ok := model.FriendGroupVersionGroupID(elem.EID); ok {
+					vl.LogLen--
+					return true
+				}
 				return false
 			})
 			return vl, nil
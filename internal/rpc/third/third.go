@@ -16,24 +16,19 @@ package third
 
 import (
 	"context"
-	"fmt"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/redis"
-	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database/mgo"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/dbbuild"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/objectstore"
 	"github.com/openimsdk/open-im-server/v3/pkg/localcache"
 	"time"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/protocol/third"
-	"github.com/openimsdk/tools/db/mongoutil"
 	"github.com/openimsdk/tools/db/redisutil"
 	"github.com/openimsdk/tools/discovery"
-	"github.com/openimsdk/tools/s3"
-	"github.com/openimsdk/tools/s3/cos"
-	"github.com/openimsdk/tools/s3/kodo"
 	"github.com/openimsdk/tools/s3/minio"
-	"github.com/openimsdk/tools/s3/oss"
 	"google.golang.org/grpc"
 )
 
@@ -50,6 +45,7 @@ type Config struct {
 	RpcConfig          config.Third
 	RedisConfig        config.Redis
 	MongodbConfig      config.Mongo
+	DatabaseConfig     config.Database
 	NotificationConfig config.Notification
 	Share              config.Share
 	MinioConfig        config.Minio
@@ -58,7 +54,7 @@ type Config struct {
 }
 
 func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryRegistry, server *grpc.Server) error {
-	mgocli, err := mongoutil.NewMongoDB(ctx, config.MongodbConfig.Build())
+	dbb, err := dbbuild.NewBuilder(ctx, &config.DatabaseConfig, &config.MongodbConfig)
 	if err != nil {
 		return err
 	}
@@ -66,33 +62,16 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	if err != nil {
 		return err
 	}
-	logdb, err := mgo.NewLogMongo(mgocli.GetDB())
+	logdb, err := dbb.Log()
 	if err != nil {
 		return err
 	}
-	s3db, err := mgo.NewS3Mongo(mgocli.GetDB())
+	s3db, err := dbb.Object()
 	if err != nil {
 		return err
 	}
 	// Select the oss method according to the profile policy
-	enable := config.RpcConfig.Object.Enable
-	var (
-		o        s3.Interface
-		minioCli *minio.Minio
-	)
-	switch enable {
-	case "minio":
-		minioCli, err = minio.NewMinio(ctx, redis.NewMinioCache(rdb), *config.MinioConfig.Build())
-		o = minioCli
-	case "cos":
-		o, err = cos.NewCos(*config.RpcConfig.Object.Cos.Build())
-	case "oss":
-		o, err = oss.NewOSS(*config.RpcConfig.Object.Oss.Build())
-	case "kodo":
-		o, err = kodo.NewKodo(*config.RpcConfig.Object.Kodo.Build())
-	default:
-		err = fmt.Errorf("invalid object enable: %s", enable)
-	}
+	o, minioCli, err := objectstore.New(ctx, &config.RpcConfig, config.MinioConfig.Build(), rdb)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,66 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package user
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/userext"
+	pbauth "github.com/openimsdk/protocol/auth"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+)
+
+func (s *userServer) SetUserDisabled(ctx context.Context, req *userext.SetUserDisabledReq) (*userext.SetUserDisabledResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if authverify.IsManagerUserID(ctx, req.UserID, s.config.Share.IMAdminUserID) {
+		return nil, errs.ErrNoPermission.WrapMsg("app admin can not be disabled")
+	}
+	if _, err := s.db.FindWithError(ctx, []string{req.UserID}); err != nil {
+		return nil, err
+	}
+	// The flag is set first, so a failure below is retried by disabling the user again.
+	if err := s.db.UpdateByMap(ctx, req.UserID, map[string]any{"disabled": req.Disabled}); err != nil {
+		return nil, err
+	}
+	if !req.Disabled {
+		return &userext.SetUserDisabledResp{}, nil
+	}
+	for platformID := range constant.PlatformID2Name {
+		logout := &pbauth.ForceLogoutReq{UserID: req.UserID, PlatformID: int32(platformID)}
+		if _, err := s.authClient.Client.ForceLogout(ctx, logout); err != nil {
+			return nil, err
+		}
+	}
+	resp, err := s.groupRpcClient.ExtClient.HandOverOwnedGroups(ctx, &groupext.HandOverOwnedGroupsReq{UserID: req.UserID})
+	if err != nil {
+		return nil, err
+	}
+	log.ZInfo(ctx, "disabled user groups handed over", "userID", req.UserID, "transferred", resp.Transferred, "dismissed", resp.Dismissed)
+	return &userext.SetUserDisabledResp{Transferred: resp.Transferred, Dismissed: resp.Dismissed}, nil
+}
+
+func (s *userServer) GetUserDisabled(ctx context.Context, req *userext.GetUserDisabledReq) (*userext.GetUserDisabledResp, error) {
+	users, err := s.db.FindWithError(ctx, []string{req.UserID})
+	if err != nil {
+		return nil, err
+	}
+	return &userext.GetUserDisabledResp{Disabled: users[0].Disabled}, nil
+}
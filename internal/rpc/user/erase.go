@@ -0,0 +1,461 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package user
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/userext"
+	pbauth "github.com/openimsdk/protocol/auth"
+	"github.com/openimsdk/protocol/constant"
+	pbgroup "github.com/openimsdk/protocol/group"
+	pbmsg "github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/protocol/relation"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// What happens to the messages an erased user sent.
+const (
+	eraseMsgAnonymize = "anonymize"
+	eraseMsgErase     = "erase"
+)
+
+// The steps of a deletion in the order they run. The user is signed out first and their
+// record goes last, so a deletion that stops halfway can be found and resumed by user ID.
+const (
+	eraseTokens         = "tokens"
+	eraseFriends        = "friends"
+	eraseFriendGroups   = "friend_groups"
+	eraseFriendRequests = "friend_requests"
+	eraseBlacks         = "blacks"
+	eraseGroups         = "groups"
+	eraseGroupRequests  = "group_requests"
+	eraseMessages       = "messages"
+	eraseConversations  = "conversations"
+	eraseObjects        = "objects"
+	eraseLogs           = "logs"
+	eraseProfile        = "profile"
+)
+
+func (j *userJobs) eraseKind() (*jobKind, error) {
+	conf := j.config.RpcConfig.Erase
+	switch conf.MsgPolicy {
+	case eraseMsgErase:
+	case eraseMsgAnonymize:
+		if conf.AnonymousUserID == "" {
+			return nil, errs.New("erase.anonymousUserID is required by the anonymize msgPolicy").Wrap()
+		}
+	default:
+		return nil, errs.New("unknown erase.msgPolicy", "msgPolicy", conf.MsgPolicy).Wrap()
+	}
+	return &jobKind{
+		steps: []jobStep{
+			{name: eraseTokens, run: j.eraseTokens},
+			{name: eraseFriends, run: j.eraseFriends},
+			{name: eraseFriendGroups, run: j.eraseFriendGroups},
+			{name: eraseFriendRequests, run: j.eraseFriendRequests},
+			{name: eraseBlacks, run: j.eraseBlacks},
+			{name: eraseGroups, run: j.eraseGroups},
+			{name: eraseGroupRequests, run: j.eraseGroupRequests},
+			{name: eraseMessages, run: j.eraseMessages},
+			{name: eraseConversations, run: j.eraseConversations},
+			{name: eraseObjects, run: j.eraseObjects},
+			{name: eraseLogs, run: j.eraseLogs},
+			{name: eraseProfile, run: j.eraseProfile},
+		},
+	}, nil
+}
+
+func (s *userServer) DeleteUser(ctx context.Context, req *userext.DeleteUserReq) (*userext.DeleteUserResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if authverify.IsManagerUserID(ctx, req.UserID, s.config.Share.IMAdminUserID) {
+		return nil, errs.ErrNoPermission.WrapMsg("app admin can not be deleted")
+	}
+	jobID, err := s.startJob(ctx, model.UserJobErase, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &userext.DeleteUserResp{JobID: jobID}, nil
+}
+
+func (s *userServer) GetUserDeletion(ctx context.Context, req *userext.GetUserDeletionReq) (*userext.GetUserDeletionResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	job, err := s.takeJob(ctx, model.UserJobErase, req.JobID)
+	if err != nil {
+		return nil, err
+	}
+	return &userext.GetUserDeletionResp{Job: userJobDB2Pb(job)}, nil
+}
+
+// eraseContext calls the other services as the admin who started the deletion, which
+// also covers deletions resumed at startup.
+func eraseContext(ctx context.Context, job *model.UserJob) context.Context {
+	return mcontext.WithOpUserIDContext(ctx, job.OperatorUserID)
+}
+
+func (j *userJobs) eraseBatchSize() int32 {
+	if size := j.config.RpcConfig.Erase.BatchSize; size > 0 {
+		return int32(size)
+	}
+	return 500
+}
+
+// drain handles the first page of records until none is left. Handling a record has to
+// remove it from the results, a page repeating a record of the previous one means the
+// step makes no progress and fails instead of looping.
+func drain[T any](ctx context.Context, j *userJobs, job *model.UserJob, step *model.UserJobStep,
+	fetch func(page *sdkws.RequestPagination) ([]T, error), key func(T) string, handle func(records []T) error) error {
+	page := &sdkws.RequestPagination{PageNumber: 1, ShowNumber: j.eraseBatchSize()}
+	var last map[string]struct{}
+	for {
+		records, err := fetch(page)
+		if err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			return nil
+		}
+		keys := make(map[string]struct{}, len(records))
+		for _, record := range records {
+			k := key(record)
+			if _, ok := last[k]; ok {
+				return errs.New("user deletion makes no progress", "step", step.Name, "record", k).Wrap()
+			}
+			keys[k] = struct{}{}
+		}
+		if err := handle(records); err != nil {
+			return err
+		}
+		last = keys
+		step.Count += int64(len(records))
+		step.UpdateTime = time.Now()
+		if err := j.jobs.Save(ctx, job); err != nil {
+			return err
+		}
+	}
+}
+
+// eraseTokens kicks the user off every platform, which also marks their tokens as kicked.
+func (j *userJobs) eraseTokens(ctx context.Context, job *model.UserJob, step *model.UserJobStep) error {
+	ctx = eraseContext(ctx, job)
+	step.Count = 0
+	for platformID := range constant.PlatformID2Name {
+		req := &pbauth.ForceLogoutReq{UserID: job.UserID, PlatformID: int32(platformID)}
+		if _, err := j.authClient.Client.ForceLogout(ctx, req); err != nil {
+			return err
+		}
+		step.Count++
+	}
+	return nil
+}
+
+// eraseFriends removes the user's friends and the user from the friend lists of others,
+// through the friend service so both sides are notified.
+func (j *userJobs) eraseFriends(ctx context.Context, job *model.UserJob, step *model.UserJobStep) error {
+	ctx = eraseContext(ctx, job)
+	err := drain(ctx, j, job, step, func(page *sdkws.RequestPagination) ([]*model.Friend, error) {
+		_, friends, err := j.friend.PageOwnerFriends(ctx, job.UserID, page)
+		return friends, err
+	}, func(f *model.Friend) string {
+		return f.FriendUserID
+	}, func(friends []*model.Friend) error {
+		for _, f := range friends {
+			req := &relation.DeleteFriendReq{OwnerUserID: job.UserID, FriendUserID: f.FriendUserID}
+			if _, err := j.friendClient.Client.DeleteFriend(ctx, req); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return drain(ctx, j, job, step, func(page *sdkws.RequestPagination) ([]*model.Friend, error) {
+		_, friends, err := j.friend.PageInWhoseFriends(ctx, job.UserID, page)
+		return friends, err
+	}, func(f *model.Friend) string {
+		return f.OwnerUserID
+	}, func(friends []*model.Friend) error {
+		for _, f := range friends {
+			req := &relation.DeleteFriendReq{OwnerUserID: f.OwnerUserID, FriendUserID: job.UserID}
+			if _, err := j.friendClient.Client.DeleteFriend(ctx, req); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// eraseFriendGroups deletes the user's friend groups, emptied along with the friend list.
+func (j *userJobs) eraseFriendGroups(ctx context.Context, job *model.UserJob, step *model.UserJobStep) error {
+	groups, err := j.friendGroup.FindFriendGroups(ctx, job.UserID, nil)
+	if err != nil {
+		return err
+	}
+	for _, group := range groups {
+		if err := j.friendGroup.DeleteFriendGroup(ctx, job.UserID, group.GroupID); err != nil {
+			return err
+		}
+		step.Count++
+	}
+	return nil
+}
+
+func (j *userJobs) eraseFriendRequests(ctx context.Context, job *model.UserJob, step *model.UserJobStep) error {
+	key := func(r *model.FriendRequest) string { return r.FromUserID + ">" + r.ToUserID }
+	handle := func(requests []*model.FriendRequest) error {
+		for _, r := range requests {
+			if err := j.friend.DeleteFriendRequest(ctx, r.FromUserID, r.ToUserID); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	err := drain(ctx, j, job, step, func(page *sdkws.RequestPagination) ([]*model.FriendRequest, error) {
+		_, requests, err := j.friend.PageFriendRequestFromMe(ctx, job.UserID, page)
+		return requests, err
+	}, key, handle)
+	if err != nil {
+		return err
+	}
+	return drain(ctx, j, job, step, func(page *sdkws.RequestPagination) ([]*model.FriendRequest, error) {
+		_, requests, err := j.friend.PageFriendRequestToMe(ctx, job.UserID, page)
+		return requests, err
+	}, key, handle)
+}
+
+// eraseBlacks removes the user's blacklist and the user from the blacklists of others.
+func (j *userJobs) eraseBlacks(ctx context.Context, job *model.UserJob, step *model.UserJobStep) error {
+	ctx = eraseContext(ctx, job)
+	key := func(b *model.Black) string { return b.OwnerUserID + ">" + b.BlockUserID }
+	handle := func(blacks []*model.Black) error {
+		for _, b := range blacks {
+			req := &relation.RemoveBlackReq{OwnerUserID: b.OwnerUserID, BlackUserID: b.BlockUserID}
+			if _, err := j.friendClient.Client.RemoveBlack(ctx, req); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	err := drain(ctx, j, job, step, func(page *sdkws.RequestPagination) ([]*model.Black, error) {
+		_, blacks, err := j.black.FindOwnerBlacks(ctx, job.UserID, page)
+		return blacks, err
+	}, key, handle)
+	if err != nil {
+		return err
+	}
+	return drain(ctx, j, job, step, func(page *sdkws.RequestPagination) ([]*model.Black, error) {
+		_, blacks, err := j.black.FindByBlockUser(ctx, job.UserID, page)
+		return blacks, err
+	}, key, handle)
+}
+
+// eraseGroups takes the user out of every group through the group service.
+func (j *userJobs) eraseGroups(ctx context.Context, job *model.UserJob, step *model.UserJobStep) error {
+	ctx = eraseContext(ctx, job)
+	return drain(ctx, j, job, step, func(page *sdkws.RequestPagination) ([]*model.GroupMember, error) {
+		_, members, err := j.group.PageGetJoinGroup(ctx, job.UserID, page)
+		return members, err
+	}, func(m *model.GroupMember) string {
+		return m.GroupID
+	}, func(members []*model.GroupMember) error {
+		for _, m := range members {
+			if err := j.leaveGroup(ctx, job, m); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// leaveGroup removes the user from the group. A group the user owns is handed over by its
+// succession policy, and is dismissed when nobody is left or the policy says so.
+func (j *userJobs) leaveGroup(ctx context.Context, job *model.UserJob, member *model.GroupMember) error {
+	var group *model.Group
+	if member.RoleLevel == constant.GroupOwner {
+		var err error
+		group, err = j.group.TakeGroup(ctx, member.GroupID)
+		if err != nil {
+			return err
+		}
+		if group.Status == constant.GroupStatusDismissed {
+			return j.dismissGroup(ctx, job, group)
+		}
+	}
+	req := &groupext.QuitGroupWithSuccessionReq{GroupID: member.GroupID, UserID: job.UserID}
+	resp, err := j.groupClient.ExtClient.QuitGroupWithSuccession(ctx, req)
+	if err != nil {
+		return err
+	}
+	if resp.Dismissed {
+		group.Status = constant.GroupStatusDismissed
+		return j.dismissGroup(ctx, job, group)
+	}
+	if resp.NewOwnerUserID != "" {
+		log.ZInfo(ctx, "user deletion transferred group", "jobID", job.JobID, "userID", job.UserID,
+			"groupID", member.GroupID, "newOwnerUserID", resp.NewOwnerUserID)
+	}
+	return nil
+}
+
+// dismissGroup dismisses the group, notifying its members unless that was done already,
+// and removes the members right away instead of after the notification is pushed.
+func (j *userJobs) dismissGroup(ctx context.Context, job *model.UserJob, group *model.Group) error {
+	if group.Status != constant.GroupStatusDismissed {
+		if _, err := j.groupClient.Client.DismissGroup(ctx, &pbgroup.DismissGroupReq{GroupID: group.GroupID}); err != nil {
+			return err
+		}
+	}
+	if _, err := j.groupClient.Client.DismissGroup(ctx, &pbgroup.DismissGroupReq{GroupID: group.GroupID, DeleteMember: true}); err != nil {
+		return err
+	}
+	log.ZInfo(ctx, "user deletion dismissed group", "jobID", job.JobID, "userID", job.UserID, "groupID", group.GroupID)
+	return nil
+}
+
+func (j *userJobs) eraseGroupRequests(ctx context.Context, job *model.UserJob, step *model.UserJobStep) error {
+	return drain(ctx, j, job, step, func(page *sdkws.RequestPagination) ([]*model.GroupRequest, error) {
+		_, requests, err := j.group.PageGroupRequestUser(ctx, job.UserID, page)
+		return requests, err
+	}, func(r *model.GroupRequest) string {
+		return r.GroupID
+	}, func(requests []*model.GroupRequest) error {
+		for _, r := range requests {
+			if err := j.group.DeleteGroupRequest(ctx, r.GroupID, r.UserID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// eraseMessages anonymizes or deletes the messages the user sent, by the configured policy.
+// Either way they no longer match a search by the user as sender.
+func (j *userJobs) eraseMessages(ctx context.Context, job *model.UserJob, step *model.UserJobStep) error {
+	conf := j.config.RpcConfig.Erase
+	return drain(ctx, j, job, step, func(page *sdkws.RequestPagination) ([]*sdkws.MsgData, error) {
+		_, msgs, err := j.msg.SearchMessage(ctx, &pbmsg.SearchMessageReq{SendID: job.UserID, Pagination: page})
+		return msgs, err
+	}, func(msg *sdkws.MsgData) string {
+		return msgprocessor.GetConversationIDByMsg(msg) + ":" + strconv.FormatInt(msg.Seq, 10)
+	}, func(msgs []*sdkws.MsgData) error {
+		seqs := make(map[string][]int64)
+		for _, msg := range msgs {
+			conversationID := msgprocessor.GetConversationIDByMsg(msg)
+			seqs[conversationID] = append(seqs[conversationID], msg.Seq)
+		}
+		for conversationID, conversationSeqs := range seqs {
+			var err error
+			if conf.MsgPolicy == eraseMsgAnonymize {
+				err = j.msg.AnonymizeMsgsSender(ctx, conversationID, conversationSeqs, job.UserID, conf.AnonymousUserID)
+			} else {
+				err = j.msg.DeleteMsgsPhysicalBySeqs(ctx, conversationID, conversationSeqs)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// eraseConversations removes the user's conversations and their seqs in them.
+func (j *userJobs) eraseConversations(ctx context.Context, job *model.UserJob, step *model.UserJobStep) error {
+	conversationIDs, err := j.conversation.GetConversationIDs(ctx, job.UserID)
+	if err != nil {
+		return err
+	}
+	if err := j.msg.DeleteUserSeqs(ctx, job.UserID, conversationIDs); err != nil {
+		return err
+	}
+	if err := j.conversation.DeleteUserConversations(ctx, job.UserID); err != nil {
+		return err
+	}
+	step.Count = int64(len(conversationIDs))
+	return nil
+}
+
+// eraseObjects removes the records of the objects the user uploaded, and the stored objects
+// that no other record refers to.
+func (j *userJobs) eraseObjects(ctx context.Context, job *model.UserJob, step *model.UserJobStep) error {
+	return drain(ctx, j, job, step, func(page *sdkws.RequestPagination) ([]*model.Object, error) {
+		_, objects, err := j.s3.FindByUser(ctx, job.UserID, page)
+		return objects, err
+	}, func(obj *model.Object) string {
+		return obj.Engine + ":" + obj.Name
+	}, func(objects []*model.Object) error {
+		for _, obj := range objects {
+			if err := j.eraseObject(ctx, obj); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (j *userJobs) eraseObject(ctx context.Context, obj *model.Object) error {
+	// The record goes last, so a run cut short finds the object again.
+	refs, err := j.s3.FindNotDelByS3(ctx, obj.Key, time.Time{})
+	if err != nil {
+		return err
+	}
+	if refs <= 1 {
+		if obj.Engine == j.store.Engine() {
+			if err := j.s3.DeleteObject(ctx, obj.Key); err != nil && !j.store.IsNotFound(err) {
+				return err
+			}
+			if err := j.s3.DelS3Key(ctx, obj.Engine, obj.Key); err != nil {
+				return err
+			}
+		} else {
+			log.ZWarn(ctx, "user deletion keeps object of another engine", nil, "name", obj.Name, "engine", obj.Engine)
+		}
+	}
+	return j.s3.DeleteSpecifiedData(ctx, obj.Engine, obj.Name)
+}
+
+func (j *userJobs) eraseLogs(ctx context.Context, job *model.UserJob, step *model.UserJobStep) error {
+	return drain(ctx, j, job, step, func(page *sdkws.RequestPagination) ([]*model.Log, error) {
+		_, logs, err := j.third.SearchLogs(ctx, userLogKeyword(job.UserID), time.Unix(0, 0), time.Now(), page)
+		return logs, err
+	}, func(l *model.Log) string {
+		return l.LogID
+	}, func(logs []*model.Log) error {
+		return j.third.DeleteLogs(ctx, datautil.Slice(logs, func(l *model.Log) string { return l.LogID }), job.UserID)
+	})
+}
+
+// eraseProfile removes the user record and commands, after which the user no longer exists.
+func (j *userJobs) eraseProfile(ctx context.Context, job *model.UserJob, step *model.UserJobStep) error {
+	if err := j.user.DeleteUser(ctx, job.UserID); err != nil {
+		return err
+	}
+	step.Count = 1
+	return nil
+}
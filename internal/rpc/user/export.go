@@ -0,0 +1,549 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package user
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/objectstore"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/userext"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/s3"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+const (
+	exportKeyPrefix   = "openim/user_export/"
+	exportObjectGroup = "user_export"
+	exportPartExpire  = time.Hour
+	// exportMsgSeqWindow is how many seqs of a conversation are read at once, and
+	// exportMsgSeqScan how many the messages step reads before it saves its cursor.
+	exportMsgSeqWindow = 1000
+	exportMsgSeqScan   = exportMsgSeqWindow * 10
+)
+
+// The steps of an export in the order they run. Every step but the last uploads the
+// records it reads as JSON-lines parts, which exportPackage joins into the zip together
+// with the objects the user uploaded.
+const (
+	exportProfile            = "profile"
+	exportCommands           = "commands"
+	exportFriends            = "friends"
+	exportFriendRequestsSent = "friend_requests_sent"
+	exportFriendRequestsRecv = "friend_requests_received"
+	exportBlacks             = "blacks"
+	exportGroups             = "groups"
+	exportGroupRequests      = "group_requests"
+	exportConversations      = "conversations"
+	exportMessages           = "messages"
+	exportObjects            = "objects"
+	exportLogs               = "logs"
+	exportPackage            = "package"
+)
+
+type exportStep struct {
+	name string
+	// paged steps are read a page at a time until a short page, the others in one go.
+	paged bool
+	fetch func(ctx context.Context, userID string, page *sdkws.RequestPagination) ([]any, error)
+	// scan replaces fetch for steps read from a stable position instead of by page: it
+	// returns up to about limit records after the position and the position to continue
+	// from, empty once everything was read.
+	scan func(ctx context.Context, userID string, after string, limit int) ([]any, string, error)
+}
+
+// exportGroup is a group the user is in, with their membership.
+type exportGroup struct {
+	Group  *model.Group       `json:"group"`
+	Member *model.GroupMember `json:"member"`
+}
+
+// exportMsg writes the content of a message as text instead of base64.
+type exportMsg struct {
+	*sdkws.MsgData
+	Content string `json:"content"`
+}
+
+func (j *userJobs) exportKind() *jobKind {
+	steps := []exportStep{
+		{name: exportProfile, fetch: j.fetchProfile},
+		{name: exportCommands, fetch: j.fetchCommands},
+		{name: exportFriends, paged: true, fetch: j.fetchFriends},
+		{name: exportFriendRequestsSent, paged: true, fetch: j.fetchFriendRequestsSent},
+		{name: exportFriendRequestsRecv, paged: true, fetch: j.fetchFriendRequestsReceived},
+		{name: exportBlacks, paged: true, fetch: j.fetchBlacks},
+		{name: exportGroups, paged: true, fetch: j.fetchGroups},
+		{name: exportGroupRequests, paged: true, fetch: j.fetchGroupRequests},
+		{name: exportConversations, fetch: j.fetchConversations},
+		{name: exportMessages, scan: j.scanMessages},
+		{name: exportObjects, paged: true, fetch: j.fetchObjects},
+		{name: exportLogs, paged: true, fetch: j.fetchLogs},
+	}
+	kind := &jobKind{done: j.deleteParts}
+	for i := range steps {
+		es := &steps[i]
+		kind.steps = append(kind.steps, jobStep{
+			name: es.name,
+			run: func(ctx context.Context, job *model.UserJob, step *model.UserJobStep) error {
+				return j.export(ctx, job, step, es)
+			},
+		})
+	}
+	kind.steps = append(kind.steps, jobStep{name: exportPackage, run: j.pack})
+	return kind
+}
+
+func (s *userServer) ExportUserData(ctx context.Context, req *userext.ExportUserDataReq) (*userext.ExportUserDataResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	jobID, err := s.startJob(ctx, model.UserJobExport, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &userext.ExportUserDataResp{JobID: jobID}, nil
+}
+
+func (s *userServer) GetUserExport(ctx context.Context, req *userext.GetUserExportReq) (*userext.GetUserExportResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	job, err := s.takeJob(ctx, model.UserJobExport, req.JobID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &userext.GetUserExportResp{Job: userJobDB2Pb(job)}
+	if job.Status == model.UserJobSucceeded {
+		expire := time.Duration(s.config.RpcConfig.Export.URLExpire) * time.Second
+		if expire <= 0 {
+			expire = time.Hour * 24
+		}
+		opt := &s3.AccessURLOption{Filename: job.UserID + ".zip"}
+		expireTime, rawURL, err := s.jobs.s3.AccessURL(ctx, job.Result, expire, opt)
+		if err != nil {
+			return nil, err
+		}
+		resp.AccessURL = rawURL
+		resp.ExpireTime = expireTime.UnixMilli()
+	}
+	return resp, nil
+}
+
+func (j *userJobs) pageSize() int32 {
+	if size := j.config.RpcConfig.Export.PageSize; size > 0 {
+		return int32(size)
+	}
+	return 1000
+}
+
+// stepCursor splits the cursor of a step into the next page and, for scanned steps, the
+// position after the "|" to continue from.
+func stepCursor(step *model.UserJobStep) (int32, string) {
+	if step.Cursor == "" {
+		return 1, ""
+	}
+	page, after, _ := strings.Cut(step.Cursor, "|")
+	n, _ := strconv.Atoi(page)
+	return int32(n), after
+}
+
+// partCount returns how many parts the step has uploaded.
+func partCount(step *model.UserJobStep) int32 {
+	page, _ := stepCursor(step)
+	return page - 1
+}
+
+func partKey(jobID string, step string, page int32) string {
+	return fmt.Sprintf("%s%s/%s/%d.jsonl", exportKeyPrefix, jobID, step, page)
+}
+
+// export uploads the records of a step page by page, saving the cursor after each page.
+func (j *userJobs) export(ctx context.Context, job *model.UserJob, step *model.UserJobStep, es *exportStep) error {
+	if es.scan != nil {
+		return j.exportScan(ctx, job, step, es)
+	}
+	size := j.pageSize()
+	for page := partCount(step) + 1; ; page++ {
+		records, err := es.fetch(ctx, job.UserID, &sdkws.RequestPagination{PageNumber: page, ShowNumber: size})
+		if err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			return nil
+		}
+		if err := j.putPart(ctx, job, step, page, records); err != nil {
+			return err
+		}
+		step.Cursor = strconv.Itoa(int(page + 1))
+		step.Count += int64(len(records))
+		step.UpdateTime = time.Now()
+		if err := j.jobs.Save(ctx, job); err != nil {
+			return err
+		}
+		if !es.paged || len(records) < int(size) {
+			return nil
+		}
+	}
+}
+
+// exportScan uploads the records of a scanned step, saving the next page and the position
+// after each call so a resumed job neither repeats nor skips records.
+func (j *userJobs) exportScan(ctx context.Context, job *model.UserJob, step *model.UserJobStep, es *exportStep) error {
+	page, after := stepCursor(step)
+	for {
+		records, next, err := es.scan(ctx, job.UserID, after, int(j.pageSize()))
+		if err != nil {
+			return err
+		}
+		if len(records) > 0 {
+			if err := j.putPart(ctx, job, step, page, records); err != nil {
+				return err
+			}
+			page++
+			step.Count += int64(len(records))
+		}
+		if next == "" {
+			step.Cursor = strconv.Itoa(int(page))
+		} else {
+			step.Cursor = strconv.Itoa(int(page)) + "|" + next
+		}
+		step.UpdateTime = time.Now()
+		if err := j.jobs.Save(ctx, job); err != nil {
+			return err
+		}
+		if next == "" {
+			return nil
+		}
+		after = next
+	}
+}
+
+// putPart uploads records as the page-th JSON-lines part of the step.
+func (j *userJobs) putPart(ctx context.Context, job *model.UserJob, step *model.UserJobStep, page int32, records []any) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	for _, record := range records {
+		if err := enc.Encode(record); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	key := partKey(job.JobID, step.Name, page)
+	return objectstore.PutObject(ctx, j.client, j.store, key, &buf, int64(buf.Len()), exportPartExpire)
+}
+
+// pack joins the parts of every step and the user's objects into the zip, which is then
+// registered under its key so its download URL can be signed.
+func (j *userJobs) pack(ctx context.Context, job *model.UserJob, step *model.UserJobStep) error {
+	f, err := os.CreateTemp("", "openim-user-export-*.zip")
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer func() {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+	}()
+	zw := zip.NewWriter(f)
+	for i := range job.Steps {
+		if job.Steps[i].Name == exportPackage {
+			continue
+		}
+		if err := j.packParts(ctx, zw, job.JobID, &job.Steps[i]); err != nil {
+			return err
+		}
+	}
+	var attachments int64
+	size := j.pageSize()
+	for page := int32(1); ; page++ {
+		_, objects, err := j.s3.FindByUser(ctx, job.UserID, &sdkws.RequestPagination{PageNumber: page, ShowNumber: size})
+		if err != nil {
+			return err
+		}
+		for _, obj := range objects {
+			if obj.Group == exportObjectGroup {
+				continue
+			}
+			ok, err := j.packObject(ctx, zw, obj)
+			if err != nil {
+				return err
+			}
+			if ok {
+				attachments++
+			}
+		}
+		if len(objects) < int(size) {
+			break
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return errs.Wrap(err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return errs.Wrap(err)
+	}
+	name := exportKeyPrefix + job.JobID + ".zip"
+	if err := objectstore.PutObject(ctx, j.client, j.store, name, f, info.Size(), exportPartExpire); err != nil {
+		return err
+	}
+	err = j.s3.SetObject(ctx, &model.Object{
+		Name:        name,
+		UserID:      job.UserID,
+		Key:         name,
+		Size:        info.Size(),
+		ContentType: "application/zip",
+		Group:       exportObjectGroup,
+		CreateTime:  time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+	job.Result = name
+	step.Count = attachments
+	return nil
+}
+
+func (j *userJobs) packParts(ctx context.Context, zw *zip.Writer, jobID string, step *model.UserJobStep) error {
+	w, err := zw.Create(step.Name + ".jsonl")
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	for page := int32(1); page <= partCount(step); page++ {
+		if err := j.copyObject(ctx, w, partKey(jobID, step.Name, page)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// packObject adds an uploaded object under attachments/, skipping the ones no longer stored.
+func (j *userJobs) packObject(ctx context.Context, zw *zip.Writer, obj *model.Object) (bool, error) {
+	if obj.Engine != j.store.Engine() {
+		log.ZWarn(ctx, "user export skips object of another engine", nil, "name", obj.Name, "engine", obj.Engine)
+		return false, nil
+	}
+	if _, err := j.store.StatObject(ctx, obj.Key); err != nil {
+		if j.store.IsNotFound(err) {
+			log.ZWarn(ctx, "user export skips missing object", nil, "name", obj.Name, "key", obj.Key)
+			return false, nil
+		}
+		return false, err
+	}
+	w, err := zw.Create("attachments/" + obj.Name)
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+	if err := j.copyObject(ctx, w, obj.Key); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (j *userJobs) copyObject(ctx context.Context, w io.Writer, key string) error {
+	rawURL, err := j.store.AccessURL(ctx, key, exportPartExpire, &s3.AccessURLOption{})
+	if err != nil {
+		return err
+	}
+	body, err := objectstore.OpenURL(ctx, j.client, rawURL)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+	if _, err := io.Copy(w, body); err != nil {
+		return errs.WrapMsg(err, "download object failed", "key", key)
+	}
+	return nil
+}
+
+func (j *userJobs) deleteParts(ctx context.Context, job *model.UserJob) {
+	for i := range job.Steps {
+		for page := int32(1); page <= partCount(&job.Steps[i]); page++ {
+			key := partKey(job.JobID, job.Steps[i].Name, page)
+			if err := j.store.DeleteObject(ctx, key); err != nil && !j.store.IsNotFound(err) {
+				log.ZWarn(ctx, "delete user export part failed", err, "key", key)
+			}
+		}
+	}
+}
+
+func anySlice[T any](vs []T) []any {
+	return datautil.Slice(vs, func(v T) any { return v })
+}
+
+func (j *userJobs) fetchProfile(ctx context.Context, userID string, _ *sdkws.RequestPagination) ([]any, error) {
+	users, err := j.user.FindWithError(ctx, []string{userID})
+	if err != nil {
+		return nil, err
+	}
+	return anySlice(users), nil
+}
+
+func (j *userJobs) fetchCommands(ctx context.Context, userID string, _ *sdkws.RequestPagination) ([]any, error) {
+	commands, err := j.user.GetAllUserCommands(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return anySlice(commands), nil
+}
+
+func (j *userJobs) fetchFriends(ctx context.Context, userID string, page *sdkws.RequestPagination) ([]any, error) {
+	_, friends, err := j.friend.PageOwnerFriends(ctx, userID, page)
+	if err != nil {
+		return nil, err
+	}
+	return anySlice(friends), nil
+}
+
+func (j *userJobs) fetchFriendRequestsSent(ctx context.Context, userID string, page *sdkws.RequestPagination) ([]any, error) {
+	_, requests, err := j.friend.PageFriendRequestFromMe(ctx, userID, page)
+	if err != nil {
+		return nil, err
+	}
+	return anySlice(requests), nil
+}
+
+func (j *userJobs) fetchFriendRequestsReceived(ctx context.Context, userID string, page *sdkws.RequestPagination) ([]any, error) {
+	_, requests, err := j.friend.PageFriendRequestToMe(ctx, userID, page)
+	if err != nil {
+		return nil, err
+	}
+	return anySlice(requests), nil
+}
+
+func (j *userJobs) fetchBlacks(ctx context.Context, userID string, page *sdkws.RequestPagination) ([]any, error) {
+	_, blacks, err := j.black.FindOwnerBlacks(ctx, userID, page)
+	if err != nil {
+		return nil, err
+	}
+	return anySlice(blacks), nil
+}
+
+func (j *userJobs) fetchGroups(ctx context.Context, userID string, page *sdkws.RequestPagination) ([]any, error) {
+	_, members, err := j.group.PageGetJoinGroup(ctx, userID, page)
+	if err != nil || len(members) == 0 {
+		return nil, err
+	}
+	groups, err := j.group.FindGroup(ctx, datautil.Slice(members, func(m *model.GroupMember) string { return m.GroupID }))
+	if err != nil {
+		return nil, err
+	}
+	groupMap := datautil.SliceToMap(groups, func(g *model.Group) string { return g.GroupID })
+	return datautil.Slice(members, func(m *model.GroupMember) any {
+		return &exportGroup{Group: groupMap[m.GroupID], Member: m}
+	}), nil
+}
+
+func (j *userJobs) fetchGroupRequests(ctx context.Context, userID string, page *sdkws.RequestPagination) ([]any, error) {
+	_, requests, err := j.group.PageGroupRequestUser(ctx, userID, page)
+	if err != nil {
+		return nil, err
+	}
+	return anySlice(requests), nil
+}
+
+func (j *userJobs) fetchConversations(ctx context.Context, userID string, _ *sdkws.RequestPagination) ([]any, error) {
+	conversations, err := j.conversation.GetUserAllConversation(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return anySlice(conversations), nil
+}
+
+// scanMessages reads the messages the user sent conversation by conversation in seq order,
+// the position is the conversation and the next seq to read as "conversationID:seq".
+func (j *userJobs) scanMessages(ctx context.Context, userID string, after string, limit int) ([]any, string, error) {
+	conversations, err := j.conversation.GetUserAllConversation(ctx, userID)
+	if err != nil {
+		return nil, "", err
+	}
+	conversationIDs := datautil.Slice(conversations, func(c *model.Conversation) string { return c.ConversationID })
+	sort.Strings(conversationIDs)
+	conversationID, seq := "", int64(1)
+	if i := strings.LastIndex(after, ":"); i >= 0 {
+		conversationID = after[:i]
+		if seq, err = strconv.ParseInt(after[i+1:], 10, 64); err != nil {
+			return nil, "", errs.ErrArgs.WrapMsg("invalid export cursor", "cursor", after)
+		}
+	}
+	var (
+		records []any
+		scanned int64
+	)
+	for i := sort.SearchStrings(conversationIDs, conversationID); i < len(conversationIDs); i++ {
+		if conversationIDs[i] != conversationID {
+			conversationID, seq = conversationIDs[i], 1
+		}
+		maxSeq, err := j.msg.GetMaxSeq(ctx, conversationID)
+		if err != nil {
+			return nil, "", err
+		}
+		for seq <= maxSeq {
+			end := seq + exportMsgSeqWindow - 1
+			msgs, err := j.msg.FindSentMsgs(ctx, conversationID, userID, seq, end)
+			if err != nil {
+				return nil, "", err
+			}
+			for _, msg := range msgs {
+				records = append(records, &exportMsg{MsgData: msg, Content: string(msg.Content)})
+			}
+			seq = end + 1
+			scanned += exportMsgSeqWindow
+			if len(records) >= limit || scanned >= exportMsgSeqScan {
+				return records, conversationID + ":" + strconv.FormatInt(seq, 10), nil
+			}
+		}
+	}
+	return records, "", nil
+}
+
+func (j *userJobs) fetchObjects(ctx context.Context, userID string, page *sdkws.RequestPagination) ([]any, error) {
+	_, objects, err := j.s3.FindByUser(ctx, userID, page)
+	if err != nil {
+		return nil, err
+	}
+	return anySlice(objects), nil
+}
+
+func (j *userJobs) fetchLogs(ctx context.Context, userID string, page *sdkws.RequestPagination) ([]any, error) {
+	_, logs, err := j.third.SearchLogs(ctx, userLogKeyword(userID), time.Unix(0, 0), time.Now(), page)
+	if err != nil {
+		return nil, err
+	}
+	return anySlice(logs), nil
+}
+
+// userLogKeyword matches the logs of exactly the user, the keyword is a regular expression
+// on both backends.
+func userLogKeyword(userID string) string {
+	return "^" + regexp.QuoteMeta(userID) + "$"
+}
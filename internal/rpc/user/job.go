@@ -0,0 +1,409 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package user
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/mq"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/redis"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/dbbuild"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/objectstore"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/userext"
+	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/s3"
+	"github.com/openimsdk/tools/utils/datautil"
+	redisv9 "github.com/redis/go-redis/v9"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	userJobLockExpire  = time.Minute
+	userJobResumeLimit = 100
+)
+
+// jobStep is one stage of a user job. A step saves its progress in the job as it goes, so
+// a run cut short resumes where the step stopped.
+type jobStep struct {
+	name string
+	run  func(ctx context.Context, job *model.UserJob, step *model.UserJobStep) error
+}
+
+// jobKind is a type of user job: its steps in the order they run, and what to do once
+// they all succeeded.
+type jobKind struct {
+	steps []jobStep
+	done  func(ctx context.Context, job *model.UserJob)
+}
+
+// userJobs runs the export and deletion jobs. A job holds a Redis lock while it runs, so one
+// instance works on it at a time and another takes over once the lock expires.
+type userJobs struct {
+	config       *Config
+	owner        string
+	jobs         controller.UserJobDatabase
+	user         controller.UserDatabase
+	friend       controller.FriendDatabase
+	friendGroup  controller.FriendGroupDatabase
+	black        controller.BlackDatabase
+	group        controller.GroupDatabase
+	conversation controller.ConversationDatabase
+	msg          controller.CommonMsgDatabase
+	third        controller.ThirdDatabase
+	s3           controller.S3Database
+	store        s3.Interface
+	client       *http.Client
+	friendClient *rpcclient.FriendRpcClient
+	groupClient  *rpcclient.GroupRpcClient
+	authClient   *rpcclient.Auth
+	kinds        map[string]*jobKind
+}
+
+func newUserJobs(ctx context.Context, config *Config, dbb dbbuild.Builder, rdb redisv9.UniversalClient, user controller.UserDatabase,
+	friendClient *rpcclient.FriendRpcClient, groupClient *rpcclient.GroupRpcClient, authClient *rpcclient.Auth) (*userJobs, error) {
+	userJob, err := dbb.UserJob()
+	if err != nil {
+		return nil, err
+	}
+	friendDB, err := dbb.Friend()
+	if err != nil {
+		return nil, err
+	}
+	friendRequestDB, err := dbb.FriendRequest()
+	if err != nil {
+		return nil, err
+	}
+	friendGroupDB, err := dbb.FriendGroup()
+	if err != nil {
+		return nil, err
+	}
+	friendGroupMemberDB, err := dbb.FriendGroupMember()
+	if err != nil {
+		return nil, err
+	}
+	blackDB, err := dbb.Black()
+	if err != nil {
+		return nil, err
+	}
+	groupDB, err := dbb.Group()
+	if err != nil {
+		return nil, err
+	}
+	groupMemberDB, err := dbb.GroupMember()
+	if err != nil {
+		return nil, err
+	}
+	groupRequestDB, err := dbb.GroupRequest()
+	if err != nil {
+		return nil, err
+	}
+	conversationDB, err := dbb.Conversation()
+	if err != nil {
+		return nil, err
+	}
+	msgDocModel, err := dbb.Msg()
+	if err != nil {
+		return nil, err
+	}
+	seqConversation, err := dbb.SeqConversation()
+	if err != nil {
+		return nil, err
+	}
+	seqUser, err := dbb.SeqUser()
+	if err != nil {
+		return nil, err
+	}
+	logDB, err := dbb.Log()
+	if err != nil {
+		return nil, err
+	}
+	objectDB, err := dbb.Object()
+	if err != nil {
+		return nil, err
+	}
+	mqBuilder, err := mq.NewBuilder(&config.MQConfig, &config.KafkaConfig, rdb)
+	if err != nil {
+		return nil, err
+	}
+	msgDatabase, err := controller.NewCommonMsgDatabase(msgDocModel, redis.NewMsgCache(rdb), redis.NewSeqUserCacheRedis(rdb, seqUser),
+		redis.NewSeqConversationCacheRedis(rdb, seqConversation), &config.KafkaConfig, mqBuilder, nil)
+	if err != nil {
+		return nil, err
+	}
+	// The download URL is signed for clients, the parts and attachments are transferred by
+	// this service and need the internal address.
+	o, _, err := objectstore.New(ctx, &config.ThirdConfig, config.MinioConfig.Build(), rdb)
+	if err != nil {
+		return nil, err
+	}
+	minioConf := config.MinioConfig.Build()
+	minioConf.SignEndpoint = ""
+	store, _, err := objectstore.New(ctx, &config.ThirdConfig, minioConf, rdb)
+	if err != nil {
+		return nil, err
+	}
+	friendCache := redis.NewFriendCacheRedis(rdb, &config.LocalCacheConfig, friendDB, redis.GetRocksCacheOptions())
+	j := &userJobs{
+		config:      config,
+		owner:       primitive.NewObjectID().Hex(),
+		jobs:        controller.NewUserJobDatabase(userJob, redis.NewUserJobCacheRedis(rdb)),
+		user:        user,
+		friend:      controller.NewFriendDatabase(friendDB, friendRequestDB, friendCache, dbb.Tx()),
+		friendGroup: controller.NewFriendGroupDatabase(friendGroupDB, friendGroupMemberDB, friendDB, friendCache, dbb.Tx()),
+		black: controller.NewBlackDatabase(blackDB,
+			redis.NewBlackCacheRedis(rdb, &config.LocalCacheConfig, blackDB, redis.GetRocksCacheOptions())),
+		// Members are only read and removed through the group service, which keeps the member hash.
+		group: controller.NewGroupDatabase(rdb, &config.LocalCacheConfig, groupDB, groupMemberDB, groupRequestDB, nil, dbb.Tx(), nil),
+		conversation: controller.NewConversationDatabase(conversationDB,
+			redis.NewConversationRedis(rdb, &config.LocalCacheConfig, redis.GetRocksCacheOptions(), conversationDB), dbb.Tx()),
+		msg:          msgDatabase,
+		third:        controller.NewThirdDatabase(redis.NewThirdCache(rdb), logDB),
+		s3:           controller.NewS3Database(rdb, o, objectDB),
+		store:        store,
+		client:       &http.Client{},
+		friendClient: friendClient,
+		groupClient:  groupClient,
+		authClient:   authClient,
+		kinds:        make(map[string]*jobKind),
+	}
+	if config.RpcConfig.Export.Enable {
+		j.kinds[model.UserJobExport] = j.exportKind()
+	}
+	if config.RpcConfig.Erase.Enable {
+		kind, err := j.eraseKind()
+		if err != nil {
+			return nil, err
+		}
+		j.kinds[model.UserJobErase] = kind
+	}
+	return j, nil
+}
+
+// startJob runs the unfinished job of the type for the user in the background, creating
+// it if there is none, and returns its ID.
+func (s *userServer) startJob(ctx context.Context, jobType string, userID string) (string, error) {
+	if s.jobs == nil || s.jobs.kinds[jobType] == nil {
+		return "", errs.ErrArgs.WrapMsg("user job is disabled", "type", jobType)
+	}
+	job, err := s.jobs.jobs.TakeUnfinished(ctx, userID, jobType)
+	if err != nil {
+		if errs.Unwrap(err) != mongo.ErrNoDocuments {
+			return "", err
+		}
+		if _, err := s.db.FindWithError(ctx, []string{userID}); err != nil {
+			return "", err
+		}
+		if job, err = s.jobs.newJob(ctx, jobType, userID); err != nil {
+			return "", err
+		}
+	}
+	go s.jobs.run(context.WithoutCancel(ctx), job)
+	return job.JobID, nil
+}
+
+// takeJob returns the job of the type with the ID.
+func (s *userServer) takeJob(ctx context.Context, jobType string, jobID string) (*model.UserJob, error) {
+	if s.jobs == nil || s.jobs.kinds[jobType] == nil {
+		return nil, errs.ErrArgs.WrapMsg("user job is disabled", "type", jobType)
+	}
+	job, err := s.jobs.jobs.Take(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	if job.Type != jobType {
+		return nil, errs.ErrRecordNotFound.WrapMsg("user job not found", "type", jobType, "jobID", jobID)
+	}
+	return job, nil
+}
+
+func userJobDB2Pb(job *model.UserJob) *userext.UserJob {
+	return &userext.UserJob{
+		JobID:          job.JobID,
+		Type:           job.Type,
+		UserID:         job.UserID,
+		OperatorUserID: job.OperatorUserID,
+		Status:         job.Status,
+		Steps: datautil.Slice(job.Steps, func(step model.UserJobStep) *userext.UserJobStep {
+			return &userext.UserJobStep{Name: step.Name, Status: step.Status, Count: step.Count, Error: step.Error}
+		}),
+		Error:      job.Error,
+		CreateTime: job.CreateTime.UnixMilli(),
+		UpdateTime: job.UpdateTime.UnixMilli(),
+	}
+}
+
+func (j *userJobs) newJob(ctx context.Context, jobType string, userID string) (*model.UserJob, error) {
+	now := time.Now()
+	job := &model.UserJob{
+		JobID:          primitive.NewObjectID().Hex(),
+		Type:           jobType,
+		UserID:         userID,
+		OperatorUserID: mcontext.GetOpUserID(ctx),
+		Status:         model.UserJobRunning,
+		CreateTime:     now,
+		UpdateTime:     now,
+	}
+	for _, step := range j.kinds[jobType].steps {
+		job.Steps = append(job.Steps, model.UserJobStep{Name: step.name, UpdateTime: now})
+	}
+	if err := j.jobs.Create(ctx, job); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// resume picks up the jobs left running by instances that stopped.
+func (j *userJobs) resume(ctx context.Context) {
+	for jobType := range j.kinds {
+		jobs, err := j.jobs.FindRunning(ctx, jobType, userJobResumeLimit)
+		if err != nil {
+			log.ZError(ctx, "find running user jobs failed", err, "type", jobType)
+			continue
+		}
+		for _, job := range jobs {
+			j.run(ctx, job)
+		}
+	}
+}
+
+// run continues the job from its first unfinished step, unless another instance holds it.
+// The saved job records who started it and how far each step got, every step is also logged.
+func (j *userJobs) run(ctx context.Context, job *model.UserJob) {
+	kind := j.kinds[job.Type]
+	if kind == nil {
+		log.ZWarn(ctx, "user job type is disabled", nil, "jobID", job.JobID, "type", job.Type)
+		return
+	}
+	ok, err := j.jobs.LockJob(ctx, job.JobID, j.owner, userJobLockExpire)
+	if err != nil {
+		log.ZError(ctx, "lock user job failed", err, "jobID", job.JobID)
+		return
+	}
+	if !ok {
+		log.ZDebug(ctx, "user job is running elsewhere", "jobID", job.JobID)
+		return
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer func() {
+		cancel()
+		if err := j.jobs.UnlockJob(context.WithoutCancel(ctx), job.JobID, j.owner); err != nil {
+			log.ZWarn(ctx, "unlock user job failed", err, "jobID", job.JobID)
+		}
+	}()
+	go j.keepLock(ctx, cancel, job.JobID)
+	// Another instance may have saved progress since job was read.
+	job, err = j.jobs.Take(ctx, job.JobID)
+	if err != nil {
+		log.ZError(ctx, "take user job failed", err)
+		return
+	}
+	if job.Status == model.UserJobSucceeded {
+		return
+	}
+	job.Status = model.UserJobRunning
+	job.Error = ""
+	start := time.Now()
+	for i := range job.Steps {
+		step := &job.Steps[i]
+		if step.Status == model.UserJobStepDone {
+			continue
+		}
+		js := kind.step(step.Name)
+		if js == nil {
+			log.ZError(ctx, "unknown user job step", nil, "jobID", job.JobID, "type", job.Type, "step", step.Name)
+			return
+		}
+		step.Status = model.UserJobStepRunning
+		step.Error = ""
+		step.UpdateTime = time.Now()
+		if err := j.jobs.Save(ctx, job); err != nil {
+			log.ZError(ctx, "save user job failed", err, "jobID", job.JobID)
+			return
+		}
+		if err := js.run(ctx, job, step); err != nil {
+			if ctx.Err() != nil {
+				// Stopped or lost the lock, the job stays running for the next holder.
+				log.ZWarn(ctx, "user job interrupted", err, "jobID", job.JobID, "step", step.Name)
+				return
+			}
+			log.ZError(ctx, "user job failed", err, "jobID", job.JobID, "type", job.Type, "step", step.Name)
+			step.Status = model.UserJobStepFailed
+			step.Error = err.Error()
+			job.Status = model.UserJobFailed
+			job.Error = step.Name + ": " + err.Error()
+			if err := j.jobs.Save(ctx, job); err != nil {
+				log.ZError(ctx, "save user job failed", err, "jobID", job.JobID)
+			}
+			return
+		}
+		step.Status = model.UserJobStepDone
+		step.UpdateTime = time.Now()
+		if err := j.jobs.Save(ctx, job); err != nil {
+			log.ZError(ctx, "save user job failed", err, "jobID", job.JobID)
+			return
+		}
+		log.ZInfo(ctx, "user job step done", "jobID", job.JobID, "type", job.Type, "userID", job.UserID,
+			"operatorUserID", job.OperatorUserID, "step", step.Name, "count", step.Count)
+	}
+	job.Status = model.UserJobSucceeded
+	if err := j.jobs.Save(ctx, job); err != nil {
+		log.ZError(ctx, "save user job failed", err, "jobID", job.JobID)
+		return
+	}
+	log.ZInfo(ctx, "user job finished", "jobID", job.JobID, "type", job.Type, "userID", job.UserID,
+		"operatorUserID", job.OperatorUserID, "cost", time.Since(start))
+	if kind.done != nil {
+		kind.done(ctx, job)
+	}
+}
+
+func (k *jobKind) step(name string) *jobStep {
+	for i := range k.steps {
+		if k.steps[i].name == name {
+			return &k.steps[i]
+		}
+	}
+	return nil
+}
+
+func (j *userJobs) keepLock(ctx context.Context, cancel context.CancelFunc, jobID string) {
+	ticker := time.NewTicker(userJobLockExpire / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			ok, err := j.jobs.RenewJobLock(ctx, jobID, j.owner, userJobLockExpire)
+			if err != nil {
+				log.ZWarn(ctx, "renew user job lock failed", err, "jobID", jobID)
+				continue
+			}
+			if !ok {
+				log.ZWarn(ctx, "user job lock lost", nil, "jobID", jobID)
+				cancel()
+				return
+			}
+		}
+	}
+}
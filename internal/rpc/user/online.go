@@ -2,8 +2,11 @@ package user
 
 import (
 	"context"
+
 	"github.com/openimsdk/protocol/constant"
 	pbuser "github.com/openimsdk/protocol/user"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
 )
 
 func (s *userServer) getUserOnlineStatus(ctx context.Context, userID string) (*pbuser.OnlineStatus, error) {
@@ -78,5 +81,10 @@ func (s *userServer) SetUserOnlineStatus(ctx context.Context, req *pbuser.SetUse
 			return nil, err
 		}
 	}
+	// The gateway renews the status of online users, so this also keeps their activity recent.
+	userIDs := datautil.Slice(req.Status, func(e *pbuser.UserOnlineStatus) string { return e.UserID })
+	if err := s.activityDB.SetUsersActive(ctx, userIDs); err != nil {
+		log.ZWarn(ctx, "set users active failed", err, "userIDs", userIDs)
+	}
 	return &pbuser.SetUserOnlineStatusResp{}, nil
 }
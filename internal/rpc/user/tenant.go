@@ -0,0 +1,218 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database/mgo"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	pbtenant "github.com/openimsdk/open-im-server/v3/pkg/protocol/tenant"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// fetchTenant resolves tenants for the services of this process without a round trip
+// through the tenant service.
+func (s *userServer) fetchTenant(ctx context.Context, tenantID string) (*tenant.Info, error) {
+	t, err := s.takeTenant(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return convert.TenantPb2Info(convert.TenantDB2Pb(t)), nil
+}
+
+func (s *userServer) takeTenant(ctx context.Context, tenantID string) (*model.Tenant, error) {
+	t, err := s.tenantDB.Take(ctx, tenantID)
+	if err != nil {
+		if mgo.IsNotFound(err) {
+			return nil, servererrs.ErrTenantNotFound.WrapMsg("tenant not found", "tenantID", tenantID)
+		}
+		return nil, err
+	}
+	return t, nil
+}
+
+const tenantPageSize = 100
+
+// resumeJobs resumes the user jobs of the default tenant, then those of every enabled tenant.
+func (s *userServer) resumeJobs(ctx context.Context) {
+	s.jobs.resume(ctx)
+	for page := int32(1); ; page++ {
+		_, tenants, err := s.tenantDB.Page(ctx, &sdkws.RequestPagination{PageNumber: page, ShowNumber: tenantPageSize})
+		if err != nil {
+			log.ZError(ctx, "find tenants failed", err, "page", page)
+			return
+		}
+		for _, t := range tenants {
+			if t.Status == model.TenantDisabled {
+				continue
+			}
+			s.jobs.resume(tenant.WithID(ctx, t.TenantID))
+		}
+		if len(tenants) < tenantPageSize {
+			return
+		}
+	}
+}
+
+func (s *userServer) checkTenantNotification(notification string) error {
+	if notification == "" {
+		return nil
+	}
+	if _, err := config.MergeNotification(&s.config.NotificationConfig, notification); err != nil {
+		return errs.ErrArgs.WrapMsg(err.Error())
+	}
+	return nil
+}
+
+// initTenantAdmins registers the admins of a tenant as app manager users of the tenant.
+func (s *userServer) initTenantAdmins(ctx context.Context, tenantID string, adminUserIDs []string) error {
+	users := datautil.Slice(adminUserIDs, func(userID string) *model.User {
+		return &model.User{UserID: userID, Nickname: userID, AppMangerLevel: constant.AppNotificationAdmin, CreateTime: time.Now()}
+	})
+	return s.db.InitOnce(tenant.WithID(ctx, tenantID), users)
+}
+
+func (s *userServer) CreateTenant(ctx context.Context, req *pbtenant.CreateTenantReq) (*pbtenant.CreateTenantResp, error) {
+	if err := authverify.CheckPlatformAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	info := req.Tenant
+	if err := tenant.CheckID(info.TenantID); err != nil {
+		return nil, err
+	}
+	if err := s.checkTenantNotification(info.Notification); err != nil {
+		return nil, err
+	}
+	if _, err := s.takeTenant(ctx, info.TenantID); err == nil {
+		return nil, errs.ErrArgs.WrapMsg("tenant already exists", "tenantID", info.TenantID)
+	} else if !servererrs.ErrTenantNotFound.Is(err) {
+		return nil, err
+	}
+	adminUserIDs := datautil.Distinct(info.AdminUserIDs)
+	if err := s.initTenantAdmins(ctx, info.TenantID, adminUserIDs); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	t := &model.Tenant{
+		TenantID:     info.TenantID,
+		Name:         info.Name,
+		Secret:       info.Secret,
+		AdminUserIDs: adminUserIDs,
+		WebhookURL:   info.WebhookURL,
+		Notification: info.Notification,
+		Status:       model.TenantNormal,
+		Ex:           info.Ex,
+		CreateTime:   now,
+		UpdateTime:   now,
+	}
+	if err := s.tenantDB.Create(ctx, t); err != nil {
+		return nil, err
+	}
+	return &pbtenant.CreateTenantResp{}, nil
+}
+
+func (s *userServer) UpdateTenant(ctx context.Context, req *pbtenant.UpdateTenantReq) (*pbtenant.UpdateTenantResp, error) {
+	if err := authverify.CheckPlatformAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if _, err := s.takeTenant(ctx, req.TenantID); err != nil {
+		return nil, err
+	}
+	data := make(map[string]any)
+	if req.Name != nil {
+		data["name"] = req.Name.Value
+	}
+	if req.Secret != nil {
+		data["secret"] = req.Secret.Value
+	}
+	if len(req.AdminUserIDs) > 0 {
+		adminUserIDs := datautil.Distinct(req.AdminUserIDs)
+		if err := s.initTenantAdmins(ctx, req.TenantID, adminUserIDs); err != nil {
+			return nil, err
+		}
+		data["admin_user_ids"] = adminUserIDs
+	}
+	if req.WebhookURL != nil {
+		data["webhook_url"] = req.WebhookURL.Value
+	}
+	if req.Notification != nil {
+		if err := s.checkTenantNotification(req.Notification.Value); err != nil {
+			return nil, err
+		}
+		data["notification"] = req.Notification.Value
+	}
+	if req.Status != nil {
+		if req.Status.Value != model.TenantNormal && req.Status.Value != model.TenantDisabled {
+			return nil, errs.ErrArgs.WrapMsg("invalid tenant status", "status", req.Status.Value)
+		}
+		data["status"] = req.Status.Value
+	}
+	if req.Ex != nil {
+		data["ex"] = req.Ex.Value
+	}
+	if len(data) == 0 {
+		return &pbtenant.UpdateTenantResp{}, nil
+	}
+	if err := s.tenantDB.Update(ctx, req.TenantID, data); err != nil {
+		return nil, err
+	}
+	return &pbtenant.UpdateTenantResp{}, nil
+}
+
+func (s *userServer) DeleteTenant(ctx context.Context, req *pbtenant.DeleteTenantReq) (*pbtenant.DeleteTenantResp, error) {
+	if err := authverify.CheckPlatformAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if _, err := s.takeTenant(ctx, req.TenantID); err != nil {
+		return nil, err
+	}
+	if err := s.tenantDB.Delete(ctx, req.TenantID); err != nil {
+		return nil, err
+	}
+	return &pbtenant.DeleteTenantResp{}, nil
+}
+
+func (s *userServer) GetTenant(ctx context.Context, req *pbtenant.GetTenantReq) (*pbtenant.GetTenantResp, error) {
+	if err := authverify.CheckPlatformAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	t, err := s.takeTenant(ctx, req.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	return &pbtenant.GetTenantResp{Tenant: convert.TenantDB2Pb(t)}, nil
+}
+
+func (s *userServer) GetTenants(ctx context.Context, req *pbtenant.GetTenantsReq) (*pbtenant.GetTenantsResp, error) {
+	if err := authverify.CheckPlatformAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	total, tenants, err := s.tenantDB.Page(ctx, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &pbtenant.GetTenantsResp{Total: total, Tenants: datautil.Slice(tenants, convert.TenantDB2Pb)}, nil
+}
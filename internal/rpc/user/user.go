@@ -22,14 +22,20 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/redis"
-	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database/mgo"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/dbbuild"
 	tablerelation "github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/webhook"
 	"github.com/openimsdk/open-im-server/v3/pkg/localcache"
+	pbtenant "github.com/openimsdk/open-im-server/v3/pkg/protocol/tenant"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/userext"
 	"github.com/openimsdk/protocol/group"
 	friendpb "github.com/openimsdk/protocol/relation"
 	"github.com/openimsdk/tools/db/redisutil"
+	"github.com/openimsdk/tools/mcontext"
 	"math/rand"
+	"os"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -42,7 +48,6 @@ import (
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/sdkws"
 	pbuser "github.com/openimsdk/protocol/user"
-	"github.com/openimsdk/tools/db/mongoutil"
 	"github.com/openimsdk/tools/db/pagination"
 	registry "github.com/openimsdk/tools/discovery"
 	"github.com/openimsdk/tools/errs"
@@ -58,25 +63,33 @@ type userServer struct {
 	userNotificationSender   *UserNotificationSender
 	friendRpcClient          *rpcclient.FriendRpcClient
 	groupRpcClient           *rpcclient.GroupRpcClient
+	authClient               *rpcclient.Auth
 	RegisterCenter           registry.SvcDiscoveryRegistry
 	config                   *Config
 	webhookClient            *webhook.Client
+	jobs                     *userJobs // User data export and deletion, nil when both are disabled.
+	tenantDB                 controller.TenantDatabase
+	activityDB               controller.UserActivityDatabase
 }
 
 type Config struct {
 	RpcConfig          config.User
 	RedisConfig        config.Redis
 	MongodbConfig      config.Mongo
+	DatabaseConfig     config.Database
 	KafkaConfig        config.Kafka
+	MQConfig           config.MQ
 	NotificationConfig config.Notification
 	Share              config.Share
 	WebhooksConfig     config.Webhooks
 	LocalCacheConfig   config.LocalCache
+	ThirdConfig        config.Third
+	MinioConfig        config.Minio
 	Discovery          config.Discovery
 }
 
 func Start(ctx context.Context, config *Config, client registry.SvcDiscoveryRegistry, server *grpc.Server) error {
-	mgocli, err := mongoutil.NewMongoDB(ctx, config.MongodbConfig.Build())
+	dbb, err := dbbuild.NewBuilder(ctx, &config.DatabaseConfig, &config.MongodbConfig)
 	if err != nil {
 		return err
 	}
@@ -89,15 +102,24 @@ func Start(ctx context.Context, config *Config, client registry.SvcDiscoveryRegi
 	for _, v := range config.Share.IMAdminUserID {
 		users = append(users, &tablerelation.User{UserID: v, Nickname: v, AppMangerLevel: constant.AppNotificationAdmin})
 	}
-	userDB, err := mgo.NewUserMongo(mgocli.GetDB())
+	userDB, err := dbb.User()
 	if err != nil {
 		return err
 	}
 	userCache := redis.NewUserCacheRedis(rdb, &config.LocalCacheConfig, userDB, redis.GetRocksCacheOptions())
-	database := controller.NewUserDatabase(userDB, userCache, mgocli.GetTx())
+	database := controller.NewUserDatabase(userDB, userCache, dbb.Tx())
+	tenantDB, err := dbb.Tenant()
+	if err != nil {
+		return err
+	}
+	userActivityDB, err := dbb.UserActivity()
+	if err != nil {
+		return err
+	}
 	friendRpcClient := rpcclient.NewFriendRpcClient(client, config.Share.RpcRegisterName.Friend)
 	groupRpcClient := rpcclient.NewGroupRpcClient(client, config.Share.RpcRegisterName.Group)
 	msgRpcClient := rpcclient.NewMessageRpcClient(client, config.Share.RpcRegisterName.Msg)
+	authClient := rpcclient.NewAuth(client, config.Share.RpcRegisterName.Auth)
 	localcache.InitLocalCache(&config.LocalCacheConfig)
 	u := &userServer{
 		online:                   redis.NewUserOnline(rdb),
@@ -105,12 +127,24 @@ func Start(ctx context.Context, config *Config, client registry.SvcDiscoveryRegi
 		RegisterCenter:           client,
 		friendRpcClient:          &friendRpcClient,
 		groupRpcClient:           &groupRpcClient,
+		authClient:               authClient,
 		friendNotificationSender: relation.NewFriendNotificationSender(&config.NotificationConfig, &msgRpcClient, relation.WithDBFunc(database.FindWithError)),
 		userNotificationSender:   NewUserNotificationSender(config, &msgRpcClient, WithUserFunc(database.FindWithError)),
 		config:                   config,
 		webhookClient:            webhook.NewWebhookClient(config.WebhooksConfig.URL),
+		tenantDB:                 controller.NewTenantDatabase(tenantDB, redis.NewTenantCacheRedis(rdb, tenantDB, redis.GetRocksCacheOptions())),
+		activityDB:               controller.NewUserActivityDatabase(userActivityDB, userDB),
+	}
+	tenant.SetResolver(tenant.NewCachedResolver(u.fetchTenant, tenant.CacheTTL))
+	if config.RpcConfig.Export.Enable || config.RpcConfig.Erase.Enable {
+		if u.jobs, err = newUserJobs(ctx, config, dbb, rdb, database, &friendRpcClient, &groupRpcClient, authClient); err != nil {
+			return err
+		}
+		go u.resumeJobs(mcontext.SetOperationID(context.Background(), "user_job_resume_"+strconv.Itoa(os.Getpid())))
 	}
 	pbuser.RegisterUserServer(server, u)
+	userext.RegisterUserExtServer(server, u)
+	pbtenant.RegisterTenantServer(server, u)
 	return u.db.InitOnce(context.Background(), users)
 }
 
@@ -556,7 +590,7 @@ func (s *userServer) SearchNotificationAccount(ctx context.Context, req *pbuser.
 		}
 
 		// Convert users to response format
-		resp := s.userModelToResp(users, req.Pagination)
+		resp := s.userModelToResp(ctx, users, req.Pagination)
 		if resp.Total != 0 {
 			return resp, nil
 		}
@@ -566,7 +600,7 @@ func (s *userServer) SearchNotificationAccount(ctx context.Context, req *pbuser.
 		if err != nil {
 			return nil, err
 		}
-		resp = s.userModelToResp(users, req.Pagination)
+		resp = s.userModelToResp(ctx, users, req.Pagination)
 		return resp, nil
 	}
 
@@ -576,7 +610,7 @@ func (s *userServer) SearchNotificationAccount(ctx context.Context, req *pbuser.
 		return nil, err
 	}
 
-	resp := s.userModelToResp(users, req.Pagination)
+	resp := s.userModelToResp(ctx, users, req.Pagination)
 	return resp, nil
 }
 
@@ -610,11 +644,11 @@ func (s *userServer) genUserID() string {
 	return string(data)
 }
 
-func (s *userServer) userModelToResp(users []*tablerelation.User, pagination pagination.Pagination) *pbuser.SearchNotificationAccountResp {
+func (s *userServer) userModelToResp(ctx context.Context, users []*tablerelation.User, pagination pagination.Pagination) *pbuser.SearchNotificationAccountResp {
 	accounts := make([]*pbuser.NotificationAccountInfo, 0)
 	var total int64
 	for _, v := range users {
-		if v.AppMangerLevel == constant.AppNotificationAdmin && !datautil.Contain(v.UserID, s.config.Share.IMAdminUserID...) {
+		if v.AppMangerLevel == constant.AppNotificationAdmin && !authverify.IsManagerUserID(ctx, v.UserID, s.config.Share.IMAdminUserID) {
 			temp := &pbuser.NotificationAccountInfo{
 				UserID:   v.UserID,
 				FaceURL:  v.FaceURL,
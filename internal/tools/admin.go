@@ -0,0 +1,302 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	kdisc "github.com/openimsdk/open-im-server/v3/pkg/common/discoveryregister"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
+	pbauth "github.com/openimsdk/protocol/auth"
+	"github.com/openimsdk/protocol/constant"
+	pbconversation "github.com/openimsdk/protocol/conversation"
+	pbgroup "github.com/openimsdk/protocol/group"
+	"github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/protocol/msggateway"
+	"github.com/openimsdk/protocol/sdkws"
+	pbuser "github.com/openimsdk/protocol/user"
+	"github.com/openimsdk/tools/discovery"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/mw"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Admin calls the services as the app admin, or as the first admin of a tenant, on behalf of
+// the command line tools.
+type Admin struct {
+	conf         *CronTaskConfig
+	discov       discovery.SvcDiscoveryRegistry
+	cron         *cronClients
+	user         pbuser.UserClient
+	group        pbgroup.GroupClient
+	auth         pbauth.AuthClient
+	conversation pbconversation.ConversationClient
+}
+
+func NewAdmin(ctx context.Context, conf *CronTaskConfig) (*Admin, error) {
+	if len(conf.Share.IMAdminUserID) == 0 {
+		return nil, errs.New("imAdminUserID of share.yml is empty").Wrap()
+	}
+	client, err := kdisc.NewDiscoveryRegister(&conf.Discovery, &conf.Share)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "failed to register discovery service")
+	}
+	client.AddOption(mw.GrpcClient(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	tenant.SetResolver(rpcclient.NewTenantResolver(client, conf.Share.RpcRegisterName.User, conf.Share.IMAdminUserID))
+	ctx = mcontext.SetOpUserID(ctx, conf.Share.IMAdminUserID[0])
+	cron, err := newCronClients(ctx, client, &conf.Share)
+	if err != nil {
+		return nil, err
+	}
+	userConn, err := client.GetConn(ctx, conf.Share.RpcRegisterName.User)
+	if err != nil {
+		return nil, err
+	}
+	groupConn, err := client.GetConn(ctx, conf.Share.RpcRegisterName.Group)
+	if err != nil {
+		return nil, err
+	}
+	authConn, err := client.GetConn(ctx, conf.Share.RpcRegisterName.Auth)
+	if err != nil {
+		return nil, err
+	}
+	return &Admin{
+		conf:         conf,
+		discov:       client,
+		cron:         cron,
+		user:         pbuser.NewUserClient(userConn),
+		group:        pbgroup.NewGroupClient(groupConn),
+		auth:         pbauth.NewAuthClient(authConn),
+		conversation: cron.conversation,
+	}, nil
+}
+
+func (a *Admin) Close() {
+	a.discov.Close()
+}
+
+// Context returns the context of an admin operation in the tenant, empty for the default
+// tenant. Tenants are operated by their first admin.
+func (a *Admin) Context(ctx context.Context, tenantID string) (context.Context, error) {
+	ctx = mcontext.SetOperationID(ctx, fmt.Sprintf("admin_%d_%d", os.Getpid(), time.Now().UnixMilli()))
+	ctx = tenant.WithID(ctx, tenantID)
+	adminUserIDs, err := tenant.AdminUserIDs(ctx, a.conf.Share.IMAdminUserID)
+	if err != nil {
+		return nil, err
+	}
+	if len(adminUserIDs) == 0 {
+		return nil, errs.ErrArgs.WrapMsg("tenant has no admin", "tenantID", tenantID)
+	}
+	return mcontext.SetOpUserID(ctx, adminUserIDs[0]), nil
+}
+
+func (a *Admin) GetUsers(ctx context.Context, userIDs []string) ([]*sdkws.UserInfo, error) {
+	resp, err := a.user.GetDesignateUsers(ctx, &pbuser.GetDesignateUsersReq{UserIDs: userIDs})
+	if err != nil {
+		return nil, err
+	}
+	return resp.UsersInfo, nil
+}
+
+func (a *Admin) GetGroups(ctx context.Context, groupIDs []string) ([]*sdkws.GroupInfo, error) {
+	resp, err := a.group.GetGroupsInfo(ctx, &pbgroup.GetGroupsInfoReq{GroupIDs: groupIDs})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GroupInfos, nil
+}
+
+// GetConversations returns the conversations of ownerUserID, all of them when conversationIDs
+// is empty. Without an owner it returns the conversations of every owner.
+func (a *Admin) GetConversations(ctx context.Context, ownerUserID string, conversationIDs []string) ([]*pbconversation.Conversation, error) {
+	switch {
+	case ownerUserID == "":
+		if len(conversationIDs) == 0 {
+			return nil, errs.ErrArgs.WrapMsg("conversationIDs or ownerUserID is required")
+		}
+		resp, err := a.conversation.GetConversationsByConversationID(ctx, &pbconversation.GetConversationsByConversationIDReq{ConversationIDs: conversationIDs})
+		if err != nil {
+			return nil, err
+		}
+		return resp.Conversations, nil
+	case len(conversationIDs) == 0:
+		resp, err := a.conversation.GetAllConversations(ctx, &pbconversation.GetAllConversationsReq{OwnerUserID: ownerUserID})
+		if err != nil {
+			return nil, err
+		}
+		return resp.Conversations, nil
+	default:
+		resp, err := a.conversation.GetConversations(ctx, &pbconversation.GetConversationsReq{OwnerUserID: ownerUserID, ConversationIDs: conversationIDs})
+		if err != nil {
+			return nil, err
+		}
+		return resp.Conversations, nil
+	}
+}
+
+func (a *Admin) GetConversationIDs(ctx context.Context, userID string) ([]string, error) {
+	resp, err := a.conversation.GetConversationIDs(ctx, &pbconversation.GetConversationIDsReq{UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+	return resp.ConversationIDs, nil
+}
+
+func (a *Admin) CheckSeq(ctx context.Context, req *msgext.CheckConversationSeqReq) (*msgext.CheckConversationSeqResp, error) {
+	return a.cron.msgExt.CheckConversationSeq(ctx, req)
+}
+
+// ScanSeq checks every conversation with allocated seqs page by page, starting at req.Cursor.
+func (a *Admin) ScanSeq(ctx context.Context, req *msgext.CheckConversationSeqReq, fn func(resp *msgext.CheckConversationSeqResp) error) error {
+	for {
+		resp, err := a.cron.msgExt.CheckConversationSeq(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := fn(resp); err != nil {
+			return err
+		}
+		if resp.NextCursor == "" {
+			return nil
+		}
+		req.Cursor = resp.NextCursor
+	}
+}
+
+func (a *Admin) GetMsgs(ctx context.Context, conversationID string, seqs []int64) ([]*sdkws.MsgData, error) {
+	resp, err := a.cron.msgExt.GetMsgsBySeqs(ctx, &msgext.GetMsgsBySeqsReq{ConversationID: conversationID, Seqs: seqs})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msgs, nil
+}
+
+// platformIDs returns the given platform, every platform when platformID is 0.
+func platformIDs(platformID int32) []int32 {
+	if platformID != 0 {
+		return []int32{platformID}
+	}
+	ids := make([]int32, 0, len(constant.PlatformID2Name))
+	for id := range constant.PlatformID2Name {
+		ids = append(ids, int32(id))
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// ForceLogout invalidates the tokens of the user and closes their connections, on every
+// platform when platformID is 0.
+func (a *Admin) ForceLogout(ctx context.Context, userID string, platformID int32) error {
+	for _, id := range platformIDs(platformID) {
+		if _, err := a.auth.ForceLogout(ctx, &pbauth.ForceLogoutReq{UserID: userID, PlatformID: id}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// KickConnections closes the connections of the users on every gateway and keeps their
+// tokens, so that clients can reconnect.
+func (a *Admin) KickConnections(ctx context.Context, userIDs []string, platformID int32) error {
+	conns, err := a.discov.GetConns(ctx, a.conf.Share.RpcRegisterName.MessageGateway)
+	if err != nil {
+		return err
+	}
+	if len(conns) == 0 {
+		return errs.ErrInternalServer.WrapMsg("no msg gateway found")
+	}
+	var errList []error
+	for _, conn := range conns {
+		client := msggateway.NewMsgGatewayClient(conn)
+		for _, id := range platformIDs(platformID) {
+			if _, err := client.KickUserOffline(ctx, &msggateway.KickUserOfflineReq{KickUserIDList: userIDs, PlatformID: id}); err != nil {
+				log.ZWarn(ctx, "kick user offline failed", err, "target", conn.Target(), "userIDs", userIDs, "platformID", id)
+				errList = append(errList, errs.WrapMsg(err, "kick user offline failed", "target", conn.Target()))
+				break
+			}
+		}
+	}
+	return errors.Join(errList...)
+}
+
+// RunCronJob runs a cron job once for the tenant of ctx, and with allTenants for every enabled
+// tenant as well.
+func (a *Admin) RunCronJob(ctx context.Context, name string, allTenants bool) error {
+	job, ok := cronJobs(&a.conf.CronTask, a.cron)[name]
+	if !ok {
+		return errs.ErrArgs.WrapMsg("unknown cron job", "name", name, "jobs", CronJobNames)
+	}
+	if err := job(ctx); err != nil {
+		return err
+	}
+	if !allTenants {
+		return nil
+	}
+	return forEachTenant(ctx, a.cron.tenant, func(ctx context.Context) error {
+		if err := job(ctx); err != nil {
+			return errs.WrapMsg(err, "cron job failed", "tenantID", tenant.ID(ctx))
+		}
+		return nil
+	})
+}
+
+// ClearConversations hides the messages of the conversations from userID, or with everyone set
+// from every member, like clearing them in a client.
+func (a *Admin) ClearConversations(ctx context.Context, userID string, conversationIDs []string, everyone bool) error {
+	_, err := a.cron.msg.ClearConversationsMsg(ctx, &msg.ClearConversationsMsgReq{
+		UserID:          userID,
+		ConversationIDs: conversationIDs,
+		DeleteSyncOpt:   &msg.DeleteSyncOpt{IsSyncSelf: true, IsSyncOther: everyone},
+	})
+	return err
+}
+
+// ClearUserMsgs clears every conversation of the user, see ClearConversations.
+func (a *Admin) ClearUserMsgs(ctx context.Context, userID string, everyone bool) error {
+	_, err := a.cron.msg.UserClearAllMsg(ctx, &msg.UserClearAllMsgReq{
+		UserID:        userID,
+		DeleteSyncOpt: &msg.DeleteSyncOpt{IsSyncSelf: true, IsSyncOther: everyone},
+	})
+	return err
+}
+
+// ClearMsgBefore physically deletes the messages of every conversation sent before ts.
+func (a *Admin) ClearMsgBefore(ctx context.Context, ts time.Time) error {
+	_, err := a.cron.msg.ClearMsg(ctx, &msg.ClearMsgReq{Timestamp: ts.UnixMilli()})
+	return err
+}
+
+// DeleteMsgs physically deletes the messages of the conversation with the given seqs.
+func (a *Admin) DeleteMsgs(ctx context.Context, conversationID string, seqs []int64) error {
+	_, err := a.cron.msg.DeleteMsgPhysicalBySeq(ctx, &msg.DeleteMsgPhysicalBySeqReq{ConversationID: conversationID, Seqs: seqs})
+	return err
+}
+
+// DeleteMsgsBefore physically deletes the messages of the conversations sent before ts and
+// raises their min seqs.
+func (a *Admin) DeleteMsgsBefore(ctx context.Context, conversationIDs []string, ts time.Time) error {
+	_, err := a.cron.msg.DeleteMsgPhysical(ctx, &msg.DeleteMsgPhysicalReq{ConversationIDs: conversationIDs, Timestamp: ts.Unix()})
+	return err
+}
@@ -22,10 +22,18 @@ import (
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	kdisc "github.com/openimsdk/open-im-server/v3/pkg/common/discoveryregister"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	pbtenant "github.com/openimsdk/open-im-server/v3/pkg/protocol/tenant"
+	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	pbconversation "github.com/openimsdk/protocol/conversation"
 	"github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/protocol/sdkws"
 
 	"github.com/openimsdk/protocol/third"
+	"github.com/openimsdk/tools/discovery"
 	"github.com/openimsdk/tools/mcontext"
 	"github.com/openimsdk/tools/mw"
 	"google.golang.org/grpc"
@@ -42,93 +50,185 @@ type CronTaskConfig struct {
 	Discovery config.Discovery
 }
 
-func Start(ctx context.Context, config *CronTaskConfig) error {
-	log.CInfo(ctx, "CRON-TASK server is initializing", "chatRecordsClearTime", config.CronTask.CronExecuteTime, "msgDestructTime", config.CronTask.RetainChatRecords)
-	if config.CronTask.RetainChatRecords < 1 {
-		return errs.New("msg destruct time must be greater than 1").Wrap()
+// Names of the cron jobs, in the order they are scheduled.
+const (
+	CronJobClearMsg           = "clear_msg"
+	CronJobArchiveMsg         = "archive_msg"
+	CronJobDestructMsg        = "destruct_msg"
+	CronJobDeleteObject       = "delete_object"
+	CronJobExpireGroupRequest = "expire_group_request"
+	CronJobSucceedGroupOwner  = "succeed_group_owner"
+)
+
+var CronJobNames = []string{CronJobClearMsg, CronJobArchiveMsg, CronJobDestructMsg, CronJobDeleteObject, CronJobExpireGroupRequest,
+	CronJobSucceedGroupOwner}
+
+type cronClients struct {
+	msg          msg.MsgClient
+	msgExt       msgext.MsgExtClient
+	conversation pbconversation.ConversationClient
+	third        third.ThirdClient
+	groupExt     groupext.GroupExtClient
+	tenant       pbtenant.TenantClient
+}
+
+func newCronClients(ctx context.Context, client discovery.SvcDiscoveryRegistry, share *config.Share) (*cronClients, error) {
+	msgConn, err := client.GetConn(ctx, share.RpcRegisterName.Msg)
+	if err != nil {
+		return nil, err
 	}
-	client, err := kdisc.NewDiscoveryRegister(&config.Discovery, &config.Share)
+	thirdConn, err := client.GetConn(ctx, share.RpcRegisterName.Third)
 	if err != nil {
-		return errs.WrapMsg(err, "failed to register discovery service")
+		return nil, err
 	}
-	client.AddOption(mw.GrpcClient(), grpc.WithTransportCredentials(insecure.NewCredentials()))
-	ctx = mcontext.SetOpUserID(ctx, config.Share.IMAdminUserID[0])
-
-	msgConn, err := client.GetConn(ctx, config.Share.RpcRegisterName.Msg)
+	conversationConn, err := client.GetConn(ctx, share.RpcRegisterName.Conversation)
 	if err != nil {
-		return err
+		return nil, err
 	}
-
-	thirdConn, err := client.GetConn(ctx, config.Share.RpcRegisterName.Third)
+	userConn, err := client.GetConn(ctx, share.RpcRegisterName.User)
 	if err != nil {
-		return err
+		return nil, err
 	}
-
-	conversationConn, err := client.GetConn(ctx, config.Share.RpcRegisterName.Conversation)
+	groupConn, err := client.GetConn(ctx, share.RpcRegisterName.Group)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return &cronClients{
+		msg:          msg.NewMsgClient(msgConn),
+		msgExt:       msgext.NewMsgExtClient(msgConn),
+		conversation: pbconversation.NewConversationClient(conversationConn),
+		third:        third.NewThirdClient(thirdConn),
+		groupExt:     groupext.NewGroupExtClient(groupConn),
+		tenant:       pbtenant.NewTenantClient(userConn),
+	}, nil
+}
 
-	msgClient := msg.NewMsgClient(msgConn)
-	conversationClient := pbconversation.NewConversationClient(conversationConn)
-	thirdClient := third.NewThirdClient(thirdConn)
-
-	crontab := cron.New()
-
-	// scheduled hard delete outdated Msgs in specific time.
-	clearMsgFunc := func() {
+// cronJobs returns the cron jobs by name, each runs once for the tenant of ctx.
+func cronJobs(conf *config.CronTask, c *cronClients) map[string]func(ctx context.Context) error {
+	// hard delete outdated Msgs.
+	clearMsgFunc := func(ctx context.Context) error {
 		now := time.Now()
-		deltime := now.Add(-time.Hour * 24 * time.Duration(config.CronTask.RetainChatRecords))
-		ctx := mcontext.SetOperationID(ctx, fmt.Sprintf("cron_%d_%d", os.Getpid(), deltime.UnixMilli()))
+		deltime := now.Add(-time.Hour * 24 * time.Duration(conf.RetainChatRecords))
+		ctx = mcontext.SetOperationID(ctx, fmt.Sprintf("cron_%d_%d", os.Getpid(), deltime.UnixMilli()))
 		log.ZInfo(ctx, "clear chat records", "deltime", deltime, "timestamp", deltime.UnixMilli())
 
-		if _, err := msgClient.ClearMsg(ctx, &msg.ClearMsgReq{Timestamp: deltime.UnixMilli()}); err != nil {
+		if _, err := c.msg.ClearMsg(ctx, &msg.ClearMsgReq{Timestamp: deltime.UnixMilli()}); err != nil {
 			log.ZError(ctx, "cron clear chat records failed", err, "deltime", deltime, "cont", time.Since(now))
-			return
+			return err
 		}
 		log.ZInfo(ctx, "cron clear chat records success", "deltime", deltime, "cont", time.Since(now))
+		return nil
 	}
-	if _, err := crontab.AddFunc(config.CronTask.CronExecuteTime, clearMsgFunc); err != nil {
-		return errs.Wrap(err)
+
+	// move old Msgs to object storage, the msg service decides the age and whether it is enabled.
+	archiveMsgFunc := func(ctx context.Context) error {
+		now := time.Now()
+		ctx = mcontext.SetOperationID(ctx, fmt.Sprintf("cron_%d_%d", os.Getpid(), now.UnixMilli()))
+		resp, err := c.msgExt.ArchiveMsg(ctx, &msgext.ArchiveMsgReq{})
+		if err != nil {
+			log.ZError(ctx, "cron archive msg failed", err, "cont", time.Since(now))
+			return err
+		}
+		log.ZInfo(ctx, "cron archive msg success", "docNum", resp.DocNum, "cont", time.Since(now))
+		return nil
 	}
 
-	// scheduled soft delete outdated Msgs in specific time when user set `is_msg_destruct` feature.
-	msgDestructFunc := func() {
+	// soft delete outdated Msgs when user set `is_msg_destruct` feature.
+	msgDestructFunc := func(ctx context.Context) error {
 		now := time.Now()
-		ctx := mcontext.SetOperationID(ctx, fmt.Sprintf("cron_%d_%d", os.Getpid(), now.UnixMilli()))
+		ctx = mcontext.SetOperationID(ctx, fmt.Sprintf("cron_%d_%d", os.Getpid(), now.UnixMilli()))
 		log.ZInfo(ctx, "msg destruct cron start", "now", now)
 
-		conversations, err := conversationClient.GetConversationsNeedDestructMsgs(ctx, &pbconversation.GetConversationsNeedDestructMsgsReq{})
+		conversations, err := c.conversation.GetConversationsNeedDestructMsgs(ctx, &pbconversation.GetConversationsNeedDestructMsgsReq{})
 		if err != nil {
 			log.ZError(ctx, "Get conversation need Destruct msgs failed.", err)
-			return
+			return err
 		} else {
-			_, err := msgClient.DestructMsgs(ctx, &msg.DestructMsgsReq{Conversations: conversations.Conversations})
+			_, err := c.msg.DestructMsgs(ctx, &msg.DestructMsgsReq{Conversations: conversations.Conversations})
 			if err != nil {
 				log.ZError(ctx, "Destruct Msgs failed.", err)
-				return
+				return err
 			}
 		}
 		log.ZInfo(ctx, "msg destruct cron task completed", "cont", time.Since(now))
-	}
-	if _, err := crontab.AddFunc(config.CronTask.CronExecuteTime, msgDestructFunc); err != nil {
-		return errs.Wrap(err)
+		return nil
 	}
 
-	// scheduled delete outdated file Objects and their datas in specific time.
-	deleteObjectFunc := func() {
+	// delete outdated file Objects and their datas.
+	deleteObjectFunc := func(ctx context.Context) error {
 		now := time.Now()
-		deleteTime := now.Add(-time.Hour * 24 * time.Duration(config.CronTask.FileExpireTime))
-		ctx := mcontext.SetOperationID(ctx, fmt.Sprintf("cron_%d_%d", os.Getpid(), deleteTime.UnixMilli()))
+		deleteTime := now.Add(-time.Hour * 24 * time.Duration(conf.FileExpireTime))
+		ctx = mcontext.SetOperationID(ctx, fmt.Sprintf("cron_%d_%d", os.Getpid(), deleteTime.UnixMilli()))
 		log.ZInfo(ctx, "deleteoutDatedData ", "deletetime", deleteTime, "timestamp", deleteTime.UnixMilli())
-		if _, err := thirdClient.DeleteOutdatedData(ctx, &third.DeleteOutdatedDataReq{ExpireTime: deleteTime.UnixMilli()}); err != nil {
+		if _, err := c.third.DeleteOutdatedData(ctx, &third.DeleteOutdatedDataReq{ExpireTime: deleteTime.UnixMilli()}); err != nil {
 			log.ZError(ctx, "cron deleteoutDatedData failed", err, "deleteTime", deleteTime, "cont", time.Since(now))
-			return
+			return err
 		}
 		log.ZInfo(ctx, "cron deleteoutDatedData success", "deltime", deleteTime, "cont", time.Since(now))
+		return nil
+	}
+
+	// expire the group join requests nobody handled, the group service decides the age.
+	expireGroupRequestFunc := func(ctx context.Context) error {
+		now := time.Now()
+		ctx = mcontext.SetOperationID(ctx, fmt.Sprintf("cron_%d_%d", os.Getpid(), now.UnixMilli()))
+		resp, err := c.groupExt.ExpireGroupApplications(ctx, &groupext.ExpireGroupApplicationsReq{})
+		if err != nil {
+			log.ZError(ctx, "cron expire group request failed", err, "cont", time.Since(now))
+			return err
+		}
+		log.ZInfo(ctx, "cron expire group request success", "count", resp.Count, "cont", time.Since(now))
+		return nil
+	}
+
+	// hand over the groups of inactive owners, the group service decides the inactivity.
+	succeedGroupOwnerFunc := func(ctx context.Context) error {
+		now := time.Now()
+		ctx = mcontext.SetOperationID(ctx, fmt.Sprintf("cron_%d_%d", os.Getpid(), now.UnixMilli()))
+		resp, err := c.groupExt.SucceedInactiveGroupOwners(ctx, &groupext.SucceedInactiveGroupOwnersReq{})
+		if err != nil {
+			log.ZError(ctx, "cron succeed group owner failed", err, "cont", time.Since(now))
+			return err
+		}
+		log.ZInfo(ctx, "cron succeed group owner success", "transferred", resp.Transferred, "dismissed", resp.Dismissed, "cont", time.Since(now))
+		return nil
+	}
+
+	return map[string]func(ctx context.Context) error{
+		CronJobClearMsg:           clearMsgFunc,
+		CronJobArchiveMsg:         archiveMsgFunc,
+		CronJobDestructMsg:        msgDestructFunc,
+		CronJobDeleteObject:       deleteObjectFunc,
+		CronJobExpireGroupRequest: expireGroupRequestFunc,
+		CronJobSucceedGroupOwner:  succeedGroupOwnerFunc,
+	}
+}
+
+func Start(ctx context.Context, config *CronTaskConfig) error {
+	log.CInfo(ctx, "CRON-TASK server is initializing", "chatRecordsClearTime", config.CronTask.CronExecuteTime, "msgDestructTime", config.CronTask.RetainChatRecords)
+	if config.CronTask.RetainChatRecords < 1 {
+		return errs.New("msg destruct time must be greater than 1").Wrap()
+	}
+	client, err := kdisc.NewDiscoveryRegister(&config.Discovery, &config.Share)
+	if err != nil {
+		return errs.WrapMsg(err, "failed to register discovery service")
+	}
+	client.AddOption(mw.GrpcClient(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	tenant.SetResolver(rpcclient.NewTenantResolver(client, config.Share.RpcRegisterName.User, config.Share.IMAdminUserID))
+	ctx = mcontext.SetOpUserID(ctx, config.Share.IMAdminUserID[0])
+
+	clients, err := newCronClients(ctx, client, &config.Share)
+	if err != nil {
+		return err
 	}
-	if _, err := crontab.AddFunc(config.CronTask.CronExecuteTime, deleteObjectFunc); err != nil {
-		return errs.Wrap(err)
+	jobs := cronJobs(&config.CronTask, clients)
+
+	crontab := cron.New()
+	for _, name := range CronJobNames {
+		job := jobs[name]
+		if _, err := crontab.AddFunc(config.CronTask.CronExecuteTime, eachTenant(ctx, clients.tenant, func(ctx context.Context) { _ = job(ctx) })); err != nil {
+			return errs.Wrap(err)
+		}
 	}
 
 	log.ZInfo(ctx, "start cron task", "CronExecuteTime", config.CronTask.CronExecuteTime)
@@ -136,3 +236,45 @@ func Start(ctx context.Context, config *CronTaskConfig) error {
 	<-ctx.Done()
 	return nil
 }
+
+const tenantPageSize = 100
+
+// eachTenant returns a cron job running fn for the default tenant, then for every enabled
+// tenant as the first admin of the tenant.
+func eachTenant(ctx context.Context, tenantClient pbtenant.TenantClient, fn func(ctx context.Context)) func() {
+	return func() {
+		fn(ctx)
+		ctx := mcontext.SetOperationID(ctx, fmt.Sprintf("cron_tenant_%d_%d", os.Getpid(), time.Now().UnixMilli()))
+		err := forEachTenant(ctx, tenantClient, func(ctx context.Context) error {
+			fn(ctx)
+			return nil
+		})
+		if err != nil {
+			log.ZError(ctx, "cron get tenants failed", err)
+		}
+	}
+}
+
+// forEachTenant runs fn for every enabled tenant as the first admin of the tenant and stops
+// at the first error.
+func forEachTenant(ctx context.Context, tenantClient pbtenant.TenantClient, fn func(ctx context.Context) error) error {
+	for page := int32(1); ; page++ {
+		resp, err := tenantClient.GetTenants(ctx, &pbtenant.GetTenantsReq{
+			Pagination: &sdkws.RequestPagination{PageNumber: page, ShowNumber: tenantPageSize},
+		})
+		if err != nil {
+			return err
+		}
+		for _, t := range resp.Tenants {
+			if t.Status == model.TenantDisabled || len(t.AdminUserIDs) == 0 {
+				continue
+			}
+			if err := fn(tenant.WithID(mcontext.SetOpUserID(ctx, t.AdminUserIDs[0]), t.TenantID)); err != nil {
+				return err
+			}
+		}
+		if len(resp.Tenants) < tenantPageSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authverify
+
+import (
+	"fmt"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/protocol/constant"
+)
+
+// GroupPermission is a set of things a group member may do to the group or its other members.
+type GroupPermission int64
+
+const (
+	GroupPermKickMember GroupPermission = 1 << iota
+	GroupPermMuteMember
+	GroupPermMuteGroup
+	GroupPermRevokeMsg
+	GroupPermSetGroupInfo
+	GroupPermSetNotification
+	GroupPermSetMemberInfo
+	// GroupPermSetMemberRole changes the role level or the custom role of members.
+	GroupPermSetMemberRole
+	// GroupPermApproveApplication handles join requests and invites without a request.
+	GroupPermApproveApplication
+	// GroupPermModerate manages the sensitive words and the read receipts of the group.
+	GroupPermModerate
+	GroupPermManageRoles
+	// GroupPermPublish posts to a channel, where the other members only read.
+	GroupPermPublish
+
+	GroupPermAll = GroupPermPublish<<1 - 1
+)
+
+// GroupAdminPermissions are the permissions of group admins, everything but managing roles.
+const GroupAdminPermissions = GroupPermAll &^ GroupPermManageRoles
+
+// GroupMember is what authorizing a group operation needs to know about a member.
+type GroupMember struct {
+	UserID    string
+	RoleLevel int32
+	RoleID    string
+	// Permissions are those of the custom role of the member.
+	Permissions GroupPermission
+}
+
+// Permission returns the permissions of the role level of the member and of its custom role.
+func (m *GroupMember) Permission() GroupPermission {
+	switch m.RoleLevel {
+	case constant.GroupOwner:
+		return GroupPermAll
+	case constant.GroupAdmin:
+		return GroupAdminPermissions | m.Permissions
+	default:
+		return m.Permissions & GroupPermAll
+	}
+}
+
+// rank orders members by authority, a member only acts on members of a lower rank.
+func (m *GroupMember) rank() int {
+	switch {
+	case m.RoleLevel == constant.GroupOwner:
+		return 3
+	case m.RoleLevel == constant.GroupAdmin:
+		return 2
+	case m.RoleID != "":
+		return 1
+	default:
+		return 0
+	}
+}
+
+// CheckGroupPermission checks that op has perm and outranks every target. A nil op is the
+// app admin, who may do everything.
+func CheckGroupPermission(op *GroupMember, perm GroupPermission, targets ...*GroupMember) error {
+	if op == nil {
+		return nil
+	}
+	if op.Permission()&perm != perm {
+		return servererrs.ErrNoPermission.WrapMsg(fmt.Sprintf("group member %s has no permission %d", op.UserID, perm))
+	}
+	for _, target := range targets {
+		if target.rank() >= op.rank() {
+			return servererrs.ErrNoPermission.WrapMsg(fmt.Sprintf("group member %s can not act on %s", op.UserID, target.UserID))
+		}
+	}
+	return nil
+}
+
+// CheckGroupGrant checks that op may grant perm to others, which it may only when it has
+// every permission it grants. A nil op is the app admin.
+func CheckGroupGrant(op *GroupMember, perm GroupPermission) error {
+	if op == nil {
+		return nil
+	}
+	if perm&^GroupPermAll != 0 {
+		return servererrs.ErrArgs.WrapMsg(fmt.Sprintf("unknown group permission %d", perm))
+	}
+	if op.Permission()&perm != perm {
+		return servererrs.ErrNoPermission.WrapMsg(fmt.Sprintf("group member %s can not grant permission %d", op.UserID, perm))
+	}
+	return nil
+}
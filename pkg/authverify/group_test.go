@@ -0,0 +1,55 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authverify
+
+import (
+	"testing"
+
+	"github.com/openimsdk/protocol/constant"
+)
+
+func TestCheckGroupPermission(t *testing.T) {
+	owner := &GroupMember{UserID: "owner", RoleLevel: constant.GroupOwner}
+	admin := &GroupMember{UserID: "admin", RoleLevel: constant.GroupAdmin}
+	moderator := &GroupMember{UserID: "moderator", RoleLevel: constant.GroupOrdinaryUsers, RoleID: "mod",
+		Permissions: GroupPermMuteMember | GroupPermRevokeMsg}
+	member := &GroupMember{UserID: "member", RoleLevel: constant.GroupOrdinaryUsers}
+	tests := []struct {
+		name    string
+		op      *GroupMember
+		perm    GroupPermission
+		targets []*GroupMember
+		ok      bool
+	}{
+		{"app admin", nil, GroupPermAll, []*GroupMember{owner}, true},
+		{"owner kicks admin", owner, GroupPermKickMember, []*GroupMember{admin}, true},
+		{"owner manages roles", owner, GroupPermManageRoles, nil, true},
+		{"admin manages roles", admin, GroupPermManageRoles, nil, false},
+		{"admin kicks admin", admin, GroupPermKickMember, []*GroupMember{admin}, false},
+		{"admin kicks moderator", admin, GroupPermKickMember, []*GroupMember{moderator, member}, true},
+		{"moderator mutes member", moderator, GroupPermMuteMember, []*GroupMember{member}, true},
+		{"moderator mutes moderator", moderator, GroupPermMuteMember, []*GroupMember{moderator}, false},
+		{"moderator kicks member", moderator, GroupPermKickMember, []*GroupMember{member}, false},
+		{"member mutes member", member, GroupPermMuteMember, []*GroupMember{member}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckGroupPermission(tt.op, tt.perm, tt.targets...)
+			if (err == nil) != tt.ok {
+				t.Errorf("CheckGroupPermission() error = %v, want ok %v", err, tt.ok)
+			}
+		})
+	}
+}
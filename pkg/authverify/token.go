@@ -20,7 +20,11 @@ import (
 
 	"github.com/golang-jwt/jwt/v4"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
 	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/tokenverify"
 	"github.com/openimsdk/tools/utils/datautil"
 )
 
@@ -30,9 +34,41 @@ func Secret(secret string) jwt.Keyfunc {
 	}
 }
 
+// Claims are the token claims of tokenverify with the tenant of the user, which is left
+// out for the default tenant so its tokens keep their format.
+type Claims struct {
+	tokenverify.Claims
+	TenantID string `json:"TenantID,omitempty"`
+}
+
+func BuildClaims(userID string, platformID int, tenantID string, ttl int64) Claims {
+	return Claims{Claims: tokenverify.BuildClaims(userID, platformID, ttl), TenantID: tenantID}
+}
+
+// TenantIDFromToken reads the tenant of a token without verifying it, to pick the secret
+// the token is verified with.
+func TenantIDFromToken(tokenString string) (string, error) {
+	var claims Claims
+	if _, _, err := jwt.NewParser().ParseUnverified(tokenString, &claims); err != nil {
+		return "", errs.Wrap(errs.ErrTokenMalformed)
+	}
+	return claims.TenantID, nil
+}
+
+// adminUserIDs returns the app admins of the tenant of ctx, imAdminUserID being those of
+// the default tenant. Nobody is an admin of a tenant that cannot be resolved.
+func adminUserIDs(ctx context.Context, imAdminUserID []string) []string {
+	userIDs, err := tenant.AdminUserIDs(ctx, imAdminUserID)
+	if err != nil {
+		log.ZWarn(ctx, "get tenant admin userIDs failed", err)
+		return nil
+	}
+	return userIDs
+}
+
 func CheckAccessV3(ctx context.Context, ownerUserID string, imAdminUserID []string) (err error) {
 	opUserID := mcontext.GetOpUserID(ctx)
-	if datautil.Contain(opUserID, imAdminUserID...) {
+	if datautil.Contain(opUserID, adminUserIDs(ctx, imAdminUserID)...) {
 		return nil
 	}
 	if opUserID == ownerUserID {
@@ -42,16 +78,24 @@ func CheckAccessV3(ctx context.Context, ownerUserID string, imAdminUserID []stri
 }
 
 func IsAppManagerUid(ctx context.Context, imAdminUserID []string) bool {
-	return datautil.Contain(mcontext.GetOpUserID(ctx), imAdminUserID...)
+	return datautil.Contain(mcontext.GetOpUserID(ctx), adminUserIDs(ctx, imAdminUserID)...)
 }
 
 func CheckAdmin(ctx context.Context, imAdminUserID []string) error {
-	if datautil.Contain(mcontext.GetOpUserID(ctx), imAdminUserID...) {
+	if datautil.Contain(mcontext.GetOpUserID(ctx), adminUserIDs(ctx, imAdminUserID)...) {
 		return nil
 	}
 	return servererrs.ErrNoPermission.WrapMsg(fmt.Sprintf("user %s is not admin userID", mcontext.GetOpUserID(ctx)))
 }
 
-func IsManagerUserID(opUserID string, imAdminUserID []string) bool {
-	return datautil.Contain(opUserID, imAdminUserID...)
+func IsManagerUserID(ctx context.Context, opUserID string, imAdminUserID []string) bool {
+	return datautil.Contain(opUserID, adminUserIDs(ctx, imAdminUserID)...)
+}
+
+// CheckPlatformAdmin allows the app admins of the default tenant only, who manage the tenants.
+func CheckPlatformAdmin(ctx context.Context, imAdminUserID []string) error {
+	if tenant.ID(ctx) == "" && datautil.Contain(mcontext.GetOpUserID(ctx), imAdminUserID...) {
+		return nil
+	}
+	return servererrs.ErrNoPermission.WrapMsg(fmt.Sprintf("user %s is not a platform admin", mcontext.GetOpUserID(ctx)))
 }
@@ -16,6 +16,7 @@ package callbackstruct
 
 import (
 	"github.com/openimsdk/open-im-server/v3/pkg/apistruct"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
 	common "github.com/openimsdk/protocol/sdkws"
 )
 
@@ -152,11 +153,12 @@ type CallbackDisMissGroupResp struct {
 
 type CallbackJoinGroupReq struct {
 	CallbackCommand `json:"callbackCommand"`
-	GroupID         string `json:"groupID"`
-	GroupType       string `json:"groupType"`
-	ApplyID         string `json:"applyID"`
-	ReqMessage      string `json:"reqMessage"`
-	Ex              string `json:"ex"`
+	GroupID         string                      `json:"groupID"`
+	GroupType       string                      `json:"groupType"`
+	ApplyID         string                      `json:"applyID"`
+	ReqMessage      string                      `json:"reqMessage"`
+	Ex              string                      `json:"ex"`
+	Answers         []*groupext.GroupJoinAnswer `json:"answers"`
 }
 
 type CallbackJoinGroupResp struct {
@@ -42,6 +42,10 @@ var (
 	OpenIMRPCThirdCfgFileName        string
 	OpenIMRPCUserCfgFileName         string
 	DiscoveryConfigFilename          string
+	SearchConfigFileName             string
+	ModerationConfigFileName         string
+	MQConfigFileName                 string
+	DatabaseConfigFileName           string
 )
 
 var ConfigEnvPrefixMap map[string]string
@@ -70,6 +74,10 @@ func init() {
 	OpenIMRPCThirdCfgFileName = "openim-rpc-third.yml"
 	OpenIMRPCUserCfgFileName = "openim-rpc-user.yml"
 	DiscoveryConfigFilename = "discovery.yml"
+	SearchConfigFileName = "search.yml"
+	ModerationConfigFileName = "moderation.yml"
+	MQConfigFileName = "mq.yml"
+	DatabaseConfigFileName = "database.yml"
 
 	ConfigEnvPrefixMap = make(map[string]string)
 	fileNames := []string{
@@ -80,6 +88,7 @@ func init() {
 		OpenIMMsgTransferCfgFileName, OpenIMPushCfgFileName, OpenIMRPCAuthCfgFileName,
 		OpenIMRPCConversationCfgFileName, OpenIMRPCFriendCfgFileName, OpenIMRPCGroupCfgFileName,
 		OpenIMRPCMsgCfgFileName, OpenIMRPCThirdCfgFileName, OpenIMRPCUserCfgFileName, DiscoveryConfigFilename,
+		SearchConfigFileName, ModerationConfigFileName, MQConfigFileName, DatabaseConfigFileName,
 	}
 
 	for _, fileName := range fileNames {
@@ -38,6 +38,7 @@ func NewConversationRpcCmd() *ConversationRpcCmd {
 		OpenIMRPCConversationCfgFileName: &conversationConfig.RpcConfig,
 		RedisConfigFileName:              &conversationConfig.RedisConfig,
 		MongodbConfigFileName:            &conversationConfig.MongodbConfig,
+		DatabaseConfigFileName:           &conversationConfig.DatabaseConfig,
 		ShareFileName:                    &conversationConfig.Share,
 		NotificationFileName:             &conversationConfig.NotificationConfig,
 		LocalCacheConfigFileName:         &conversationConfig.LocalCacheConfig,
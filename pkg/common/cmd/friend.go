@@ -38,6 +38,7 @@ func NewFriendRpcCmd() *FriendRpcCmd {
 		OpenIMRPCFriendCfgFileName: &relationConfig.RpcConfig,
 		RedisConfigFileName:        &relationConfig.RedisConfig,
 		MongodbConfigFileName:      &relationConfig.MongodbConfig,
+		DatabaseConfigFileName:     &relationConfig.DatabaseConfig,
 		ShareFileName:              &relationConfig.Share,
 		NotificationFileName:       &relationConfig.NotificationConfig,
 		WebhooksConfigFileName:     &relationConfig.WebhooksConfig,
@@ -39,6 +39,7 @@ func NewGroupRpcCmd() *GroupRpcCmd {
 		OpenIMRPCGroupCfgFileName: &groupConfig.RpcConfig,
 		RedisConfigFileName:       &groupConfig.RedisConfig,
 		MongodbConfigFileName:     &groupConfig.MongodbConfig,
+		DatabaseConfigFileName:    &groupConfig.DatabaseConfig,
 		ShareFileName:             &groupConfig.Share,
 		NotificationFileName:      &groupConfig.NotificationConfig,
 		WebhooksConfigFileName:    &groupConfig.WebhooksConfig,
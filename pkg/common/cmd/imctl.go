@@ -0,0 +1,476 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/internal/tools"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"github.com/openimsdk/open-im-server/v3/version"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/spf13/cobra"
+)
+
+const (
+	OutputTable = "table"
+	OutputJSON  = "json"
+
+	// seqCheckBatch is the number of conversations checked per request.
+	seqCheckBatch = 100
+)
+
+// ImctlCmd is the admin command line of OpenIM. It reads the config directory of the services
+// and calls them as the app admin, or as the first admin of --tenant.
+type ImctlCmd struct {
+	cobra.Command
+	ctx     context.Context
+	admin   *tools.Admin
+	printer *printer
+}
+
+func NewImctlCmd() *ImctlCmd {
+	c := &ImctlCmd{
+		Command: cobra.Command{
+			Use:           "imctl",
+			Short:         "OpenIM admin command line",
+			SilenceUsage:  true,
+			SilenceErrors: true,
+		},
+	}
+	c.PersistentFlags().StringP(FlagConf, "c", "", "path of config directory")
+	c.PersistentFlags().String("tenant", "", "tenant to operate on, the default tenant if empty")
+	c.PersistentFlags().StringP("output", "o", OutputTable, "output format, table or json")
+	c.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		return c.preRun(cmd)
+	}
+	c.PersistentPostRun = func(cmd *cobra.Command, args []string) {
+		c.admin.Close()
+	}
+	c.AddCommand(c.userCmd(), c.groupCmd(), c.conversationCmd(), c.seqCmd(), c.msgCmd(), c.cronCmd())
+	return c
+}
+
+func (c *ImctlCmd) Exec() error {
+	return c.Execute()
+}
+
+func (c *ImctlCmd) preRun(cmd *cobra.Command) error {
+	format, _ := cmd.Flags().GetString("output")
+	p, err := newPrinter(cmd.OutOrStdout(), format)
+	if err != nil {
+		return err
+	}
+	admin, err := loadAdmin(cmd, "imctl")
+	if err != nil {
+		return err
+	}
+	tenantID, _ := cmd.Flags().GetString("tenant")
+	ctx, err := admin.Context(cmd.Context(), tenantID)
+	if err != nil {
+		admin.Close()
+		return err
+	}
+	c.ctx, c.admin, c.printer = ctx, admin, p
+	return nil
+}
+
+// loadAdmin loads the config directory given by the command and connects the admin client.
+// Logs only go to the files configured in log.yml so that the output stays parseable.
+func loadAdmin(cmd *cobra.Command, processName string) (*tools.Admin, error) {
+	configDirectory, err := cmd.Flags().GetString(FlagConf)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	var (
+		conf    tools.CronTaskConfig
+		logConf config.Log
+	)
+	configMap := map[string]any{
+		ShareFileName:             &conf.Share,
+		DiscoveryConfigFilename:   &conf.Discovery,
+		OpenIMCronTaskCfgFileName: &conf.CronTask,
+		LogConfigFileName:         &logConf,
+	}
+	for configFileName, configStruct := range configMap {
+		err := config.LoadConfig(filepath.Join(configDirectory, configFileName),
+			ConfigEnvPrefixMap[configFileName], configStruct)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = log.InitFromConfig("openim-service-log", processName, logConf.RemainLogLevel, false, logConf.IsJson,
+		logConf.StorageLocation, logConf.RemainRotationCount, logConf.RotationTime, version.Version, logConf.IsSimplify)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "failed to initialize logger")
+	}
+	return tools.NewAdmin(cmd.Context(), &conf)
+}
+
+func (c *ImctlCmd) userCmd() *cobra.Command {
+	userCmd := &cobra.Command{Use: "user", Short: "look up users and manage their sessions"}
+	get := &cobra.Command{
+		Use:   "get userID...",
+		Short: "show users",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			users, err := c.admin.GetUsers(c.ctx, args)
+			if err != nil {
+				return err
+			}
+			return c.printer.users(users)
+		},
+	}
+	logout := &cobra.Command{
+		Use:   "logout userID",
+		Short: "invalidate the tokens of a user and close their connections",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			platformID, _ := cmd.Flags().GetInt32("platform")
+			return c.admin.ForceLogout(c.ctx, args[0], platformID)
+		},
+	}
+	kick := &cobra.Command{
+		Use:   "kick userID...",
+		Short: "close the connections of users, keeping their tokens",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			platformID, _ := cmd.Flags().GetInt32("platform")
+			return c.admin.KickConnections(c.ctx, args, platformID)
+		},
+	}
+	logout.Flags().Int32("platform", 0, "platform ID, every platform if 0")
+	kick.Flags().Int32("platform", 0, "platform ID, every platform if 0")
+	userCmd.AddCommand(get, logout, kick)
+	return userCmd
+}
+
+func (c *ImctlCmd) groupCmd() *cobra.Command {
+	groupCmd := &cobra.Command{Use: "group", Short: "look up groups"}
+	groupCmd.AddCommand(&cobra.Command{
+		Use:   "get groupID...",
+		Short: "show groups",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			groups, err := c.admin.GetGroups(c.ctx, args)
+			if err != nil {
+				return err
+			}
+			return c.printer.groups(groups)
+		},
+	})
+	return groupCmd
+}
+
+func (c *ImctlCmd) conversationCmd() *cobra.Command {
+	conversationCmd := &cobra.Command{Use: "conversation", Short: "look up and clear conversations"}
+	get := &cobra.Command{
+		Use:   "get [conversationID...]",
+		Short: "show conversations, every conversation of --user without IDs",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			userID, _ := cmd.Flags().GetString("user")
+			conversations, err := c.admin.GetConversations(c.ctx, userID, args)
+			if err != nil {
+				return err
+			}
+			rows := make([][]string, 0, len(conversations))
+			for _, conversation := range conversations {
+				rows = append(rows, []string{conversation.ConversationID, conversation.OwnerUserID,
+					strconv.Itoa(int(conversation.ConversationType)), conversation.UserID, conversation.GroupID,
+					strconv.Itoa(int(conversation.RecvMsgOpt)), strconv.FormatBool(conversation.IsPinned),
+					strconv.FormatInt(conversation.MinSeq, 10), strconv.FormatInt(conversation.MaxSeq, 10)})
+			}
+			return c.printer.print(conversations,
+				[]string{"CONVERSATION_ID", "OWNER", "TYPE", "USER_ID", "GROUP_ID", "RECV_OPT", "PINNED", "MIN_SEQ", "MAX_SEQ"}, rows)
+		},
+	}
+	get.Flags().String("user", "", "owner of the conversations")
+	clear := &cobra.Command{
+		Use:   "clear conversationID...",
+		Short: "clear the messages of conversations for --user, or for every member with --everyone",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			userID, _ := cmd.Flags().GetString("user")
+			everyone, _ := cmd.Flags().GetBool("everyone")
+			return c.admin.ClearConversations(c.ctx, userID, args, everyone)
+		},
+	}
+	clear.Flags().String("user", "", "user clearing the conversations")
+	clear.Flags().Bool("everyone", false, "clear for every member of the conversations")
+	_ = clear.MarkFlagRequired("user")
+	conversationCmd.AddCommand(get, clear)
+	return conversationCmd
+}
+
+func (c *ImctlCmd) seqCmd() *cobra.Command {
+	seqCmd := &cobra.Command{Use: "seq", Short: "inspect and repair conversation seqs"}
+	newSeqCmd := func(use, short string, repair bool) *cobra.Command {
+		cmd := &cobra.Command{
+			Use:   use,
+			Short: short,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				userID, _ := cmd.Flags().GetString("user")
+				all, _ := cmd.Flags().GetBool("all")
+				checkMissing, _ := cmd.Flags().GetBool("missing")
+				onlyIssues, _ := cmd.Flags().GetBool("issues-only")
+				req := &msgext.CheckConversationSeqReq{Repair: repair, CheckMissing: checkMissing, OnlyIssues: onlyIssues}
+				resp, err := checkSeq(c.ctx, c.admin, req, args, userID, all)
+				if err != nil {
+					return err
+				}
+				return c.printer.seqs(resp)
+			},
+		}
+		cmd.Flags().String("user", "", "check the conversations of the user")
+		cmd.Flags().Bool("all", false, "check every conversation")
+		cmd.Flags().Bool("missing", false, "look for missing messages")
+		cmd.Flags().Bool("issues-only", false, "only show conversations with issues")
+		return cmd
+	}
+	seqCmd.AddCommand(
+		newSeqCmd("get [conversationID...]", "show the seqs of conversations and their inconsistencies", false),
+		newSeqCmd("fix [conversationID...]", "repair inconsistent seqs of conversations", true),
+	)
+	return seqCmd
+}
+
+// checkSeq checks the conversations and those of userID, or every conversation with all set.
+func checkSeq(ctx context.Context, admin *tools.Admin, req *msgext.CheckConversationSeqReq, conversationIDs []string, userID string, all bool) (*msgext.CheckConversationSeqResp, error) {
+	resp := &msgext.CheckConversationSeqResp{Summary: &msgext.SeqCheckSummary{}}
+	add := func(page *msgext.CheckConversationSeqResp) error {
+		resp.Reports = append(resp.Reports, page.Reports...)
+		addSeqCheckSummary(resp.Summary, page.Summary)
+		return nil
+	}
+	if all {
+		req.Count = seqCheckBatch
+		if err := admin.ScanSeq(ctx, req, add); err != nil {
+			return nil, err
+		}
+		return resp, nil
+	}
+	if userID != "" {
+		userConversationIDs, err := admin.GetConversationIDs(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		conversationIDs = append(conversationIDs, userConversationIDs...)
+	}
+	if len(conversationIDs) == 0 {
+		return nil, errs.ErrArgs.WrapMsg("conversation IDs, a user or all is required")
+	}
+	for i := 0; i < len(conversationIDs); i += seqCheckBatch {
+		req.ConversationIDs = conversationIDs[i:min(i+seqCheckBatch, len(conversationIDs))]
+		page, err := admin.CheckSeq(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		_ = add(page)
+	}
+	return resp, nil
+}
+
+func addSeqCheckSummary(sum, page *msgext.SeqCheckSummary) {
+	if page == nil {
+		return
+	}
+	sum.ConversationNum += page.ConversationNum
+	sum.InconsistentNum += page.InconsistentNum
+	sum.IssueNum += page.IssueNum
+	sum.RepairedNum += page.RepairedNum
+	sum.FailedNum += page.FailedNum
+	sum.MissingSeqNum += page.MissingSeqNum
+}
+
+func (c *ImctlCmd) msgCmd() *cobra.Command {
+	msgCmd := &cobra.Command{Use: "msg", Short: "read messages"}
+	msgCmd.AddCommand(&cobra.Command{
+		Use:   "get conversationID seq...",
+		Short: "show messages of a conversation by seq, a range is written as begin-end",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			seqs, err := parseSeqs(args[1:])
+			if err != nil {
+				return err
+			}
+			msgs, err := c.admin.GetMsgs(c.ctx, args[0], seqs)
+			if err != nil {
+				return err
+			}
+			return c.printer.msgs(msgs)
+		},
+	})
+	return msgCmd
+}
+
+// maxSeqRange bounds the seqs of one range so that a typo cannot read a whole conversation.
+const maxSeqRange = 1000
+
+func parseSeqs(args []string) ([]int64, error) {
+	var seqs []int64
+	for _, arg := range args {
+		begin, end, isRange := strings.Cut(arg, "-")
+		first, err := strconv.ParseInt(begin, 10, 64)
+		if err != nil || first <= 0 {
+			return nil, errs.ErrArgs.WrapMsg("invalid seq", "seq", arg)
+		}
+		last := first
+		if isRange {
+			last, err = strconv.ParseInt(end, 10, 64)
+			if err != nil || last < first || last-first >= maxSeqRange {
+				return nil, errs.ErrArgs.WrapMsg("invalid seq range", "range", arg, "max", maxSeqRange)
+			}
+		}
+		for seq := first; seq <= last; seq++ {
+			seqs = append(seqs, seq)
+		}
+	}
+	return seqs, nil
+}
+
+func (c *ImctlCmd) cronCmd() *cobra.Command {
+	cronCmd := &cobra.Command{Use: "cron", Short: "trigger cron jobs"}
+	run := &cobra.Command{
+		Use:       fmt.Sprintf("run {%s}", strings.Join(tools.CronJobNames, "|")),
+		Short:     "run a cron job once",
+		Args:      cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+		ValidArgs: tools.CronJobNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			allTenants, _ := cmd.Flags().GetBool("all-tenants")
+			return c.admin.RunCronJob(c.ctx, args[0], allTenants)
+		},
+	}
+	run.Flags().Bool("all-tenants", false, "run the job for every enabled tenant as well")
+	cronCmd.AddCommand(run)
+	return cronCmd
+}
+
+// printer writes results as JSON or as a table.
+type printer struct {
+	w      io.Writer
+	format string
+}
+
+func newPrinter(w io.Writer, format string) (*printer, error) {
+	if format != OutputTable && format != OutputJSON {
+		return nil, errs.ErrArgs.WrapMsg("invalid output format", "output", format)
+	}
+	return &printer{w: w, format: format}, nil
+}
+
+// print writes v as JSON, or the header and rows as a table.
+func (p *printer) print(v any, header []string, rows [][]string) error {
+	if p.format == OutputJSON {
+		data, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		_, err = fmt.Fprintln(p.w, string(data))
+		return errs.Wrap(err)
+	}
+	tw := tabwriter.NewWriter(p.w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(header, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return errs.Wrap(tw.Flush())
+}
+
+func (p *printer) users(users []*sdkws.UserInfo) error {
+	rows := make([][]string, 0, len(users))
+	for _, user := range users {
+		rows = append(rows, []string{user.UserID, user.Nickname, strconv.Itoa(int(user.AppMangerLevel)),
+			strconv.Itoa(int(user.GlobalRecvMsgOpt)), formatMilli(user.CreateTime)})
+	}
+	return p.print(users, []string{"USER_ID", "NICKNAME", "APP_LEVEL", "RECV_OPT", "CREATE_TIME"}, rows)
+}
+
+func (p *printer) groups(groups []*sdkws.GroupInfo) error {
+	rows := make([][]string, 0, len(groups))
+	for _, group := range groups {
+		rows = append(rows, []string{group.GroupID, group.GroupName, group.OwnerUserID,
+			strconv.Itoa(int(group.MemberCount)), strconv.Itoa(int(group.Status)), formatMilli(group.CreateTime)})
+	}
+	return p.print(groups, []string{"GROUP_ID", "NAME", "OWNER", "MEMBERS", "STATUS", "CREATE_TIME"}, rows)
+}
+
+// maxContentWidth truncates message contents in tables.
+const maxContentWidth = 64
+
+func (p *printer) msgs(msgs []*sdkws.MsgData) error {
+	rows := make([][]string, 0, len(msgs))
+	for _, msg := range msgs {
+		recvID := msg.RecvID
+		if msg.GroupID != "" {
+			recvID = msg.GroupID
+		}
+		content := []rune(strings.Join(strings.Fields(string(msg.Content)), " "))
+		if len(content) > maxContentWidth {
+			content = append(content[:maxContentWidth], '…')
+		}
+		rows = append(rows, []string{strconv.FormatInt(msg.Seq, 10), msg.ServerMsgID, msg.SendID, recvID,
+			strconv.Itoa(int(msg.ContentType)), strconv.Itoa(int(msg.Status)), formatMilli(msg.SendTime), string(content)})
+	}
+	return p.print(msgs, []string{"SEQ", "SERVER_MSG_ID", "SEND_ID", "RECV_ID", "CONTENT_TYPE", "STATUS", "SEND_TIME", "CONTENT"}, rows)
+}
+
+func (p *printer) seqs(resp *msgext.CheckConversationSeqResp) error {
+	rows := make([][]string, 0, len(resp.Reports))
+	for _, report := range resp.Reports {
+		issues := make([]string, 0, len(report.Issues))
+		for _, issue := range report.Issues {
+			s := fmt.Sprintf("%s(%d->%d)", issue.Type, issue.Seq, issue.Expected)
+			if issue.UserID != "" {
+				s = issue.UserID + ":" + s
+			}
+			if issue.Repaired {
+				s += "*"
+			}
+			issues = append(issues, s)
+		}
+		rows = append(rows, []string{report.ConversationID,
+			fmt.Sprintf("%d-%d", report.MinSeqMsg, report.MaxSeqMsg),
+			fmt.Sprintf("%d/%d", report.MaxSeqCache, report.LastSeqCache),
+			fmt.Sprintf("%d-%d", report.MinSeqDB, report.MaxSeqDB),
+			strconv.Itoa(int(report.UserNum)), strconv.FormatInt(report.MissingSeqNum, 10),
+			strings.Join(issues, ","), report.Error})
+	}
+	err := p.print(resp, []string{"CONVERSATION_ID", "MSG", "CACHE/LAST", "DB", "USERS", "MISSING", "ISSUES", "ERROR"}, rows)
+	if err != nil || p.format == OutputJSON {
+		return err
+	}
+	s := resp.Summary
+	_, err = fmt.Fprintf(p.w, "\nconversations: %d, inconsistent: %d, issues: %d, repaired: %d, failed: %d, missing seqs: %d\n",
+		s.ConversationNum, s.InconsistentNum, s.IssueNum, s.RepairedNum, s.FailedNum, s.MissingSeqNum)
+	return errs.Wrap(err)
+}
+
+func formatMilli(ms int64) string {
+	if ms <= 0 {
+		return ""
+	}
+	return time.UnixMilli(ms).Format(time.DateTime)
+}
@@ -0,0 +1,35 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseSeqs(t *testing.T) {
+	seqs, err := parseSeqs([]string{"3", "10-12"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int64{3, 10, 11, 12}; !reflect.DeepEqual(seqs, want) {
+		t.Errorf("seqs = %v, want %v", seqs, want)
+	}
+	for _, arg := range []string{"0", "a", "5-4", "1-1001", "-3"} {
+		if _, err := parseSeqs([]string{arg}); err == nil {
+			t.Errorf("parseSeqs(%q) succeeded", arg)
+		}
+	}
+}
@@ -35,15 +35,21 @@ func NewMsgRpcCmd() *MsgRpcCmd {
 	var msgConfig msg.Config
 	ret := &MsgRpcCmd{msgConfig: &msgConfig}
 	ret.configMap = map[string]any{
-		OpenIMRPCMsgCfgFileName:  &msgConfig.RpcConfig,
-		RedisConfigFileName:      &msgConfig.RedisConfig,
-		MongodbConfigFileName:    &msgConfig.MongodbConfig,
-		KafkaConfigFileName:      &msgConfig.KafkaConfig,
-		ShareFileName:            &msgConfig.Share,
-		NotificationFileName:     &msgConfig.NotificationConfig,
-		WebhooksConfigFileName:   &msgConfig.WebhooksConfig,
-		LocalCacheConfigFileName: &msgConfig.LocalCacheConfig,
-		DiscoveryConfigFilename:  &msgConfig.Discovery,
+		OpenIMRPCMsgCfgFileName:   &msgConfig.RpcConfig,
+		RedisConfigFileName:       &msgConfig.RedisConfig,
+		MongodbConfigFileName:     &msgConfig.MongodbConfig,
+		DatabaseConfigFileName:    &msgConfig.DatabaseConfig,
+		KafkaConfigFileName:       &msgConfig.KafkaConfig,
+		MQConfigFileName:          &msgConfig.MQConfig,
+		ShareFileName:             &msgConfig.Share,
+		NotificationFileName:      &msgConfig.NotificationConfig,
+		WebhooksConfigFileName:    &msgConfig.WebhooksConfig,
+		LocalCacheConfigFileName:  &msgConfig.LocalCacheConfig,
+		SearchConfigFileName:      &msgConfig.SearchConfig,
+		ModerationConfigFileName:  &msgConfig.ModerationConfig,
+		OpenIMRPCThirdCfgFileName: &msgConfig.ThirdConfig,
+		MinioConfigFileName:       &msgConfig.MinioConfig,
+		DiscoveryConfigFilename:   &msgConfig.Discovery,
 	}
 	ret.RootCmd = NewRootCmd(program.GetProcessName(), WithConfigMap(ret.configMap))
 	ret.ctx = context.WithValue(context.Background(), "version", version.Version)
@@ -37,9 +37,12 @@ func NewMsgTransferCmd() *MsgTransferCmd {
 		OpenIMMsgTransferCfgFileName: &msgTransferConfig.MsgTransfer,
 		RedisConfigFileName:          &msgTransferConfig.RedisConfig,
 		MongodbConfigFileName:        &msgTransferConfig.MongodbConfig,
+		DatabaseConfigFileName:       &msgTransferConfig.DatabaseConfig,
 		KafkaConfigFileName:          &msgTransferConfig.KafkaConfig,
+		MQConfigFileName:             &msgTransferConfig.MQConfig,
 		ShareFileName:                &msgTransferConfig.Share,
 		WebhooksConfigFileName:       &msgTransferConfig.WebhooksConfig,
+		SearchConfigFileName:         &msgTransferConfig.SearchConfig,
 		DiscoveryConfigFilename:      &msgTransferConfig.Discovery,
 	}
 	ret.RootCmd = NewRootCmd(program.GetProcessName(), WithConfigMap(ret.configMap))
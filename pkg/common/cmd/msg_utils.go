@@ -15,6 +15,15 @@
 package cmd
 
 import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/internal/tools"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/errs"
 	"github.com/spf13/cobra"
 )
 
@@ -43,19 +52,19 @@ func (m *MsgUtilsCmd) AddFixAllFlag() {
 	m.Command.PersistentFlags().BoolP("fixAll", "f", false, "openIM fix all seqs")
 }
 
-/* func (m *MsgUtilsCmd) getFixAllFlag(cmdLines *cobra.Command) bool {
+func (m *MsgUtilsCmd) getFixAllFlag(cmdLines *cobra.Command) bool {
 	fixAll, _ := cmdLines.Flags().GetBool("fixAll")
 	return fixAll
-} */
+}
 
 func (m *MsgUtilsCmd) AddClearAllFlag() {
 	m.Command.PersistentFlags().BoolP("clearAll", "", false, "openIM clear all seqs")
 }
 
-/* func (m *MsgUtilsCmd) getClearAllFlag(cmdLines *cobra.Command) bool {
+func (m *MsgUtilsCmd) getClearAllFlag(cmdLines *cobra.Command) bool {
 	clearAll, _ := cmdLines.Flags().GetBool("clearAll")
 	return clearAll
-} */
+}
 
 func (m *MsgUtilsCmd) AddSuperGroupIDFlag() {
 	m.Command.PersistentFlags().StringP("superGroupID", "g", "", "openIM superGroupID")
@@ -70,19 +79,19 @@ func (m *MsgUtilsCmd) AddBeginSeqFlag() {
 	m.Command.PersistentFlags().Int64P("beginSeq", "b", 0, "openIM beginSeq")
 }
 
-/* func (m *MsgUtilsCmd) getBeginSeqFlag(cmdLines *cobra.Command) int64 {
+func (m *MsgUtilsCmd) getBeginSeqFlag(cmdLines *cobra.Command) int64 {
 	beginSeq, _ := cmdLines.Flags().GetInt64("beginSeq")
 	return beginSeq
-} */
+}
 
 func (m *MsgUtilsCmd) AddLimitFlag() {
 	m.Command.PersistentFlags().Int64P("limit", "l", 0, "openIM limit")
 }
 
-/* func (m *MsgUtilsCmd) getLimitFlag(cmdLines *cobra.Command) int64 {
+func (m *MsgUtilsCmd) getLimitFlag(cmdLines *cobra.Command) int64 {
 	limit, _ := cmdLines.Flags().GetInt64("limit")
 	return limit
-} */
+}
 
 func (m *MsgUtilsCmd) Execute() error {
 	return m.Command.Execute()
@@ -128,6 +137,51 @@ func NewClearCmd() *ClearCmd {
 	}
 }
 
+// defaultMsgLimit is the number of messages read from beginSeq without --limit.
+const defaultMsgLimit = 10
+
+// run connects the admin client for the command and passes it the admin context and the
+// conversations selected by --userID or --superGroupID.
+func (m *MsgUtilsCmd) run(cmdLines *cobra.Command, fn func(ctx context.Context, admin *tools.Admin, conversationIDs []string) error) error {
+	admin, err := loadAdmin(cmdLines, "openim-cmdutils")
+	if err != nil {
+		return err
+	}
+	defer admin.Close()
+	ctx, err := admin.Context(cmdLines.Context(), "")
+	if err != nil {
+		return err
+	}
+	var conversationIDs []string
+	if userID := m.getUserIDFlag(cmdLines); userID != "" {
+		conversationIDs, err = admin.GetConversationIDs(ctx, userID)
+		if err != nil {
+			return err
+		}
+	}
+	if superGroupID := m.getSuperGroupIDFlag(cmdLines); superGroupID != "" {
+		conversationIDs = append(conversationIDs, msgprocessor.GetConversationIDBySessionType(constant.ReadGroupChatType, superGroupID))
+	}
+	return fn(ctx, admin, conversationIDs)
+}
+
+// seqRange returns the seqs selected by --beginSeq and --limit, nil without --beginSeq.
+func (m *MsgUtilsCmd) seqRange(cmdLines *cobra.Command) []int64 {
+	beginSeq := m.getBeginSeqFlag(cmdLines)
+	if beginSeq <= 0 {
+		return nil
+	}
+	limit := m.getLimitFlag(cmdLines)
+	if limit <= 0 {
+		limit = defaultMsgLimit
+	}
+	seqs := make([]int64, 0, limit)
+	for seq := beginSeq; seq < beginSeq+limit; seq++ {
+		seqs = append(seqs, seq)
+	}
+	return seqs
+}
+
 type SeqCmd struct {
 	*MsgUtilsCmd
 }
@@ -140,14 +194,36 @@ func NewSeqCmd() *SeqCmd {
 }
 
 func (s *SeqCmd) GetSeqCmd() *cobra.Command {
-	s.Command.Run = func(cmdLines *cobra.Command, args []string) {
-
+	return &cobra.Command{
+		Use:   "seq",
+		Short: "show the seqs of the conversations of --userID or --superGroupID",
+		RunE: func(cmdLines *cobra.Command, args []string) error {
+			return s.run(cmdLines, func(ctx context.Context, admin *tools.Admin, conversationIDs []string) error {
+				resp, err := checkSeq(ctx, admin, &msgext.CheckConversationSeqReq{}, conversationIDs, "", false)
+				if err != nil {
+					return err
+				}
+				return (&printer{w: os.Stdout, format: OutputTable}).seqs(resp)
+			})
+		},
 	}
-	return &s.Command
 }
 
 func (s *SeqCmd) FixSeqCmd() *cobra.Command {
-	return &s.Command
+	return &cobra.Command{
+		Use:   "seq",
+		Short: "repair the seqs of the conversations of --userID or --superGroupID, or of every conversation with --fixAll",
+		RunE: func(cmdLines *cobra.Command, args []string) error {
+			return s.run(cmdLines, func(ctx context.Context, admin *tools.Admin, conversationIDs []string) error {
+				req := &msgext.CheckConversationSeqReq{Repair: true, OnlyIssues: true}
+				resp, err := checkSeq(ctx, admin, req, conversationIDs, "", s.getFixAllFlag(cmdLines))
+				if err != nil {
+					return err
+				}
+				return (&printer{w: os.Stdout, format: OutputTable}).seqs(resp)
+			})
+		},
+	}
 }
 
 type MsgCmd struct {
@@ -162,9 +238,79 @@ func NewMsgCmd() *MsgCmd {
 }
 
 func (m *MsgCmd) GetMsgCmd() *cobra.Command {
-	return &m.Command
+	return &cobra.Command{
+		Use:   "msg",
+		Short: "show the messages from --beginSeq of the conversations of --userID or --superGroupID",
+		RunE: func(cmdLines *cobra.Command, args []string) error {
+			seqs := m.seqRange(cmdLines)
+			if len(seqs) == 0 {
+				return errs.ErrArgs.WrapMsg("beginSeq is required")
+			}
+			return m.run(cmdLines, func(ctx context.Context, admin *tools.Admin, conversationIDs []string) error {
+				if len(conversationIDs) == 0 {
+					return errs.ErrArgs.WrapMsg("userID or superGroupID is required")
+				}
+				p := &printer{w: os.Stdout, format: OutputTable}
+				for _, conversationID := range conversationIDs {
+					msgs, err := admin.GetMsgs(ctx, conversationID, seqs)
+					if err != nil {
+						return err
+					}
+					if len(msgs) == 0 {
+						continue
+					}
+					if _, err := os.Stdout.WriteString(conversationID + ":\n"); err != nil {
+						return errs.Wrap(err)
+					}
+					if err := p.msgs(msgs); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+		},
+	}
 }
 
+// ClearMsgCmd physically deletes the messages from --beginSeq of the selected conversations.
+// Without --beginSeq it clears the conversations of --userID for that user, the conversation
+// of --superGroupID for every member, and with --clearAll it deletes the messages of every
+// conversation sent before now.
 func (m *MsgCmd) ClearMsgCmd() *cobra.Command {
-	return &m.Command
+	return &cobra.Command{
+		Use:   "msg",
+		Short: "clear the messages of the conversations of --userID or --superGroupID, or of every conversation with --clearAll",
+		RunE: func(cmdLines *cobra.Command, args []string) error {
+			return m.run(cmdLines, func(ctx context.Context, admin *tools.Admin, conversationIDs []string) error {
+				if m.getClearAllFlag(cmdLines) {
+					return admin.ClearMsgBefore(ctx, time.Now())
+				}
+				if seqs := m.seqRange(cmdLines); len(seqs) > 0 {
+					if len(conversationIDs) == 0 {
+						return errs.ErrArgs.WrapMsg("userID or superGroupID is required")
+					}
+					for _, conversationID := range conversationIDs {
+						if err := admin.DeleteMsgs(ctx, conversationID, seqs); err != nil {
+							return err
+						}
+					}
+					return nil
+				}
+				userID, superGroupID := m.getUserIDFlag(cmdLines), m.getSuperGroupIDFlag(cmdLines)
+				if userID == "" && superGroupID == "" {
+					return errs.ErrArgs.WrapMsg("userID, superGroupID or clearAll is required")
+				}
+				if userID != "" {
+					if err := admin.ClearUserMsgs(ctx, userID, false); err != nil {
+						return err
+					}
+				}
+				if superGroupID != "" {
+					conversationID := msgprocessor.GetConversationIDBySessionType(constant.ReadGroupChatType, superGroupID)
+					return admin.DeleteMsgsBefore(ctx, []string{conversationID}, time.Now())
+				}
+				return nil
+			})
+		},
+	}
 }
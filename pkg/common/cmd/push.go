@@ -39,6 +39,7 @@ func NewPushRpcCmd() *PushRpcCmd {
 		RedisConfigFileName:      &pushConfig.RedisConfig,
 		MongodbConfigFileName:    &pushConfig.MongodbConfig,
 		KafkaConfigFileName:      &pushConfig.KafkaConfig,
+		MQConfigFileName:         &pushConfig.MQConfig,
 		ShareFileName:            &pushConfig.Share,
 		NotificationFileName:     &pushConfig.NotificationConfig,
 		WebhooksConfigFileName:   &pushConfig.WebhooksConfig,
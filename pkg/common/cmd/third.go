@@ -38,6 +38,7 @@ func NewThirdRpcCmd() *ThirdRpcCmd {
 		OpenIMRPCThirdCfgFileName: &thirdConfig.RpcConfig,
 		RedisConfigFileName:       &thirdConfig.RedisConfig,
 		MongodbConfigFileName:     &thirdConfig.MongodbConfig,
+		DatabaseConfigFileName:    &thirdConfig.DatabaseConfig,
 		ShareFileName:             &thirdConfig.Share,
 		NotificationFileName:      &thirdConfig.NotificationConfig,
 		MinioConfigFileName:       &thirdConfig.MinioConfig,
@@ -35,15 +35,19 @@ func NewUserRpcCmd() *UserRpcCmd {
 	var userConfig user.Config
 	ret := &UserRpcCmd{userConfig: &userConfig}
 	ret.configMap = map[string]any{
-		OpenIMRPCUserCfgFileName: &userConfig.RpcConfig,
-		RedisConfigFileName:      &userConfig.RedisConfig,
-		MongodbConfigFileName:    &userConfig.MongodbConfig,
-		KafkaConfigFileName:      &userConfig.KafkaConfig,
-		ShareFileName:            &userConfig.Share,
-		NotificationFileName:     &userConfig.NotificationConfig,
-		WebhooksConfigFileName:   &userConfig.WebhooksConfig,
-		LocalCacheConfigFileName: &userConfig.LocalCacheConfig,
-		DiscoveryConfigFilename:  &userConfig.Discovery,
+		OpenIMRPCUserCfgFileName:  &userConfig.RpcConfig,
+		RedisConfigFileName:       &userConfig.RedisConfig,
+		MongodbConfigFileName:     &userConfig.MongodbConfig,
+		DatabaseConfigFileName:    &userConfig.DatabaseConfig,
+		KafkaConfigFileName:       &userConfig.KafkaConfig,
+		MQConfigFileName:          &userConfig.MQConfig,
+		ShareFileName:             &userConfig.Share,
+		NotificationFileName:      &userConfig.NotificationConfig,
+		WebhooksConfigFileName:    &userConfig.WebhooksConfig,
+		LocalCacheConfigFileName:  &userConfig.LocalCacheConfig,
+		OpenIMRPCThirdCfgFileName: &userConfig.ThirdConfig,
+		MinioConfigFileName:       &userConfig.MinioConfig,
+		DiscoveryConfigFilename:   &userConfig.Discovery,
 	}
 	ret.RootCmd = NewRootCmd(program.GetProcessName(), WithConfigMap(ret.configMap))
 	ret.ctx = context.WithValue(context.Background(), "version", version.Version)
@@ -63,6 +63,26 @@ type Minio struct {
 	PublicRead      bool   `mapstructure:"publicRead"`
 }
 
+type Database struct {
+	Enable   string   `mapstructure:"enable"`
+	Postgres Postgres `mapstructure:"postgres"`
+}
+
+type Postgres struct {
+	DSN                string `mapstructure:"dsn"`
+	Address            string `mapstructure:"address"`
+	Database           string `mapstructure:"database"`
+	Username           string `mapstructure:"username"`
+	Password           string `mapstructure:"password"`
+	SSLMode            string `mapstructure:"sslMode"`
+	MaxOpenConns       int    `mapstructure:"maxOpenConns"`
+	MaxIdleConns       int    `mapstructure:"maxIdleConns"`
+	MaxLifetime        int    `mapstructure:"maxLifetime"`
+	SlowThreshold      int    `mapstructure:"slowThreshold"`
+	TenantMaxOpenConns int    `mapstructure:"tenantMaxOpenConns"`
+	TenantMaxPools     int    `mapstructure:"tenantMaxPools"`
+}
+
 type Mongo struct {
 	URI         string   `mapstructure:"uri"`
 	Address     []string `mapstructure:"address"`
@@ -73,19 +93,33 @@ type Mongo struct {
 	MaxRetry    int      `mapstructure:"maxRetry"`
 }
 type Kafka struct {
-	Username       string    `mapstructure:"username"`
-	Password       string    `mapstructure:"password"`
-	ProducerAck    string    `mapstructure:"producerAck"`
-	CompressType   string    `mapstructure:"compressType"`
-	Address        []string  `mapstructure:"address"`
-	ToRedisTopic   string    `mapstructure:"toRedisTopic"`
-	ToMongoTopic   string    `mapstructure:"toMongoTopic"`
-	ToPushTopic    string    `mapstructure:"toPushTopic"`
-	ToRedisGroupID string    `mapstructure:"toRedisGroupID"`
-	ToMongoGroupID string    `mapstructure:"toMongoGroupID"`
-	ToPushGroupID  string    `mapstructure:"toPushGroupID"`
-	Tls            TLSConfig `mapstructure:"tls"`
+	Username                 string    `mapstructure:"username"`
+	Password                 string    `mapstructure:"password"`
+	ProducerAck              string    `mapstructure:"producerAck"`
+	CompressType             string    `mapstructure:"compressType"`
+	Address                  []string  `mapstructure:"address"`
+	ToRedisTopic             string    `mapstructure:"toRedisTopic"`
+	ToMongoTopic             string    `mapstructure:"toMongoTopic"`
+	ToPushTopic              string    `mapstructure:"toPushTopic"`
+	ToRedisGroupID           string    `mapstructure:"toRedisGroupID"`
+	ToMongoGroupID           string    `mapstructure:"toMongoGroupID"`
+	ToPushGroupID            string    `mapstructure:"toPushGroupID"`
+	ToMongoDeadLetterTopic   string    `mapstructure:"toMongoDeadLetterTopic"`
+	ToMongoDeadLetterGroupID string    `mapstructure:"toMongoDeadLetterGroupID"`
+	Tls                      TLSConfig `mapstructure:"tls"`
+}
+type MQ struct {
+	Enable string  `mapstructure:"enable"`
+	Redis  MQRedis `mapstructure:"redis"`
+}
+
+type MQRedis struct {
+	Partitions   int   `mapstructure:"partitions"`
+	MaxLen       int64 `mapstructure:"maxLen"`
+	LeaseTimeout int   `mapstructure:"leaseTimeout"`
+	BatchSize    int64 `mapstructure:"batchSize"`
 }
+
 type TLSConfig struct {
 	EnableTLS          bool   `mapstructure:"enableTLS"`
 	CACrt              string `mapstructure:"caCrt"`
@@ -148,6 +182,7 @@ type Notification struct {
 	GroupMemberSetToOrdinary  NotificationConfig `yaml:"groupMemberSetToOrdinaryUser"`
 	GroupInfoSetAnnouncement  NotificationConfig `mapstructure:"groupInfoSetAnnouncement"`
 	GroupInfoSetName          NotificationConfig `mapstructure:"groupInfoSetName"`
+	GroupRoleChanged          NotificationConfig `mapstructure:"groupRoleChanged"`
 	FriendApplicationAdded    NotificationConfig `mapstructure:"friendApplicationAdded"`
 	FriendApplicationApproved NotificationConfig `mapstructure:"friendApplicationApproved"`
 	FriendApplicationRejected NotificationConfig `mapstructure:"friendApplicationRejected"`
@@ -186,6 +221,11 @@ type MsgGateway struct {
 
 type MsgTransfer struct {
 	Prometheus Prometheus `mapstructure:"prometheus"`
+	ToMongo    struct {
+		MaxRetries       int `mapstructure:"maxRetries"`
+		RetryInterval    int `mapstructure:"retryInterval"`
+		MaxRetryInterval int `mapstructure:"maxRetryInterval"`
+	} `mapstructure:"toMongo"`
 }
 
 type Push struct {
@@ -220,6 +260,11 @@ type Push struct {
 		BadgeCount bool   `mapstructure:"badgeCount"`
 		Production bool   `mapstructure:"production"`
 	} `mapstructure:"iosPush"`
+	Channel struct {
+		PageSize    int  `mapstructure:"pageSize"`
+		Concurrency int  `mapstructure:"concurrency"`
+		OfflinePush bool `mapstructure:"offlinePush"`
+	} `mapstructure:"channel"`
 }
 
 type Auth struct {
@@ -258,8 +303,34 @@ type Group struct {
 		ListenIP   string `mapstructure:"listenIP"`
 		Ports      []int  `mapstructure:"ports"`
 	} `mapstructure:"rpc"`
-	Prometheus                 Prometheus `mapstructure:"prometheus"`
-	EnableHistoryForNewMembers bool       `mapstructure:"enableHistoryForNewMembers"`
+	Prometheus                 Prometheus      `mapstructure:"prometheus"`
+	EnableHistoryForNewMembers bool            `mapstructure:"enableHistoryForNewMembers"`
+	ApplicationExpireHours     int             `mapstructure:"applicationExpireHours"`
+	Quota                      GroupQuota      `mapstructure:"quota"`
+	Succession                 GroupSuccession `mapstructure:"succession"`
+}
+
+// GroupSuccession is the succession policy of groups without their own.
+type GroupSuccession struct {
+	Policy            int32 `mapstructure:"policy"`
+	OwnerInactiveDays int   `mapstructure:"ownerInactiveDays"`
+}
+
+// GroupQuota limits groups and their members, 0 is no limit.
+type GroupQuota struct {
+	MaxCreatedGroups       int               `mapstructure:"maxCreatedGroups"`
+	MaxJoinedGroups        int               `mapstructure:"maxJoinedGroups"`
+	MaxWorkingGroupMembers int               `mapstructure:"maxWorkingGroupMembers"`
+	MaxChannelMembers      int               `mapstructure:"maxChannelMembers"`
+	Levels                 []GroupLevelQuota `mapstructure:"levels"`
+}
+
+// GroupLevelQuota replaces the user quotas for the users of an AppMangerLevel, the ones left
+// unset are kept.
+type GroupLevelQuota struct {
+	AppMangerLevel   int32 `mapstructure:"appMangerLevel"`
+	MaxCreatedGroups *int  `mapstructure:"maxCreatedGroups"`
+	MaxJoinedGroups  *int  `mapstructure:"maxJoinedGroups"`
 }
 
 type Msg struct {
@@ -268,8 +339,56 @@ type Msg struct {
 		ListenIP   string `mapstructure:"listenIP"`
 		Ports      []int  `mapstructure:"ports"`
 	} `mapstructure:"rpc"`
-	Prometheus   Prometheus `mapstructure:"prometheus"`
-	FriendVerify bool       `mapstructure:"friendVerify"`
+	Prometheus       Prometheus `mapstructure:"prometheus"`
+	FriendVerify     bool       `mapstructure:"friendVerify"`
+	GroupReadReceipt struct {
+		MaxMemberCount int `mapstructure:"maxMemberCount"`
+	} `mapstructure:"groupReadReceipt"`
+	Forward struct {
+		MaxMsgs       int `mapstructure:"maxMsgs"`
+		MaxMergedMsgs int `mapstructure:"maxMergedMsgs"`
+		MaxTargets    int `mapstructure:"maxTargets"`
+	} `mapstructure:"forward"`
+	Idempotency struct {
+		Window int `mapstructure:"window"`
+	} `mapstructure:"idempotency"`
+	Archive struct {
+		Enable      bool `mapstructure:"enable"`
+		AfterDays   int  `mapstructure:"afterDays"`
+		CacheExpire int  `mapstructure:"cacheExpire"`
+	} `mapstructure:"archive"`
+}
+
+type Search struct {
+	Enable   bool   `mapstructure:"enable"`
+	Backend  string `mapstructure:"backend"`
+	Embedded struct {
+		Dir             string `mapstructure:"dir"`
+		SegmentMaxSize  int64  `mapstructure:"segmentMaxSize"`
+		RefreshInterval int    `mapstructure:"refreshInterval"`
+	} `mapstructure:"embedded"`
+	Mongo struct {
+		AtlasSearchIndex string `mapstructure:"atlasSearchIndex"`
+	} `mapstructure:"mongo"`
+	BatchSize int `mapstructure:"batchSize"`
+}
+
+type Moderation struct {
+	Enable   bool     `mapstructure:"enable"`
+	Stages   []string `mapstructure:"stages"`
+	MaskChar string   `mapstructure:"maskChar"`
+	Keyword  struct {
+		RulesFile      string `mapstructure:"rulesFile"`
+		ReloadInterval int    `mapstructure:"reloadInterval"`
+	} `mapstructure:"keyword"`
+	GroupWord struct {
+		DefaultAction string `mapstructure:"defaultAction"`
+		MaxWords      int    `mapstructure:"maxWords"`
+	} `mapstructure:"groupWord"`
+	Link struct {
+		Allowlist []string `mapstructure:"allowlist"`
+		Action    string   `mapstructure:"action"`
+	} `mapstructure:"link"`
 }
 
 type Third struct {
@@ -328,6 +447,17 @@ type User struct {
 		Ports      []int  `mapstructure:"ports"`
 	} `mapstructure:"rpc"`
 	Prometheus Prometheus `mapstructure:"prometheus"`
+	Export     struct {
+		Enable    bool `mapstructure:"enable"`
+		PageSize  int  `mapstructure:"pageSize"`
+		URLExpire int  `mapstructure:"urlExpire"`
+	} `mapstructure:"export"`
+	Erase struct {
+		Enable          bool   `mapstructure:"enable"`
+		MsgPolicy       string `mapstructure:"msgPolicy"`
+		AnonymousUserID string `mapstructure:"anonymousUserID"`
+		BatchSize       int    `mapstructure:"batchSize"`
+	} `mapstructure:"erase"`
 }
 
 type Redis struct {
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"encoding/json"
+
 	"github.com/mitchellh/mapstructure"
 	"github.com/openimsdk/tools/errs"
 	"github.com/spf13/viper"
@@ -25,3 +27,25 @@ func LoadConfig(path string, envPrefix string, config any) error {
 	}
 	return nil
 }
+
+// MergeNotification returns a copy of base with the entries of data, a JSON object keyed
+// like notification.yml, applied on top. Entries missing from data keep the value of base.
+func MergeNotification(base *Notification, data string) (*Notification, error) {
+	var m map[string]any
+	if err := json.Unmarshal([]byte(data), &m); err != nil {
+		return nil, errs.WrapMsg(err, "invalid notification config")
+	}
+	conf := *base
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		TagName:     "mapstructure",
+		Result:      &conf,
+		ErrorUnused: true,
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if err := decoder.Decode(m); err != nil {
+		return nil, errs.WrapMsg(err, "invalid notification config")
+	}
+	return &conf, nil
+}
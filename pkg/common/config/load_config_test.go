@@ -59,3 +59,30 @@ func TestLoadOpenIMThirdConfig(t *testing.T) {
 
 	// Environment: IMENV_OPENIM_RPC_THIRD_OBJECT_ENABLE=enabled;IMENV_OPENIM_RPC_THIRD_OBJECT_OSS_ENDPOINT=https://oss-cn-chengdu.aliyuncs.com;IMENV_OPENIM_RPC_THIRD_OBJECT_OSS_BUCKET=my_bucket_name;IMENV_OPENIM_RPC_THIRD_OBJECT_OSS_BUCKETURL=https://my_bucket_name.oss-cn-chengdu.aliyuncs.com;IMENV_OPENIM_RPC_THIRD_OBJECT_OSS_ACCESSKEYID=AKID1234567890;IMENV_OPENIM_RPC_THIRD_OBJECT_OSS_ACCESSKEYSECRET=abc123xyz789;IMENV_OPENIM_RPC_THIRD_OBJECT_OSS_SESSIONTOKEN=session_token_value;IMENV_OPENIM_RPC_THIRD_OBJECT_OSS_PUBLICREAD=true
 }
+
+func TestMergeNotification(t *testing.T) {
+	var noti Notification
+	err := LoadConfig("../../../config/notification.yml", "IMENV_NOTIFICATION", &noti)
+	assert.Nil(t, err)
+	merged, err := MergeNotification(&noti, `{"friendRemarkSet":{"offlinePush":{"title":"Remark changed"}}}`)
+	assert.Nil(t, err)
+	assert.Equal(t, "Remark changed", merged.FriendRemarkSet.OfflinePush.Title)
+	assert.Equal(t, noti.FriendRemarkSet.IsSendMsg, merged.FriendRemarkSet.IsSendMsg)
+	assert.Equal(t, "Your friend's profile has been changed", noti.FriendRemarkSet.OfflinePush.Title)
+	_, err = MergeNotification(&noti, `{"friendRemark":{}}`)
+	assert.NotNil(t, err)
+}
+
+func TestLoadOpenIMRpcGroupConfig(t *testing.T) {
+	var group Group
+	err := LoadConfig("../../../config/openim-rpc-group.yml", "IMENV_OPENIM_RPC_GROUP", &group)
+	assert.Nil(t, err)
+	assert.Equal(t, 168, group.ApplicationExpireHours)
+	assert.Len(t, group.Quota.Levels, 1)
+	level := group.Quota.Levels[0]
+	assert.Equal(t, int32(2), level.AppMangerLevel)
+	if assert.NotNil(t, level.MaxCreatedGroups) {
+		assert.Equal(t, 0, *level.MaxCreatedGroups)
+	}
+	assert.Equal(t, int32(1), group.Succession.Policy)
+}
@@ -0,0 +1,47 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	pbtenant "github.com/openimsdk/open-im-server/v3/pkg/protocol/tenant"
+)
+
+func TenantDB2Pb(t *model.Tenant) *pbtenant.TenantInfo {
+	return &pbtenant.TenantInfo{
+		TenantID:     t.TenantID,
+		Name:         t.Name,
+		Secret:       t.Secret,
+		AdminUserIDs: t.AdminUserIDs,
+		WebhookURL:   t.WebhookURL,
+		Notification: t.Notification,
+		Status:       t.Status,
+		Ex:           t.Ex,
+		CreateTime:   t.CreateTime.UnixMilli(),
+		UpdateTime:   t.UpdateTime.UnixMilli(),
+	}
+}
+
+func TenantPb2Info(t *pbtenant.TenantInfo) *tenant.Info {
+	return &tenant.Info{
+		TenantID:     t.TenantID,
+		Secret:       t.Secret,
+		AdminUserIDs: t.AdminUserIDs,
+		WebhookURL:   t.WebhookURL,
+		Notification: t.Notification,
+		Disabled:     t.Status == model.TenantDisabled,
+	}
+}
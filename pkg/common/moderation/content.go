@@ -0,0 +1,86 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moderation
+
+import (
+	"encoding/json"
+
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/errs"
+)
+
+// textKeys lists the user written fields of each moderated content type.
+var textKeys = map[int32][]string{
+	constant.Text:         {"content"},
+	constant.AtText:       {"text"},
+	constant.Quote:        {"text"},
+	constant.AdvancedText: {"text"},
+	constant.File:         {"fileName"},
+}
+
+type content struct {
+	raw   []byte
+	elem  map[string]json.RawMessage
+	keys  []string
+	plain bool
+	Texts []string
+}
+
+func parseContent(contentType int32, raw []byte) *content {
+	c := &content{raw: raw}
+	keys, ok := textKeys[contentType]
+	if !ok || len(raw) == 0 {
+		return c
+	}
+	if err := json.Unmarshal(raw, &c.elem); err != nil {
+		// Some clients send plain text messages without the JSON envelope.
+		if contentType == constant.Text {
+			c.plain = true
+			c.Texts = []string{string(raw)}
+		}
+		return c
+	}
+	for _, key := range keys {
+		var text string
+		if value, ok := c.elem[key]; !ok || json.Unmarshal(value, &text) != nil {
+			continue
+		}
+		c.keys = append(c.keys, key)
+		c.Texts = append(c.Texts, text)
+	}
+	return c
+}
+
+// Bytes encodes the content with the current texts, keeping all other fields.
+func (c *content) Bytes() ([]byte, error) {
+	if c.plain {
+		return []byte(c.Texts[0]), nil
+	}
+	if c.elem == nil {
+		return c.raw, nil
+	}
+	for i, key := range c.keys {
+		value, err := json.Marshal(c.Texts[i])
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		c.elem[key] = value
+	}
+	data, err := json.Marshal(c.elem)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return data, nil
+}
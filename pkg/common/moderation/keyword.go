@@ -0,0 +1,229 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moderation
+
+import (
+	"context"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"gopkg.in/yaml.v3"
+)
+
+// Rule matches text either by a list of keywords or by a regular expression.
+type Rule struct {
+	Name     string   `yaml:"name"`
+	Keywords []string `yaml:"keywords"`
+	Regex    string   `yaml:"regex"`
+	Action   string   `yaml:"action"`
+}
+
+type compiledRule struct {
+	name   string
+	re     *regexp.Regexp
+	action Action
+}
+
+func (r *compiledRule) match(msg *Message) []*Verdict {
+	var res []*Verdict
+	for i, text := range msg.Texts {
+		ranges := r.re.FindAllStringIndex(text, -1)
+		if len(ranges) == 0 {
+			continue
+		}
+		res = append(res, &Verdict{
+			Action: r.action,
+			Rule:   r.name,
+			Reason: text[ranges[0][0]:ranges[0][1]],
+			Field:  i,
+			Ranges: ranges,
+		})
+	}
+	return res
+}
+
+// CompileWords builds a case-insensitive matcher for a list of literal words, nil when
+// the list is empty.
+func CompileWords(words []string) (*regexp.Regexp, error) {
+	quoted := make([]string, 0, len(words))
+	for _, word := range words {
+		if word = strings.TrimSpace(word); word != "" {
+			quoted = append(quoted, regexp.QuoteMeta(word))
+		}
+	}
+	if len(quoted) == 0 {
+		return nil, nil
+	}
+	re, err := regexp.Compile("(?i)(?:" + strings.Join(quoted, "|") + ")")
+	if err != nil {
+		return nil, errs.WrapMsg(err, "compile words failed")
+	}
+	return re, nil
+}
+
+// MatchWords reports every text of msg that contains one of the words of re.
+func MatchWords(msg *Message, rule string, re *regexp.Regexp, action Action) []*Verdict {
+	if re == nil {
+		return nil
+	}
+	return (&compiledRule{name: rule, re: re, action: action}).match(msg)
+}
+
+func compileRules(rules []Rule) ([]*compiledRule, error) {
+	res := make([]*compiledRule, 0, len(rules))
+	for i, rule := range rules {
+		action, err := ParseAction(rule.Action)
+		if err != nil {
+			return nil, errs.WrapMsg(err, "invalid rule", "index", i, "name", rule.Name)
+		}
+		var re *regexp.Regexp
+		switch {
+		case rule.Regex != "" && len(rule.Keywords) > 0:
+			return nil, errs.ErrArgs.WrapMsg("rule has both keywords and regex", "index", i, "name", rule.Name)
+		case rule.Regex != "":
+			if re, err = regexp.Compile(rule.Regex); err != nil {
+				return nil, errs.WrapMsg(err, "invalid rule regex", "index", i, "name", rule.Name)
+			}
+		default:
+			if re, err = CompileWords(rule.Keywords); err != nil {
+				return nil, err
+			}
+		}
+		if re == nil {
+			continue
+		}
+		name := rule.Name
+		if name == "" {
+			name = re.String()
+		}
+		res = append(res, &compiledRule{name: name, re: re, action: action})
+	}
+	return res, nil
+}
+
+// LoadRules reads a rule file of the form {rules: [...]}.
+func LoadRules(path string) ([]Rule, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "read moderation rules failed", "path", path)
+	}
+	var file struct {
+		Rules []Rule `yaml:"rules"`
+	}
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, errs.WrapMsg(err, "parse moderation rules failed", "path", path)
+	}
+	return file.Rules, nil
+}
+
+// KeywordStage applies the keyword and regex rules of a rule file. The file is checked
+// for changes at most once per reload interval; a file that fails to load leaves the
+// previous rules in place.
+type KeywordStage struct {
+	path           string
+	reloadInterval time.Duration
+
+	mu        sync.RWMutex
+	rules     []*compiledRule
+	modTime   time.Time
+	lastCheck time.Time
+}
+
+// NewKeywordStage loads the rule file, which must be valid at startup.
+func NewKeywordStage(path string, reloadInterval time.Duration) (*KeywordStage, error) {
+	k := &KeywordStage{path: path, reloadInterval: reloadInterval}
+	if err := k.load(); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+func (k *KeywordStage) Name() string {
+	return "keyword"
+}
+
+func (k *KeywordStage) load() error {
+	info, err := os.Stat(k.path)
+	if err != nil {
+		return errs.WrapMsg(err, "stat moderation rules failed", "path", k.path)
+	}
+	rules, err := LoadRules(k.path)
+	if err != nil {
+		return err
+	}
+	compiled, err := compileRules(rules)
+	if err != nil {
+		return err
+	}
+	k.mu.Lock()
+	k.rules = compiled
+	k.modTime = info.ModTime()
+	k.lastCheck = time.Now()
+	k.mu.Unlock()
+	return nil
+}
+
+func (k *KeywordStage) reload(ctx context.Context) {
+	if k.reloadInterval <= 0 {
+		return
+	}
+	k.mu.Lock()
+	if time.Since(k.lastCheck) < k.reloadInterval {
+		k.mu.Unlock()
+		return
+	}
+	k.lastCheck = time.Now()
+	modTime := k.modTime
+	k.mu.Unlock()
+	info, err := os.Stat(k.path)
+	if err != nil {
+		log.ZWarn(ctx, "stat moderation rules failed", err, "path", k.path)
+		return
+	}
+	if info.ModTime().Equal(modTime) {
+		return
+	}
+	if err := k.load(); err != nil {
+		log.ZWarn(ctx, "reload moderation rules failed, keep the previous rules", err, "path", k.path)
+		return
+	}
+	log.ZInfo(ctx, "moderation rules reloaded", "path", k.path)
+}
+
+func (k *KeywordStage) Moderate(ctx context.Context, msg *Message) ([]*Verdict, error) {
+	if len(msg.Texts) == 0 {
+		return nil, nil
+	}
+	k.reload(ctx)
+	k.mu.RLock()
+	rules := k.rules
+	k.mu.RUnlock()
+	var res []*Verdict
+	for _, rule := range rules {
+		verdicts := rule.match(msg)
+		for _, v := range verdicts {
+			res = append(res, v)
+			if v.Action == ActionBlock {
+				return res, nil
+			}
+		}
+	}
+	return res, nil
+}
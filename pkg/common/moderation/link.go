@@ -0,0 +1,85 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moderation
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var linkRegexp = regexp.MustCompile(`(?i)\b(?:https?://|www\.)[^\s<>"'\x60]+`)
+
+// LinkStage reports links to hosts outside an allowlist. A host is allowed when it equals
+// an allowlisted domain or is a subdomain of one.
+type LinkStage struct {
+	allowlist []string
+	action    Action
+}
+
+func NewLinkStage(allowlist []string, action Action) *LinkStage {
+	domains := make([]string, 0, len(allowlist))
+	for _, domain := range allowlist {
+		if domain = strings.Trim(strings.ToLower(strings.TrimSpace(domain)), "."); domain != "" {
+			domains = append(domains, domain)
+		}
+	}
+	return &LinkStage{allowlist: domains, action: action}
+}
+
+func (l *LinkStage) Name() string {
+	return "link"
+}
+
+func (l *LinkStage) allowed(link string) bool {
+	if !strings.Contains(link, "://") {
+		link = "http://" + link
+	}
+	u, err := url.Parse(link)
+	if err != nil {
+		return false
+	}
+	host := strings.ToLower(u.Hostname())
+	for _, domain := range l.allowlist {
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *LinkStage) Moderate(ctx context.Context, msg *Message) ([]*Verdict, error) {
+	var res []*Verdict
+	for i, text := range msg.Texts {
+		var ranges [][]int
+		for _, r := range linkRegexp.FindAllStringIndex(text, -1) {
+			if !l.allowed(text[r[0]:r[1]]) {
+				ranges = append(ranges, r)
+			}
+		}
+		if len(ranges) == 0 {
+			continue
+		}
+		res = append(res, &Verdict{
+			Action: l.action,
+			Rule:   "link allowlist",
+			Reason: text[ranges[0][0]:ranges[0][1]],
+			Field:  i,
+			Ranges: ranges,
+		})
+	}
+	return res, nil
+}
@@ -0,0 +1,196 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package moderation runs the text of outgoing messages through an ordered chain of
+// stages before the messages are persisted.
+package moderation
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+)
+
+// Action is what a stage wants done with a message. Actions are ordered by severity.
+type Action int
+
+const (
+	ActionPass Action = iota
+	ActionFlag
+	ActionMask
+	ActionBlock
+)
+
+func (a Action) String() string {
+	switch a {
+	case ActionFlag:
+		return "flag"
+	case ActionMask:
+		return "mask"
+	case ActionBlock:
+		return "block"
+	default:
+		return "pass"
+	}
+}
+
+// ParseAction parses the action names used in configuration and rule files.
+func ParseAction(s string) (Action, error) {
+	switch strings.ToLower(s) {
+	case "flag":
+		return ActionFlag, nil
+	case "mask":
+		return ActionMask, nil
+	case "block":
+		return ActionBlock, nil
+	default:
+		return ActionPass, errs.ErrArgs.WrapMsg("unknown moderation action, expected block, mask or flag", "action", s)
+	}
+}
+
+// Verdict is one rule hit reported by a stage.
+type Verdict struct {
+	Action Action
+	Stage  string
+	Rule   string
+	Reason string
+	// Field and Ranges locate the matched text for ActionMask, Ranges are byte offsets
+	// into Message.Texts[Field].
+	Field  int
+	Ranges [][]int
+}
+
+// Message is the view of a message handed to a stage.
+type Message struct {
+	Data *sdkws.MsgData
+	// Texts are the moderatable text fields of the content, with the masks of earlier
+	// stages applied.
+	Texts []string
+}
+
+// Stage is one step of the chain. A stage may also rewrite Message.Data directly, the
+// next stage sees the rewritten content.
+type Stage interface {
+	Name() string
+	Moderate(ctx context.Context, msg *Message) ([]*Verdict, error)
+}
+
+// Result is the outcome of running a chain.
+type Result struct {
+	// Action is the most severe action of all verdicts.
+	Action   Action
+	Verdicts []*Verdict
+}
+
+// Blocked returns the verdict that stopped the message, nil if it was not blocked.
+func (r *Result) Blocked() *Verdict {
+	for _, v := range r.Verdicts {
+		if v.Action == ActionBlock {
+			return v
+		}
+	}
+	return nil
+}
+
+// Flagged returns the verdicts that ask for a human review.
+func (r *Result) Flagged() []*Verdict {
+	var res []*Verdict
+	for _, v := range r.Verdicts {
+		if v.Action == ActionFlag {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+// Chain runs its stages in order and stops at the first block.
+type Chain struct {
+	stages   []Stage
+	maskRune rune
+}
+
+func NewChain(maskRune rune, stages ...Stage) *Chain {
+	if maskRune == 0 {
+		maskRune = '*'
+	}
+	return &Chain{stages: stages, maskRune: maskRune}
+}
+
+func (c *Chain) Run(ctx context.Context, data *sdkws.MsgData) (*Result, error) {
+	res := &Result{}
+	for _, stage := range c.stages {
+		content := parseContent(data.ContentType, data.Content)
+		verdicts, err := stage.Moderate(ctx, &Message{Data: data, Texts: content.Texts})
+		if err != nil {
+			return nil, err
+		}
+		masks := make(map[int][][]int)
+		for _, v := range verdicts {
+			if v.Stage == "" {
+				v.Stage = stage.Name()
+			}
+			res.Verdicts = append(res.Verdicts, v)
+			if v.Action > res.Action {
+				res.Action = v.Action
+			}
+			if v.Action == ActionMask && v.Field >= 0 && v.Field < len(content.Texts) {
+				masks[v.Field] = append(masks[v.Field], v.Ranges...)
+			}
+		}
+		if res.Action == ActionBlock {
+			return res, nil
+		}
+		if len(masks) > 0 {
+			for field, ranges := range masks {
+				content.Texts[field] = Mask(content.Texts[field], ranges, c.maskRune)
+			}
+			if data.Content, err = content.Bytes(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return res, nil
+}
+
+// Mask replaces every character inside ranges with maskRune. Ranges are byte offsets
+// into text and may overlap.
+func Mask(text string, ranges [][]int, maskRune rune) string {
+	if len(ranges) == 0 {
+		return text
+	}
+	sorted := make([][]int, 0, len(ranges))
+	for _, r := range ranges {
+		if len(r) >= 2 && r[0] >= 0 && r[0] < r[1] && r[1] <= len(text) {
+			sorted = append(sorted, r)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i][0] < sorted[j][0] })
+	var sb strings.Builder
+	last := 0
+	for _, r := range sorted {
+		start := max(r[0], last)
+		if start >= r[1] {
+			continue
+		}
+		sb.WriteString(text[last:start])
+		sb.WriteString(strings.Repeat(string(maskRune), utf8.RuneCountInString(text[start:r[1]])))
+		last = r[1]
+	}
+	sb.WriteString(text[last:])
+	return sb.String()
+}
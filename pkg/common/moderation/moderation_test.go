@@ -0,0 +1,109 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moderation
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+)
+
+func writeRules(t *testing.T, path, rules string, modTime time.Time) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(rules), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMask(t *testing.T) {
+	tests := []struct {
+		text   string
+		ranges [][]int
+		want   string
+	}{
+		{"hello world", [][]int{{6, 11}}, "hello *****"},
+		{"abcdef", [][]int{{3, 5}, {0, 2}, {1, 4}}, "*****f"},
+		{"坏人来了", [][]int{{0, 6}}, "**来了"},
+	}
+	for _, tt := range tests {
+		if got := Mask(tt.text, tt.ranges, '*'); got != tt.want {
+			t.Errorf("Mask(%q, %v) = %q, want %q", tt.text, tt.ranges, got, tt.want)
+		}
+	}
+}
+
+func TestChain(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "rules.yml")
+	writeRules(t, path, `
+rules:
+  - name: rude
+    keywords: [damn, 坏人]
+    action: mask
+  - name: phone
+    regex: '\d{11}'
+    action: flag
+`, time.Now().Add(-time.Hour))
+	keyword, err := NewKeywordStage(path, time.Nanosecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain := NewChain('*', keyword, NewLinkStage([]string{"openim.io"}, ActionBlock))
+
+	data := &sdkws.MsgData{ContentType: constant.Text, Content: []byte(`{"content":"Damn 坏人, call 13800000000 or see https://doc.openim.io/x"}`)}
+	res, err := chain.Run(ctx, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Action != ActionMask || len(res.Flagged()) != 1 || res.Blocked() != nil {
+		t.Fatalf("unexpected result %+v", res)
+	}
+	if got := string(data.Content); got != `{"content":"**** **, call 13800000000 or see https://doc.openim.io/x"}` {
+		t.Fatalf("unexpected content %s", got)
+	}
+
+	data = &sdkws.MsgData{ContentType: constant.AtText, Content: []byte(`{"text":"see www.example.com","atUserList":["a"]}`)}
+	if res, err = chain.Run(ctx, data); err != nil {
+		t.Fatal(err)
+	}
+	if v := res.Blocked(); v == nil || v.Stage != "link" || v.Reason != "www.example.com" {
+		t.Fatalf("link not blocked %+v", res)
+	}
+
+	// A changed rule file is picked up, a broken one keeps the previous rules.
+	writeRules(t, path, "rules:\n  - keywords: [spam]\n    action: block\n", time.Now())
+	data = &sdkws.MsgData{ContentType: constant.Text, Content: []byte("buy SPAM now")}
+	if res, err = chain.Run(ctx, data); err != nil {
+		t.Fatal(err)
+	}
+	if res.Blocked() == nil {
+		t.Fatalf("reloaded rule not applied %+v", res)
+	}
+	writeRules(t, path, "rules: [", time.Now().Add(time.Hour))
+	if res, err = chain.Run(ctx, data); err != nil {
+		t.Fatal(err)
+	}
+	if res.Blocked() == nil {
+		t.Fatalf("previous rules lost %+v", res)
+	}
+}
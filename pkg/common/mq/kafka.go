@@ -0,0 +1,148 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mq
+
+import (
+	"context"
+	"errors"
+
+	"github.com/IBM/sarama"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mq/kafka"
+	"google.golang.org/protobuf/proto"
+)
+
+type kafkaBuilder struct {
+	conf     *kafka.Config
+	producer *sarama.Config
+}
+
+func newKafkaBuilder(kafkaConf *config.Kafka) (*kafkaBuilder, error) {
+	conf := kafkaConf.Build()
+	producer, err := kafka.BuildProducerConfig(*conf)
+	if err != nil {
+		return nil, err
+	}
+	return &kafkaBuilder{conf: conf, producer: producer}, nil
+}
+
+func (b *kafkaBuilder) GetTopicProducer(topic string) (Producer, error) {
+	producer, err := kafka.NewKafkaProducer(b.producer, b.conf.Addr, topic)
+	if err != nil {
+		return nil, err
+	}
+	return &kafkaProducer{producer: producer}, nil
+}
+
+func (b *kafkaBuilder) GetTopicConsumer(topic, groupID string, opts ...ConsumerOption) (Consumer, error) {
+	var o consumerOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	initial := sarama.OffsetNewest
+	if o.oldest {
+		initial = sarama.OffsetOldest
+	}
+	conf, err := kafka.BuildConsumerGroupConfig(b.conf, initial, !o.manualCommit)
+	if err != nil {
+		return nil, err
+	}
+	group, err := kafka.NewConsumerGroup(conf, b.conf.Addr, groupID)
+	if err != nil {
+		return nil, err
+	}
+	return &kafkaConsumer{group: group, topic: topic, groupID: groupID, manualCommit: o.manualCommit}, nil
+}
+
+type kafkaProducer struct {
+	producer *kafka.Producer
+}
+
+func (p *kafkaProducer) SendMessage(ctx context.Context, key string, msg proto.Message) error {
+	// The tenant travels in the key, the headers written by the producer are fixed.
+	_, _, err := p.producer.SendMessage(ctx, tenant.WithKey(ctx, key), msg)
+	return err
+}
+
+// Close is a no-op, the sync producer lives as long as the process.
+func (p *kafkaProducer) Close() error {
+	return nil
+}
+
+type kafkaConsumer struct {
+	group        sarama.ConsumerGroup
+	topic        string
+	groupID      string
+	manualCommit bool
+}
+
+func (c *kafkaConsumer) Subscribe(ctx context.Context, fn Handler) error {
+	handler := &kafkaHandler{fn: fn, manualCommit: c.manualCommit}
+	for {
+		err := c.group.Consume(ctx, []string{c.topic}, handler)
+		if errors.Is(err, sarama.ErrClosedConsumerGroup) || errors.Is(err, context.Canceled) || ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			log.ZWarn(ctx, "consume err", err, "topic", c.topic, "groupID", c.groupID)
+		}
+	}
+}
+
+func (c *kafkaConsumer) Close() error {
+	return errs.Wrap(c.group.Close())
+}
+
+type kafkaHandler struct {
+	fn           Handler
+	manualCommit bool
+}
+
+func (*kafkaHandler) Setup(sarama.ConsumerGroupSession) error { return nil }
+
+func (*kafkaHandler) Cleanup(sarama.ConsumerGroupSession) error { return nil }
+
+func (h *kafkaHandler) ConsumeClaim(sess sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	log.ZDebug(context.Background(), "kafka claim", "highWaterMarkOffset", claim.HighWaterMarkOffset(),
+		"topic", claim.Topic(), "partition", claim.Partition())
+	for {
+		select {
+		case msg, ok := <-claim.Messages():
+			if !ok {
+				return nil
+			}
+			tenantID, key := tenant.SplitKey(string(msg.Key))
+			m := &Message{
+				Key:   key,
+				Value: msg.Value,
+				ctx:   tenant.WithID(kafka.GetContextWithMQHeader(msg.Headers), tenantID),
+				mark: func() {
+					sess.MarkMessage(msg, "")
+					if h.manualCommit {
+						sess.Commit()
+					}
+				},
+			}
+			if err := h.fn(sess.Context(), m); err != nil {
+				return nil
+			}
+		case <-sess.Context().Done():
+			return nil
+		}
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mq hides the message queue behind the to-Redis, to-Mongo and to-Push pipelines.
+// Messages with the same key always land on the same partition and are delivered in order.
+package mq
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	BackendKafka = "kafka"
+	BackendRedis = "redis"
+)
+
+// Message is a message delivered to a consumer.
+type Message struct {
+	Key   string
+	Value []byte
+
+	ctx  context.Context
+	mark func()
+}
+
+// Context returns a context carrying the operation ID and operator of the producer.
+func (m *Message) Context() context.Context {
+	return m.ctx
+}
+
+// Mark commits the message together with every earlier message of its partition, so they
+// are not delivered to the consumer group again.
+func (m *Message) Mark() {
+	m.mark()
+}
+
+type Producer interface {
+	SendMessage(ctx context.Context, key string, msg proto.Message) error
+	Close() error
+}
+
+// Handler processes one message and is called sequentially per partition. ctx is done when
+// the partition is revoked. Returning an error stops the partition without committing the
+// message; it is delivered again once the partition is consumed again.
+type Handler func(ctx context.Context, msg *Message) error
+
+type Consumer interface {
+	// Subscribe blocks until ctx is done or the consumer is closed.
+	Subscribe(ctx context.Context, fn Handler) error
+	Close() error
+}
+
+type consumerOptions struct {
+	manualCommit bool
+	oldest       bool
+}
+
+type ConsumerOption func(*consumerOptions)
+
+// WithManualCommit commits synchronously in Message.Mark instead of periodically.
+func WithManualCommit() ConsumerOption {
+	return func(o *consumerOptions) {
+		o.manualCommit = true
+	}
+}
+
+// WithOldest starts a new consumer group at the oldest retained message instead of the newest.
+func WithOldest() ConsumerOption {
+	return func(o *consumerOptions) {
+		o.oldest = true
+	}
+}
+
+// Builder creates producers and consumers for the configured backend.
+type Builder interface {
+	GetTopicProducer(topic string) (Producer, error)
+	GetTopicConsumer(topic, groupID string, opts ...ConsumerOption) (Consumer, error)
+}
+
+// NewBuilder selects the backend from conf. rdb is only used by the Redis backend.
+func NewBuilder(conf *config.MQ, kafkaConf *config.Kafka, rdb redis.UniversalClient) (Builder, error) {
+	switch conf.Enable {
+	case BackendKafka, "":
+		return newKafkaBuilder(kafkaConf)
+	case BackendRedis:
+		if rdb == nil {
+			return nil, errs.ErrArgs.WrapMsg("redis mq backend needs a redis client")
+		}
+		return newRedisBuilder(&conf.Redis, rdb), nil
+	default:
+		return nil, errs.ErrArgs.WrapMsg("unsupported mq backend", "enable", conf.Enable)
+	}
+}
@@ -0,0 +1,418 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mq
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/stringutil"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/proto"
+)
+
+// The Redis backend splits every topic into a fixed number of streams. A message goes to
+// the stream chosen by the hash of its key, and each stream is read by at most one consumer
+// of a group at a time, which holds a lease on it. That keeps per-key ordering like Kafka
+// partitions do. Unacknowledged entries stay pending under a consumer name derived from the
+// partition, so whoever takes the lease over delivers them again first.
+
+const (
+	streamKeyPrefix = "MQ_STREAM:"
+	leaseKeyPrefix  = "MQ_LEASE:"
+
+	defaultPartitions   = 8
+	defaultLeaseTimeout = 30 * time.Second
+	defaultBatchSize    = 100
+	readBlock           = time.Second
+	retryWait           = time.Second
+	// ackInterval is how often marked entries are acknowledged without WithManualCommit,
+	// like the Kafka auto-commit interval.
+	ackInterval = time.Second
+)
+
+var (
+	renewLease = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+	releaseLease = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+)
+
+func streamKey(topic string, partition int) string {
+	return streamKeyPrefix + topic + ":" + strconv.Itoa(partition)
+}
+
+func leaseKey(groupID, stream string) string {
+	return leaseKeyPrefix + groupID + ":" + stream
+}
+
+type redisBuilder struct {
+	rdb          redis.UniversalClient
+	partitions   int
+	maxLen       int64
+	leaseTimeout time.Duration
+	batchSize    int64
+}
+
+func newRedisBuilder(conf *config.MQRedis, rdb redis.UniversalClient) *redisBuilder {
+	b := &redisBuilder{
+		rdb:          rdb,
+		partitions:   conf.Partitions,
+		maxLen:       conf.MaxLen,
+		leaseTimeout: time.Duration(conf.LeaseTimeout) * time.Second,
+		batchSize:    conf.BatchSize,
+	}
+	if b.partitions <= 0 {
+		b.partitions = defaultPartitions
+	}
+	if b.leaseTimeout <= 0 {
+		b.leaseTimeout = defaultLeaseTimeout
+	}
+	if b.batchSize <= 0 {
+		b.batchSize = defaultBatchSize
+	}
+	return b
+}
+
+func (b *redisBuilder) GetTopicProducer(topic string) (Producer, error) {
+	return &redisProducer{builder: b, topic: topic}, nil
+}
+
+func (b *redisBuilder) GetTopicConsumer(topic, groupID string, opts ...ConsumerOption) (Consumer, error) {
+	var o consumerOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return &redisConsumer{
+		builder: b,
+		topic:   topic,
+		groupID: groupID,
+		opts:    o,
+		id:      uuid.New().String(),
+		closed:  make(chan struct{}),
+	}, nil
+}
+
+type redisProducer struct {
+	builder *redisBuilder
+	topic   string
+}
+
+func (p *redisProducer) SendMessage(ctx context.Context, key string, msg proto.Message) error {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return errs.WrapMsg(err, "redis mq proto Marshal err")
+	}
+	if key == "" || len(data) == 0 {
+		return errs.ErrArgs.WrapMsg("redis mq msg is empty")
+	}
+	operationID, opUserID, platform, connID, err := mcontext.GetCtxInfos(ctx)
+	if err != nil {
+		return err
+	}
+	partition := int(stringutil.GetHashCode(key) % uint32(p.builder.partitions))
+	args := &redis.XAddArgs{
+		Stream: streamKey(p.topic, partition),
+		Values: []any{"key", key, "value", data, "operationID", operationID, "opUserID", opUserID,
+			"platform", platform, "connID", connID, "tenantID", tenant.ID(ctx)},
+	}
+	if p.builder.maxLen > 0 {
+		args.MaxLen = p.builder.maxLen
+		args.Approx = true
+	}
+	return errs.Wrap(p.builder.rdb.XAdd(ctx, args).Err())
+}
+
+func (p *redisProducer) Close() error {
+	return nil
+}
+
+type redisConsumer struct {
+	builder   *redisBuilder
+	topic     string
+	groupID   string
+	opts      consumerOptions
+	id        string
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func (c *redisConsumer) Subscribe(ctx context.Context, fn Handler) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-c.closed:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	var wg sync.WaitGroup
+	for i := 0; i < c.builder.partitions; i++ {
+		wg.Add(1)
+		go func(partition int) {
+			defer wg.Done()
+			c.runPartition(ctx, partition, fn)
+		}(i)
+	}
+	wg.Wait()
+	return nil
+}
+
+func (c *redisConsumer) Close() error {
+	c.closeOnce.Do(func() {
+		close(c.closed)
+	})
+	return nil
+}
+
+// runPartition keeps competing for the lease of one partition until ctx is done.
+func (c *redisConsumer) runPartition(ctx context.Context, partition int, fn Handler) {
+	stream := streamKey(c.topic, partition)
+	// A new group starts at the newest entry, or at the oldest one kept by maxLen.
+	start := "$"
+	if c.opts.oldest {
+		start = "0"
+	}
+	for {
+		err := c.builder.rdb.XGroupCreateMkStream(ctx, stream, c.groupID, start).Err()
+		if err == nil || strings.HasPrefix(err.Error(), "BUSYGROUP") {
+			break
+		}
+		log.ZWarn(ctx, "create redis stream group failed", err, "stream", stream, "groupID", c.groupID)
+		if !sleep(ctx, retryWait) {
+			return
+		}
+	}
+	lease := leaseKey(c.groupID, stream)
+	for {
+		ok, err := c.builder.rdb.SetNX(ctx, lease, c.id, c.builder.leaseTimeout).Result()
+		if err != nil && ctx.Err() == nil {
+			log.ZWarn(ctx, "acquire redis stream lease failed", err, "stream", stream, "groupID", c.groupID)
+		}
+		if ok {
+			log.ZDebug(ctx, "redis stream partition claimed", "stream", stream, "groupID", c.groupID, "consumer", c.id)
+			c.consumePartition(ctx, stream, lease, "p"+strconv.Itoa(partition), fn)
+			if err := releaseLease.Run(context.Background(), c.builder.rdb, []string{lease}, c.id).Err(); err != nil {
+				log.ZWarn(ctx, "release redis stream lease failed", err, "stream", stream)
+			}
+		}
+		if !sleep(ctx, c.builder.leaseTimeout/3) {
+			return
+		}
+	}
+}
+
+// consumePartition reads one partition while the lease is held. It first delivers the
+// entries left pending by the previous holder, then new ones.
+func (c *redisConsumer) consumePartition(ctx context.Context, stream, lease, consumer string, fn Handler) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go c.keepLease(ctx, cancel, lease)
+	p := &redisPartition{rdb: c.builder.rdb, stream: stream, groupID: c.groupID, manualCommit: c.opts.manualCommit}
+	if !p.manualCommit {
+		defer p.flush()
+		go p.flushEvery(ctx, ackInterval)
+	}
+	id := "0"
+	for ctx.Err() == nil {
+		res, err := c.builder.rdb.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    c.groupID,
+			Consumer: consumer,
+			Streams:  []string{stream, id},
+			Count:    c.builder.batchSize,
+			Block:    readBlock,
+		}).Result()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.ZWarn(ctx, "read redis stream failed", err, "stream", stream, "groupID", c.groupID)
+			sleep(ctx, retryWait)
+			continue
+		}
+		var msgs []redis.XMessage
+		if len(res) > 0 {
+			msgs = res[0].Messages
+		}
+		if id != ">" {
+			if len(msgs) == 0 {
+				id = ">"
+				continue
+			}
+			id = msgs[len(msgs)-1].ID
+		}
+		for _, xm := range msgs {
+			m := p.message(xm)
+			if m == nil {
+				continue
+			}
+			if err := fn(ctx, m); err != nil {
+				// Marked entries are acknowledged before the pending ones are read again.
+				p.flush()
+				p.reset()
+				id = "0"
+				sleep(ctx, retryWait)
+				break
+			}
+		}
+	}
+}
+
+func (c *redisConsumer) keepLease(ctx context.Context, cancel context.CancelFunc, lease string) {
+	ticker := time.NewTicker(c.builder.leaseTimeout / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := renewLease.Run(ctx, c.builder.rdb, []string{lease}, c.id, c.builder.leaseTimeout.Milliseconds()).Int()
+			if err != nil {
+				log.ZWarn(ctx, "renew redis stream lease failed", err, "lease", lease)
+				continue
+			}
+			if n == 0 {
+				log.ZWarn(ctx, "redis stream lease lost", nil, "lease", lease)
+				cancel()
+				return
+			}
+		}
+	}
+}
+
+// redisPartition tracks the entries delivered but not yet acknowledged, in stream order,
+// so that marking one entry acknowledges the earlier ones as well. Marked entries are
+// acknowledged at once with manualCommit, otherwise every ackInterval.
+type redisPartition struct {
+	rdb          redis.UniversalClient
+	stream       string
+	groupID      string
+	manualCommit bool
+
+	mu     sync.Mutex
+	ids    []string
+	marked []string
+}
+
+func (p *redisPartition) message(xm redis.XMessage) *Message {
+	field := func(name string) string {
+		s, _ := xm.Values[name].(string)
+		return s
+	}
+	value := field("value")
+	if value == "" {
+		// The entry was trimmed away while pending.
+		log.ZWarn(context.Background(), "drop empty redis stream entry", nil, "stream", p.stream, "id", xm.ID)
+		p.ack(xm.ID)
+		return nil
+	}
+	p.mu.Lock()
+	p.ids = append(p.ids, xm.ID)
+	p.mu.Unlock()
+	return &Message{
+		Key:   field("key"),
+		Value: []byte(value),
+		ctx: tenant.WithID(mcontext.WithMustInfoCtx([]string{field("operationID"), field("opUserID"), field("platform"), field("connID")}),
+			field("tenantID")),
+		mark: func() {
+			p.mark(xm.ID)
+		},
+	}
+}
+
+func (p *redisPartition) mark(id string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for i, v := range p.ids {
+		if v == id {
+			if p.manualCommit {
+				p.ack(p.ids[:i+1]...)
+			} else {
+				p.marked = append(p.marked, p.ids[:i+1]...)
+			}
+			p.ids = p.ids[i+1:]
+			return
+		}
+	}
+}
+
+// flush acknowledges the marked entries.
+func (p *redisPartition) flush() {
+	p.mu.Lock()
+	ids := p.marked
+	p.marked = nil
+	p.mu.Unlock()
+	if len(ids) > 0 {
+		p.ack(ids...)
+	}
+}
+
+func (p *redisPartition) flushEvery(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.flush()
+		}
+	}
+}
+
+func (p *redisPartition) reset() {
+	p.mu.Lock()
+	p.ids = nil
+	p.mu.Unlock()
+}
+
+func (p *redisPartition) ack(ids ...string) {
+	if err := p.rdb.XAck(context.Background(), p.stream, p.groupID, ids...).Err(); err != nil {
+		log.ZWarn(context.Background(), "ack redis stream entries failed", err, "stream", p.stream, "ids", ids)
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
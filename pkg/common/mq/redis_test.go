@@ -0,0 +1,153 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mq
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/proto"
+)
+
+func testRedisBuilder(t *testing.T) (Builder, string) {
+	rdb := redis.NewClient(&redis.Options{Addr: "localhost:16379", Password: "openIM123"})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := rdb.Ping(ctx).Err(); err != nil {
+		t.Skip("redis not available:", err)
+	}
+	topic := "test_" + uuid.New().String()
+	t.Cleanup(func() {
+		keys, _ := rdb.Keys(context.Background(), "MQ_*"+topic+"*").Result()
+		if len(keys) > 0 {
+			rdb.Del(context.Background(), keys...)
+		}
+		rdb.Close()
+	})
+	b, err := NewBuilder(&config.MQ{Enable: BackendRedis, Redis: config.MQRedis{Partitions: 4, LeaseTimeout: 3}}, nil, rdb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b, topic
+}
+
+func send(t *testing.T, p Producer, key string, seq int64) {
+	ctx := mcontext.WithMustInfoCtx([]string{"op", "user", "1", "conn"})
+	if err := p.SendMessage(ctx, key, &sdkws.MsgData{Seq: seq}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRedisKeyOrder(t *testing.T) {
+	b, topic := testRedisBuilder(t)
+	p, _ := b.GetTopicProducer(topic)
+	for i := int64(1); i <= 20; i++ {
+		send(t, p, "k"+strconv.Itoa(int(i%3)), i)
+	}
+	c, _ := b.GetTopicConsumer(topic, "g", WithOldest())
+	var (
+		mu   sync.Mutex
+		last = make(map[string]int64)
+		n    int
+	)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_ = c.Subscribe(ctx, func(_ context.Context, msg *Message) error {
+		var data sdkws.MsgData
+		if err := proto.Unmarshal(msg.Value, &data); err != nil {
+			t.Error(err)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if data.Seq <= last[msg.Key] {
+			t.Errorf("key %s: seq %d after %d", msg.Key, data.Seq, last[msg.Key])
+		}
+		last[msg.Key] = data.Seq
+		msg.Mark()
+		if n++; n == 20 {
+			cancel()
+		}
+		return nil
+	})
+	if n != 20 {
+		t.Fatalf("got %d messages, want 20", n)
+	}
+}
+
+func TestRedisRedeliverUnmarked(t *testing.T) {
+	b, topic := testRedisBuilder(t)
+	p, _ := b.GetTopicProducer(topic)
+	for i := int64(1); i <= 3; i++ {
+		send(t, p, "k", i)
+	}
+	consume := func(markUpTo int64) []int64 {
+		c, _ := b.GetTopicConsumer(topic, "g", WithOldest())
+		var seqs []int64
+		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		defer cancel()
+		_ = c.Subscribe(ctx, func(_ context.Context, msg *Message) error {
+			var data sdkws.MsgData
+			_ = proto.Unmarshal(msg.Value, &data)
+			seqs = append(seqs, data.Seq)
+			if data.Seq == markUpTo {
+				msg.Mark()
+			}
+			return nil
+		})
+		return seqs
+	}
+	if seqs := consume(2); len(seqs) != 3 {
+		t.Fatalf("first round got %v", seqs)
+	}
+	if seqs := consume(3); len(seqs) != 1 || seqs[0] != 3 {
+		t.Fatalf("second round got %v, want [3]", seqs)
+	}
+}
+
+func TestRedisNewGroupStartsAtNewest(t *testing.T) {
+	b, topic := testRedisBuilder(t)
+	p, _ := b.GetTopicProducer(topic)
+	send(t, p, "k", 1)
+	c, _ := b.GetTopicConsumer(topic, "g")
+	var seqs []int64
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	go func() {
+		// Sent once the group exists.
+		time.Sleep(time.Second)
+		ctx := mcontext.WithMustInfoCtx([]string{"op", "user", "1", "conn"})
+		if err := p.SendMessage(ctx, "k", &sdkws.MsgData{Seq: 2}); err != nil {
+			t.Error(err)
+		}
+	}()
+	_ = c.Subscribe(ctx, func(_ context.Context, msg *Message) error {
+		var data sdkws.MsgData
+		_ = proto.Unmarshal(msg.Value, &data)
+		seqs = append(seqs, data.Seq)
+		msg.Mark()
+		return nil
+	})
+	if len(seqs) != 1 || seqs[0] != 2 {
+		t.Fatalf("got %v, want [2]", seqs)
+	}
+}
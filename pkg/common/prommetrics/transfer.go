@@ -36,6 +36,10 @@ var (
 		Name: "msg_insert_mongo_failed_total",
 		Help: "The number of failed insert msg to mongo",
 	})
+	MsgToMongoDeadLetterCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "msg_to_mongo_dead_letter_total",
+		Help: "The number of msg batches sent to the to-mongo dead-letter topic",
+	})
 	SeqSetFailedCounter = prometheus.NewCounter(prometheus.CounterOpts{
 		Name: "seq_set_failed_total",
 		Help: "The number of failed set seq",
@@ -50,6 +54,7 @@ func TransferInit(prometheusPort int) error {
 		MsgInsertRedisFailedCounter,
 		MsgInsertMongoSuccessCounter,
 		MsgInsertMongoFailedCounter,
+		MsgToMongoDeadLetterCounter,
 		SeqSetFailedCounter,
 	)
 	return Init(reg, prometheusPort, commonPath, promhttp.HandlerFor(reg, promhttp.HandlerOpts{Registry: reg}), cs...)
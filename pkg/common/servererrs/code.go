@@ -54,6 +54,7 @@ const (
 	// Account error codes.
 	UserIDNotFoundError    = 1101 // UserID does not exist or is not registered
 	RegisteredAlreadyError = 1102 // user is already registered
+	UserDisabledError      = 1103 // User account is disabled
 
 	// Group error codes.
 	GroupIDNotFoundError  = 1201 // GroupID does not exist
@@ -62,6 +63,11 @@ const (
 	DismissedAlreadyError = 1204 // Group has already been dismissed
 	GroupTypeNotSupport   = 1205
 	GroupRequestHandled   = 1206
+	NotInCommunityError   = 1207 // Not in the community the group belongs to
+	GroupCreateLimitError = 1208 // Owns as many groups as the quota allows
+	GroupJoinLimitError   = 1209 // In as many groups as the quota allows
+	GroupMemberLimitError = 1210 // Group has as many members as the quota allows
+	JoinAnswersRequired   = 1211 // Group asks required join questions, join with JoinGroupWithAnswers
 
 	// Relationship error codes.
 	CanNotAddYourselfError   = 1301 // Cannot add yourself as a friend
@@ -74,6 +80,10 @@ const (
 	MutedInGroup          = 1402 // Member muted in the group
 	MutedGroup            = 1403 // Group is muted
 	MsgAlreadyRevoke      = 1404 // Message already revoked
+	MsgBlocked            = 1405 // Message blocked by moderation
+	MsgSending            = 1406 // First send of the same ClientMsgID still in progress
+	SlowModeGroup         = 1407 // Sending faster than the slow mode of the group allows
+	ChannelReadOnly       = 1408 // Only publishers can post in the channel
 
 	// Token error codes.
 	TokenExpiredError     = 1501
@@ -92,4 +102,8 @@ const (
 
 	// S3 error codes.
 	FileUploadedExpiredError = 1701 // Upload expired
+
+	// Tenant error codes.
+	TenantNotFoundError = 1801 // Tenant does not exist
+	TenantDisabledError = 1802 // Tenant has been disabled
 )
@@ -35,8 +35,14 @@ var (
 	ErrNotInGroupYet       = errs.NewCodeError(NotInGroupYetError, "NotInGroupYetError")
 	ErrDismissedAlready    = errs.NewCodeError(DismissedAlreadyError, "DismissedAlreadyError")
 	ErrRegisteredAlready   = errs.NewCodeError(RegisteredAlreadyError, "RegisteredAlreadyError")
+	ErrUserDisabled        = errs.NewCodeError(UserDisabledError, "UserDisabledError")
 	ErrGroupTypeNotSupport = errs.NewCodeError(GroupTypeNotSupport, "")
 	ErrGroupRequestHandled = errs.NewCodeError(GroupRequestHandled, "GroupRequestHandled")
+	ErrNotInCommunity      = errs.NewCodeError(NotInCommunityError, "NotInCommunityError")
+	ErrGroupCreateLimit    = errs.NewCodeError(GroupCreateLimitError, "GroupCreateLimitError")
+	ErrGroupJoinLimit      = errs.NewCodeError(GroupJoinLimitError, "GroupJoinLimitError")
+	ErrGroupMemberLimit    = errs.NewCodeError(GroupMemberLimitError, "GroupMemberLimitError")
+	ErrJoinAnswersRequired = errs.NewCodeError(JoinAnswersRequired, "JoinAnswersRequired")
 
 	ErrData             = errs.NewCodeError(DataError, "DataError")
 	ErrTokenExpired     = errs.NewCodeError(TokenExpiredError, "TokenExpiredError")
@@ -57,6 +63,10 @@ var (
 	ErrMutedInGroup     = errs.NewCodeError(MutedInGroup, "MutedInGroup")
 	ErrMutedGroup       = errs.NewCodeError(MutedGroup, "MutedGroup")
 	ErrMsgAlreadyRevoke = errs.NewCodeError(MsgAlreadyRevoke, "MsgAlreadyRevoke")
+	ErrMsgBlocked       = errs.NewCodeError(MsgBlocked, "MsgBlocked")
+	ErrMsgSending       = errs.NewCodeError(MsgSending, "MsgSending")
+	ErrSlowModeGroup    = errs.NewCodeError(SlowModeGroup, "SlowModeGroup")
+	ErrChannelReadOnly  = errs.NewCodeError(ChannelReadOnly, "ChannelReadOnly")
 
 	ErrConnOverMaxNumLimit = errs.NewCodeError(ConnOverMaxNumLimit, "ConnOverMaxNumLimit")
 
@@ -65,4 +75,7 @@ var (
 	ErrIOSBackgroundPushErr = errs.NewCodeError(IOSBackgroundPushErr, "ios background push err")
 
 	ErrFileUploadedExpired = errs.NewCodeError(FileUploadedExpiredError, "FileUploadedExpiredError")
+
+	ErrTenantNotFound = errs.NewCodeError(TenantNotFoundError, "TenantNotFoundError")
+	ErrTenantDisabled = errs.NewCodeError(TenantDisabledError, "TenantDisabledError")
 )
@@ -18,6 +18,8 @@ import (
 	"context"
 	"fmt"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/tools/utils/datautil"
 	"google.golang.org/grpc/status"
 	"net"
@@ -70,6 +72,7 @@ func Start[T any](ctx context.Context, discovery *config.Discovery, prometheusCo
 
 	defer client.Close()
 	client.AddOption(mw.GrpcClient(), grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithDefaultServiceConfig(fmt.Sprintf(`{"LoadBalancingPolicy": "%s"}`, "round_robin")))
+	tenant.SetResolver(rpcclient.NewTenantResolver(client, share.RpcRegisterName.User, share.IMAdminUserID))
 	registerIP, err = network.GetRpcRegisterIP(registerIP)
 	if err != nil {
 		return err
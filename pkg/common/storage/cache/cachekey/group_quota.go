@@ -0,0 +1,33 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cachekey
+
+const (
+	GroupMemberQuotaKey = "GROUP_MEMBER_QUOTA:"
+	UserJoinQuotaKey    = "USER_JOIN_GROUP_QUOTA:"
+	UserCreateQuotaKey  = "USER_CREATE_GROUP_QUOTA:"
+)
+
+func GetGroupMemberQuotaKey(groupID string) string {
+	return GroupMemberQuotaKey + groupID
+}
+
+func GetUserJoinQuotaKey(userID string) string {
+	return UserJoinQuotaKey + userID
+}
+
+func GetUserCreateQuotaKey(userID string) string {
+	return UserCreateQuotaKey + userID
+}
@@ -24,6 +24,8 @@ const (
 	messageDelUserList   = "MESSAGE_DEL_USER_LIST:"
 	userDelMessagesList  = "USER_DEL_MESSAGES_LIST:"
 	sendMsgFailedFlag    = "SEND_MSG_FAILED_FLAG:"
+	sendMsgIdempotency   = "SEND_MSG_IDEMPOTENCY:"
+	msgArchiveDoc        = "MSG_ARCHIVE_DOC:"
 	exTypeKeyLocker      = "EX_LOCK:"
 	reactionExSingle     = "EX_SINGLE_"
 	reactionWriteGroup   = "EX_GROUP_"
@@ -64,3 +66,11 @@ func GetLockMessageTypeKey(clientMsgID string, TypeKey string) string {
 func GetSendMsgKey(id string) string {
 	return sendMsgFailedFlag + id
 }
+
+func GetSendMsgIdempotencyKey(sendID, clientMsgID string) string {
+	return sendMsgIdempotency + sendID + ":" + clientMsgID
+}
+
+func GetMsgArchiveDocKey(docID string) string {
+	return msgArchiveDoc + docID
+}
@@ -0,0 +1,29 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type CommunityCache interface {
+	// GetGroupCommunityID returns the community of the group, empty when the group is in none.
+	GetGroupCommunityID(ctx context.Context, groupID string) (string, error)
+	DelGroupCommunityID(ctx context.Context, groupIDs ...string) error
+	FindMaxJoinCommunityVersion(ctx context.Context, userID string) (*model.VersionLog, error)
+	DelMaxJoinCommunityVersion(ctx context.Context, userIDs ...string) error
+}
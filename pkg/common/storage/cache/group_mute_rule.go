@@ -0,0 +1,30 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type GroupMuteRuleCache interface {
+	SetGroupMuteRule(ctx context.Context, rule *model.GroupMuteRule) error
+	GetGroupMuteRule(ctx context.Context, groupID string) (*model.GroupMuteRule, error)
+	// TakeSlowModeSend counts a message of userID in the group and reports whether the user
+	// sent none during the last interval.
+	TakeSlowModeSend(ctx context.Context, groupID string, userID string, interval time.Duration) (bool, error)
+}
@@ -0,0 +1,26 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import "context"
+
+// GroupQuotaCache counts the slots of a quota taken by writes that checked it and have not
+// finished yet, so checks running at the same time count each other.
+type GroupQuotaCache interface {
+	// ReserveQuota adds n to the reservations of key and returns them, n included.
+	ReserveQuota(ctx context.Context, key string, n int64) (int64, error)
+	// ReleaseQuota takes back n reservations of key once the write is stored or failed.
+	ReleaseQuota(ctx context.Context, key string, n int64) error
+}
@@ -21,12 +21,33 @@ import (
 	"github.com/openimsdk/protocol/sdkws"
 )
 
+// SendMsgRecord is the result of the first send of a ClientMsgID.
+type SendMsgRecord struct {
+	ServerMsgID string
+	SendTime    int64
+	Seq         int64
+	// Done is false while the first send has not been enqueued yet.
+	Done bool
+}
+
 type MsgCache interface {
 	GetMessagesBySeq(ctx context.Context, conversationID string, seqs []int64) (seqMsg []*sdkws.MsgData, failedSeqList []int64, err error)
 	SetMessagesToCache(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) (int, error)
 	DeleteMessagesFromCache(ctx context.Context, conversationID string, seqs []int64) error
 	SetSendMsgStatus(ctx context.Context, id string, status int32) error
 	GetSendMsgStatus(ctx context.Context, id string) (int32, error)
+	// ClaimSendMsg reserves clientMsgID of sendID for expire. When the sender already used
+	// it inside the window the stored record is returned and nothing is written.
+	ClaimSendMsg(ctx context.Context, sendID, clientMsgID string, record *SendMsgRecord, expire time.Duration) (*SendMsgRecord, error)
+	// FinishSendMsg marks a claimed send as enqueued.
+	FinishSendMsg(ctx context.Context, sendID, clientMsgID string) error
+	// ReleaseSendMsg drops a claim whose send failed, so a retry is not treated as a duplicate.
+	ReleaseSendMsg(ctx context.Context, sendID, clientMsgID string) error
+	// GetSendMsgSeqs returns the seqs already assigned to the claimed msgs, indexed like msgs.
+	// Unclaimed msgs and msgs without a seq yet get 0.
+	GetSendMsgSeqs(ctx context.Context, msgs []*sdkws.MsgData) ([]int64, error)
+	// SetSendMsgSeqs records the assigned seq on the claims of msgs that still exist.
+	SetSendMsgSeqs(ctx context.Context, msgs []*sdkws.MsgData) error
 	JudgeMessageReactionExist(ctx context.Context, clientMsgID string, sessionType int32) (bool, error)
 	GetOneMessageAllReactionList(ctx context.Context, clientMsgID string, sessionType int32) (map[string]string, error)
 	DeleteOneMessageKey(ctx context.Context, clientMsgID string, sessionType int32, subKey string) error
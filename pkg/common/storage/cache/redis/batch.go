@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"github.com/dtm-labs/rockscache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/tools/log"
 	"github.com/redis/go-redis/v9"
 	"golang.org/x/sync/singleflight"
@@ -27,7 +28,7 @@ func batchGetCache2[K comparable, V any](ctx context.Context, rcClient *rockscac
 	findKeys := make([]string, 0, len(ids))
 	keyId := make(map[string]K)
 	for _, id := range ids {
-		key := idKey(id)
+		key := tenant.WithKey(ctx, idKey(id))
 		if _, ok := keyId[key]; ok {
 			continue
 		}
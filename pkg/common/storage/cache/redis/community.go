@@ -0,0 +1,82 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/dtm-labs/rockscache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"github.com/redis/go-redis/v9"
+)
+
+func NewCommunityCacheRedis(rdb redis.UniversalClient, memberDB database.CommunityMember, groupDB database.CommunityGroup) cache.CommunityCache {
+	return &communityCacheRedis{
+		memberDB:   memberDB,
+		groupDB:    groupDB,
+		expireTime: time.Hour * 24,
+		rocks:      rockscache.NewClient(rdb, *GetRocksCacheOptions()),
+	}
+}
+
+type communityCacheRedis struct {
+	memberDB   database.CommunityMember
+	groupDB    database.CommunityGroup
+	rocks      *rockscache.Client
+	expireTime time.Duration
+}
+
+func (c *communityCacheRedis) getGroupCommunityKey(groupID string) string {
+	return cachekey.GetGroupCommunityKey(groupID)
+}
+
+func (c *communityCacheRedis) getCommunityJoinMaxVersionKey(userID string) string {
+	return cachekey.GetCommunityJoinMaxVersionKey(userID)
+}
+
+func (c *communityCacheRedis) GetGroupCommunityID(ctx context.Context, groupID string) (string, error) {
+	return getCache(ctx, c.rocks, c.getGroupCommunityKey(groupID), c.expireTime, func(ctx context.Context) (string, error) {
+		return c.groupDB.TakeCommunityID(ctx, groupID)
+	})
+}
+
+func (c *communityCacheRedis) DelGroupCommunityID(ctx context.Context, groupIDs ...string) error {
+	for _, groupID := range groupIDs {
+		if err := c.rocks.TagAsDeleted2(ctx, tenant.WithKey(ctx, c.getGroupCommunityKey(groupID))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *communityCacheRedis) FindMaxJoinCommunityVersion(ctx context.Context, userID string) (*model.VersionLog, error) {
+	return getCache(ctx, c.rocks, c.getCommunityJoinMaxVersionKey(userID), c.expireTime, func(ctx context.Context) (*model.VersionLog, error) {
+		return c.memberDB.FindJoinIncrVersion(ctx, userID, 0, 0)
+	})
+}
+
+func (c *communityCacheRedis) DelMaxJoinCommunityVersion(ctx context.Context, userIDs ...string) error {
+	for _, userID := range userIDs {
+		if err := c.rocks.TagAsDeleted2(ctx, tenant.WithKey(ctx, c.getCommunityJoinMaxVersionKey(userID))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/dtm-labs/rockscache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+func NewGroupMuteRuleCacheRedis(rdb redis.UniversalClient, db database.GroupMuteRule) cache.GroupMuteRuleCache {
+	return &groupMuteRuleCacheRedis{
+		db:         db,
+		rdb:        rdb,
+		expireTime: time.Hour * 24,
+		rocks:      rockscache.NewClient(rdb, *GetRocksCacheOptions()),
+	}
+}
+
+type groupMuteRuleCacheRedis struct {
+	db         database.GroupMuteRule
+	rdb        redis.UniversalClient
+	rocks      *rockscache.Client
+	expireTime time.Duration
+}
+
+func (g *groupMuteRuleCacheRedis) getGroupMuteRuleKey(groupID string) string {
+	return cachekey.GetGroupMuteRuleKey(groupID)
+}
+
+func (g *groupMuteRuleCacheRedis) SetGroupMuteRule(ctx context.Context, rule *model.GroupMuteRule) error {
+	if err := g.db.Set(ctx, rule); err != nil {
+		return err
+	}
+	return g.rocks.TagAsDeleted2(ctx, tenant.WithKey(ctx, g.getGroupMuteRuleKey(rule.GroupID)))
+}
+
+func (g *groupMuteRuleCacheRedis) GetGroupMuteRule(ctx context.Context, groupID string) (*model.GroupMuteRule, error) {
+	return getCache(ctx, g.rocks, g.getGroupMuteRuleKey(groupID), g.expireTime, func(ctx context.Context) (*model.GroupMuteRule, error) {
+		return g.db.Take(ctx, groupID)
+	})
+}
+
+// slowModeSendScript counts a send and starts the interval on the first one, so the
+// counter only goes back to zero once the interval after an accepted send is over.
+var slowModeSendScript = redis.NewScript(`
+local n = redis.call("INCR", KEYS[1])
+if n == 1 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+return n
+`)
+
+func (g *groupMuteRuleCacheRedis) TakeSlowModeSend(ctx context.Context, groupID string, userID string, interval time.Duration) (bool, error) {
+	key := tenant.WithKey(ctx, cachekey.GetGroupSlowModeSendKey(groupID, userID))
+	n, err := slowModeSendScript.Run(ctx, g.rdb, []string{key}, interval.Milliseconds()).Int64()
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+	return n == 1, nil
+}
@@ -0,0 +1,62 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+// groupQuotaReserveExpire bounds how long the reservations of a crashed write are kept.
+const groupQuotaReserveExpire = time.Minute
+
+var reserveQuotaScript = redis.NewScript(`
+local n = redis.call("INCRBY", KEYS[1], ARGV[1])
+redis.call("PEXPIRE", KEYS[1], ARGV[2])
+return n
+`)
+
+var releaseQuotaScript = redis.NewScript(`
+local n = redis.call("DECRBY", KEYS[1], ARGV[1])
+if n <= 0 then
+	redis.call("DEL", KEYS[1])
+end
+return n
+`)
+
+func NewGroupQuotaCacheRedis(rdb redis.UniversalClient) cache.GroupQuotaCache {
+	return &groupQuotaCacheRedis{rdb: rdb}
+}
+
+type groupQuotaCacheRedis struct {
+	rdb redis.UniversalClient
+}
+
+func (g *groupQuotaCacheRedis) ReserveQuota(ctx context.Context, key string, n int64) (int64, error) {
+	res, err := reserveQuotaScript.Run(ctx, g.rdb, []string{tenant.WithKey(ctx, key)}, n, groupQuotaReserveExpire.Milliseconds()).Int64()
+	if err != nil {
+		return 0, errs.Wrap(err)
+	}
+	return res, nil
+}
+
+func (g *groupQuotaCacheRedis) ReleaseQuota(ctx context.Context, key string, n int64) error {
+	return errs.Wrap(releaseQuotaScript.Run(ctx, g.rdb, []string{tenant.WithKey(ctx, key)}, n).Err())
+}
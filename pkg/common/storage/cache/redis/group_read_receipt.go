@@ -0,0 +1,58 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/dtm-labs/rockscache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"github.com/redis/go-redis/v9"
+)
+
+func NewGroupReadReceiptCacheRedis(rdb redis.UniversalClient, mgo database.GroupReadReceipt) cache.GroupReadReceiptCache {
+	return &groupReadReceiptCacheRedis{
+		mgo:        mgo,
+		expireTime: time.Hour * 24 * 7,
+		rocks:      rockscache.NewClient(rdb, *GetRocksCacheOptions()),
+	}
+}
+
+type groupReadReceiptCacheRedis struct {
+	mgo        database.GroupReadReceipt
+	rocks      *rockscache.Client
+	expireTime time.Duration
+}
+
+func (g *groupReadReceiptCacheRedis) getGroupReadReceiptKey(groupID string) string {
+	return cachekey.GetGroupReadReceiptKey(groupID)
+}
+
+func (g *groupReadReceiptCacheRedis) SetEnable(ctx context.Context, groupID string, enable bool) error {
+	if err := g.mgo.SetEnable(ctx, groupID, enable); err != nil {
+		return err
+	}
+	return g.rocks.TagAsDeleted2(ctx, tenant.WithKey(ctx, g.getGroupReadReceiptKey(groupID)))
+}
+
+func (g *groupReadReceiptCacheRedis) IsEnabled(ctx context.Context, groupID string) (bool, error) {
+	return getCache(ctx, g.rocks, g.getGroupReadReceiptKey(groupID), g.expireTime, func(ctx context.Context) (bool, error) {
+		return g.mgo.IsEnabled(ctx, groupID)
+	})
+}
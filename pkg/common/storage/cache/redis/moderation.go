@@ -0,0 +1,59 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/dtm-labs/rockscache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"github.com/redis/go-redis/v9"
+)
+
+func NewGroupSensitiveWordCacheRedis(rdb redis.UniversalClient, mgo database.GroupSensitiveWord) cache.GroupSensitiveWordCache {
+	return &groupSensitiveWordCacheRedis{
+		mgo:        mgo,
+		expireTime: time.Hour * 24,
+		rocks:      rockscache.NewClient(rdb, *GetRocksCacheOptions()),
+	}
+}
+
+type groupSensitiveWordCacheRedis struct {
+	mgo        database.GroupSensitiveWord
+	rocks      *rockscache.Client
+	expireTime time.Duration
+}
+
+func (g *groupSensitiveWordCacheRedis) getGroupSensitiveWordKey(groupID string) string {
+	return cachekey.GetGroupSensitiveWordKey(groupID)
+}
+
+func (g *groupSensitiveWordCacheRedis) SetGroupSensitiveWord(ctx context.Context, word *model.GroupSensitiveWord) error {
+	if err := g.mgo.Set(ctx, word); err != nil {
+		return err
+	}
+	return g.rocks.TagAsDeleted2(ctx, tenant.WithKey(ctx, g.getGroupSensitiveWordKey(word.GroupID)))
+}
+
+func (g *groupSensitiveWordCacheRedis) GetGroupSensitiveWord(ctx context.Context, groupID string) (*model.GroupSensitiveWord, error) {
+	return getCache(ctx, g.rocks, g.getGroupSensitiveWordKey(groupID), g.expireTime, func(ctx context.Context) (*model.GroupSensitiveWord, error) {
+		return g.mgo.Take(ctx, groupID)
+	})
+}
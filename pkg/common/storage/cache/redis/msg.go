@@ -16,13 +16,16 @@ package redis
 
 import (
 	"context"
+	"errors"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
 	"github.com/openimsdk/protocol/sdkws"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/utils/datautil"
 	"github.com/redis/go-redis/v9"
+	"strconv"
 	"time"
 ) //
 
@@ -37,35 +40,39 @@ type msgCache struct {
 	rdb redis.UniversalClient
 }
 
-func (c *msgCache) getMessageCacheKey(conversationID string, seq int64) string {
-	return cachekey.GetMessageCacheKey(conversationID, seq)
+func (c *msgCache) getMessageCacheKey(ctx context.Context, conversationID string, seq int64) string {
+	return tenant.WithKey(ctx, cachekey.GetMessageCacheKey(conversationID, seq))
 }
-func (c *msgCache) getMessageDelUserListKey(conversationID string, seq int64) string {
-	return cachekey.GetMessageDelUserListKey(conversationID, seq)
+func (c *msgCache) getMessageDelUserListKey(ctx context.Context, conversationID string, seq int64) string {
+	return tenant.WithKey(ctx, cachekey.GetMessageDelUserListKey(conversationID, seq))
 }
 
-func (c *msgCache) getUserDelList(conversationID, userID string) string {
-	return cachekey.GetUserDelListKey(conversationID, userID)
+func (c *msgCache) getUserDelList(ctx context.Context, conversationID, userID string) string {
+	return tenant.WithKey(ctx, cachekey.GetUserDelListKey(conversationID, userID))
 }
 
-func (c *msgCache) getSendMsgKey(id string) string {
-	return cachekey.GetSendMsgKey(id)
+func (c *msgCache) getSendMsgKey(ctx context.Context, id string) string {
+	return tenant.WithKey(ctx, cachekey.GetSendMsgKey(id))
 }
 
-func (c *msgCache) getLockMessageTypeKey(clientMsgID string, TypeKey string) string {
-	return cachekey.GetLockMessageTypeKey(clientMsgID, TypeKey)
+func (c *msgCache) getLockMessageTypeKey(ctx context.Context, clientMsgID string, TypeKey string) string {
+	return tenant.WithKey(ctx, cachekey.GetLockMessageTypeKey(clientMsgID, TypeKey))
 }
 
-func (c *msgCache) getMessageReactionExPrefix(clientMsgID string, sessionType int32) string {
-	return cachekey.GetMessageReactionExKey(clientMsgID, sessionType)
+func (c *msgCache) getMessageReactionExPrefix(ctx context.Context, clientMsgID string, sessionType int32) string {
+	return tenant.WithKey(ctx, cachekey.GetMessageReactionExKey(clientMsgID, sessionType))
+}
+
+func (c *msgCache) getSendMsgIdempotencyKey(ctx context.Context, sendID string, clientMsgID string) string {
+	return tenant.WithKey(ctx, cachekey.GetSendMsgIdempotencyKey(sendID, clientMsgID))
 }
 
 func (c *msgCache) SetMessagesToCache(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) (int, error) {
 	msgMap := datautil.SliceToMap(msgs, func(msg *sdkws.MsgData) string {
-		return c.getMessageCacheKey(conversationID, msg.Seq)
+		return c.getMessageCacheKey(ctx, conversationID, msg.Seq)
 	})
 	keys := datautil.Slice(msgs, func(msg *sdkws.MsgData) string {
-		return c.getMessageCacheKey(conversationID, msg.Seq)
+		return c.getMessageCacheKey(ctx, conversationID, msg.Seq)
 	})
 	err := ProcessKeysBySlot(ctx, c.rdb, keys, func(ctx context.Context, slot int64, keys []string) error {
 		var values []string
@@ -89,7 +96,7 @@ func (c *msgCache) SetMessagesToCache(ctx context.Context, conversationID string
 func (c *msgCache) DeleteMessagesFromCache(ctx context.Context, conversationID string, seqs []int64) error {
 	var keys []string
 	for _, seq := range seqs {
-		keys = append(keys, c.getMessageCacheKey(conversationID, seq))
+		keys = append(keys, c.getMessageCacheKey(ctx, conversationID, seq))
 	}
 
 	return ProcessKeysBySlot(ctx, c.rdb, keys, func(ctx context.Context, slot int64, keys []string) error {
@@ -98,26 +105,114 @@ func (c *msgCache) DeleteMessagesFromCache(ctx context.Context, conversationID s
 }
 
 func (c *msgCache) SetSendMsgStatus(ctx context.Context, id string, status int32) error {
-	return errs.Wrap(c.rdb.Set(ctx, c.getSendMsgKey(id), status, time.Hour*24).Err())
+	return errs.Wrap(c.rdb.Set(ctx, c.getSendMsgKey(ctx, id), status, time.Hour*24).Err())
 }
 
 func (c *msgCache) GetSendMsgStatus(ctx context.Context, id string) (int32, error) {
-	result, err := c.rdb.Get(ctx, c.getSendMsgKey(id)).Int()
+	result, err := c.rdb.Get(ctx, c.getSendMsgKey(ctx, id)).Int()
 	return int32(result), errs.Wrap(err)
 }
 
+var (
+	claimSendMsgScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 1 then
+	return redis.call("HMGET", KEYS[1], "serverMsgID", "sendTime", "seq", "done")
+end
+redis.call("HSET", KEYS[1], "serverMsgID", ARGV[1], "sendTime", ARGV[2], "seq", 0, "done", 0)
+redis.call("PEXPIRE", KEYS[1], ARGV[3])
+return false
+`)
+	setSendMsgFieldScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 1 then
+	return redis.call("HSET", KEYS[1], ARGV[1], ARGV[2])
+end
+return 0
+`)
+)
+
+func (c *msgCache) ClaimSendMsg(ctx context.Context, sendID, clientMsgID string, record *cache.SendMsgRecord, expire time.Duration) (*cache.SendMsgRecord, error) {
+	key := c.getSendMsgIdempotencyKey(ctx, sendID, clientMsgID)
+	res, err := claimSendMsgScript.Run(ctx, c.rdb, []string{key}, record.ServerMsgID, record.SendTime, expire.Milliseconds()).Slice()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	field := func(i int) string {
+		if i < len(res) {
+			s, _ := res[i].(string)
+			return s
+		}
+		return ""
+	}
+	sendTime, _ := strconv.ParseInt(field(1), 10, 64)
+	seq, _ := strconv.ParseInt(field(2), 10, 64)
+	return &cache.SendMsgRecord{
+		ServerMsgID: field(0),
+		SendTime:    sendTime,
+		Seq:         seq,
+		Done:        field(3) == "1",
+	}, nil
+}
+
+func (c *msgCache) FinishSendMsg(ctx context.Context, sendID, clientMsgID string) error {
+	key := c.getSendMsgIdempotencyKey(ctx, sendID, clientMsgID)
+	return errs.Wrap(setSendMsgFieldScript.Run(ctx, c.rdb, []string{key}, "done", 1).Err())
+}
+
+func (c *msgCache) ReleaseSendMsg(ctx context.Context, sendID, clientMsgID string) error {
+	return errs.Wrap(c.rdb.Del(ctx, c.getSendMsgIdempotencyKey(ctx, sendID, clientMsgID)).Err())
+}
+
+func (c *msgCache) GetSendMsgSeqs(ctx context.Context, msgs []*sdkws.MsgData) ([]int64, error) {
+	seqs := make([]int64, len(msgs))
+	if len(msgs) == 0 {
+		return seqs, nil
+	}
+	pipe := c.rdb.Pipeline()
+	cmds := make([]*redis.StringCmd, len(msgs))
+	for i, msg := range msgs {
+		cmds[i] = pipe.HGet(ctx, c.getSendMsgIdempotencyKey(ctx, msg.SendID, msg.ClientMsgID), "seq")
+	}
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return nil, errs.Wrap(err)
+	}
+	for i, cmd := range cmds {
+		seq, err := cmd.Int64()
+		if err != nil && !errors.Is(err, redis.Nil) {
+			return nil, errs.Wrap(err)
+		}
+		seqs[i] = seq
+	}
+	return seqs, nil
+}
+
+func (c *msgCache) SetSendMsgSeqs(ctx context.Context, msgs []*sdkws.MsgData) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+	pipe := c.rdb.Pipeline()
+	for _, msg := range msgs {
+		key := c.getSendMsgIdempotencyKey(ctx, msg.SendID, msg.ClientMsgID)
+		setSendMsgFieldScript.Eval(ctx, pipe, []string{key}, "seq", msg.Seq)
+	}
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
 func (c *msgCache) LockMessageTypeKey(ctx context.Context, clientMsgID string, TypeKey string) error {
-	key := c.getLockMessageTypeKey(clientMsgID, TypeKey)
+	key := c.getLockMessageTypeKey(ctx, clientMsgID, TypeKey)
 	return errs.Wrap(c.rdb.SetNX(ctx, key, 1, time.Minute).Err())
 }
 
 func (c *msgCache) UnLockMessageTypeKey(ctx context.Context, clientMsgID string, TypeKey string) error {
-	key := c.getLockMessageTypeKey(clientMsgID, TypeKey)
+	key := c.getLockMessageTypeKey(ctx, clientMsgID, TypeKey)
 	return errs.Wrap(c.rdb.Del(ctx, key).Err())
 }
 
 func (c *msgCache) JudgeMessageReactionExist(ctx context.Context, clientMsgID string, sessionType int32) (bool, error) {
-	n, err := c.rdb.Exists(ctx, c.getMessageReactionExPrefix(clientMsgID, sessionType)).Result()
+	n, err := c.rdb.Exists(ctx, c.getMessageReactionExPrefix(ctx, clientMsgID, sessionType)).Result()
 	if err != nil {
 		return false, errs.Wrap(err)
 	}
@@ -126,33 +221,33 @@ func (c *msgCache) JudgeMessageReactionExist(ctx context.Context, clientMsgID st
 }
 
 func (c *msgCache) SetMessageTypeKeyValue(ctx context.Context, clientMsgID string, sessionType int32, typeKey, value string) error {
-	return errs.Wrap(c.rdb.HSet(ctx, c.getMessageReactionExPrefix(clientMsgID, sessionType), typeKey, value).Err())
+	return errs.Wrap(c.rdb.HSet(ctx, c.getMessageReactionExPrefix(ctx, clientMsgID, sessionType), typeKey, value).Err())
 }
 
 func (c *msgCache) SetMessageReactionExpire(ctx context.Context, clientMsgID string, sessionType int32, expiration time.Duration) (bool, error) {
-	val, err := c.rdb.Expire(ctx, c.getMessageReactionExPrefix(clientMsgID, sessionType), expiration).Result()
+	val, err := c.rdb.Expire(ctx, c.getMessageReactionExPrefix(ctx, clientMsgID, sessionType), expiration).Result()
 	return val, errs.Wrap(err)
 }
 
 func (c *msgCache) GetMessageTypeKeyValue(ctx context.Context, clientMsgID string, sessionType int32, typeKey string) (string, error) {
-	val, err := c.rdb.HGet(ctx, c.getMessageReactionExPrefix(clientMsgID, sessionType), typeKey).Result()
+	val, err := c.rdb.HGet(ctx, c.getMessageReactionExPrefix(ctx, clientMsgID, sessionType), typeKey).Result()
 	return val, errs.Wrap(err)
 }
 
 func (c *msgCache) GetOneMessageAllReactionList(ctx context.Context, clientMsgID string, sessionType int32) (map[string]string, error) {
-	val, err := c.rdb.HGetAll(ctx, c.getMessageReactionExPrefix(clientMsgID, sessionType)).Result()
+	val, err := c.rdb.HGetAll(ctx, c.getMessageReactionExPrefix(ctx, clientMsgID, sessionType)).Result()
 	return val, errs.Wrap(err)
 }
 
 func (c *msgCache) DeleteOneMessageKey(ctx context.Context, clientMsgID string, sessionType int32, subKey string) error {
-	return errs.Wrap(c.rdb.HDel(ctx, c.getMessageReactionExPrefix(clientMsgID, sessionType), subKey).Err())
+	return errs.Wrap(c.rdb.HDel(ctx, c.getMessageReactionExPrefix(ctx, clientMsgID, sessionType), subKey).Err())
 }
 
 func (c *msgCache) GetMessagesBySeq(ctx context.Context, conversationID string, seqs []int64) (seqMsgs []*sdkws.MsgData, failedSeqs []int64, err error) {
 	var keys []string
 	keySeqMap := make(map[string]int64, 10)
 	for _, seq := range seqs {
-		key := c.getMessageCacheKey(conversationID, seq)
+		key := c.getMessageCacheKey(ctx, conversationID, seq)
 		keys = append(keys, key)
 		keySeqMap[key] = seq
 	}
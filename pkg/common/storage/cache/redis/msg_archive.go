@@ -0,0 +1,56 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/dtm-labs/rockscache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"github.com/redis/go-redis/v9"
+)
+
+func NewMsgArchiveCacheRedis(rdb redis.UniversalClient, expireTime time.Duration) cache.MsgArchiveCache {
+	return &msgArchiveCacheRedis{
+		expireTime: expireTime,
+		rocks:      rockscache.NewClient(rdb, *GetRocksCacheOptions()),
+	}
+}
+
+type msgArchiveCacheRedis struct {
+	rocks      *rockscache.Client
+	expireTime time.Duration
+}
+
+func (m *msgArchiveCacheRedis) getMsgArchiveDocKey(docID string) string {
+	return cachekey.GetMsgArchiveDocKey(docID)
+}
+
+func (m *msgArchiveCacheRedis) GetArchivedDoc(ctx context.Context, docID string, fn func(ctx context.Context) (*model.MsgDocModel, error)) (*model.MsgDocModel, error) {
+	return getCache(ctx, m.rocks, m.getMsgArchiveDocKey(docID), m.expireTime, fn)
+}
+
+func (m *msgArchiveCacheRedis) DelArchivedDocs(ctx context.Context, docIDs ...string) error {
+	for _, docID := range docIDs {
+		if err := m.rocks.TagAsDeleted2(ctx, tenant.WithKey(ctx, m.getMsgArchiveDocKey(docID))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/log"
 	"github.com/redis/go-redis/v9"
@@ -25,12 +26,12 @@ type userOnline struct {
 	channelName string
 }
 
-func (s *userOnline) getUserOnlineKey(userID string) string {
-	return cachekey.GetOnlineKey(userID)
+func (s *userOnline) getUserOnlineKey(ctx context.Context, userID string) string {
+	return tenant.WithKey(ctx, cachekey.GetOnlineKey(userID))
 }
 
 func (s *userOnline) GetOnline(ctx context.Context, userID string) ([]int32, error) {
-	members, err := s.rdb.ZRangeByScore(ctx, s.getUserOnlineKey(userID), &redis.ZRangeBy{
+	members, err := s.rdb.ZRangeByScore(ctx, s.getUserOnlineKey(ctx, userID), &redis.ZRangeBy{
 		Min: strconv.FormatInt(time.Now().Unix(), 10),
 		Max: "+inf",
 	}).Result()
@@ -82,7 +83,7 @@ func (s *userOnline) SetUserOnline(ctx context.Context, userID string, online, o
 	for _, platformID := range online {
 		argv = append(argv, platformID)
 	}
-	keys := []string{s.getUserOnlineKey(userID), userID, s.channelName}
+	keys := []string{s.getUserOnlineKey(ctx, userID), tenant.WithKey(ctx, userID), s.channelName}
 	status, err := s.rdb.Eval(ctx, script, keys, argv).Result()
 	if err != nil {
 		log.ZError(ctx, "redis SetUserOnline", err, "userID", userID, "online", online, "offline", offline)
@@ -3,8 +3,10 @@ package redis
 import (
 	"context"
 	"github.com/dtm-labs/rockscache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
 	"github.com/redis/go-redis/v9"
 	"golang.org/x/sync/errgroup"
 )
@@ -197,7 +199,9 @@ func ProcessKeysBySlot(
 	return nil
 }
 
+// DeleteCacheBySlot deletes keys scoped to the tenant of ctx.
 func DeleteCacheBySlot(ctx context.Context, rcClient *rockscache.Client, keys []string) error {
+	keys = datautil.Slice(keys, func(key string) string { return tenant.WithKey(ctx, key) })
 	switch len(keys) {
 	case 0:
 		return nil
@@ -8,10 +8,13 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/log"
 	"github.com/redis/go-redis/v9"
+	"strconv"
 	"time"
 )
 
@@ -98,7 +101,7 @@ func (s *seqConversationCacheRedis) GetMaxSeqs(ctx context.Context, conversation
 	keys := make([]string, 0, len(conversationIDs))
 	keyConversationID := make(map[string]string, len(conversationIDs))
 	for _, conversationID := range conversationIDs {
-		key := s.getSeqMallocKey(conversationID)
+		key := s.getSeqMallocKey(ctx, conversationID)
 		if _, ok := keyConversationID[key]; ok {
 			continue
 		}
@@ -121,8 +124,9 @@ func (s *seqConversationCacheRedis) GetMaxSeqs(ctx context.Context, conversation
 	return seqs, nil
 }
 
-func (s *seqConversationCacheRedis) getSeqMallocKey(conversationID string) string {
-	return cachekey.GetMallocSeqKey(conversationID)
+// getSeqMallocKey is used with the redis client directly and is scoped to the tenant of ctx here.
+func (s *seqConversationCacheRedis) getSeqMallocKey(ctx context.Context, conversationID string) string {
+	return tenant.WithKey(ctx, cachekey.GetMallocSeqKey(conversationID))
 }
 
 func (s *seqConversationCacheRedis) setSeq(ctx context.Context, key string, owner int64, currSeq int64, lastSeq int64) (int64, error) {
@@ -270,7 +274,7 @@ func (s *seqConversationCacheRedis) Malloc(ctx context.Context, conversationID s
 	if size < 0 {
 		return 0, errs.New("size must be greater than 0")
 	}
-	key := s.getSeqMallocKey(conversationID)
+	key := s.getSeqMallocKey(ctx, conversationID)
 	for i := 0; i < 10; i++ {
 		states, err := s.malloc(ctx, key, size)
 		if err != nil {
@@ -331,3 +335,69 @@ func (s *seqConversationCacheRedis) SetMinSeqs(ctx context.Context, seqs map[str
 	}
 	return DeleteCacheBySlot(ctx, s.rocks, keys)
 }
+
+func (s *seqConversationCacheRedis) GetCacheMaxSeq(ctx context.Context, conversationID string) (int64, int64, bool, error) {
+	values, err := s.rdb.HMGet(ctx, s.getSeqMallocKey(ctx, conversationID), "CURR", "LAST").Result()
+	if err != nil {
+		return 0, 0, false, errs.Wrap(err)
+	}
+	if values[0] == nil || values[1] == nil {
+		return 0, 0, false, nil
+	}
+	curr, err := strconv.ParseInt(fmt.Sprint(values[0]), 10, 64)
+	if err != nil {
+		return 0, 0, false, errs.WrapMsg(err, "invalid cached seq", "conversationID", conversationID)
+	}
+	last, err := strconv.ParseInt(fmt.Sprint(values[1]), 10, 64)
+	if err != nil {
+		return 0, 0, false, errs.WrapMsg(err, "invalid cached seq", "conversationID", conversationID)
+	}
+	return curr, last, true, nil
+}
+
+func (s *seqConversationCacheRedis) DelCacheMaxSeq(ctx context.Context, conversationID string) error {
+	// 0: deleted or not cached
+	// 1: locked by a malloc that is loading from the database
+	script := `
+local key = KEYS[1]
+if redis.call("HEXISTS", key, "LOCK") == 1 then
+	return 1
+end
+redis.call("DEL", key)
+return 0
+`
+	key := s.getSeqMallocKey(ctx, conversationID)
+	for i := 0; i < 10; i++ {
+		state, err := s.rdb.Eval(ctx, script, []string{key}).Int64()
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		if state == 0 {
+			return nil
+		}
+		if err := s.wait(ctx); err != nil {
+			return err
+		}
+	}
+	return errs.New("del seq cache waiting for lock timeout", "conversationID", conversationID)
+}
+
+func (s *seqConversationCacheRedis) GetDBSeq(ctx context.Context, conversationID string) (int64, int64, error) {
+	minSeq, err := s.mgo.GetMinSeq(ctx, conversationID)
+	if err != nil {
+		return 0, 0, err
+	}
+	maxSeq, err := s.mgo.GetMaxSeq(ctx, conversationID)
+	if err != nil {
+		return 0, 0, err
+	}
+	return minSeq, maxSeq, nil
+}
+
+func (s *seqConversationCacheRedis) RaiseDBMaxSeq(ctx context.Context, conversationID string, seq int64) error {
+	return s.mgo.RaiseMaxSeq(ctx, conversationID, seq)
+}
+
+func (s *seqConversationCacheRedis) FindSeqConversations(ctx context.Context, after string, limit int) ([]*model.SeqConversation, error) {
+	return s.mgo.FindSeqConversations(ctx, after, limit)
+}
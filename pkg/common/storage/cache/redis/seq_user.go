@@ -6,6 +6,8 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/tools/errs"
 	"github.com/redis/go-redis/v9"
 	"strconv"
@@ -54,7 +56,7 @@ func (s *seqUserCacheRedis) SetUserMaxSeq(ctx context.Context, conversationID st
 	if err := s.mgo.SetUserMaxSeq(ctx, conversationID, userID, seq); err != nil {
 		return err
 	}
-	return s.rocks.TagAsDeleted2(ctx, s.getSeqUserMaxSeqKey(conversationID, userID))
+	return s.rocks.TagAsDeleted2(ctx, tenant.WithKey(ctx, s.getSeqUserMaxSeqKey(conversationID, userID)))
 }
 
 func (s *seqUserCacheRedis) GetUserMinSeq(ctx context.Context, conversationID string, userID string) (int64, error) {
@@ -79,7 +81,7 @@ func (s *seqUserCacheRedis) SetUserReadSeq(ctx context.Context, conversationID s
 			return err
 		}
 	}
-	if err := s.rocks.RawSet(ctx, s.getSeqUserReadSeqKey(conversationID, userID), strconv.Itoa(int(seq)), s.readExpireTime); err != nil {
+	if err := s.rocks.RawSet(ctx, tenant.WithKey(ctx, s.getSeqUserReadSeqKey(conversationID, userID)), strconv.Itoa(int(seq)), s.readExpireTime); err != nil {
 		return errs.Wrap(err)
 	}
 	return nil
@@ -100,7 +102,7 @@ func (s *seqUserCacheRedis) setUserRedisReadSeqs(ctx context.Context, userID str
 	keys := make([]string, 0, len(seqs))
 	keySeq := make(map[string]int64)
 	for conversationID, seq := range seqs {
-		key := s.getSeqUserReadSeqKey(conversationID, userID)
+		key := tenant.WithKey(ctx, s.getSeqUserReadSeqKey(conversationID, userID))
 		keys = append(keys, key)
 		keySeq[key] = seq
 	}
@@ -164,6 +166,42 @@ func (s *seqUserCacheRedis) GetUserReadSeqs(ctx context.Context, userID string,
 	return data, nil
 }
 
+func (s *seqUserCacheRedis) GetUsersReadSeq(ctx context.Context, conversationID string, userIDs []string) (map[string]int64, error) {
+	return s.getUsersSeq(ctx, conversationID, userIDs, s.readExpireTime, s.getSeqUserReadSeqKey, s.mgo.GetUsersReadSeq)
+}
+
+func (s *seqUserCacheRedis) GetUsersMinSeq(ctx context.Context, conversationID string, userIDs []string) (map[string]int64, error) {
+	return s.getUsersSeq(ctx, conversationID, userIDs, s.expireTime, s.getSeqUserMinSeqKey, s.mgo.GetUsersMinSeq)
+}
+
+func (s *seqUserCacheRedis) getUsersSeq(ctx context.Context, conversationID string, userIDs []string, expire time.Duration,
+	getKey func(conversationID string, userID string) string,
+	find func(ctx context.Context, conversationID string, userIDs []string) (map[string]int64, error)) (map[string]int64, error) {
+	res, err := batchGetCache2(ctx, s.rocks, expire, userIDs, func(userID string) string {
+		return getKey(conversationID, userID)
+	}, func(v *userSeqModel) string {
+		return v.UserID
+	}, func(ctx context.Context, userIDs []string) ([]*userSeqModel, error) {
+		seqs, err := find(ctx, conversationID, userIDs)
+		if err != nil {
+			return nil, err
+		}
+		res := make([]*userSeqModel, 0, len(seqs))
+		for userID, seq := range seqs {
+			res = append(res, &userSeqModel{UserID: userID, Seq: seq})
+		}
+		return res, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	data := make(map[string]int64, len(res))
+	for _, v := range res {
+		data[v.UserID] = v.Seq
+	}
+	return data, nil
+}
+
 var _ BatchCacheCallback[string] = (*readSeqModel)(nil)
 
 type readSeqModel struct {
@@ -183,3 +221,49 @@ func (r *readSeqModel) UnmarshalJSON(bytes []byte) (err error) {
 func (r *readSeqModel) MarshalJSON() ([]byte, error) {
 	return []byte(strconv.FormatInt(r.Seq, 10)), nil
 }
+
+var _ BatchCacheCallback[string] = (*userSeqModel)(nil)
+
+type userSeqModel struct {
+	UserID string
+	Seq    int64
+}
+
+func (u *userSeqModel) BatchCache(userID string) {
+	u.UserID = userID
+}
+
+func (u *userSeqModel) UnmarshalJSON(bytes []byte) (err error) {
+	u.Seq, err = strconv.ParseInt(string(bytes), 10, 64)
+	return
+}
+
+func (u *userSeqModel) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.FormatInt(u.Seq, 10)), nil
+}
+
+func (s *seqUserCacheRedis) DeleteUserSeqs(ctx context.Context, userID string, conversationIDs []string) error {
+	if err := s.mgo.DeleteUserSeqs(ctx, userID); err != nil {
+		return err
+	}
+	keys := make([]string, 0, len(conversationIDs)*3)
+	for _, conversationID := range conversationIDs {
+		keys = append(keys,
+			s.getSeqUserMaxSeqKey(conversationID, userID),
+			s.getSeqUserMinSeqKey(conversationID, userID),
+			s.getSeqUserReadSeqKey(conversationID, userID),
+		)
+	}
+	return DeleteCacheBySlot(ctx, s.rocks, keys)
+}
+
+func (s *seqUserCacheRedis) FindConversationSeqs(ctx context.Context, conversationID string) ([]*model.SeqUser, error) {
+	return s.mgo.FindConversationSeqs(ctx, conversationID)
+}
+
+func (s *seqUserCacheRedis) ResetUserReadSeq(ctx context.Context, conversationID string, userID string, seq int64) error {
+	if err := s.mgo.SetUserReadSeq(ctx, conversationID, userID, seq); err != nil {
+		return err
+	}
+	return s.setUserRedisReadSeqs(ctx, userID, map[string]int64{conversationID: seq})
+}
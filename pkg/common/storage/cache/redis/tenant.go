@@ -0,0 +1,71 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/dtm-labs/rockscache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	tenantExpireTime = time.Second * 60 * 60 * 12
+)
+
+func NewTenantCacheRedis(rdb redis.UniversalClient, tenantDB database.Tenant, options *rockscache.Options) cache.TenantCache {
+	return &TenantCacheRedis{
+		BatchDeleter: NewBatchDeleterRedis(rdb, options, nil),
+		expireTime:   tenantExpireTime,
+		rcClient:     rockscache.NewClient(rdb, *options),
+		tenantDB:     tenantDB,
+	}
+}
+
+type TenantCacheRedis struct {
+	cache.BatchDeleter
+	expireTime time.Duration
+	rcClient   *rockscache.Client
+	tenantDB   database.Tenant
+}
+
+func (t *TenantCacheRedis) CloneTenantCache() cache.TenantCache {
+	return &TenantCacheRedis{
+		BatchDeleter: t.BatchDeleter.Clone(),
+		expireTime:   t.expireTime,
+		rcClient:     t.rcClient,
+		tenantDB:     t.tenantDB,
+	}
+}
+
+func (t *TenantCacheRedis) GetTenant(ctx context.Context, tenantID string) (*model.Tenant, error) {
+	return getCache(tenant.WithID(ctx, ""), t.rcClient, cachekey.GetTenantInfoKey(tenantID), t.expireTime, func(ctx context.Context) (*model.Tenant, error) {
+		return t.tenantDB.Take(ctx, tenantID)
+	})
+}
+
+func (t *TenantCacheRedis) DelTenant(tenantIDs ...string) cache.TenantCache {
+	c := t.CloneTenantCache()
+	for _, tenantID := range tenantIDs {
+		c.AddKeys(cachekey.GetTenantInfoKey(tenantID))
+	}
+	return c
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/tools/errs"
 	"github.com/redis/go-redis/v9"
 	"time"
@@ -39,20 +40,20 @@ func (c *thirdCache) getGetuiTaskIDKey() string {
 	return cachekey.GetGetuiTaskIDKey()
 }
 
-func (c *thirdCache) getUserBadgeUnreadCountSumKey(userID string) string {
-	return cachekey.GetUserBadgeUnreadCountSumKey(userID)
+func (c *thirdCache) getUserBadgeUnreadCountSumKey(ctx context.Context, userID string) string {
+	return tenant.WithKey(ctx, cachekey.GetUserBadgeUnreadCountSumKey(userID))
 }
 
-func (c *thirdCache) getFcmAccountTokenKey(account string, platformID int) string {
-	return cachekey.GetFcmAccountTokenKey(account, platformID)
+func (c *thirdCache) getFcmAccountTokenKey(ctx context.Context, account string, platformID int) string {
+	return tenant.WithKey(ctx, cachekey.GetFcmAccountTokenKey(account, platformID))
 }
 
 func (c *thirdCache) SetFcmToken(ctx context.Context, account string, platformID int, fcmToken string, expireTime int64) (err error) {
-	return errs.Wrap(c.rdb.Set(ctx, c.getFcmAccountTokenKey(account, platformID), fcmToken, time.Duration(expireTime)*time.Second).Err())
+	return errs.Wrap(c.rdb.Set(ctx, c.getFcmAccountTokenKey(ctx, account, platformID), fcmToken, time.Duration(expireTime)*time.Second).Err())
 }
 
 func (c *thirdCache) GetFcmToken(ctx context.Context, account string, platformID int) (string, error) {
-	val, err := c.rdb.Get(ctx, c.getFcmAccountTokenKey(account, platformID)).Result()
+	val, err := c.rdb.Get(ctx, c.getFcmAccountTokenKey(ctx, account, platformID)).Result()
 	if err != nil {
 		return "", errs.Wrap(err)
 	}
@@ -60,21 +61,21 @@ func (c *thirdCache) GetFcmToken(ctx context.Context, account string, platformID
 }
 
 func (c *thirdCache) DelFcmToken(ctx context.Context, account string, platformID int) error {
-	return errs.Wrap(c.rdb.Del(ctx, c.getFcmAccountTokenKey(account, platformID)).Err())
+	return errs.Wrap(c.rdb.Del(ctx, c.getFcmAccountTokenKey(ctx, account, platformID)).Err())
 }
 
 func (c *thirdCache) IncrUserBadgeUnreadCountSum(ctx context.Context, userID string) (int, error) {
-	seq, err := c.rdb.Incr(ctx, c.getUserBadgeUnreadCountSumKey(userID)).Result()
+	seq, err := c.rdb.Incr(ctx, c.getUserBadgeUnreadCountSumKey(ctx, userID)).Result()
 
 	return int(seq), errs.Wrap(err)
 }
 
 func (c *thirdCache) SetUserBadgeUnreadCountSum(ctx context.Context, userID string, value int) error {
-	return errs.Wrap(c.rdb.Set(ctx, c.getUserBadgeUnreadCountSumKey(userID), value, 0).Err())
+	return errs.Wrap(c.rdb.Set(ctx, c.getUserBadgeUnreadCountSumKey(ctx, userID), value, 0).Err())
 }
 
 func (c *thirdCache) GetUserBadgeUnreadCountSum(ctx context.Context, userID string) (int, error) {
-	val, err := c.rdb.Get(ctx, c.getUserBadgeUnreadCountSumKey(userID)).Int()
+	val, err := c.rdb.Get(ctx, c.getUserBadgeUnreadCountSumKey(ctx, userID)).Int()
 	return val, errs.Wrap(err)
 }
 
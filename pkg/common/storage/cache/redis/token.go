@@ -18,6 +18,7 @@ import (
 	"context"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/utils/stringutil"
 	"github.com/redis/go-redis/v9"
@@ -36,12 +37,12 @@ func NewTokenCacheModel(rdb redis.UniversalClient, accessExpire int64) cache.Tok
 }
 
 func (c *tokenCache) SetTokenFlag(ctx context.Context, userID string, platformID int, token string, flag int) error {
-	return errs.Wrap(c.rdb.HSet(ctx, cachekey.GetTokenKey(userID, platformID), token, flag).Err())
+	return errs.Wrap(c.rdb.HSet(ctx, c.getTokenKey(ctx, userID, platformID), token, flag).Err())
 }
 
 // SetTokenFlagEx set token and flag with expire time
 func (c *tokenCache) SetTokenFlagEx(ctx context.Context, userID string, platformID int, token string, flag int) error {
-	key := cachekey.GetTokenKey(userID, platformID)
+	key := c.getTokenKey(ctx, userID, platformID)
 	if err := c.rdb.HSet(ctx, key, token, flag).Err(); err != nil {
 		return errs.Wrap(err)
 	}
@@ -52,7 +53,7 @@ func (c *tokenCache) SetTokenFlagEx(ctx context.Context, userID string, platform
 }
 
 func (c *tokenCache) GetTokensWithoutError(ctx context.Context, userID string, platformID int) (map[string]int, error) {
-	m, err := c.rdb.HGetAll(ctx, cachekey.GetTokenKey(userID, platformID)).Result()
+	m, err := c.rdb.HGetAll(ctx, c.getTokenKey(ctx, userID, platformID)).Result()
 	if err != nil {
 		return nil, errs.Wrap(err)
 	}
@@ -69,11 +70,16 @@ func (c *tokenCache) SetTokenMapByUidPid(ctx context.Context, userID string, pla
 	for k, v := range m {
 		mm[k] = v
 	}
-	return errs.Wrap(c.rdb.HSet(ctx, cachekey.GetTokenKey(userID, platformID), mm).Err())
+	return errs.Wrap(c.rdb.HSet(ctx, c.getTokenKey(ctx, userID, platformID), mm).Err())
 }
 
 func (c *tokenCache) DeleteTokenByUidPid(ctx context.Context, userID string, platformID int, fields []string) error {
-	return errs.Wrap(c.rdb.HDel(ctx, cachekey.GetTokenKey(userID, platformID), fields...).Err())
+	return errs.Wrap(c.rdb.HDel(ctx, c.getTokenKey(ctx, userID, platformID), fields...).Err())
+}
+
+// getTokenKey scopes the token key to the tenant of ctx, the same user ID may exist in several tenants.
+func (c *tokenCache) getTokenKey(ctx context.Context, userID string, platformID int) string {
+	return tenant.WithKey(ctx, cachekey.GetTokenKey(userID, platformID))
 }
 
 func (c *tokenCache) getExpireTime(t int64) time.Duration {
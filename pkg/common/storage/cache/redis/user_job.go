@@ -0,0 +1,69 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	renewUserJobLock = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+	releaseUserJobLock = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+)
+
+func NewUserJobCacheRedis(rdb redis.UniversalClient) cache.UserJobCache {
+	return &userJobCacheRedis{rdb: rdb}
+}
+
+type userJobCacheRedis struct {
+	rdb redis.UniversalClient
+}
+
+func (u *userJobCacheRedis) LockJob(ctx context.Context, jobID string, owner string, expire time.Duration) (bool, error) {
+	ok, err := u.rdb.SetNX(ctx, tenant.WithKey(ctx, cachekey.GetUserJobLockKey(jobID)), owner, expire).Result()
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+	return ok, nil
+}
+
+func (u *userJobCacheRedis) RenewJobLock(ctx context.Context, jobID string, owner string, expire time.Duration) (bool, error) {
+	n, err := renewUserJobLock.Run(ctx, u.rdb, []string{tenant.WithKey(ctx, cachekey.GetUserJobLockKey(jobID))}, owner, expire.Milliseconds()).Int()
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+	return n > 0, nil
+}
+
+func (u *userJobCacheRedis) UnlockJob(ctx context.Context, jobID string, owner string) error {
+	return errs.Wrap(releaseUserJobLock.Run(ctx, u.rdb, []string{tenant.WithKey(ctx, cachekey.GetUserJobLockKey(jobID))}, owner).Err())
+}
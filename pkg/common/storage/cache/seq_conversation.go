@@ -1,6 +1,10 @@
 package cache
 
-import "context"
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
 
 type SeqConversationCache interface {
 	Malloc(ctx context.Context, conversationID string, size int64) (int64, error)
@@ -9,4 +13,15 @@ type SeqConversationCache interface {
 	GetMinSeq(ctx context.Context, conversationID string) (int64, error)
 	GetMaxSeqs(ctx context.Context, conversationIDs []string) (map[string]int64, error)
 	SetMinSeqs(ctx context.Context, seqs map[string]int64) error
+	// GetCacheMaxSeq returns the last allocated seq and the end of the block reserved in the
+	// cache without loading them, ok is false when the conversation is not cached.
+	GetCacheMaxSeq(ctx context.Context, conversationID string) (curr int64, last int64, ok bool, err error)
+	// DelCacheMaxSeq drops the reserved block so that the next malloc continues from the database.
+	DelCacheMaxSeq(ctx context.Context, conversationID string) error
+	// GetDBSeq returns the min and max seq stored in the database, bypassing the cache.
+	GetDBSeq(ctx context.Context, conversationID string) (minSeq int64, maxSeq int64, err error)
+	// RaiseDBMaxSeq sets the max seq stored in the database to seq unless it is already larger.
+	RaiseDBMaxSeq(ctx context.Context, conversationID string, seq int64) error
+	// FindSeqConversations pages through the conversations of the database ordered by ID.
+	FindSeqConversations(ctx context.Context, after string, limit int) ([]*model.SeqConversation, error)
 }
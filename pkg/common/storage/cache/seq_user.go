@@ -1,6 +1,10 @@
 package cache
 
-import "context"
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
 
 type SeqUser interface {
 	GetUserMaxSeq(ctx context.Context, conversationID string, userID string) (int64, error)
@@ -12,4 +16,13 @@ type SeqUser interface {
 	SetUserMinSeqs(ctx context.Context, userID string, seqs map[string]int64) error
 	SetUserReadSeqs(ctx context.Context, userID string, seqs map[string]int64) error
 	GetUserReadSeqs(ctx context.Context, userID string, conversationIDs []string) (map[string]int64, error)
+	GetUsersReadSeq(ctx context.Context, conversationID string, userIDs []string) (map[string]int64, error)
+	GetUsersMinSeq(ctx context.Context, conversationID string, userIDs []string) (map[string]int64, error)
+	// DeleteUserSeqs removes the stored seqs of the user and their cache in the conversations.
+	DeleteUserSeqs(ctx context.Context, userID string, conversationIDs []string) error
+	// FindConversationSeqs returns the stored seqs of every user of the conversation. Read seqs
+	// are only written through every few updates, GetUsersReadSeq returns the current ones.
+	FindConversationSeqs(ctx context.Context, conversationID string) ([]*model.SeqUser, error)
+	// ResetUserReadSeq writes the read seq to the database and the cache, so that it can be lowered.
+	ResetUserReadSeq(ctx context.Context, conversationID string, userID string, seq int64) error
 }
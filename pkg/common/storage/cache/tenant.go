@@ -0,0 +1,30 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+// TenantCache caches tenant records. Tenants are shared by the whole deployment, so the
+// keys are never scoped to the tenant of the context.
+type TenantCache interface {
+	BatchDeleter
+	CloneTenantCache() TenantCache
+	GetTenant(ctx context.Context, tenantID string) (*model.Tenant, error)
+	DelTenant(tenantIDs ...string) TenantCache
+}
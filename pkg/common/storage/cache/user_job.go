@@ -0,0 +1,29 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+// UserJobCache keeps a user job running on a single instance at a time.
+type UserJobCache interface {
+	// LockJob claims the job for owner until expire, false when another owner holds it.
+	LockJob(ctx context.Context, jobID string, owner string, expire time.Duration) (bool, error)
+	// RenewJobLock extends the claim of owner, false when it has been lost.
+	RenewJobLock(ctx context.Context, jobID string, owner string, expire time.Duration) (bool, error)
+	UnlockJob(ctx context.Context, jobID string, owner string) error
+}
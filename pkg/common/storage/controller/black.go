@@ -32,6 +32,8 @@ type BlackDatabase interface {
 	// FindOwnerBlacks get BlackList list
 	FindOwnerBlacks(ctx context.Context, ownerUserID string, pagination pagination.Pagination) (total int64, blacks []*model.Black, err error)
 	FindBlackInfos(ctx context.Context, ownerUserID string, userIDs []string) (blacks []*model.Black, err error)
+	// FindByBlockUser get the BlackList entries of other users that block blockUserID
+	FindByBlockUser(ctx context.Context, blockUserID string, pagination pagination.Pagination) (total int64, blacks []*model.Black, err error)
 	// CheckIn Check whether user2 is in the black list of user1 (inUser1Blacks==true) Check whether user1 is in the black list of user2 (inUser2Blacks==true)
 	CheckIn(ctx context.Context, userID1, userID2 string) (inUser1Blacks bool, inUser2Blacks bool, err error)
 }
@@ -75,6 +77,11 @@ func (b *blackDatabase) FindOwnerBlacks(ctx context.Context, ownerUserID string,
 	return b.black.FindOwnerBlacks(ctx, ownerUserID, pagination)
 }
 
+// FindByBlockUser Get the Blacklist entries blocking the user.
+func (b *blackDatabase) FindByBlockUser(ctx context.Context, blockUserID string, pagination pagination.Pagination) (total int64, blacks []*model.Black, err error) {
+	return b.black.FindByBlockUser(ctx, blockUserID, pagination)
+}
+
 // FindOwnerBlacks Get Blacklist List.
 func (b *blackDatabase) CheckIn(ctx context.Context, userID1, userID2 string) (inUser1Blacks bool, inUser2Blacks bool, err error) {
 	userID1BlackIDs, err := b.cache.GetBlackIDs(ctx, userID1)
@@ -0,0 +1,254 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/db/tx"
+)
+
+// CommunityDatabase stores communities, their members and the groups linked to them. Changes
+// a member sees in the community are counted in the join version log of the member.
+type CommunityDatabase interface {
+	CreateCommunity(ctx context.Context, community *model.Community, members []*model.CommunityMember, groups []*model.CommunityGroup) error
+	TakeCommunity(ctx context.Context, communityID string) (*model.Community, error)
+	FindCommunity(ctx context.Context, communityIDs []string) ([]*model.Community, error)
+	UpdateCommunity(ctx context.Context, communityID string, data map[string]any) error
+	// DismissCommunity deletes the community with its members and unlinks its groups.
+	DismissCommunity(ctx context.Context, communityID string) error
+
+	AddMembers(ctx context.Context, members []*model.CommunityMember) error
+	DeleteMembers(ctx context.Context, communityID string, userIDs []string) error
+	TakeMember(ctx context.Context, communityID string, userID string) (*model.CommunityMember, error)
+	FindMembers(ctx context.Context, communityID string, userIDs []string) ([]*model.CommunityMember, error)
+	FindMemberUserIDs(ctx context.Context, communityID string) ([]string, error)
+	PageMembers(ctx context.Context, communityID string, pagination pagination.Pagination) (int64, []*model.CommunityMember, error)
+	UpdateMemberRoleLevel(ctx context.Context, communityID string, userID string, roleLevel int32) error
+
+	AddGroups(ctx context.Context, groups []*model.CommunityGroup) error
+	RemoveGroups(ctx context.Context, communityID string, groupIDs []string) error
+	FindGroupIDs(ctx context.Context, communityID string) ([]string, error)
+	// GetGroupCommunityID returns the community of the group, empty when the group is in none.
+	GetGroupCommunityID(ctx context.Context, groupID string) (string, error)
+
+	FindJoinCommunityIDs(ctx context.Context, userID string) ([]string, error)
+	FindJoinIncrVersion(ctx context.Context, userID string, version uint, limit int) (*model.VersionLog, error)
+	FindMaxJoinCommunityVersionCache(ctx context.Context, userID string) (*model.VersionLog, error)
+}
+
+func NewCommunityDatabase(communityDB database.Community, memberDB database.CommunityMember, groupDB database.CommunityGroup,
+	cache cache.CommunityCache, ctxTx tx.Tx) CommunityDatabase {
+	return &communityDatabase{
+		communityDB: communityDB,
+		memberDB:    memberDB,
+		groupDB:     groupDB,
+		cache:       cache,
+		ctxTx:       ctxTx,
+	}
+}
+
+type communityDatabase struct {
+	communityDB database.Community
+	memberDB    database.CommunityMember
+	groupDB     database.CommunityGroup
+	cache       cache.CommunityCache
+	ctxTx       tx.Tx
+}
+
+// communityChanged counts a change of the community in the join version log of every member.
+func (c *communityDatabase) communityChanged(ctx context.Context, communityID string) error {
+	userIDs, err := c.memberDB.FindUserIDs(ctx, communityID)
+	if err != nil {
+		return err
+	}
+	for _, userID := range userIDs {
+		if err := c.memberDB.JoinCommunityIncrVersion(ctx, userID, []string{communityID}, model.VersionStateUpdate); err != nil {
+			return err
+		}
+	}
+	return c.cache.DelMaxJoinCommunityVersion(ctx, userIDs...)
+}
+
+func (c *communityDatabase) CreateCommunity(ctx context.Context, community *model.Community, members []*model.CommunityMember, groups []*model.CommunityGroup) error {
+	return c.ctxTx.Transaction(ctx, func(ctx context.Context) error {
+		if err := c.communityDB.Create(ctx, community); err != nil {
+			return err
+		}
+		if err := c.memberDB.Create(ctx, members); err != nil {
+			return err
+		}
+		if err := c.groupDB.Create(ctx, groups); err != nil {
+			return err
+		}
+		userIDs := make([]string, 0, len(members))
+		for _, member := range members {
+			userIDs = append(userIDs, member.UserID)
+		}
+		groupIDs := make([]string, 0, len(groups))
+		for _, group := range groups {
+			groupIDs = append(groupIDs, group.GroupID)
+		}
+		if err := c.cache.DelGroupCommunityID(ctx, groupIDs...); err != nil {
+			return err
+		}
+		return c.cache.DelMaxJoinCommunityVersion(ctx, userIDs...)
+	})
+}
+
+func (c *communityDatabase) TakeCommunity(ctx context.Context, communityID string) (*model.Community, error) {
+	return c.communityDB.Take(ctx, communityID)
+}
+
+func (c *communityDatabase) FindCommunity(ctx context.Context, communityIDs []string) ([]*model.Community, error) {
+	return c.communityDB.Find(ctx, communityIDs)
+}
+
+func (c *communityDatabase) UpdateCommunity(ctx context.Context, communityID string, data map[string]any) error {
+	if len(data) == 0 {
+		return nil
+	}
+	return c.ctxTx.Transaction(ctx, func(ctx context.Context) error {
+		if err := c.communityDB.UpdateMap(ctx, communityID, data); err != nil {
+			return err
+		}
+		return c.communityChanged(ctx, communityID)
+	})
+}
+
+func (c *communityDatabase) DismissCommunity(ctx context.Context, communityID string) error {
+	return c.ctxTx.Transaction(ctx, func(ctx context.Context) error {
+		userIDs, err := c.memberDB.FindUserIDs(ctx, communityID)
+		if err != nil {
+			return err
+		}
+		groupIDs, err := c.groupDB.FindGroupIDs(ctx, communityID)
+		if err != nil {
+			return err
+		}
+		if err := c.memberDB.Delete(ctx, communityID, userIDs); err != nil {
+			return err
+		}
+		if err := c.groupDB.Delete(ctx, communityID, nil); err != nil {
+			return err
+		}
+		if err := c.communityDB.Delete(ctx, communityID); err != nil {
+			return err
+		}
+		if err := c.cache.DelGroupCommunityID(ctx, groupIDs...); err != nil {
+			return err
+		}
+		return c.cache.DelMaxJoinCommunityVersion(ctx, userIDs...)
+	})
+}
+
+func (c *communityDatabase) AddMembers(ctx context.Context, members []*model.CommunityMember) error {
+	if err := c.memberDB.Create(ctx, members); err != nil {
+		return err
+	}
+	userIDs := make([]string, 0, len(members))
+	for _, member := range members {
+		userIDs = append(userIDs, member.UserID)
+	}
+	return c.cache.DelMaxJoinCommunityVersion(ctx, userIDs...)
+}
+
+func (c *communityDatabase) DeleteMembers(ctx context.Context, communityID string, userIDs []string) error {
+	if err := c.memberDB.Delete(ctx, communityID, userIDs); err != nil {
+		return err
+	}
+	return c.cache.DelMaxJoinCommunityVersion(ctx, userIDs...)
+}
+
+func (c *communityDatabase) TakeMember(ctx context.Context, communityID string, userID string) (*model.CommunityMember, error) {
+	return c.memberDB.Take(ctx, communityID, userID)
+}
+
+func (c *communityDatabase) FindMembers(ctx context.Context, communityID string, userIDs []string) ([]*model.CommunityMember, error) {
+	return c.memberDB.Find(ctx, communityID, userIDs)
+}
+
+func (c *communityDatabase) FindMemberUserIDs(ctx context.Context, communityID string) ([]string, error) {
+	return c.memberDB.FindUserIDs(ctx, communityID)
+}
+
+func (c *communityDatabase) PageMembers(ctx context.Context, communityID string, pagination pagination.Pagination) (int64, []*model.CommunityMember, error) {
+	return c.memberDB.FindPage(ctx, communityID, pagination)
+}
+
+func (c *communityDatabase) UpdateMemberRoleLevel(ctx context.Context, communityID string, userID string, roleLevel int32) error {
+	if err := c.memberDB.UpdateRoleLevel(ctx, communityID, userID, roleLevel); err != nil {
+		return err
+	}
+	return c.cache.DelMaxJoinCommunityVersion(ctx, userID)
+}
+
+func (c *communityDatabase) AddGroups(ctx context.Context, groups []*model.CommunityGroup) error {
+	if len(groups) == 0 {
+		return nil
+	}
+	return c.ctxTx.Transaction(ctx, func(ctx context.Context) error {
+		if err := c.groupDB.Create(ctx, groups); err != nil {
+			return err
+		}
+		groupIDs := make([]string, 0, len(groups))
+		for _, group := range groups {
+			groupIDs = append(groupIDs, group.GroupID)
+		}
+		if err := c.cache.DelGroupCommunityID(ctx, groupIDs...); err != nil {
+			return err
+		}
+		return c.communityChanged(ctx, groups[0].CommunityID)
+	})
+}
+
+func (c *communityDatabase) RemoveGroups(ctx context.Context, communityID string, groupIDs []string) error {
+	if len(groupIDs) == 0 {
+		return nil
+	}
+	return c.ctxTx.Transaction(ctx, func(ctx context.Context) error {
+		if err := c.groupDB.Delete(ctx, communityID, groupIDs); err != nil {
+			return err
+		}
+		if err := c.cache.DelGroupCommunityID(ctx, groupIDs...); err != nil {
+			return err
+		}
+		return c.communityChanged(ctx, communityID)
+	})
+}
+
+func (c *communityDatabase) FindGroupIDs(ctx context.Context, communityID string) ([]string, error) {
+	return c.groupDB.FindGroupIDs(ctx, communityID)
+}
+
+func (c *communityDatabase) GetGroupCommunityID(ctx context.Context, groupID string) (string, error) {
+	return c.cache.GetGroupCommunityID(ctx, groupID)
+}
+
+func (c *communityDatabase) FindJoinCommunityIDs(ctx context.Context, userID string) ([]string, error) {
+	return c.memberDB.FindJoinCommunityIDs(ctx, userID)
+}
+
+func (c *communityDatabase) FindJoinIncrVersion(ctx context.Context, userID string, version uint, limit int) (*model.VersionLog, error) {
+	return c.memberDB.FindJoinIncrVersion(ctx, userID, version, limit)
+}
+
+func (c *communityDatabase) FindMaxJoinCommunityVersionCache(ctx context.Context, userID string) (*model.VersionLog, error) {
+	return c.cache.FindMaxJoinCommunityVersion(ctx, userID)
+}
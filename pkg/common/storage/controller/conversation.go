@@ -69,6 +69,8 @@ type ConversationDatabase interface {
 	FindConversationUserVersion(ctx context.Context, userID string, version uint, limit int) (*relationtb.VersionLog, error)
 	FindMaxConversationUserVersionCache(ctx context.Context, userID string) (*relationtb.VersionLog, error)
 	GetOwnerConversation(ctx context.Context, ownerUserID string, pagination pagination.Pagination) (int64, []*relationtb.Conversation, error)
+	// DeleteUserConversations removes every conversation of the owner.
+	DeleteUserConversations(ctx context.Context, ownerUserID string) error
 }
 
 func NewConversationDatabase(conversation database.Conversation, cache cache.ConversationCache, tx tx.Tx) ConversationDatabase {
@@ -353,3 +355,20 @@ func (c *conversationDatabase) GetOwnerConversation(ctx context.Context, ownerUs
 	}
 	return int64(len(conversationIDs)), conversations, nil
 }
+
+func (c *conversationDatabase) DeleteUserConversations(ctx context.Context, ownerUserID string) error {
+	conversationIDs, err := c.conversationDB.FindUserIDAllConversationID(ctx, ownerUserID)
+	if err != nil {
+		return err
+	}
+	if err := c.conversationDB.DeleteByOwner(ctx, ownerUserID); err != nil {
+		return err
+	}
+	return c.cache.DelConversationIDs(ownerUserID).
+		DelUserConversationIDsHash(ownerUserID).
+		DelConversations(ownerUserID, conversationIDs...).
+		DelUserAllHasReadSeqs(ownerUserID, conversationIDs...).
+		DelConversationVersionUserIDs(ownerUserID).
+		DelConversationNotReceiveMessageUserIDs(conversationIDs...).
+		ChainExecDel(ctx)
+}
@@ -75,6 +75,9 @@ type FriendDatabase interface {
 	// FindBothFriendRequests finds friend requests sent and received
 	FindBothFriendRequests(ctx context.Context, fromUserID, toUserID string) (friends []*model.FriendRequest, err error)
 
+	// DeleteFriendRequest removes the friend request sent from fromUserID to toUserID
+	DeleteFriendRequest(ctx context.Context, fromUserID, toUserID string) (err error)
+
 	// UpdateFriends updates fields for friends
 	UpdateFriends(ctx context.Context, ownerUserID string, friendUserIDs []string, val map[string]any) (err error)
 
@@ -359,6 +362,11 @@ func (f *friendDatabase) FindFriendUserIDs(ctx context.Context, ownerUserID stri
 func (f *friendDatabase) FindBothFriendRequests(ctx context.Context, fromUserID, toUserID string) (friends []*model.FriendRequest, err error) {
 	return f.friendRequest.FindBothFriendRequests(ctx, fromUserID, toUserID)
 }
+
+func (f *friendDatabase) DeleteFriendRequest(ctx context.Context, fromUserID, toUserID string) (err error) {
+	return f.friendRequest.Delete(ctx, fromUserID, toUserID)
+}
+
 func (f *friendDatabase) UpdateFriends(ctx context.Context, ownerUserID string, friendUserIDs []string, val map[string]any) (err error) {
 	if len(val) == 0 {
 		return nil
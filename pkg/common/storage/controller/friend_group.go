@@ -0,0 +1,151 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/tx"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// FriendGroupDatabase stores the friend groups of users. Every change is logged in the friend
+// version log of the owner, see model.FriendGroupVersionID.
+type FriendGroupDatabase interface {
+	CreateFriendGroup(ctx context.Context, group *model.FriendGroup) error
+	SetFriendGroupName(ctx context.Context, ownerUserID string, groupID string, name string) error
+	// DeleteFriendGroup deletes the group and takes its friends out of it.
+	DeleteFriendGroup(ctx context.Context, ownerUserID string, groupID string) error
+	// SortFriendGroups sorts the groups of the owner in the order of groupIDs.
+	SortFriendGroups(ctx context.Context, ownerUserID string, groupIDs []string) error
+	AddFriendGroupMembers(ctx context.Context, ownerUserID string, groupID string, friendUserIDs []string) error
+	RemoveFriendGroupMembers(ctx context.Context, ownerUserID string, groupID string, friendUserIDs []string) error
+	// RemoveFriendsFromGroups takes the friends out of every group of the owner.
+	RemoveFriendsFromGroups(ctx context.Context, ownerUserID string, friendUserIDs []string) error
+	// FindFriendGroups returns the groups of the owner in sort order, all of them when
+	// groupIDs is nil.
+	FindFriendGroups(ctx context.Context, ownerUserID string, groupIDs []string) ([]*model.FriendGroup, error)
+	FindFriendGroupMembers(ctx context.Context, ownerUserID string, groupIDs []string) ([]*model.FriendGroupMember, error)
+}
+
+func NewFriendGroupDatabase(group database.FriendGroup, member database.FriendGroupMember, friend database.Friend, cache cache.FriendCache, tx tx.Tx) FriendGroupDatabase {
+	return &friendGroupDatabase{group: group, member: member, friend: friend, cache: cache, tx: tx}
+}
+
+type friendGroupDatabase struct {
+	group  database.FriendGroup
+	member database.FriendGroupMember
+	friend database.Friend
+	cache  cache.FriendCache
+	tx     tx.Tx
+}
+
+// incrVersion logs the change of the groups in the friend version log of the owner.
+func (f *friendGroupDatabase) incrVersion(ctx context.Context, ownerUserID string, groupIDs []string, state int32) error {
+	if len(groupIDs) == 0 {
+		return nil
+	}
+	if err := f.friend.IncrVersion(ctx, ownerUserID, datautil.Slice(groupIDs, model.FriendGroupVersionID), state); err != nil {
+		return err
+	}
+	return f.cache.DelMaxFriendVersion(ownerUserID).ChainExecDel(ctx)
+}
+
+func (f *friendGroupDatabase) CreateFriendGroup(ctx context.Context, group *model.FriendGroup) error {
+	return f.tx.Transaction(ctx, func(ctx context.Context) error {
+		if err := f.group.Create(ctx, group); err != nil {
+			return err
+		}
+		return f.incrVersion(ctx, group.OwnerUserID, []string{group.GroupID}, model.VersionStateInsert)
+	})
+}
+
+func (f *friendGroupDatabase) SetFriendGroupName(ctx context.Context, ownerUserID string, groupID string, name string) error {
+	return f.tx.Transaction(ctx, func(ctx context.Context) error {
+		if err := f.group.UpdateName(ctx, ownerUserID, groupID, name); err != nil {
+			return err
+		}
+		return f.incrVersion(ctx, ownerUserID, []string{groupID}, model.VersionStateUpdate)
+	})
+}
+
+func (f *friendGroupDatabase) DeleteFriendGroup(ctx context.Context, ownerUserID string, groupID string) error {
+	return f.tx.Transaction(ctx, func(ctx context.Context) error {
+		if err := f.member.Delete(ctx, ownerUserID, groupID, nil); err != nil {
+			return err
+		}
+		if err := f.group.Delete(ctx, ownerUserID, groupID); err != nil {
+			return err
+		}
+		return f.incrVersion(ctx, ownerUserID, []string{groupID}, model.VersionStateDelete)
+	})
+}
+
+func (f *friendGroupDatabase) SortFriendGroups(ctx context.Context, ownerUserID string, groupIDs []string) error {
+	return f.tx.Transaction(ctx, func(ctx context.Context) error {
+		if err := f.group.UpdateSortOrders(ctx, ownerUserID, groupIDs); err != nil {
+			return err
+		}
+		return f.incrVersion(ctx, ownerUserID, groupIDs, model.VersionStateUpdate)
+	})
+}
+
+func (f *friendGroupDatabase) AddFriendGroupMembers(ctx context.Context, ownerUserID string, groupID string, friendUserIDs []string) error {
+	return f.tx.Transaction(ctx, func(ctx context.Context) error {
+		members := datautil.Slice(friendUserIDs, func(friendUserID string) *model.FriendGroupMember {
+			return &model.FriendGroupMember{OwnerUserID: ownerUserID, GroupID: groupID, FriendUserID: friendUserID}
+		})
+		if err := f.member.Add(ctx, members); err != nil {
+			return err
+		}
+		return f.incrVersion(ctx, ownerUserID, []string{groupID}, model.VersionStateUpdate)
+	})
+}
+
+func (f *friendGroupDatabase) RemoveFriendGroupMembers(ctx context.Context, ownerUserID string, groupID string, friendUserIDs []string) error {
+	return f.tx.Transaction(ctx, func(ctx context.Context) error {
+		if err := f.member.Delete(ctx, ownerUserID, groupID, friendUserIDs); err != nil {
+			return err
+		}
+		return f.incrVersion(ctx, ownerUserID, []string{groupID}, model.VersionStateUpdate)
+	})
+}
+
+func (f *friendGroupDatabase) RemoveFriendsFromGroups(ctx context.Context, ownerUserID string, friendUserIDs []string) error {
+	return f.tx.Transaction(ctx, func(ctx context.Context) error {
+		groupIDs, err := f.member.FindGroupIDs(ctx, ownerUserID, friendUserIDs)
+		if err != nil {
+			return err
+		}
+		if len(groupIDs) == 0 {
+			return nil
+		}
+		if err := f.member.DeleteFriends(ctx, ownerUserID, friendUserIDs); err != nil {
+			return err
+		}
+		return f.incrVersion(ctx, ownerUserID, groupIDs, model.VersionStateUpdate)
+	})
+}
+
+func (f *friendGroupDatabase) FindFriendGroups(ctx context.Context, ownerUserID string, groupIDs []string) ([]*model.FriendGroup, error) {
+	return f.group.Find(ctx, ownerUserID, groupIDs)
+}
+
+func (f *friendGroupDatabase) FindFriendGroupMembers(ctx context.Context, ownerUserID string, groupIDs []string) ([]*model.FriendGroupMember, error) {
+	return f.member.Find(ctx, ownerUserID, groupIDs)
+}
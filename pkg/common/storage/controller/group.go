@@ -23,6 +23,7 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/common"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
 	"github.com/openimsdk/protocol/constant"
@@ -51,6 +52,8 @@ type GroupDatabase interface {
 	TakeGroupMember(ctx context.Context, groupID string, userID string) (groupMember *model.GroupMember, err error)
 	// TakeGroupOwner retrieves the owner of a group by group ID.
 	TakeGroupOwner(ctx context.Context, groupID string) (*model.GroupMember, error)
+	// FindGroupOwners returns up to limit group owners, ordered by group ID after afterGroupID.
+	FindGroupOwners(ctx context.Context, afterGroupID string, limit int) ([]*model.GroupMember, error)
 	// FindGroupMembers retrieves members of a group filtered by user IDs.
 	FindGroupMembers(ctx context.Context, groupID string, userIDs []string) (groupMembers []*model.GroupMember, err error)
 	// FindGroupMemberUser retrieves groups that a user is a member of, filtered by group IDs.
@@ -71,6 +74,8 @@ type GroupDatabase interface {
 	PageGroupRequest(ctx context.Context, groupIDs []string, pagination pagination.Pagination) (int64, []*model.GroupRequest, error)
 	// GetGroupRoleLevelMemberIDs retrieves user IDs of group members with a specific role level.
 	GetGroupRoleLevelMemberIDs(ctx context.Context, groupID string, roleLevel int32) ([]string, error)
+	// FindGroupRoleIDMemberIDs retrieves user IDs of group members with a specific custom role.
+	FindGroupRoleIDMemberIDs(ctx context.Context, groupID string, roleID string) ([]string, error)
 
 	// PageGetJoinGroup paginates through groups that a user has joined.
 	PageGetJoinGroup(ctx context.Context, userID string, pagination pagination.Pagination) (total int64, totalGroupMembers []*model.GroupMember, err error)
@@ -101,6 +106,8 @@ type GroupDatabase interface {
 	FindGroupRequests(ctx context.Context, groupID string, userIDs []string) ([]*model.GroupRequest, error)
 	// PageGroupRequestUser paginates through group join requests made by a user.
 	PageGroupRequestUser(ctx context.Context, userID string, pagination pagination.Pagination) (int64, []*model.GroupRequest, error)
+	// DeleteGroupRequest removes the join request of a user to a group.
+	DeleteGroupRequest(ctx context.Context, groupID string, userID string) error
 
 	// CountTotal counts the total number of groups as of a certain date.
 	CountTotal(ctx context.Context, before *time.Time) (count int64, err error)
@@ -124,6 +131,9 @@ type GroupDatabase interface {
 	SearchJoinGroup(ctx context.Context, userID string, keyword string, pagination pagination.Pagination) (int64, []*model.Group, error)
 
 	FindJoinGroupID(ctx context.Context, userID string) ([]string, error)
+
+	// FindChannelSubscriberIDs pages through the subscribers of a shard of a channel by user ID.
+	FindChannelSubscriberIDs(ctx context.Context, groupID string, shard int32, afterUserID string, limit int) ([]string, error)
 }
 
 func NewGroupDatabase(
@@ -132,24 +142,82 @@ func NewGroupDatabase(
 	groupDB database.Group,
 	groupMemberDB database.GroupMember,
 	groupRequestDB database.GroupRequest,
+	channelSubscriberDB database.ChannelSubscriber,
 	ctxTx tx.Tx,
 	groupHash cache.GroupHash,
 ) GroupDatabase {
 	return &groupDatabase{
-		groupDB:        groupDB,
-		groupMemberDB:  groupMemberDB,
-		groupRequestDB: groupRequestDB,
-		ctxTx:          ctxTx,
-		cache:          redis2.NewGroupCacheRedis(rdb, localCache, groupDB, groupMemberDB, groupRequestDB, groupHash, redis2.GetRocksCacheOptions()),
+		groupDB:             groupDB,
+		groupMemberDB:       groupMemberDB,
+		groupRequestDB:      groupRequestDB,
+		channelSubscriberDB: channelSubscriberDB,
+		ctxTx:               ctxTx,
+		cache:               redis2.NewGroupCacheRedis(rdb, localCache, groupDB, groupMemberDB, groupRequestDB, groupHash, redis2.GetRocksCacheOptions()),
 	}
 }
 
 type groupDatabase struct {
-	groupDB        database.Group
-	groupMemberDB  database.GroupMember
-	groupRequestDB database.GroupRequest
-	ctxTx          tx.Tx
-	cache          cache.GroupCache
+	groupDB             database.Group
+	groupMemberDB       database.GroupMember
+	groupRequestDB      database.GroupRequest
+	channelSubscriberDB database.ChannelSubscriber
+	ctxTx               tx.Tx
+	cache               cache.GroupCache
+}
+
+// channelGroupIDs returns which of groupIDs are channels, looking groups up in groups first.
+func (g *groupDatabase) channelGroupIDs(ctx context.Context, groups []*model.Group, groupIDs []string) (map[string]bool, error) {
+	channels := make(map[string]bool)
+	known := make(map[string]bool)
+	for _, group := range groups {
+		known[group.GroupID] = true
+		if group.GroupType == groupext.ChannelGroup {
+			channels[group.GroupID] = true
+		}
+	}
+	groupIDs = datautil.Filter(datautil.Distinct(groupIDs), func(groupID string) (string, bool) { return groupID, !known[groupID] })
+	if len(groupIDs) > 0 {
+		found, err := g.cache.GetGroupsInfo(ctx, groupIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, group := range found {
+			if group.GroupType == groupext.ChannelGroup {
+				channels[group.GroupID] = true
+			}
+		}
+	}
+	return channels, nil
+}
+
+// addChannelSubscribers indexes the members joining channels for the fan-out of the push service.
+func (g *groupDatabase) addChannelSubscribers(ctx context.Context, groups []*model.Group, members []*model.GroupMember) error {
+	channels, err := g.channelGroupIDs(ctx, groups, datautil.Slice(members, func(e *model.GroupMember) string { return e.GroupID }))
+	if err != nil || len(channels) == 0 {
+		return err
+	}
+	var subscribers []*model.ChannelSubscriber
+	for _, member := range members {
+		if channels[member.GroupID] {
+			subscribers = append(subscribers, &model.ChannelSubscriber{
+				GroupID:  member.GroupID,
+				Shard:    groupext.ChannelSubscriberShard(member.UserID),
+				UserID:   member.UserID,
+				JoinTime: member.JoinTime,
+			})
+		}
+	}
+	return g.channelSubscriberDB.Add(ctx, subscribers)
+}
+
+// deleteChannelSubscribers removes userIDs from the index of the group when it is a channel,
+// every subscriber when userIDs is nil.
+func (g *groupDatabase) deleteChannelSubscribers(ctx context.Context, groupID string, userIDs []string) error {
+	channels, err := g.channelGroupIDs(ctx, nil, []string{groupID})
+	if err != nil || !channels[groupID] {
+		return err
+	}
+	return g.channelSubscriberDB.Delete(ctx, groupID, userIDs)
 }
 
 func (g *groupDatabase) FindJoinGroupID(ctx context.Context, userID string) ([]string, error) {
@@ -180,6 +248,10 @@ func (g *groupDatabase) GetGroupRoleLevelMemberIDs(ctx context.Context, groupID
 	return g.cache.GetGroupRoleLevelMemberIDs(ctx, groupID, roleLevel)
 }
 
+func (g *groupDatabase) FindGroupRoleIDMemberIDs(ctx context.Context, groupID string, roleID string) ([]string, error) {
+	return g.groupMemberDB.FindRoleIDUserIDs(ctx, groupID, roleID)
+}
+
 func (g *groupDatabase) CreateGroup(ctx context.Context, groups []*model.Group, groupMembers []*model.GroupMember) error {
 	if len(groups)+len(groupMembers) == 0 {
 		return nil
@@ -204,6 +276,9 @@ func (g *groupDatabase) CreateGroup(ctx context.Context, groups []*model.Group,
 			if err := g.groupMemberDB.Create(ctx, groupMembers); err != nil {
 				return err
 			}
+			if err := g.addChannelSubscribers(ctx, groups, groupMembers); err != nil {
+				return err
+			}
 			for _, groupMember := range groupMembers {
 				c = c.DelGroupMembersHash(groupMember.GroupID).
 					DelGroupsMemberNum(groupMember.GroupID).
@@ -269,6 +344,9 @@ func (g *groupDatabase) DismissGroup(ctx context.Context, groupID string, delete
 			if err := g.groupMemberDB.Delete(ctx, groupID, nil); err != nil {
 				return err
 			}
+			if err := g.deleteChannelSubscribers(ctx, groupID, nil); err != nil {
+				return err
+			}
 			c = c.DelJoinedGroupID(userIDs...).
 				DelGroupMemberIDs(groupID).
 				DelGroupsMemberNum(groupID).
@@ -300,6 +378,10 @@ func (g *groupDatabase) TakeGroupOwner(ctx context.Context, groupID string) (*mo
 	return g.cache.GetGroupOwner(ctx, groupID)
 }
 
+func (g *groupDatabase) FindGroupOwners(ctx context.Context, afterGroupID string, limit int) ([]*model.GroupMember, error) {
+	return g.groupMemberDB.FindOwners(ctx, afterGroupID, limit)
+}
+
 func (g *groupDatabase) FindUserManagedGroupID(ctx context.Context, userID string) (groupIDs []string, err error) {
 	return g.groupMemberDB.FindUserManagedGroupID(ctx, userID)
 }
@@ -353,6 +435,9 @@ func (g *groupDatabase) HandlerGroupRequest(ctx context.Context, groupID string,
 			if err := g.groupMemberDB.Create(ctx, []*model.GroupMember{member}); err != nil {
 				return err
 			}
+			if err := g.addChannelSubscribers(ctx, nil, []*model.GroupMember{member}); err != nil {
+				return err
+			}
 			c = c.DelGroupMembersHash(groupID).
 				DelGroupMembersInfo(groupID, member.UserID).
 				DelGroupMemberIDs(groupID).
@@ -374,6 +459,9 @@ func (g *groupDatabase) DeleteGroupMember(ctx context.Context, groupID string, u
 		if err := g.groupMemberDB.Delete(ctx, groupID, userIDs); err != nil {
 			return err
 		}
+		if err := g.deleteChannelSubscribers(ctx, groupID, userIDs); err != nil {
+			return err
+		}
 		c := g.cache.CloneGroupCache()
 		return c.DelGroupMembersHash(groupID).
 			DelGroupMemberIDs(groupID).
@@ -475,6 +563,10 @@ func (g *groupDatabase) PageGroupRequestUser(ctx context.Context, userID string,
 	return g.groupRequestDB.Page(ctx, userID, pagination)
 }
 
+func (g *groupDatabase) DeleteGroupRequest(ctx context.Context, groupID string, userID string) error {
+	return g.groupRequestDB.Delete(ctx, groupID, userID)
+}
+
 func (g *groupDatabase) CountTotal(ctx context.Context, before *time.Time) (count int64, err error) {
 	return g.groupDB.CountTotal(ctx, before)
 }
@@ -565,3 +657,7 @@ func (g *groupDatabase) MemberGroupIncrVersion(ctx context.Context, groupID stri
 	}
 	return g.cache.DelMaxGroupMemberVersion(groupID).ChainExecDel(ctx)
 }
+
+func (g *groupDatabase) FindChannelSubscriberIDs(ctx context.Context, groupID string, shard int32, afterUserID string, limit int) ([]string, error) {
+	return g.channelSubscriberDB.FindUserIDs(ctx, groupID, shard, afterUserID, limit)
+}
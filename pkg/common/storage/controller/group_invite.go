@@ -0,0 +1,79 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+// GroupInviteDatabase stores the invite links of groups and the audit trail of their redemptions.
+type GroupInviteDatabase interface {
+	CreateLink(ctx context.Context, link *model.GroupInviteLink) error
+	TakeLink(ctx context.Context, token string) (*model.GroupInviteLink, error)
+	FindGroupLinks(ctx context.Context, groupID string, pagination pagination.Pagination) (int64, []*model.GroupInviteLink, error)
+	RevokeLink(ctx context.Context, token string) error
+	// UseLink counts one use of the link and reports whether the link was still usable.
+	UseLink(ctx context.Context, token string, now time.Time) (bool, error)
+	// ReleaseLink gives back a use of the link when redeeming it failed.
+	ReleaseLink(ctx context.Context, token string) error
+	CreateRedemption(ctx context.Context, redemption *model.GroupInviteRedemption) error
+	FindRedemptions(ctx context.Context, groupID string, token string, pagination pagination.Pagination) (int64, []*model.GroupInviteRedemption, error)
+}
+
+func NewGroupInviteDatabase(link database.GroupInviteLink, redemption database.GroupInviteRedemption) GroupInviteDatabase {
+	return &groupInviteDatabase{link: link, redemption: redemption}
+}
+
+type groupInviteDatabase struct {
+	link       database.GroupInviteLink
+	redemption database.GroupInviteRedemption
+}
+
+func (g *groupInviteDatabase) CreateLink(ctx context.Context, link *model.GroupInviteLink) error {
+	return g.link.Create(ctx, link)
+}
+
+func (g *groupInviteDatabase) TakeLink(ctx context.Context, token string) (*model.GroupInviteLink, error) {
+	return g.link.Take(ctx, token)
+}
+
+func (g *groupInviteDatabase) FindGroupLinks(ctx context.Context, groupID string, pagination pagination.Pagination) (int64, []*model.GroupInviteLink, error) {
+	return g.link.FindByGroup(ctx, groupID, pagination)
+}
+
+func (g *groupInviteDatabase) RevokeLink(ctx context.Context, token string) error {
+	return g.link.Revoke(ctx, token)
+}
+
+func (g *groupInviteDatabase) UseLink(ctx context.Context, token string, now time.Time) (bool, error) {
+	return g.link.IncrUse(ctx, token, now)
+}
+
+func (g *groupInviteDatabase) ReleaseLink(ctx context.Context, token string) error {
+	return g.link.DecrUse(ctx, token)
+}
+
+func (g *groupInviteDatabase) CreateRedemption(ctx context.Context, redemption *model.GroupInviteRedemption) error {
+	return g.redemption.Create(ctx, redemption)
+}
+
+func (g *groupInviteDatabase) FindRedemptions(ctx context.Context, groupID string, token string, pagination pagination.Pagination) (int64, []*model.GroupInviteRedemption, error) {
+	return g.redemption.Find(ctx, groupID, token, pagination)
+}
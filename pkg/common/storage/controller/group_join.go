@@ -0,0 +1,70 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+// GroupJoinDatabase stores the questions of groups, the answers of join requests to them and
+// expires the join requests nobody handled.
+type GroupJoinDatabase interface {
+	SetQuestions(ctx context.Context, questions *model.GroupJoinQuestions) error
+	TakeQuestions(ctx context.Context, groupID string) (*model.GroupJoinQuestions, error)
+	SetAnswers(ctx context.Context, answers *model.GroupRequestAnswers) error
+	FindAnswers(ctx context.Context, groupID string, userIDs []string) ([]*model.GroupRequestAnswers, error)
+	// FindExpiredRequests returns at most limit pending requests made before.
+	FindExpiredRequests(ctx context.Context, before time.Time, limit int) ([]*model.GroupRequest, error)
+	// ExpireRequest expires the request unless it was handled meanwhile, and reports whether it did.
+	ExpireRequest(ctx context.Context, groupID string, userID string, before time.Time, now time.Time) (bool, error)
+}
+
+func NewGroupJoinDatabase(question database.GroupJoinQuestion, answer database.GroupRequestAnswer, request database.GroupRequest) GroupJoinDatabase {
+	return &groupJoinDatabase{question: question, answer: answer, request: request}
+}
+
+type groupJoinDatabase struct {
+	question database.GroupJoinQuestion
+	answer   database.GroupRequestAnswer
+	request  database.GroupRequest
+}
+
+func (g *groupJoinDatabase) SetQuestions(ctx context.Context, questions *model.GroupJoinQuestions) error {
+	return g.question.Set(ctx, questions)
+}
+
+func (g *groupJoinDatabase) TakeQuestions(ctx context.Context, groupID string) (*model.GroupJoinQuestions, error) {
+	return g.question.Take(ctx, groupID)
+}
+
+func (g *groupJoinDatabase) SetAnswers(ctx context.Context, answers *model.GroupRequestAnswers) error {
+	return g.answer.Set(ctx, answers)
+}
+
+func (g *groupJoinDatabase) FindAnswers(ctx context.Context, groupID string, userIDs []string) ([]*model.GroupRequestAnswers, error) {
+	return g.answer.Find(ctx, groupID, userIDs)
+}
+
+func (g *groupJoinDatabase) FindExpiredRequests(ctx context.Context, before time.Time, limit int) ([]*model.GroupRequest, error) {
+	return g.request.FindExpired(ctx, before, limit)
+}
+
+func (g *groupJoinDatabase) ExpireRequest(ctx context.Context, groupID string, userID string, before time.Time, now time.Time) (bool, error) {
+	return g.request.Expire(ctx, groupID, userID, before, now)
+}
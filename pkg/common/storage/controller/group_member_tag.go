@@ -0,0 +1,60 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+// GroupMemberTagDatabase stores the tags of group members.
+type GroupMemberTagDatabase interface {
+	AddTags(ctx context.Context, tags []*model.GroupMemberTag) error
+	// DeleteTags untags userIDs, every member of the tag when userIDs is nil.
+	DeleteTags(ctx context.Context, groupID string, tag string, userIDs []string) error
+	FindGroupTags(ctx context.Context, groupID string) ([]string, error)
+	FindTaggedUserIDs(ctx context.Context, groupID string, tags []string) ([]string, error)
+	FindMemberTags(ctx context.Context, groupID string, userIDs []string) ([]*model.GroupMemberTag, error)
+}
+
+func NewGroupMemberTagDatabase(tag database.GroupMemberTag) GroupMemberTagDatabase {
+	return &groupMemberTagDatabase{tag: tag}
+}
+
+type groupMemberTagDatabase struct {
+	tag database.GroupMemberTag
+}
+
+func (g *groupMemberTagDatabase) AddTags(ctx context.Context, tags []*model.GroupMemberTag) error {
+	return g.tag.Add(ctx, tags)
+}
+
+func (g *groupMemberTagDatabase) DeleteTags(ctx context.Context, groupID string, tag string, userIDs []string) error {
+	return g.tag.Delete(ctx, groupID, tag, userIDs)
+}
+
+func (g *groupMemberTagDatabase) FindGroupTags(ctx context.Context, groupID string) ([]string, error) {
+	return g.tag.FindTags(ctx, groupID)
+}
+
+func (g *groupMemberTagDatabase) FindTaggedUserIDs(ctx context.Context, groupID string, tags []string) ([]string, error) {
+	return g.tag.FindUserIDs(ctx, groupID, tags)
+}
+
+func (g *groupMemberTagDatabase) FindMemberTags(ctx context.Context, groupID string, userIDs []string) ([]*model.GroupMemberTag, error) {
+	return g.tag.FindMemberTags(ctx, groupID, userIDs)
+}
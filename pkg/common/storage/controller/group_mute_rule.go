@@ -0,0 +1,51 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+// GroupMuteRuleDatabase stores the slow mode and the mute schedules of groups.
+type GroupMuteRuleDatabase interface {
+	SetGroupMuteRule(ctx context.Context, rule *model.GroupMuteRule) error
+	GetGroupMuteRule(ctx context.Context, groupID string) (*model.GroupMuteRule, error)
+	// TakeSlowModeSend reports whether userID may send to the group under a slow mode of interval.
+	TakeSlowModeSend(ctx context.Context, groupID string, userID string, interval time.Duration) (bool, error)
+}
+
+func NewGroupMuteRuleDatabase(cache cache.GroupMuteRuleCache) GroupMuteRuleDatabase {
+	return &groupMuteRuleDatabase{cache: cache}
+}
+
+type groupMuteRuleDatabase struct {
+	cache cache.GroupMuteRuleCache
+}
+
+func (g *groupMuteRuleDatabase) SetGroupMuteRule(ctx context.Context, rule *model.GroupMuteRule) error {
+	return g.cache.SetGroupMuteRule(ctx, rule)
+}
+
+func (g *groupMuteRuleDatabase) GetGroupMuteRule(ctx context.Context, groupID string) (*model.GroupMuteRule, error) {
+	return g.cache.GetGroupMuteRule(ctx, groupID)
+}
+
+func (g *groupMuteRuleDatabase) TakeSlowModeSend(ctx context.Context, groupID string, userID string, interval time.Duration) (bool, error) {
+	return g.cache.TakeSlowModeSend(ctx, groupID, userID, interval)
+}
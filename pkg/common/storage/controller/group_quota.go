@@ -0,0 +1,63 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+// GroupQuotaDatabase stores the member quotas set for single groups and the reservations
+// of the quota checks in flight.
+type GroupQuotaDatabase interface {
+	SetGroupQuota(ctx context.Context, quota *model.GroupQuota) error
+	DeleteGroupQuota(ctx context.Context, groupID string) error
+	// TakeGroupQuota returns nil when the group uses the quota of its type.
+	TakeGroupQuota(ctx context.Context, groupID string) (*model.GroupQuota, error)
+	ReserveQuota(ctx context.Context, key string, n int64) (int64, error)
+	ReleaseQuota(ctx context.Context, key string, n int64) error
+}
+
+func NewGroupQuotaDatabase(quota database.GroupQuota, cache cache.GroupQuotaCache) GroupQuotaDatabase {
+	return &groupQuotaDatabase{quota: quota, cache: cache}
+}
+
+type groupQuotaDatabase struct {
+	quota database.GroupQuota
+	cache cache.GroupQuotaCache
+}
+
+func (g *groupQuotaDatabase) SetGroupQuota(ctx context.Context, quota *model.GroupQuota) error {
+	return g.quota.Set(ctx, quota)
+}
+
+func (g *groupQuotaDatabase) DeleteGroupQuota(ctx context.Context, groupID string) error {
+	return g.quota.Delete(ctx, groupID)
+}
+
+func (g *groupQuotaDatabase) TakeGroupQuota(ctx context.Context, groupID string) (*model.GroupQuota, error) {
+	return g.quota.Take(ctx, groupID)
+}
+
+func (g *groupQuotaDatabase) ReserveQuota(ctx context.Context, key string, n int64) (int64, error) {
+	return g.cache.ReserveQuota(ctx, key, n)
+}
+
+func (g *groupQuotaDatabase) ReleaseQuota(ctx context.Context, key string, n int64) error {
+	return g.cache.ReleaseQuota(ctx, key, n)
+}
@@ -0,0 +1,43 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type GroupRoleDatabase interface {
+	SetGroupRoles(ctx context.Context, roles *model.GroupRoles) error
+	GetGroupRoles(ctx context.Context, groupID string) (*model.GroupRoles, error)
+}
+
+func NewGroupRoleDatabase(cache cache.GroupRoleCache) GroupRoleDatabase {
+	return &groupRoleDatabase{cache: cache}
+}
+
+type groupRoleDatabase struct {
+	cache cache.GroupRoleCache
+}
+
+func (g *groupRoleDatabase) SetGroupRoles(ctx context.Context, roles *model.GroupRoles) error {
+	return g.cache.SetGroupRoles(ctx, roles)
+}
+
+func (g *groupRoleDatabase) GetGroupRoles(ctx context.Context, groupID string) (*model.GroupRoles, error) {
+	return g.cache.GetGroupRoles(ctx, groupID)
+}
@@ -0,0 +1,50 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+// GroupSuccessionDatabase stores the succession policies set for single groups.
+type GroupSuccessionDatabase interface {
+	SetGroupSuccession(ctx context.Context, succession *model.GroupSuccession) error
+	DeleteGroupSuccession(ctx context.Context, groupID string) error
+	// TakeGroupSuccession returns nil when the group uses the configured policy.
+	TakeGroupSuccession(ctx context.Context, groupID string) (*model.GroupSuccession, error)
+}
+
+func NewGroupSuccessionDatabase(succession database.GroupSuccession) GroupSuccessionDatabase {
+	return &groupSuccessionDatabase{succession: succession}
+}
+
+type groupSuccessionDatabase struct {
+	succession database.GroupSuccession
+}
+
+func (g *groupSuccessionDatabase) SetGroupSuccession(ctx context.Context, succession *model.GroupSuccession) error {
+	return g.succession.Set(ctx, succession)
+}
+
+func (g *groupSuccessionDatabase) DeleteGroupSuccession(ctx context.Context, groupID string) error {
+	return g.succession.Delete(ctx, groupID)
+}
+
+func (g *groupSuccessionDatabase) TakeGroupSuccession(ctx context.Context, groupID string) (*model.GroupSuccession, error) {
+	return g.succession.Take(ctx, groupID)
+}
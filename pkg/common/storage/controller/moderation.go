@@ -0,0 +1,62 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+// ModerationDatabase stores group sensitive-word lists and the review queue of flagged messages.
+type ModerationDatabase interface {
+	SetGroupSensitiveWord(ctx context.Context, word *model.GroupSensitiveWord) error
+	GetGroupSensitiveWord(ctx context.Context, groupID string) (*model.GroupSensitiveWord, error)
+	CreateReviews(ctx context.Context, reviews []*model.ModerationReview) error
+	SearchReviews(ctx context.Context, status int32, sendID string, groupID string, pagination pagination.Pagination) (int64, []*model.ModerationReview, error)
+	SetReviewStatus(ctx context.Context, reviewIDs []string, status int32, reviewer string) (int64, error)
+}
+
+func NewModerationDatabase(groupSensitiveWord cache.GroupSensitiveWordCache, review database.ModerationReview) ModerationDatabase {
+	return &moderationDatabase{groupSensitiveWord: groupSensitiveWord, review: review}
+}
+
+type moderationDatabase struct {
+	groupSensitiveWord cache.GroupSensitiveWordCache
+	review             database.ModerationReview
+}
+
+func (m *moderationDatabase) SetGroupSensitiveWord(ctx context.Context, word *model.GroupSensitiveWord) error {
+	return m.groupSensitiveWord.SetGroupSensitiveWord(ctx, word)
+}
+
+func (m *moderationDatabase) GetGroupSensitiveWord(ctx context.Context, groupID string) (*model.GroupSensitiveWord, error) {
+	return m.groupSensitiveWord.GetGroupSensitiveWord(ctx, groupID)
+}
+
+func (m *moderationDatabase) CreateReviews(ctx context.Context, reviews []*model.ModerationReview) error {
+	return m.review.Create(ctx, reviews)
+}
+
+func (m *moderationDatabase) SearchReviews(ctx context.Context, status int32, sendID string, groupID string, pagination pagination.Pagination) (int64, []*model.ModerationReview, error) {
+	return m.review.Search(ctx, status, sendID, groupID, pagination)
+}
+
+func (m *moderationDatabase) SetReviewStatus(ctx context.Context, reviewIDs []string, status int32, reviewer string) (int64, error) {
+	return m.review.SetStatus(ctx, reviewIDs, status, reviewer)
+}
@@ -26,6 +26,7 @@ import (
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/mq"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
 	"github.com/openimsdk/protocol/constant"
@@ -33,7 +34,6 @@ import (
 	"github.com/openimsdk/protocol/sdkws"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/log"
-	"github.com/openimsdk/tools/mq/kafka"
 	"github.com/openimsdk/tools/utils/datautil"
 	"github.com/openimsdk/tools/utils/timeutil"
 	"github.com/redis/go-redis/v9"
@@ -61,6 +61,12 @@ type CommonMsgDatabase interface {
 	GetMsgBySeqsRange(ctx context.Context, userID string, conversationID string, begin, end, num, userMaxSeq int64) (minSeq int64, maxSeq int64, seqMsg []*sdkws.MsgData, err error)
 	// GetMsgBySeqs retrieves messages for large groups from MongoDB by sequence numbers.
 	GetMsgBySeqs(ctx context.Context, userID string, conversationID string, seqs []int64) (minSeq int64, maxSeq int64, seqMsg []*sdkws.MsgData, err error)
+	// FindMsgBySeqs retrieves messages from MongoDB by sequence numbers without applying the user's seq range,
+	// seqs whose document no longer exists are skipped.
+	FindMsgBySeqs(ctx context.Context, userID string, conversationID string, seqs []int64) ([]*sdkws.MsgData, error)
+	// GetUserMinSeqs returns the smallest seq the user can read in each conversation, the larger of the
+	// conversation's and the user's minimum seq.
+	GetUserMinSeqs(ctx context.Context, userID string, conversationIDs []string) (map[string]int64, error)
 	// DeleteConversationMsgsAndSetMinSeq deletes conversation messages and resets the minimum sequence number. If `remainTime` is 0, all messages are deleted (this method does not delete Redis
 	// cache).
 	DeleteConversationMsgsAndSetMinSeq(ctx context.Context, conversationID string, remainTime int64) error
@@ -70,6 +76,11 @@ type CommonMsgDatabase interface {
 	DeleteUserMsgsBySeqs(ctx context.Context, userID string, conversationID string, seqs []int64) error
 	// DeleteMsgsPhysicalBySeqs physically deletes messages by emptying them based on sequence numbers.
 	DeleteMsgsPhysicalBySeqs(ctx context.Context, conversationID string, seqs []int64) error
+	// AnonymizeMsgsSender replaces the sender of the messages sent by userID with anonymousUserID and clears the
+	// sender's nickname and face URL, the content is kept. Messages of archived documents are left unchanged.
+	AnonymizeMsgsSender(ctx context.Context, conversationID string, seqs []int64, userID string, anonymousUserID string) error
+	// DeleteUserSeqs removes the min, max and read seqs of the user.
+	DeleteUserSeqs(ctx context.Context, userID string, conversationIDs []string) error
 	//SetMaxSeq(ctx context.Context, conversationID string, maxSeq int64) error
 	GetMaxSeqs(ctx context.Context, conversationIDs []string) (map[string]int64, error)
 	GetMaxSeq(ctx context.Context, conversationID string) (int64, error)
@@ -82,15 +93,32 @@ type CommonMsgDatabase interface {
 	UserSetHasReadSeqs(ctx context.Context, userID string, hasReadSeqs map[string]int64) error
 
 	//GetMongoMaxAndMinSeq(ctx context.Context, conversationID string) (minSeqMongo, maxSeqMongo int64, err error)
-	//GetConversationMinMaxSeqInMongoAndCache(ctx context.Context, conversationID string) (minSeqMongo, maxSeqMongo, minSeqCache, maxSeqCache int64, err error)
+	// GetConversationMinMaxSeqInMongoAndCache returns the seqs of the oldest and newest stored messages, 0 when the
+	// conversation has none, and the min and max seq of the cache.
+	GetConversationMinMaxSeqInMongoAndCache(ctx context.Context, conversationID string) (minSeqMongo, maxSeqMongo, minSeqCache, maxSeqCache int64, err error)
+	// CheckConversationSeq compares the seqs of a conversation kept by the messages, the cache, the seq
+	// collection and its users, and repairs them when opt.Repair is set.
+	CheckConversationSeq(ctx context.Context, conversationID string, opt SeqCheckOption) (*SeqCheckResult, error)
+	// FindSeqConversationIDs pages through the conversations that have allocated seqs, ordered by ID.
+	FindSeqConversationIDs(ctx context.Context, after string, limit int) ([]string, error)
 	SetSendMsgStatus(ctx context.Context, id string, status int32) error
 	GetSendMsgStatus(ctx context.Context, id string) (int32, error)
+	// ClaimSendMsg, FinishSendMsg and ReleaseSendMsg implement the idempotency window of SendMsg
+	// keyed by sender and ClientMsgID, see cache.MsgCache.
+	ClaimSendMsg(ctx context.Context, sendID, clientMsgID string, record *cache.SendMsgRecord, expire time.Duration) (*cache.SendMsgRecord, error)
+	FinishSendMsg(ctx context.Context, sendID, clientMsgID string) error
+	ReleaseSendMsg(ctx context.Context, sendID, clientMsgID string) error
+	GetSendMsgSeqs(ctx context.Context, msgs []*sdkws.MsgData) ([]int64, error)
+	SetSendMsgSeqs(ctx context.Context, msgs []*sdkws.MsgData) error
 	SearchMessage(ctx context.Context, req *pbmsg.SearchMessageReq) (total int64, msgData []*sdkws.MsgData, err error)
+	// FindSentMsgs returns the messages of the conversation with seqs in [begin, end] sent by
+	// sendID, ordered by seq.
+	FindSentMsgs(ctx context.Context, conversationID string, sendID string, begin, end int64) ([]*sdkws.MsgData, error)
 	FindOneByDocIDs(ctx context.Context, docIDs []string, seqs map[string]int64) (map[string]*sdkws.MsgData, error)
 
 	// to mq
 	MsgToMQ(ctx context.Context, key string, msg2mq *sdkws.MsgData) error
-	MsgToPushMQ(ctx context.Context, key, conversationID string, msg2mq *sdkws.MsgData) (int32, int64, error)
+	MsgToPushMQ(ctx context.Context, key, conversationID string, msg2mq *sdkws.MsgData) error
 	MsgToMongoMQ(ctx context.Context, key, conversationID string, msgs []*sdkws.MsgData, lastSeq int64) error
 
 	RangeUserSendCount(ctx context.Context, start time.Time, end time.Time, group bool, ase bool, pageNumber int32, showNumber int32) (msgCount int64, userCount int64, users []*model.UserCount, dateCount map[string]int64, err error)
@@ -101,23 +129,26 @@ type CommonMsgDatabase interface {
 	GetBeforeMsg(ctx context.Context, ts int64, docIds []string, limit int) ([]*model.MsgDocModel, error)
 	DeleteDocMsgBefore(ctx context.Context, ts int64, doc *model.MsgDocModel) ([]int, error)
 
+	// archive msg, only available when the database was created with a MsgArchiveDatabase
+	GetArchivableMsg(ctx context.Context, ts int64, limit int) ([]*model.MsgDocModel, error)
+	ArchiveMsgDoc(ctx context.Context, doc *model.MsgDocModel) error
+	// DeleteArchivedMsgBefore drops the archived documents whose newest message was sent before ts
+	// and returns how many were dropped.
+	DeleteArchivedMsgBefore(ctx context.Context, ts int64, limit int) (int, error)
+
 	GetDocIDs(ctx context.Context) ([]string, error)
 }
 
-func NewCommonMsgDatabase(msgDocModel database.Msg, msg cache.MsgCache, seqUser cache.SeqUser, seqConversation cache.SeqConversationCache, kafkaConf *config.Kafka) (CommonMsgDatabase, error) {
-	conf, err := kafka.BuildProducerConfig(*kafkaConf.Build())
-	if err != nil {
-		return nil, err
-	}
-	producerToRedis, err := kafka.NewKafkaProducer(conf, kafkaConf.Address, kafkaConf.ToRedisTopic)
+func NewCommonMsgDatabase(msgDocModel database.Msg, msg cache.MsgCache, seqUser cache.SeqUser, seqConversation cache.SeqConversationCache, kafkaConf *config.Kafka, mqBuilder mq.Builder, archive MsgArchiveDatabase) (CommonMsgDatabase, error) {
+	producerToRedis, err := mqBuilder.GetTopicProducer(kafkaConf.ToRedisTopic)
 	if err != nil {
 		return nil, err
 	}
-	producerToMongo, err := kafka.NewKafkaProducer(conf, kafkaConf.Address, kafkaConf.ToMongoTopic)
+	producerToMongo, err := mqBuilder.GetTopicProducer(kafkaConf.ToMongoTopic)
 	if err != nil {
 		return nil, err
 	}
-	producerToPush, err := kafka.NewKafkaProducer(conf, kafkaConf.Address, kafkaConf.ToPushTopic)
+	producerToPush, err := mqBuilder.GetTopicProducer(kafkaConf.ToPushTopic)
 	if err != nil {
 		return nil, err
 	}
@@ -129,6 +160,7 @@ func NewCommonMsgDatabase(msgDocModel database.Msg, msg cache.MsgCache, seqUser
 		producer:        producerToRedis,
 		producerToMongo: producerToMongo,
 		producerToPush:  producerToPush,
+		archive:         archive,
 	}, nil
 }
 
@@ -138,29 +170,28 @@ type commonMsgDatabase struct {
 	msg             cache.MsgCache
 	seqConversation cache.SeqConversationCache
 	seqUser         cache.SeqUser
-	producer        *kafka.Producer
-	producerToMongo *kafka.Producer
-	producerToPush  *kafka.Producer
+	producer        mq.Producer
+	producerToMongo mq.Producer
+	producerToPush  mq.Producer
+	archive         MsgArchiveDatabase // nil when archiving is disabled
 }
 
 func (db *commonMsgDatabase) MsgToMQ(ctx context.Context, key string, msg2mq *sdkws.MsgData) error {
-	_, _, err := db.producer.SendMessage(ctx, key, msg2mq)
-	return err
+	return db.producer.SendMessage(ctx, key, msg2mq)
 }
 
-func (db *commonMsgDatabase) MsgToPushMQ(ctx context.Context, key, conversationID string, msg2mq *sdkws.MsgData) (int32, int64, error) {
-	partition, offset, err := db.producerToPush.SendMessage(ctx, key, &pbmsg.PushMsgDataToMQ{MsgData: msg2mq, ConversationID: conversationID})
+func (db *commonMsgDatabase) MsgToPushMQ(ctx context.Context, key, conversationID string, msg2mq *sdkws.MsgData) error {
+	err := db.producerToPush.SendMessage(ctx, key, &pbmsg.PushMsgDataToMQ{MsgData: msg2mq, ConversationID: conversationID})
 	if err != nil {
 		log.ZError(ctx, "MsgToPushMQ", err, "key", key, "msg2mq", msg2mq)
-		return 0, 0, err
+		return err
 	}
-	return partition, offset, nil
+	return nil
 }
 
 func (db *commonMsgDatabase) MsgToMongoMQ(ctx context.Context, key, conversationID string, messages []*sdkws.MsgData, lastSeq int64) error {
 	if len(messages) > 0 {
-		_, _, err := db.producerToMongo.SendMessage(ctx, key, &pbmsg.MsgDataToMongoByMQ{LastSeq: lastSeq, ConversationID: conversationID, MsgData: messages})
-		return err
+		return db.producerToMongo.SendMessage(ctx, key, &pbmsg.MsgDataToMongoByMQ{LastSeq: lastSeq, ConversationID: conversationID, MsgData: messages})
 	}
 	return nil
 }
@@ -395,6 +426,42 @@ func (db *commonMsgDatabase) getMsgBySeqs(ctx context.Context, userID, conversat
 	return totalMsgs, nil
 }
 
+func (db *commonMsgDatabase) FindMsgBySeqs(ctx context.Context, userID string, conversationID string, seqs []int64) ([]*sdkws.MsgData, error) {
+	var totalMsgs []*sdkws.MsgData
+	for docID, seqs := range db.msgTable.GetDocIDSeqsMap(conversationID, seqs) {
+		msgs, err := db.findMsgInfoBySeq(ctx, userID, docID, conversationID, seqs)
+		if err != nil {
+			if errs.Unwrap(err) == mongo.ErrNoDocuments {
+				continue
+			}
+			return nil, err
+		}
+		for _, msg := range msgs {
+			totalMsgs = append(totalMsgs, convert.MsgDB2Pb(msg.Msg))
+		}
+	}
+	return totalMsgs, nil
+}
+
+func (db *commonMsgDatabase) GetUserMinSeqs(ctx context.Context, userID string, conversationIDs []string) (map[string]int64, error) {
+	minSeqs := make(map[string]int64, len(conversationIDs))
+	for _, conversationID := range conversationIDs {
+		userMinSeq, err := db.seqUser.GetUserMinSeq(ctx, conversationID, userID)
+		if err != nil && errs.Unwrap(err) != redis.Nil {
+			return nil, err
+		}
+		minSeq, err := db.seqConversation.GetMinSeq(ctx, conversationID)
+		if err != nil {
+			return nil, err
+		}
+		if userMinSeq > minSeq {
+			minSeq = userMinSeq
+		}
+		minSeqs[conversationID] = minSeq
+	}
+	return minSeqs, nil
+}
+
 func (db *commonMsgDatabase) handlerDBMsg(ctx context.Context, cache map[int64][]*model.MsgInfoModel, userID, conversationID string, msg *model.MsgInfoModel) {
 	if msg.IsRead {
 		msg.Msg.IsRead = true
@@ -422,7 +489,7 @@ func (db *commonMsgDatabase) handlerDBMsg(ctx context.Context, cache map[int64][
 		msgs = v
 	} else {
 		if quoteMsg.QuoteMessage.Seq > 0 {
-			ms, err := db.msgDocDatabase.GetMsgBySeqIndexIn1Doc(ctx, userID, db.msgTable.GetDocID(conversationID, quoteMsg.QuoteMessage.Seq), []int64{quoteMsg.QuoteMessage.Seq})
+			ms, err := db.getMsgBySeqIndexIn1Doc(ctx, userID, db.msgTable.GetDocID(conversationID, quoteMsg.QuoteMessage.Seq), []int64{quoteMsg.QuoteMessage.Seq})
 			if err != nil {
 				log.ZError(ctx, "GetMsgBySeqIndexIn1Doc", err, "conversationID", conversationID, "seq", quoteMsg.QuoteMessage.Seq)
 				return
@@ -451,8 +518,25 @@ func (db *commonMsgDatabase) handlerDBMsg(ctx context.Context, cache map[int64][
 	}
 }
 
-func (db *commonMsgDatabase) findMsgInfoBySeq(ctx context.Context, userID, docID string, conversationID string, seqs []int64) (totalMsgs []*model.MsgInfoModel, err error) {
+// getMsgBySeqIndexIn1Doc falls back to the archive when the document is no longer in the msg collection.
+func (db *commonMsgDatabase) getMsgBySeqIndexIn1Doc(ctx context.Context, userID, docID string, seqs []int64) ([]*model.MsgInfoModel, error) {
 	msgs, err := db.msgDocDatabase.GetMsgBySeqIndexIn1Doc(ctx, userID, docID, seqs)
+	if err == nil || db.archive == nil || errs.Unwrap(err) != mongo.ErrNoDocuments {
+		return msgs, err
+	}
+	doc, err := db.archive.Load(ctx, docID)
+	if err != nil {
+		return nil, err
+	}
+	msgs, err = model.UserViewMsgs(userID, doc.GetMsgsBySeqs(seqs))
+	if err != nil {
+		return nil, errs.WrapMsg(err, "view archived msgs failed", "docID", docID, "seqs", seqs)
+	}
+	return model.FillMissingSeqs(seqs, msgs), nil
+}
+
+func (db *commonMsgDatabase) findMsgInfoBySeq(ctx context.Context, userID, docID string, conversationID string, seqs []int64) (totalMsgs []*model.MsgInfoModel, err error) {
+	msgs, err := db.getMsgBySeqIndexIn1Doc(ctx, userID, docID, seqs)
 	if err != nil {
 		return nil, err
 	}
@@ -766,6 +850,40 @@ func (db *commonMsgDatabase) DeleteMsgsPhysicalBySeqs(ctx context.Context, conve
 	return nil
 }
 
+func (db *commonMsgDatabase) AnonymizeMsgsSender(ctx context.Context, conversationID string, allSeqs []int64, userID string, anonymousUserID string) error {
+	for docID, seqs := range db.msgTable.GetDocIDSeqsMap(conversationID, allSeqs) {
+		doc, err := db.msgDocDatabase.FindOneByDocID(ctx, docID)
+		if err != nil {
+			if errs.Unwrap(err) == mongo.ErrNoDocuments {
+				log.ZWarn(ctx, "anonymize msgs skips missing doc", nil, "docID", docID)
+				continue
+			}
+			return err
+		}
+		for _, seq := range seqs {
+			index := db.msgTable.GetMsgIndex(seq)
+			if index >= int64(len(doc.Msg)) || doc.Msg[index] == nil || doc.Msg[index].Msg == nil {
+				continue
+			}
+			msg := doc.Msg[index].Msg
+			if msg.SendID != userID {
+				continue
+			}
+			msg.SendID = anonymousUserID
+			msg.SenderNickname = ""
+			msg.SenderFaceURL = ""
+			if _, err := db.msgDocDatabase.UpdateMsg(ctx, docID, index, "msg", msg); err != nil {
+				return err
+			}
+		}
+	}
+	return db.msg.DeleteMessagesFromCache(ctx, conversationID, allSeqs)
+}
+
+func (db *commonMsgDatabase) DeleteUserSeqs(ctx context.Context, userID string, conversationIDs []string) error {
+	return db.seqUser.DeleteUserSeqs(ctx, userID, conversationIDs)
+}
+
 func (db *commonMsgDatabase) DeleteUserMsgsBySeqs(ctx context.Context, userID string, conversationID string, seqs []int64) error {
 	if err := db.msg.DeleteMessagesFromCache(ctx, conversationID, seqs); err != nil {
 		return err
@@ -824,10 +942,33 @@ func (db *commonMsgDatabase) GetSendMsgStatus(ctx context.Context, id string) (i
 	return db.msg.GetSendMsgStatus(ctx, id)
 }
 
+func (db *commonMsgDatabase) ClaimSendMsg(ctx context.Context, sendID, clientMsgID string, record *cache.SendMsgRecord, expire time.Duration) (*cache.SendMsgRecord, error) {
+	return db.msg.ClaimSendMsg(ctx, sendID, clientMsgID, record, expire)
+}
+
+func (db *commonMsgDatabase) FinishSendMsg(ctx context.Context, sendID, clientMsgID string) error {
+	return db.msg.FinishSendMsg(ctx, sendID, clientMsgID)
+}
+
+func (db *commonMsgDatabase) ReleaseSendMsg(ctx context.Context, sendID, clientMsgID string) error {
+	return db.msg.ReleaseSendMsg(ctx, sendID, clientMsgID)
+}
+
+func (db *commonMsgDatabase) GetSendMsgSeqs(ctx context.Context, msgs []*sdkws.MsgData) ([]int64, error) {
+	return db.msg.GetSendMsgSeqs(ctx, msgs)
+}
+
+func (db *commonMsgDatabase) SetSendMsgSeqs(ctx context.Context, msgs []*sdkws.MsgData) error {
+	return db.msg.SetSendMsgSeqs(ctx, msgs)
+}
+
 func (db *commonMsgDatabase) GetConversationMinMaxSeqInMongoAndCache(ctx context.Context, conversationID string) (minSeqMongo, maxSeqMongo, minSeqCache, maxSeqCache int64, err error) {
 	minSeqMongo, maxSeqMongo, err = db.GetMinMaxSeqMongo(ctx, conversationID)
 	if err != nil {
-		return
+		if !errors.Is(err, model.ErrMsgListNotExist) {
+			return
+		}
+		minSeqMongo, maxSeqMongo = 0, 0
 	}
 	minSeqCache, err = db.seqConversation.GetMinSeq(ctx, conversationID)
 	if err != nil {
@@ -896,6 +1037,31 @@ func (db *commonMsgDatabase) SearchMessage(ctx context.Context, req *pbmsg.Searc
 	return total, totalMsgs, nil
 }
 
+func (db *commonMsgDatabase) FindSentMsgs(ctx context.Context, conversationID string, sendID string, begin, end int64) ([]*sdkws.MsgData, error) {
+	if begin < 1 {
+		begin = 1
+	}
+	var docIDs []string
+	for seq := begin; seq <= end; seq += db.msgTable.GetSingleGocMsgNum() - db.msgTable.GetMsgIndex(seq) {
+		docIDs = append(docIDs, db.msgTable.GetDocID(conversationID, seq))
+	}
+	msgs, err := db.msgDocDatabase.FindMsgsBySender(ctx, docIDs, sendID)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*sdkws.MsgData, 0, len(msgs))
+	for _, msg := range msgs {
+		if msg.Msg == nil || msg.Msg.Seq < begin || msg.Msg.Seq > end {
+			continue
+		}
+		if msg.IsRead {
+			msg.Msg.IsRead = true
+		}
+		res = append(res, convert.MsgDB2Pb(msg.Msg))
+	}
+	return res, nil
+}
+
 func (db *commonMsgDatabase) FindOneByDocIDs(ctx context.Context, conversationIDs []string, seqs map[string]int64) (map[string]*sdkws.MsgData, error) {
 	totalMsgs := make(map[string]*sdkws.MsgData)
 	for _, conversationID := range conversationIDs {
@@ -964,6 +1130,48 @@ func (db *commonMsgDatabase) DeleteDocMsgBefore(ctx context.Context, ts int64, d
 	}
 }
 
+func (db *commonMsgDatabase) GetArchivableMsg(ctx context.Context, ts int64, limit int) ([]*model.MsgDocModel, error) {
+	if db.archive == nil {
+		return nil, errs.ErrInternalServer.WrapMsg("msg archive is disabled")
+	}
+	return db.msgDocDatabase.FindArchivable(ctx, ts, limit)
+}
+
+func (db *commonMsgDatabase) ArchiveMsgDoc(ctx context.Context, doc *model.MsgDocModel) error {
+	if db.archive == nil {
+		return errs.ErrInternalServer.WrapMsg("msg archive is disabled")
+	}
+	return db.archive.Archive(ctx, doc)
+}
+
+func (db *commonMsgDatabase) DeleteArchivedMsgBefore(ctx context.Context, ts int64, limit int) (int, error) {
+	if db.archive == nil {
+		return 0, nil
+	}
+	archives, err := db.archive.FindBefore(ctx, ts, limit)
+	if err != nil {
+		return 0, err
+	}
+	if len(archives) == 0 {
+		return 0, nil
+	}
+	minSeqs := make(map[string]int64)
+	for _, archive := range archives {
+		if archive.MaxSeq+1 > minSeqs[archive.ConversationID] {
+			minSeqs[archive.ConversationID] = archive.MaxSeq + 1
+		}
+	}
+	for conversationID, seq := range minSeqs {
+		if err := db.setMinSeq(ctx, conversationID, seq); err != nil {
+			return 0, err
+		}
+	}
+	if err := db.archive.Delete(ctx, archives); err != nil {
+		return 0, err
+	}
+	return len(archives), nil
+}
+
 func (db *commonMsgDatabase) setMinSeq(ctx context.Context, conversationID string, seq int64) error {
 	dbSeq, err := db.seqConversation.GetMinSeq(ctx, conversationID)
 	if err != nil {
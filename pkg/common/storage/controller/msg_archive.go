@@ -0,0 +1,236 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/objectstore"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/s3"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	msgArchiveKeyPrefix = "openim/msg_archive/"
+	msgArchiveURLExpire = time.Hour
+	// msgArchiveAttempts bounds how often a document written to while being archived is
+	// uploaded again before Archive gives up until the next run.
+	msgArchiveAttempts = 3
+)
+
+// MsgArchiveDatabase moves whole message documents to object storage as gzipped BSON and
+// reads them back. Archived documents are read-only: revoking, deleting or marking their
+// messages as read no longer has an effect.
+type MsgArchiveDatabase interface {
+	// Archive uploads the document, records its stub and removes it from the msg collection.
+	// A document written to in the meantime is uploaded again instead of being removed.
+	Archive(ctx context.Context, doc *model.MsgDocModel) error
+	// Load returns an archived document, mongo.ErrNoDocuments when docID was never archived.
+	Load(ctx context.Context, docID string) (*model.MsgDocModel, error)
+	// Exists reports whether docID was archived without loading it.
+	Exists(ctx context.Context, docID string) (bool, error)
+	FindBefore(ctx context.Context, ts int64, limit int) ([]*model.MsgArchiveModel, error)
+	// Delete removes the objects and stubs of the archives.
+	Delete(ctx context.Context, archives []*model.MsgArchiveModel) error
+}
+
+func NewMsgArchiveDatabase(archive database.MsgArchive, msgDoc database.Msg, cache cache.MsgArchiveCache, s3 s3.Interface) MsgArchiveDatabase {
+	return &msgArchiveDatabase{
+		archive: archive,
+		msgDoc:  msgDoc,
+		cache:   cache,
+		s3:      s3,
+		client:  &http.Client{Timeout: time.Minute},
+	}
+}
+
+type msgArchiveDatabase struct {
+	archive database.MsgArchive
+	msgDoc  database.Msg
+	cache   cache.MsgArchiveCache
+	s3      s3.Interface
+	client  *http.Client
+}
+
+func (m *msgArchiveDatabase) objectKey(docID string) string {
+	index := strings.LastIndex(docID, ":")
+	return msgArchiveKeyPrefix + docID[:index] + "/" + docID[index+1:] + ".bson.gz"
+}
+
+func (m *msgArchiveDatabase) Archive(ctx context.Context, doc *model.MsgDocModel) error {
+	for attempt := 1; ; attempt++ {
+		stub, err := m.upload(ctx, doc)
+		if err != nil {
+			return err
+		}
+		deleted, err := m.msgDoc.DeleteDocIfUnchanged(ctx, doc)
+		if err != nil {
+			return err
+		}
+		if deleted {
+			return nil
+		}
+		if attempt == msgArchiveAttempts {
+			return errs.ErrInternalServer.WrapMsg("msg doc keeps changing while archived", "docID", doc.DocID)
+		}
+		log.ZInfo(ctx, "msg doc changed while archived, uploading again", "docID", doc.DocID, "attempt", attempt)
+		doc, err = m.msgDoc.FindOneByDocID(ctx, doc.DocID)
+		if err != nil {
+			if errs.Unwrap(err) == mongo.ErrNoDocuments {
+				// Cleared meanwhile, so the upload must not outlive it.
+				return m.Delete(ctx, []*model.MsgArchiveModel{stub})
+			}
+			return err
+		}
+	}
+}
+
+// upload writes the document to object storage and records its stub.
+func (m *msgArchiveDatabase) upload(ctx context.Context, doc *model.MsgDocModel) (*model.MsgArchiveModel, error) {
+	data, err := bson.Marshal(doc)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "marshal msg doc failed", "docID", doc.DocID)
+	}
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(data); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if err := zw.Close(); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	key := m.objectKey(doc.DocID)
+	if err := m.put(ctx, key, buf.Bytes()); err != nil {
+		return nil, err
+	}
+	stub := &model.MsgArchiveModel{
+		DocID:          doc.DocID,
+		ConversationID: doc.DocID[:strings.LastIndex(doc.DocID, ":")],
+		Engine:         m.s3.Engine(),
+		Key:            key,
+		Size:           int64(buf.Len()),
+		ArchiveTime:    time.Now(),
+	}
+	for _, msg := range doc.Msg {
+		if msg == nil || msg.Msg == nil {
+			continue
+		}
+		if stub.MinSendTime == 0 || msg.Msg.SendTime < stub.MinSendTime {
+			stub.MinSendTime = msg.Msg.SendTime
+		}
+		if msg.Msg.SendTime > stub.MaxSendTime {
+			stub.MaxSendTime = msg.Msg.SendTime
+		}
+		if msg.Msg.Seq > stub.MaxSeq {
+			stub.MaxSeq = msg.Msg.Seq
+		}
+	}
+	if err := m.archive.Upsert(ctx, stub); err != nil {
+		return nil, err
+	}
+	if err := m.cache.DelArchivedDocs(ctx, doc.DocID); err != nil {
+		return nil, err
+	}
+	return stub, nil
+}
+
+func (m *msgArchiveDatabase) Load(ctx context.Context, docID string) (*model.MsgDocModel, error) {
+	stub, err := m.archive.Take(ctx, docID)
+	if err != nil {
+		return nil, err
+	}
+	return m.cache.GetArchivedDoc(ctx, docID, func(ctx context.Context) (*model.MsgDocModel, error) {
+		data, err := m.get(ctx, stub.Key)
+		if err != nil {
+			return nil, err
+		}
+		zr, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, errs.WrapMsg(err, "invalid msg archive", "docID", docID, "key", stub.Key)
+		}
+		defer zr.Close()
+		raw, err := io.ReadAll(zr)
+		if err != nil {
+			return nil, errs.WrapMsg(err, "invalid msg archive", "docID", docID, "key", stub.Key)
+		}
+		var doc model.MsgDocModel
+		if err := bson.Unmarshal(raw, &doc); err != nil {
+			return nil, errs.WrapMsg(err, "invalid msg archive", "docID", docID, "key", stub.Key)
+		}
+		log.ZDebug(ctx, "msg archive loaded", "docID", docID, "key", stub.Key, "size", stub.Size)
+		return &doc, nil
+	})
+}
+
+func (m *msgArchiveDatabase) Exists(ctx context.Context, docID string) (bool, error) {
+	if _, err := m.archive.Take(ctx, docID); err != nil {
+		if errs.Unwrap(err) == mongo.ErrNoDocuments {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (m *msgArchiveDatabase) FindBefore(ctx context.Context, ts int64, limit int) ([]*model.MsgArchiveModel, error) {
+	return m.archive.FindBefore(ctx, ts, limit)
+}
+
+func (m *msgArchiveDatabase) Delete(ctx context.Context, archives []*model.MsgArchiveModel) error {
+	docIDs := make([]string, 0, len(archives))
+	for _, archive := range archives {
+		if err := m.s3.DeleteObject(ctx, archive.Key); err != nil && !m.s3.IsNotFound(err) {
+			return errs.WrapMsg(err, "delete msg archive object failed", "key", archive.Key)
+		}
+		docIDs = append(docIDs, archive.DocID)
+	}
+	if err := m.archive.Delete(ctx, docIDs); err != nil {
+		return err
+	}
+	return m.cache.DelArchivedDocs(ctx, docIDs...)
+}
+
+func (m *msgArchiveDatabase) put(ctx context.Context, key string, data []byte) error {
+	return objectstore.PutObject(ctx, m.client, m.s3, key, bytes.NewReader(data), int64(len(data)), msgArchiveURLExpire)
+}
+
+func (m *msgArchiveDatabase) get(ctx context.Context, key string) ([]byte, error) {
+	rawURL, err := m.s3.AccessURL(ctx, key, msgArchiveURLExpire, &s3.AccessURLOption{})
+	if err != nil {
+		return nil, err
+	}
+	body, err := objectstore.OpenURL(ctx, m.client, rawURL)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return data, nil
+}
@@ -0,0 +1,300 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Issues reported by CheckConversationSeq.
+const (
+	// SeqIssueDBBehindCache is a seq collection max seq below the block reserved in the cache, the
+	// next reload would hand out seqs twice.
+	SeqIssueDBBehindCache = "db_behind_cache"
+	// SeqIssueDBBehindMsg is a seq collection max seq below the newest stored message.
+	SeqIssueDBBehindMsg = "db_behind_msg"
+	// SeqIssueCacheBehindMsg is a cached max seq below the newest stored message, new messages
+	// would overwrite stored ones.
+	SeqIssueCacheBehindMsg = "cache_behind_msg"
+	// SeqIssueMinSeqAboveMax is a conversation min seq beyond the max seq plus one.
+	SeqIssueMinSeqAboveMax = "min_seq_above_max"
+	// SeqIssueMinSeqCache is a cached min seq that differs from the seq collection.
+	SeqIssueMinSeqCache = "min_seq_cache"
+	// SeqIssueUserReadSeqAboveMax is a user read seq beyond the max seq, hiding new messages from
+	// the unread count.
+	SeqIssueUserReadSeqAboveMax = "user_read_seq_above_max"
+	// SeqIssueUserMinSeqAboveMax is a user min seq beyond the max seq plus one.
+	SeqIssueUserMinSeqAboveMax = "user_min_seq_above_max"
+	// SeqIssueUserMaxSeqAboveMax is a user max seq beyond the max seq.
+	SeqIssueUserMaxSeqAboveMax = "user_max_seq_above_max"
+
+	maxMissingSeqSample = 100
+)
+
+// SeqCheckOption selects what CheckConversationSeq looks at.
+type SeqCheckOption struct {
+	// Repair applies the fixes, otherwise the check is a dry run.
+	Repair bool
+	// CheckMissing scans the message documents between the min seq and the newest message for
+	// seqs without a message, reading one document per 100 seqs.
+	CheckMissing bool
+}
+
+// ConversationSeqState is a snapshot of where the seqs of a conversation are kept. It is read in
+// allocation order, messages first and the seq collection last, so that ongoing sends cannot
+// cause false reports.
+type ConversationSeqState struct {
+	ConversationID string
+	// MinSeqMsg and MaxSeqMsg are the oldest and newest stored messages, 0 without messages.
+	MinSeqMsg int64
+	MaxSeqMsg int64
+	// MaxSeqCache is the last allocated seq and LastSeqCache the end of the block reserved in
+	// the cache.
+	MinSeqCache  int64
+	MaxSeqCache  int64
+	LastSeqCache int64
+	MinSeqDB     int64
+	MaxSeqDB     int64
+	// Users holds the seqs of every user of the conversation, with the current read seq.
+	Users []*model.SeqUser
+}
+
+// MaxSeq is the largest seq known to be handed out.
+func (s *ConversationSeqState) MaxSeq() int64 {
+	return max(s.MaxSeqCache, s.MaxSeqMsg)
+}
+
+// SeqIssue is an inconsistency found by CheckConversationSeq.
+type SeqIssue struct {
+	Type string
+	// UserID is set for the seqs of a user.
+	UserID string
+	// Seq is the inconsistent value and Expected the value it is repaired to.
+	Seq      int64
+	Expected int64
+	Repaired bool
+}
+
+type SeqCheckResult struct {
+	ConversationSeqState
+	Issues []*SeqIssue
+	// MissingSeqNum counts seqs up to the newest message that have neither a stored nor an
+	// archived message, MissingSeqs holds the first of them. Physically deleted messages are
+	// counted as well; missing messages cannot be repaired.
+	MissingSeqNum int64
+	MissingSeqs   []int64
+}
+
+// checkSeqState lists the inconsistencies of a conversation, in the order they are repaired.
+func checkSeqState(state *ConversationSeqState) []*SeqIssue {
+	var issues []*SeqIssue
+	if state.MaxSeqDB < state.LastSeqCache {
+		issues = append(issues, &SeqIssue{Type: SeqIssueDBBehindCache, Seq: state.MaxSeqDB, Expected: state.LastSeqCache})
+	}
+	if state.MaxSeqDB < state.MaxSeqMsg {
+		issues = append(issues, &SeqIssue{Type: SeqIssueDBBehindMsg, Seq: state.MaxSeqDB, Expected: state.MaxSeqMsg})
+	}
+	if state.MaxSeqCache < state.MaxSeqMsg {
+		issues = append(issues, &SeqIssue{Type: SeqIssueCacheBehindMsg, Seq: state.MaxSeqCache, Expected: state.MaxSeqMsg})
+	}
+	maxSeq := state.MaxSeq()
+	minSeq := state.MinSeqDB
+	if minSeq > maxSeq+1 {
+		issues = append(issues, &SeqIssue{Type: SeqIssueMinSeqAboveMax, Seq: minSeq, Expected: maxSeq + 1})
+		minSeq = maxSeq + 1
+	}
+	if state.MinSeqCache != minSeq {
+		issues = append(issues, &SeqIssue{Type: SeqIssueMinSeqCache, Seq: state.MinSeqCache, Expected: minSeq})
+	}
+	for _, user := range state.Users {
+		if user.ReadSeq > maxSeq {
+			issues = append(issues, &SeqIssue{Type: SeqIssueUserReadSeqAboveMax, UserID: user.UserID, Seq: user.ReadSeq, Expected: maxSeq})
+		}
+		if user.MinSeq > maxSeq+1 {
+			issues = append(issues, &SeqIssue{Type: SeqIssueUserMinSeqAboveMax, UserID: user.UserID, Seq: user.MinSeq, Expected: maxSeq + 1})
+		}
+		if user.MaxSeq > maxSeq {
+			issues = append(issues, &SeqIssue{Type: SeqIssueUserMaxSeqAboveMax, UserID: user.UserID, Seq: user.MaxSeq, Expected: maxSeq})
+		}
+	}
+	return issues
+}
+
+func (db *commonMsgDatabase) FindSeqConversationIDs(ctx context.Context, after string, limit int) ([]string, error) {
+	seqs, err := db.seqConversation.FindSeqConversations(ctx, after, limit)
+	if err != nil {
+		return nil, err
+	}
+	return datautil.Slice(seqs, func(seq *model.SeqConversation) string { return seq.ConversationID }), nil
+}
+
+func (db *commonMsgDatabase) getConversationSeqState(ctx context.Context, conversationID string) (*ConversationSeqState, error) {
+	state := &ConversationSeqState{ConversationID: conversationID}
+	var err error
+	state.MinSeqMsg, state.MaxSeqMsg, state.MinSeqCache, state.MaxSeqCache, err = db.GetConversationMinMaxSeqInMongoAndCache(ctx, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	curr, last, ok, err := db.seqConversation.GetCacheMaxSeq(ctx, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		state.MaxSeqCache = max(state.MaxSeqCache, curr)
+		state.LastSeqCache = last
+	}
+	state.MinSeqDB, state.MaxSeqDB, err = db.seqConversation.GetDBSeq(ctx, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	state.Users, err = db.seqUser.FindConversationSeqs(ctx, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	if len(state.Users) > 0 {
+		readSeqs, err := db.seqUser.GetUsersReadSeq(ctx, conversationID, datautil.Slice(state.Users, func(user *model.SeqUser) string { return user.UserID }))
+		if err != nil {
+			return nil, err
+		}
+		for _, user := range state.Users {
+			user.ReadSeq = readSeqs[user.UserID]
+		}
+	}
+	return state, nil
+}
+
+func (db *commonMsgDatabase) CheckConversationSeq(ctx context.Context, conversationID string, opt SeqCheckOption) (*SeqCheckResult, error) {
+	state, err := db.getConversationSeqState(ctx, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	res := &SeqCheckResult{ConversationSeqState: *state, Issues: checkSeqState(state)}
+	if opt.CheckMissing && state.MaxSeqMsg > 0 {
+		res.MissingSeqNum, res.MissingSeqs, err = db.findMissingSeqs(ctx, conversationID, max(state.MinSeqDB, 1), state.MaxSeqMsg)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !opt.Repair || len(res.Issues) == 0 {
+		return res, nil
+	}
+	if err := db.repairConversationSeq(ctx, conversationID, res.Issues); err != nil {
+		return nil, err
+	}
+	log.ZInfo(ctx, "conversation seq repaired", "conversationID", conversationID, "issues", len(res.Issues))
+	return res, nil
+}
+
+// repairConversationSeq applies the issues in order. The max seq is only ever raised, so that no
+// seq is handed out twice, and the cached block is dropped once the seq collection is ahead.
+func (db *commonMsgDatabase) repairConversationSeq(ctx context.Context, conversationID string, issues []*SeqIssue) error {
+	var (
+		raiseDB  int64
+		delCache bool
+	)
+	for _, issue := range issues {
+		switch issue.Type {
+		case SeqIssueDBBehindCache, SeqIssueDBBehindMsg:
+			raiseDB = max(raiseDB, issue.Expected)
+		case SeqIssueCacheBehindMsg:
+			raiseDB = max(raiseDB, issue.Expected)
+			delCache = true
+		}
+	}
+	if raiseDB > 0 {
+		if err := db.seqConversation.RaiseDBMaxSeq(ctx, conversationID, raiseDB); err != nil {
+			return err
+		}
+	}
+	if delCache {
+		if err := db.seqConversation.DelCacheMaxSeq(ctx, conversationID); err != nil {
+			return err
+		}
+	}
+	for _, issue := range issues {
+		var err error
+		switch issue.Type {
+		case SeqIssueDBBehindCache, SeqIssueDBBehindMsg, SeqIssueCacheBehindMsg:
+		case SeqIssueMinSeqAboveMax, SeqIssueMinSeqCache:
+			// SetMinSeq writes the seq collection and drops the cached value.
+			err = db.seqConversation.SetMinSeq(ctx, conversationID, issue.Expected)
+		case SeqIssueUserReadSeqAboveMax:
+			err = db.seqUser.ResetUserReadSeq(ctx, conversationID, issue.UserID, issue.Expected)
+		case SeqIssueUserMinSeqAboveMax:
+			err = db.seqUser.SetUserMinSeq(ctx, conversationID, issue.UserID, issue.Expected)
+		case SeqIssueUserMaxSeqAboveMax:
+			err = db.seqUser.SetUserMaxSeq(ctx, conversationID, issue.UserID, issue.Expected)
+		default:
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		issue.Repaired = true
+	}
+	return nil
+}
+
+// findMissingSeqs counts the seqs in [begin, end] whose slot is empty or whose document is
+// neither stored nor archived.
+func (db *commonMsgDatabase) findMissingSeqs(ctx context.Context, conversationID string, begin, end int64) (int64, []int64, error) {
+	var (
+		num    int64
+		sample []int64
+	)
+	missing := func(from, to int64) {
+		for seq := from; seq <= to; seq++ {
+			num++
+			if len(sample) < maxMissingSeqSample {
+				sample = append(sample, seq)
+			}
+		}
+	}
+	size := db.msgTable.GetSingleGocMsgNum()
+	for seq := begin; seq <= end; {
+		docEnd := min((seq-1)/size*size+size, end)
+		docID := db.msgTable.GetDocID(conversationID, seq)
+		doc, err := db.msgDocDatabase.FindOneByDocID(ctx, docID)
+		if err == nil {
+			for ; seq <= docEnd; seq++ {
+				index := db.msgTable.GetMsgIndex(seq)
+				if index >= int64(len(doc.Msg)) || doc.Msg[index] == nil || doc.Msg[index].Msg == nil {
+					missing(seq, seq)
+				}
+			}
+			continue
+		}
+		if errs.Unwrap(err) != mongo.ErrNoDocuments {
+			return 0, nil, err
+		}
+		archived := false
+		if db.archive != nil {
+			if archived, err = db.archive.Exists(ctx, docID); err != nil {
+				return 0, nil, err
+			}
+		}
+		if !archived {
+			missing(seq, docEnd)
+		}
+		seq = docEnd + 1
+	}
+	return num, sample, nil
+}
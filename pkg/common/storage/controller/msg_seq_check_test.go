@@ -0,0 +1,76 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+func TestCheckSeqState(t *testing.T) {
+	tests := []struct {
+		name  string
+		state ConversationSeqState
+		want  []SeqIssue
+	}{
+		{
+			name:  "consistent",
+			state: ConversationSeqState{MinSeqMsg: 1, MaxSeqMsg: 10, MaxSeqCache: 12, LastSeqCache: 150, MaxSeqDB: 150},
+		},
+		{
+			name:  "seq collection behind cache and messages",
+			state: ConversationSeqState{MaxSeqMsg: 200, MaxSeqCache: 120, LastSeqCache: 150, MaxSeqDB: 100},
+			want: []SeqIssue{
+				{Type: SeqIssueDBBehindCache, Seq: 100, Expected: 150},
+				{Type: SeqIssueDBBehindMsg, Seq: 100, Expected: 200},
+				{Type: SeqIssueCacheBehindMsg, Seq: 120, Expected: 200},
+			},
+		},
+		{
+			name:  "min seq beyond max",
+			state: ConversationSeqState{MaxSeqMsg: 10, MaxSeqCache: 10, LastSeqCache: 10, MaxSeqDB: 10, MinSeqDB: 20, MinSeqCache: 20},
+			want: []SeqIssue{
+				{Type: SeqIssueMinSeqAboveMax, Seq: 20, Expected: 11},
+				{Type: SeqIssueMinSeqCache, Seq: 20, Expected: 11},
+			},
+		},
+		{
+			name: "users",
+			state: ConversationSeqState{MaxSeqMsg: 10, MaxSeqCache: 10, LastSeqCache: 10, MaxSeqDB: 10, Users: []*model.SeqUser{
+				{UserID: "a", MinSeq: 11, ReadSeq: 10},
+				{UserID: "b", MinSeq: 12, ReadSeq: 15, MaxSeq: 11},
+			}},
+			want: []SeqIssue{
+				{Type: SeqIssueUserReadSeqAboveMax, UserID: "b", Seq: 15, Expected: 10},
+				{Type: SeqIssueUserMinSeqAboveMax, UserID: "b", Seq: 12, Expected: 11},
+				{Type: SeqIssueUserMaxSeqAboveMax, UserID: "b", Seq: 11, Expected: 10},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues := checkSeqState(&tt.state)
+			if len(issues) != len(tt.want) {
+				t.Fatalf("got %d issues, want %d", len(issues), len(tt.want))
+			}
+			for i, issue := range issues {
+				if *issue != tt.want[i] {
+					t.Errorf("issue %d = %+v, want %+v", i, *issue, tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+)
+
+// ReadReceiptDatabase serves per-member read receipts of group messages.
+type ReadReceiptDatabase interface {
+	// SetGroupReadReceipt turns per-member read receipts of a group on or off.
+	SetGroupReadReceipt(ctx context.Context, groupID string, enable bool) error
+	// IsGroupReadReceiptEnabled reports whether a group tracks per-member read receipts.
+	IsGroupReadReceiptEnabled(ctx context.Context, groupID string) (bool, error)
+	// GetUsersHasReadSeq returns the has-read seq of each user in a conversation.
+	GetUsersHasReadSeq(ctx context.Context, conversationID string, userIDs []string) (map[string]int64, error)
+	// GetUsersMinSeq returns the min seq of each user in a conversation.
+	GetUsersMinSeq(ctx context.Context, conversationID string, userIDs []string) (map[string]int64, error)
+}
+
+func NewReadReceiptDatabase(groupReadReceipt cache.GroupReadReceiptCache, seqUser cache.SeqUser) ReadReceiptDatabase {
+	return &readReceiptDatabase{groupReadReceipt: groupReadReceipt, seqUser: seqUser}
+}
+
+type readReceiptDatabase struct {
+	groupReadReceipt cache.GroupReadReceiptCache
+	seqUser          cache.SeqUser
+}
+
+func (r *readReceiptDatabase) SetGroupReadReceipt(ctx context.Context, groupID string, enable bool) error {
+	return r.groupReadReceipt.SetEnable(ctx, groupID, enable)
+}
+
+func (r *readReceiptDatabase) IsGroupReadReceiptEnabled(ctx context.Context, groupID string) (bool, error) {
+	return r.groupReadReceipt.IsEnabled(ctx, groupID)
+}
+
+func (r *readReceiptDatabase) GetUsersHasReadSeq(ctx context.Context, conversationID string, userIDs []string) (map[string]int64, error) {
+	return r.seqUser.GetUsersReadSeq(ctx, conversationID, userIDs)
+}
+
+func (r *readReceiptDatabase) GetUsersMinSeq(ctx context.Context, conversationID string, userIDs []string) (map[string]int64, error) {
+	return r.seqUser.GetUsersMinSeq(ctx, conversationID, userIDs)
+}
@@ -41,6 +41,7 @@ type S3Database interface {
 	StatObject(ctx context.Context, name string) (*s3.ObjectInfo, error)
 	FormData(ctx context.Context, name string, size int64, contentType string, duration time.Duration) (*s3.FormData, error)
 	FindByExpires(ctx context.Context, duration time.Time, pagination pagination.Pagination) (total int64, objects []*model.Object, err error)
+	FindByUser(ctx context.Context, userID string, pagination pagination.Pagination) (total int64, objects []*model.Object, err error)
 	DeleteObject(ctx context.Context, name string) error
 	DeleteSpecifiedData(ctx context.Context, engine string, name string) error
 	FindNotDelByS3(ctx context.Context, key string, duration time.Time) (int64, error)
@@ -125,11 +126,18 @@ func (s *s3Database) FindByExpires(ctx context.Context, duration time.Time, pagi
 	return s.db.FindByExpires(ctx, duration, pagination)
 }
 
+func (s *s3Database) FindByUser(ctx context.Context, userID string, pagination pagination.Pagination) (total int64, objects []*model.Object, err error) {
+	return s.db.FindByUser(ctx, userID, pagination)
+}
+
 func (s *s3Database) DeleteObject(ctx context.Context, name string) error {
 	return s.s3.DeleteObject(ctx, name)
 }
 func (s *s3Database) DeleteSpecifiedData(ctx context.Context, engine string, name string) error {
-	return s.db.Delete(ctx, engine, name)
+	if err := s.db.Delete(ctx, engine, name); err != nil {
+		return err
+	}
+	return s.cache.DelObjectName(engine, name).ChainExecDel(ctx)
 }
 
 func (s *s3Database) FindNotDelByS3(ctx context.Context, key string, duration time.Time) (int64, error) {
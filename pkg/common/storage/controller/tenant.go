@@ -0,0 +1,78 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+// TenantDatabase stores the tenants of the deployment. Tenant records live in the storage
+// of the default tenant whatever the tenant of the context.
+type TenantDatabase interface {
+	Create(ctx context.Context, t *model.Tenant) error
+	Take(ctx context.Context, tenantID string) (*model.Tenant, error)
+	Update(ctx context.Context, tenantID string, data map[string]any) error
+	Delete(ctx context.Context, tenantID string) error
+	Page(ctx context.Context, pagination pagination.Pagination) (int64, []*model.Tenant, error)
+}
+
+func NewTenantDatabase(db database.Tenant, cache cache.TenantCache) TenantDatabase {
+	return &tenantDatabase{db: db, cache: cache}
+}
+
+type tenantDatabase struct {
+	db    database.Tenant
+	cache cache.TenantCache
+}
+
+func (t *tenantDatabase) Create(ctx context.Context, m *model.Tenant) error {
+	ctx = tenant.WithID(ctx, "")
+	if err := t.db.Create(ctx, m); err != nil {
+		return err
+	}
+	return t.cache.DelTenant(m.TenantID).ChainExecDel(ctx)
+}
+
+func (t *tenantDatabase) Take(ctx context.Context, tenantID string) (*model.Tenant, error) {
+	return t.cache.GetTenant(tenant.WithID(ctx, ""), tenantID)
+}
+
+func (t *tenantDatabase) Update(ctx context.Context, tenantID string, data map[string]any) error {
+	ctx = tenant.WithID(ctx, "")
+	data["update_time"] = time.Now()
+	if err := t.db.Update(ctx, tenantID, data); err != nil {
+		return err
+	}
+	return t.cache.DelTenant(tenantID).ChainExecDel(ctx)
+}
+
+func (t *tenantDatabase) Delete(ctx context.Context, tenantID string) error {
+	ctx = tenant.WithID(ctx, "")
+	if err := t.db.Delete(ctx, tenantID); err != nil {
+		return err
+	}
+	return t.cache.DelTenant(tenantID).ChainExecDel(ctx)
+}
+
+func (t *tenantDatabase) Page(ctx context.Context, pagination pagination.Pagination) (int64, []*model.Tenant, error) {
+	return t.db.Page(tenant.WithID(ctx, ""), pagination)
+}
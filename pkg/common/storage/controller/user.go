@@ -56,6 +56,8 @@ type UserDatabase interface {
 	GetUserByID(ctx context.Context, userID string) (user *model.User, err error)
 	// InitOnce Inside the function, first query whether it exists in the storage, if it exists, do nothing; if it does not exist, insert it
 	InitOnce(ctx context.Context, users []*model.User) (err error)
+	// DeleteUser removes the user and their commands, no error if the user does not exist
+	DeleteUser(ctx context.Context, userID string) error
 	// CountTotal Get the total number of users
 	CountTotal(ctx context.Context, before *time.Time) (int64, error)
 	// CountRangeEverydayTotal Get the user increment in the range
@@ -156,6 +158,19 @@ func (u *userDatabase) UpdateByMap(ctx context.Context, userID string, args map[
 	})
 }
 
+// DeleteUser removes the user and their commands.
+func (u *userDatabase) DeleteUser(ctx context.Context, userID string) error {
+	return u.tx.Transaction(ctx, func(ctx context.Context) error {
+		if err := u.userDB.DeleteAllUserCommand(ctx, userID); err != nil {
+			return err
+		}
+		if err := u.userDB.Delete(ctx, []string{userID}); err != nil {
+			return err
+		}
+		return u.cache.DelUsersInfo(userID).DelUsersGlobalRecvMsgOpt(userID).ChainExecDel(ctx)
+	})
+}
+
 // Page Gets, returns no error if not found.
 func (u *userDatabase) Page(ctx context.Context, pagination pagination.Pagination) (count int64, users []*model.User, err error) {
 	return u.userDB.Page(ctx, pagination)
@@ -0,0 +1,86 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// UserActivityPrecision is how often the active time of an online user is written.
+const UserActivityPrecision = time.Hour
+
+// UserActivityDatabase stores when users were last seen online.
+type UserActivityDatabase interface {
+	// SetUsersActive records the users as active now.
+	SetUsersActive(ctx context.Context, userIDs []string) error
+	// FindInactiveUserIDs returns the users among userIDs active last before the time. Users
+	// never seen online count as active when they were created, deleted users are skipped.
+	FindInactiveUserIDs(ctx context.Context, userIDs []string, before time.Time) ([]string, error)
+}
+
+func NewUserActivityDatabase(activity database.UserActivity, user database.User) UserActivityDatabase {
+	return &userActivityDatabase{activity: activity, user: user}
+}
+
+type userActivityDatabase struct {
+	activity database.UserActivity
+	user     database.User
+}
+
+func (u *userActivityDatabase) SetUsersActive(ctx context.Context, userIDs []string) error {
+	now := time.Now()
+	return u.activity.Touch(ctx, userIDs, now, now.Add(-UserActivityPrecision))
+}
+
+func (u *userActivityDatabase) FindInactiveUserIDs(ctx context.Context, userIDs []string, before time.Time) ([]string, error) {
+	userIDs = datautil.Distinct(userIDs)
+	activities, err := u.activity.Find(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	activeTimes := make(map[string]time.Time, len(activities))
+	for _, activity := range activities {
+		activeTimes[activity.UserID] = activity.ActiveTime
+	}
+	var (
+		inactive []string
+		unseen   []string
+	)
+	for _, userID := range userIDs {
+		activeTime, ok := activeTimes[userID]
+		if !ok {
+			unseen = append(unseen, userID)
+		} else if activeTime.Before(before) {
+			inactive = append(inactive, userID)
+		}
+	}
+	if len(unseen) == 0 {
+		return inactive, nil
+	}
+	users, err := u.user.Find(ctx, unseen)
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		if user.CreateTime.Before(before) {
+			inactive = append(inactive, user.UserID)
+		}
+	}
+	return inactive, nil
+}
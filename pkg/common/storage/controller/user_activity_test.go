@@ -0,0 +1,78 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type findUsers struct {
+	database.User
+	users []*model.User
+}
+
+func (f *findUsers) Find(_ context.Context, userIDs []string) ([]*model.User, error) {
+	var res []*model.User
+	for _, user := range f.users {
+		if slices.Contains(userIDs, user.UserID) {
+			res = append(res, user)
+		}
+	}
+	return res, nil
+}
+
+type findActivity struct {
+	database.UserActivity
+	activities []*model.UserActivity
+}
+
+func (f *findActivity) Find(_ context.Context, userIDs []string) ([]*model.UserActivity, error) {
+	var res []*model.UserActivity
+	for _, activity := range f.activities {
+		if slices.Contains(userIDs, activity.UserID) {
+			res = append(res, activity)
+		}
+	}
+	return res, nil
+}
+
+func TestFindInactiveUserIDs(t *testing.T) {
+	before := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	old, recent := before.AddDate(0, -1, 0), before.AddDate(0, 1, 0)
+	users := &findUsers{users: []*model.User{
+		{UserID: "active", CreateTime: old},
+		{UserID: "inactive", CreateTime: old},
+		{UserID: "unseen_old", CreateTime: old},
+		{UserID: "unseen_new", CreateTime: recent},
+	}}
+	activity := &findActivity{activities: []*model.UserActivity{
+		{UserID: "active", ActiveTime: recent},
+		{UserID: "inactive", ActiveTime: old},
+	}}
+	userIDs := []string{"active", "inactive", "unseen_old", "unseen_new", "deleted", "inactive"}
+	got, err := NewUserActivityDatabase(activity, users).FindInactiveUserIDs(context.Background(), userIDs, before)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"inactive", "unseen_old"}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+// UserJobDatabase stores user jobs and the locks that keep each running on one instance.
+type UserJobDatabase interface {
+	Create(ctx context.Context, job *model.UserJob) error
+	Take(ctx context.Context, jobID string) (*model.UserJob, error)
+	// TakeUnfinished returns the newest job of the type for the user that has not succeeded.
+	TakeUnfinished(ctx context.Context, userID string, jobType string) (*model.UserJob, error)
+	FindRunning(ctx context.Context, jobType string, limit int) ([]*model.UserJob, error)
+	// Save stores the progress of the job and refreshes its update time.
+	Save(ctx context.Context, job *model.UserJob) error
+	LockJob(ctx context.Context, jobID string, owner string, expire time.Duration) (bool, error)
+	RenewJobLock(ctx context.Context, jobID string, owner string, expire time.Duration) (bool, error)
+	UnlockJob(ctx context.Context, jobID string, owner string) error
+}
+
+func NewUserJobDatabase(db database.UserJob, cache cache.UserJobCache) UserJobDatabase {
+	return &userJobDatabase{db: db, cache: cache}
+}
+
+type userJobDatabase struct {
+	db    database.UserJob
+	cache cache.UserJobCache
+}
+
+func (u *userJobDatabase) Create(ctx context.Context, job *model.UserJob) error {
+	return u.db.Create(ctx, job)
+}
+
+func (u *userJobDatabase) Take(ctx context.Context, jobID string) (*model.UserJob, error) {
+	return u.db.Take(ctx, jobID)
+}
+
+func (u *userJobDatabase) TakeUnfinished(ctx context.Context, userID string, jobType string) (*model.UserJob, error) {
+	return u.db.TakeUnfinished(ctx, userID, jobType)
+}
+
+func (u *userJobDatabase) FindRunning(ctx context.Context, jobType string, limit int) ([]*model.UserJob, error) {
+	return u.db.FindRunning(ctx, jobType, limit)
+}
+
+func (u *userJobDatabase) Save(ctx context.Context, job *model.UserJob) error {
+	job.UpdateTime = time.Now()
+	return u.db.Save(ctx, job)
+}
+
+func (u *userJobDatabase) LockJob(ctx context.Context, jobID string, owner string, expire time.Duration) (bool, error) {
+	return u.cache.LockJob(ctx, jobID, owner, expire)
+}
+
+func (u *userJobDatabase) RenewJobLock(ctx context.Context, jobID string, owner string, expire time.Duration) (bool, error) {
+	return u.cache.RenewJobLock(ctx, jobID, owner, expire)
+}
+
+func (u *userJobDatabase) UnlockJob(ctx context.Context, jobID string, owner string) error {
+	return u.cache.UnlockJob(ctx, jobID, owner)
+}
@@ -28,4 +28,6 @@ type Black interface {
 	FindOwnerBlacks(ctx context.Context, ownerUserID string, pagination pagination.Pagination) (total int64, blacks []*model.Black, err error)
 	FindOwnerBlackInfos(ctx context.Context, ownerUserID string, userIDs []string) (blacks []*model.Black, err error)
 	FindBlackUserIDs(ctx context.Context, ownerUserID string) (blackUserIDs []string, err error)
+	// FindByBlockUser returns the blacklist entries that block blockUserID.
+	FindByBlockUser(ctx context.Context, blockUserID string, pagination pagination.Pagination) (total int64, blacks []*model.Black, err error)
 }
@@ -0,0 +1,30 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type ChannelSubscriber interface {
+	// Add indexes subscribers, those already indexed are left as they are.
+	Add(ctx context.Context, subscribers []*model.ChannelSubscriber) error
+	// Delete removes userIDs from the channel, every subscriber when userIDs is nil.
+	Delete(ctx context.Context, groupID string, userIDs []string) error
+	// FindUserIDs returns up to limit subscribers of the shard after afterUserID, by user ID.
+	FindUserIDs(ctx context.Context, groupID string, shard int32, afterUserID string, limit int) ([]string, error)
+}
@@ -0,0 +1,55 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+type Community interface {
+	Create(ctx context.Context, community *model.Community) error
+	Take(ctx context.Context, communityID string) (*model.Community, error)
+	Find(ctx context.Context, communityIDs []string) ([]*model.Community, error)
+	UpdateMap(ctx context.Context, communityID string, args map[string]any) error
+	Delete(ctx context.Context, communityID string) error
+}
+
+type CommunityMember interface {
+	// Create adds the members and counts the communities they join in their join version log.
+	Create(ctx context.Context, members []*model.CommunityMember) error
+	// Delete removes the members and counts the community they leave in their join version log.
+	Delete(ctx context.Context, communityID string, userIDs []string) error
+	Take(ctx context.Context, communityID string, userID string) (*model.CommunityMember, error)
+	Find(ctx context.Context, communityID string, userIDs []string) ([]*model.CommunityMember, error)
+	FindUserIDs(ctx context.Context, communityID string) ([]string, error)
+	// FindPage returns the members of the community, the owner and the admins first.
+	FindPage(ctx context.Context, communityID string, pagination pagination.Pagination) (int64, []*model.CommunityMember, error)
+	UpdateRoleLevel(ctx context.Context, communityID string, userID string, roleLevel int32) error
+	FindJoinCommunityIDs(ctx context.Context, userID string) ([]string, error)
+	JoinCommunityIncrVersion(ctx context.Context, userID string, communityIDs []string, state int32) error
+	FindJoinIncrVersion(ctx context.Context, userID string, version uint, limit int) (*model.VersionLog, error)
+}
+
+type CommunityGroup interface {
+	Create(ctx context.Context, groups []*model.CommunityGroup) error
+	// Delete unlinks groupIDs from the community, every group when groupIDs is nil.
+	Delete(ctx context.Context, communityID string, groupIDs []string) error
+	// TakeCommunityID returns the community of the group, empty when the group is in none.
+	TakeCommunityID(ctx context.Context, groupID string) (string, error)
+	FindGroupIDs(ctx context.Context, communityID string) ([]string, error)
+}
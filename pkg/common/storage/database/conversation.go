@@ -39,4 +39,6 @@ type Conversation interface {
 	GetConversationIDsNeedDestruct(ctx context.Context) ([]*model.Conversation, error)
 	GetConversationNotReceiveMessageUserIDs(ctx context.Context, conversationID string) ([]string, error)
 	FindConversationUserVersion(ctx context.Context, userID string, version uint, limit int) (*model.VersionLog, error)
+	// DeleteByOwner removes every conversation of the owner.
+	DeleteByOwner(ctx context.Context, ownerUserID string) error
 }
@@ -0,0 +1,44 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type FriendGroup interface {
+	Create(ctx context.Context, group *model.FriendGroup) error
+	UpdateName(ctx context.Context, ownerUserID string, groupID string, name string) error
+	// UpdateSortOrders sets the sort order of each group to its index in groupIDs.
+	UpdateSortOrders(ctx context.Context, ownerUserID string, groupIDs []string) error
+	Delete(ctx context.Context, ownerUserID string, groupID string) error
+	// Find returns the groups of the owner in sort order, all of them when groupIDs is nil.
+	Find(ctx context.Context, ownerUserID string, groupIDs []string) ([]*model.FriendGroup, error)
+}
+
+type FriendGroupMember interface {
+	// Add puts the friends into their groups, skipping those already in them.
+	Add(ctx context.Context, members []*model.FriendGroupMember) error
+	// Delete takes the friends out of the group, all of them when friendUserIDs is nil.
+	Delete(ctx context.Context, ownerUserID string, groupID string, friendUserIDs []string) error
+	// DeleteFriends takes the friends out of every group of the owner.
+	DeleteFriends(ctx context.Context, ownerUserID string, friendUserIDs []string) error
+	// Find returns the members of the groups of the owner.
+	Find(ctx context.Context, ownerUserID string, groupIDs []string) ([]*model.FriendGroupMember, error)
+	// FindGroupIDs returns the groups of the owner any of the friends is in.
+	FindGroupIDs(ctx context.Context, ownerUserID string, friendUserIDs []string) ([]string, error)
+}
@@ -0,0 +1,42 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+type GroupInviteLink interface {
+	Create(ctx context.Context, link *model.GroupInviteLink) error
+	Take(ctx context.Context, token string) (*model.GroupInviteLink, error)
+	// FindByGroup returns the links of the group, newest first.
+	FindByGroup(ctx context.Context, groupID string, pagination pagination.Pagination) (int64, []*model.GroupInviteLink, error)
+	Revoke(ctx context.Context, token string) error
+	// IncrUse counts one use of the link when it is neither revoked, expired at now nor used
+	// up, and reports whether it did.
+	IncrUse(ctx context.Context, token string, now time.Time) (bool, error)
+	// DecrUse gives back a use counted by IncrUse.
+	DecrUse(ctx context.Context, token string) error
+}
+
+type GroupInviteRedemption interface {
+	Create(ctx context.Context, redemption *model.GroupInviteRedemption) error
+	// Find returns the redemptions of the group, of one link when token is not empty, newest first.
+	Find(ctx context.Context, groupID string, token string, pagination pagination.Pagination) (int64, []*model.GroupInviteRedemption, error)
+}
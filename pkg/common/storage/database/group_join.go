@@ -0,0 +1,33 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type GroupJoinQuestion interface {
+	Set(ctx context.Context, questions *model.GroupJoinQuestions) error
+	// Take returns the questions of the group, none when the group never set them.
+	Take(ctx context.Context, groupID string) (*model.GroupJoinQuestions, error)
+}
+
+type GroupRequestAnswer interface {
+	// Set replaces the answers of the user to the group.
+	Set(ctx context.Context, answers *model.GroupRequestAnswers) error
+	Find(ctx context.Context, groupID string, userIDs []string) ([]*model.GroupRequestAnswers, error)
+}
@@ -32,8 +32,11 @@ type GroupMember interface {
 	Find(ctx context.Context, groupID string, userIDs []string) ([]*model.GroupMember, error)
 	FindInGroup(ctx context.Context, userID string, groupIDs []string) ([]*model.GroupMember, error)
 	TakeOwner(ctx context.Context, groupID string) (groupMember *model.GroupMember, err error)
+	// FindOwners returns up to limit group owners, ordered by group ID after afterGroupID.
+	FindOwners(ctx context.Context, afterGroupID string, limit int) ([]*model.GroupMember, error)
 	SearchMember(ctx context.Context, keyword string, groupID string, pagination pagination.Pagination) (total int64, groupList []*model.GroupMember, err error)
 	FindRoleLevelUserIDs(ctx context.Context, groupID string, roleLevel int32) ([]string, error)
+	FindRoleIDUserIDs(ctx context.Context, groupID string, roleID string) ([]string, error)
 	FindUserJoinedGroupID(ctx context.Context, userID string) (groupIDs []string, err error)
 	TakeGroupMemberNum(ctx context.Context, groupID string) (count int64, err error)
 	FindUserManagedGroupID(ctx context.Context, userID string) (groupIDs []string, err error)
@@ -0,0 +1,33 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type GroupMemberTag interface {
+	// Add tags members, the members already tagged are left as they are.
+	Add(ctx context.Context, tags []*model.GroupMemberTag) error
+	// Delete untags userIDs, every member of the tag when userIDs is nil.
+	Delete(ctx context.Context, groupID string, tag string, userIDs []string) error
+	// FindTags returns the tags of the group.
+	FindTags(ctx context.Context, groupID string) ([]string, error)
+	// FindUserIDs returns the members under any of the tags.
+	FindUserIDs(ctx context.Context, groupID string, tags []string) ([]string, error)
+	FindMemberTags(ctx context.Context, groupID string, userIDs []string) ([]*model.GroupMemberTag, error)
+}
@@ -16,6 +16,8 @@ package database
 
 import (
 	"context"
+	"time"
+
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
 	"github.com/openimsdk/tools/db/pagination"
 )
@@ -28,4 +30,9 @@ type GroupRequest interface {
 	FindGroupRequests(ctx context.Context, groupID string, userIDs []string) ([]*model.GroupRequest, error)
 	Page(ctx context.Context, userID string, pagination pagination.Pagination) (total int64, groups []*model.GroupRequest, err error)
 	PageGroup(ctx context.Context, groupIDs []string, pagination pagination.Pagination) (total int64, groups []*model.GroupRequest, err error)
+	// FindExpired returns at most limit requests still pending that were made before.
+	FindExpired(ctx context.Context, before time.Time, limit int) ([]*model.GroupRequest, error)
+	// Expire marks the request expired at now if it is still pending and was made before, and
+	// reports whether it did.
+	Expire(ctx context.Context, groupID string, userID string, before time.Time, now time.Time) (bool, error)
 }
@@ -0,0 +1,80 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewChannelSubscriberMongo(db *mongo.Database) (database.ChannelSubscriber, error) {
+	coll, err := newCollection(db, database.ChannelSubscriberName, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "group_id", Value: 1},
+			{Key: "shard", Value: 1},
+			{Key: "user_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &channelSubscriberMongo{coll: coll}, nil
+}
+
+type channelSubscriberMongo struct {
+	coll *collection
+}
+
+func (c *channelSubscriberMongo) Add(ctx context.Context, subscribers []*model.ChannelSubscriber) error {
+	if len(subscribers) == 0 {
+		return nil
+	}
+	writes := make([]mongo.WriteModel, 0, len(subscribers))
+	for _, subscriber := range subscribers {
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"group_id": subscriber.GroupID, "shard": subscriber.Shard, "user_id": subscriber.UserID}).
+			SetUpdate(bson.M{"$setOnInsert": bson.M{"join_time": subscriber.JoinTime}}).
+			SetUpsert(true))
+	}
+	if _, err := c.coll.get(ctx).BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false)); err != nil {
+		return errs.Wrap(err)
+	}
+	return nil
+}
+
+func (c *channelSubscriberMongo) Delete(ctx context.Context, groupID string, userIDs []string) error {
+	filter := bson.M{"group_id": groupID}
+	if userIDs != nil {
+		if len(userIDs) == 0 {
+			return nil
+		}
+		filter["user_id"] = bson.M{"$in": userIDs}
+	}
+	return mongoutil.DeleteMany(ctx, c.coll.get(ctx), filter)
+}
+
+func (c *channelSubscriberMongo) FindUserIDs(ctx context.Context, groupID string, shard int32, afterUserID string, limit int) ([]string, error) {
+	filter := bson.M{"group_id": groupID, "shard": shard, "user_id": bson.M{"$gt": afterUserID}}
+	opts := options.Find().SetSort(bson.M{"user_id": 1}).SetLimit(int64(limit)).SetProjection(bson.M{"_id": 0, "user_id": 1})
+	return mongoutil.Find[string](ctx, c.coll.get(ctx), filter, opts)
+}
@@ -0,0 +1,80 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"sync"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"github.com/openimsdk/tools/log"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// collection resolves a collection for the tenant of ctx. The collections of a tenant are
+// prefixed with its namespace and get their indexes when first used, the default tenant
+// keeps the unprefixed collection.
+type collection struct {
+	db      *mongo.Database
+	name    string
+	indexes []mongo.IndexModel
+	base    *mongo.Collection
+	tenants sync.Map // tenant ID -> *mongo.Collection
+}
+
+// newCollection creates the indexes of the collection of the default tenant.
+func newCollection(db *mongo.Database, name string, indexes ...mongo.IndexModel) (*collection, error) {
+	c := &collection{db: db, name: name, indexes: indexes, base: db.Collection(name)}
+	if len(indexes) > 0 {
+		if _, err := c.base.Indexes().CreateMany(context.Background(), indexes); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// Name is the collection name shared by every tenant.
+func (c *collection) Name() string {
+	return c.name
+}
+
+func (c *collection) get(ctx context.Context) *mongo.Collection {
+	id := tenant.ID(ctx)
+	if id == "" {
+		return c.base
+	}
+	if coll, ok := c.tenants.Load(id); ok {
+		return coll.(*mongo.Collection)
+	}
+	coll := c.db.Collection(tenant.Namespace(id) + "_" + c.name)
+	if len(c.indexes) > 0 {
+		if _, err := coll.Indexes().CreateMany(ctx, c.indexes); err != nil {
+			// Retried on the next use, the collection works without its indexes meanwhile.
+			log.ZWarn(ctx, "create tenant index failed", err, "coll", coll.Name())
+			return coll
+		}
+	}
+	c.tenants.Store(id, coll)
+	return coll
+}
+
+// sibling returns the collection named name of the tenant of ctx, for collections
+// without a repository of their own.
+func (c *collection) sibling(ctx context.Context, name string) *mongo.Collection {
+	if id := tenant.ID(ctx); id != "" {
+		name = tenant.Namespace(id) + "_" + name
+	}
+	return c.db.Collection(name)
+}
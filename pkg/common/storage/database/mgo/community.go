@@ -0,0 +1,222 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewCommunityMongo(db *mongo.Database) (database.Community, error) {
+	coll, err := newCollection(db, database.CommunityName, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "community_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &communityMongo{coll: coll}, nil
+}
+
+type communityMongo struct {
+	coll *collection
+}
+
+func (c *communityMongo) Create(ctx context.Context, community *model.Community) error {
+	return mongoutil.InsertMany(ctx, c.coll.get(ctx), []*model.Community{community})
+}
+
+func (c *communityMongo) Take(ctx context.Context, communityID string) (*model.Community, error) {
+	return mongoutil.FindOne[*model.Community](ctx, c.coll.get(ctx), bson.M{"community_id": communityID})
+}
+
+func (c *communityMongo) Find(ctx context.Context, communityIDs []string) ([]*model.Community, error) {
+	return mongoutil.Find[*model.Community](ctx, c.coll.get(ctx), bson.M{"community_id": bson.M{"$in": communityIDs}})
+}
+
+func (c *communityMongo) UpdateMap(ctx context.Context, communityID string, args map[string]any) error {
+	if len(args) == 0 {
+		return nil
+	}
+	return mongoutil.UpdateOne(ctx, c.coll.get(ctx), bson.M{"community_id": communityID}, bson.M{"$set": args}, true)
+}
+
+func (c *communityMongo) Delete(ctx context.Context, communityID string) error {
+	return mongoutil.DeleteOne(ctx, c.coll.get(ctx), bson.M{"community_id": communityID})
+}
+
+func NewCommunityMemberMongo(db *mongo.Database) (database.CommunityMember, error) {
+	coll, err := newCollection(db, database.CommunityMemberName,
+		mongo.IndexModel{
+			Keys: bson.D{
+				{Key: "community_id", Value: 1},
+				{Key: "user_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		mongo.IndexModel{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	join, err := NewVersionLog(db, database.CommunityJoinVersionName)
+	if err != nil {
+		return nil, err
+	}
+	return &communityMemberMongo{coll: coll, join: join}, nil
+}
+
+type communityMemberMongo struct {
+	coll *collection
+	join database.VersionLog
+}
+
+func (c *communityMemberMongo) Create(ctx context.Context, members []*model.CommunityMember) error {
+	return mongoutil.IncrVersion(func() error {
+		return mongoutil.InsertMany(ctx, c.coll.get(ctx), members)
+	}, func() error {
+		for _, member := range members {
+			if err := c.join.IncrVersion(ctx, member.UserID, []string{member.CommunityID}, model.VersionStateInsert); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (c *communityMemberMongo) Delete(ctx context.Context, communityID string, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	return mongoutil.IncrVersion(func() error {
+		return mongoutil.DeleteMany(ctx, c.coll.get(ctx), bson.M{"community_id": communityID, "user_id": bson.M{"$in": userIDs}})
+	}, func() error {
+		for _, userID := range userIDs {
+			if err := c.join.IncrVersion(ctx, userID, []string{communityID}, model.VersionStateDelete); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (c *communityMemberMongo) Take(ctx context.Context, communityID string, userID string) (*model.CommunityMember, error) {
+	return mongoutil.FindOne[*model.CommunityMember](ctx, c.coll.get(ctx), bson.M{"community_id": communityID, "user_id": userID})
+}
+
+func (c *communityMemberMongo) Find(ctx context.Context, communityID string, userIDs []string) ([]*model.CommunityMember, error) {
+	return mongoutil.Find[*model.CommunityMember](ctx, c.coll.get(ctx), bson.M{"community_id": communityID, "user_id": bson.M{"$in": userIDs}})
+}
+
+func (c *communityMemberMongo) FindUserIDs(ctx context.Context, communityID string) ([]string, error) {
+	return mongoutil.Find[string](ctx, c.coll.get(ctx), bson.M{"community_id": communityID},
+		options.Find().SetProjection(bson.M{"_id": 0, "user_id": 1}))
+}
+
+func (c *communityMemberMongo) FindPage(ctx context.Context, communityID string, pagination pagination.Pagination) (int64, []*model.CommunityMember, error) {
+	return mongoutil.FindPage[*model.CommunityMember](ctx, c.coll.get(ctx), bson.M{"community_id": communityID}, pagination,
+		options.Find().SetSort(bson.D{{Key: "role_level", Value: -1}, {Key: "join_time", Value: 1}}))
+}
+
+func (c *communityMemberMongo) UpdateRoleLevel(ctx context.Context, communityID string, userID string, roleLevel int32) error {
+	return mongoutil.IncrVersion(func() error {
+		return mongoutil.UpdateOne(ctx, c.coll.get(ctx), bson.M{"community_id": communityID, "user_id": userID},
+			bson.M{"$set": bson.M{"role_level": roleLevel}}, true)
+	}, func() error {
+		return c.join.IncrVersion(ctx, userID, []string{communityID}, model.VersionStateUpdate)
+	})
+}
+
+func (c *communityMemberMongo) FindJoinCommunityIDs(ctx context.Context, userID string) ([]string, error) {
+	return mongoutil.Find[string](ctx, c.coll.get(ctx), bson.M{"user_id": userID},
+		options.Find().SetProjection(bson.M{"_id": 0, "community_id": 1}))
+}
+
+func (c *communityMemberMongo) JoinCommunityIncrVersion(ctx context.Context, userID string, communityIDs []string, state int32) error {
+	return c.join.IncrVersion(ctx, userID, communityIDs, state)
+}
+
+func (c *communityMemberMongo) FindJoinIncrVersion(ctx context.Context, userID string, version uint, limit int) (*model.VersionLog, error) {
+	return c.join.FindChangeLog(ctx, userID, version, limit)
+}
+
+func NewCommunityGroupMongo(db *mongo.Database) (database.CommunityGroup, error) {
+	coll, err := newCollection(db, database.CommunityGroupName,
+		mongo.IndexModel{
+			Keys: bson.D{
+				{Key: "group_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		mongo.IndexModel{
+			Keys: bson.D{
+				{Key: "community_id", Value: 1},
+				{Key: "create_time", Value: 1},
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &communityGroupMongo{coll: coll}, nil
+}
+
+type communityGroupMongo struct {
+	coll *collection
+}
+
+func (c *communityGroupMongo) Create(ctx context.Context, groups []*model.CommunityGroup) error {
+	return mongoutil.InsertMany(ctx, c.coll.get(ctx), groups)
+}
+
+func (c *communityGroupMongo) Delete(ctx context.Context, communityID string, groupIDs []string) error {
+	filter := bson.M{"community_id": communityID}
+	if groupIDs != nil {
+		if len(groupIDs) == 0 {
+			return nil
+		}
+		filter["group_id"] = bson.M{"$in": groupIDs}
+	}
+	return mongoutil.DeleteMany(ctx, c.coll.get(ctx), filter)
+}
+
+func (c *communityGroupMongo) TakeCommunityID(ctx context.Context, groupID string) (string, error) {
+	group, err := mongoutil.FindOne[*model.CommunityGroup](ctx, c.coll.get(ctx), bson.M{"group_id": groupID})
+	if err != nil {
+		if IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return group.CommunityID, nil
+}
+
+func (c *communityGroupMongo) FindGroupIDs(ctx context.Context, communityID string) ([]string, error) {
+	return mongoutil.Find[string](ctx, c.coll.get(ctx), bson.M{"community_id": communityID},
+		options.Find().SetSort(bson.M{"create_time": 1}).SetProjection(bson.M{"_id": 0, "group_id": 1}))
+}
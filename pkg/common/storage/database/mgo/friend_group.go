@@ -0,0 +1,170 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewFriendGroupMongo(db *mongo.Database) (database.FriendGroup, error) {
+	coll, err := newCollection(db, database.FriendGroupName, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "owner_user_id", Value: 1},
+			{Key: "group_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &friendGroupMongo{coll: coll}, nil
+}
+
+type friendGroupMongo struct {
+	coll *collection
+}
+
+func (f *friendGroupMongo) Create(ctx context.Context, group *model.FriendGroup) error {
+	return mongoutil.InsertMany(ctx, f.coll.get(ctx), []*model.FriendGroup{group})
+}
+
+func (f *friendGroupMongo) UpdateName(ctx context.Context, ownerUserID string, groupID string, name string) error {
+	filter := bson.M{"owner_user_id": ownerUserID, "group_id": groupID}
+	return mongoutil.UpdateOne(ctx, f.coll.get(ctx), filter, bson.M{"$set": bson.M{"name": name}}, true)
+}
+
+func (f *friendGroupMongo) UpdateSortOrders(ctx context.Context, ownerUserID string, groupIDs []string) error {
+	if len(groupIDs) == 0 {
+		return nil
+	}
+	writes := make([]mongo.WriteModel, 0, len(groupIDs))
+	for i, groupID := range groupIDs {
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"owner_user_id": ownerUserID, "group_id": groupID}).
+			SetUpdate(bson.M{"$set": bson.M{"sort_order": i}}))
+	}
+	if _, err := f.coll.get(ctx).BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false)); err != nil {
+		return errs.Wrap(err)
+	}
+	return nil
+}
+
+func (f *friendGroupMongo) Delete(ctx context.Context, ownerUserID string, groupID string) error {
+	return mongoutil.DeleteOne(ctx, f.coll.get(ctx), bson.M{"owner_user_id": ownerUserID, "group_id": groupID})
+}
+
+func (f *friendGroupMongo) Find(ctx context.Context, ownerUserID string, groupIDs []string) ([]*model.FriendGroup, error) {
+	filter := bson.M{"owner_user_id": ownerUserID}
+	if groupIDs != nil {
+		if len(groupIDs) == 0 {
+			return nil, nil
+		}
+		filter["group_id"] = bson.M{"$in": groupIDs}
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "sort_order", Value: 1}, {Key: "create_time", Value: 1}})
+	return mongoutil.Find[*model.FriendGroup](ctx, f.coll.get(ctx), filter, opts)
+}
+
+func NewFriendGroupMemberMongo(db *mongo.Database) (database.FriendGroupMember, error) {
+	coll, err := newCollection(db, database.FriendGroupMemberName, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "owner_user_id", Value: 1},
+			{Key: "group_id", Value: 1},
+			{Key: "friend_user_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	}, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "owner_user_id", Value: 1},
+			{Key: "friend_user_id", Value: 1},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &friendGroupMemberMongo{coll: coll}, nil
+}
+
+type friendGroupMemberMongo struct {
+	coll *collection
+}
+
+func (f *friendGroupMemberMongo) Add(ctx context.Context, members []*model.FriendGroupMember) error {
+	if len(members) == 0 {
+		return nil
+	}
+	writes := make([]mongo.WriteModel, 0, len(members))
+	for _, member := range members {
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"owner_user_id": member.OwnerUserID, "group_id": member.GroupID, "friend_user_id": member.FriendUserID}).
+			SetUpdate(bson.M{"$setOnInsert": bson.M{}}).
+			SetUpsert(true))
+	}
+	if _, err := f.coll.get(ctx).BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false)); err != nil {
+		return errs.Wrap(err)
+	}
+	return nil
+}
+
+func (f *friendGroupMemberMongo) Delete(ctx context.Context, ownerUserID string, groupID string, friendUserIDs []string) error {
+	filter := bson.M{"owner_user_id": ownerUserID, "group_id": groupID}
+	if friendUserIDs != nil {
+		if len(friendUserIDs) == 0 {
+			return nil
+		}
+		filter["friend_user_id"] = bson.M{"$in": friendUserIDs}
+	}
+	return mongoutil.DeleteMany(ctx, f.coll.get(ctx), filter)
+}
+
+func (f *friendGroupMemberMongo) DeleteFriends(ctx context.Context, ownerUserID string, friendUserIDs []string) error {
+	if len(friendUserIDs) == 0 {
+		return nil
+	}
+	return mongoutil.DeleteMany(ctx, f.coll.get(ctx), bson.M{"owner_user_id": ownerUserID, "friend_user_id": bson.M{"$in": friendUserIDs}})
+}
+
+func (f *friendGroupMemberMongo) Find(ctx context.Context, ownerUserID string, groupIDs []string) ([]*model.FriendGroupMember, error) {
+	if len(groupIDs) == 0 {
+		return nil, nil
+	}
+	filter := bson.M{"owner_user_id": ownerUserID, "group_id": bson.M{"$in": groupIDs}}
+	return mongoutil.Find[*model.FriendGroupMember](ctx, f.coll.get(ctx), filter, options.Find().SetSort(bson.M{"friend_user_id": 1}))
+}
+
+func (f *friendGroupMemberMongo) FindGroupIDs(ctx context.Context, ownerUserID string, friendUserIDs []string) ([]string, error) {
+	if len(friendUserIDs) == 0 {
+		return nil, nil
+	}
+	values, err := f.coll.get(ctx).Distinct(ctx, "group_id", bson.M{"owner_user_id": ownerUserID, "friend_user_id": bson.M{"$in": friendUserIDs}})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	groupIDs := make([]string, 0, len(values))
+	for _, value := range values {
+		if groupID, ok := value.(string); ok {
+			groupIDs = append(groupIDs, groupID)
+		}
+	}
+	return groupIDs, nil
+}
@@ -0,0 +1,130 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewGroupInviteLinkMongo(db *mongo.Database) (database.GroupInviteLink, error) {
+	coll, err := newCollection(db, database.GroupInviteLinkName,
+		mongo.IndexModel{
+			Keys: bson.D{
+				{Key: "token", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		mongo.IndexModel{
+			Keys: bson.D{
+				{Key: "group_id", Value: 1},
+				{Key: "create_time", Value: -1},
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &groupInviteLinkMongo{coll: coll}, nil
+}
+
+type groupInviteLinkMongo struct {
+	coll *collection
+}
+
+func (g *groupInviteLinkMongo) Create(ctx context.Context, link *model.GroupInviteLink) error {
+	return mongoutil.InsertMany(ctx, g.coll.get(ctx), []*model.GroupInviteLink{link})
+}
+
+func (g *groupInviteLinkMongo) Take(ctx context.Context, token string) (*model.GroupInviteLink, error) {
+	return mongoutil.FindOne[*model.GroupInviteLink](ctx, g.coll.get(ctx), bson.M{"token": token})
+}
+
+func (g *groupInviteLinkMongo) FindByGroup(ctx context.Context, groupID string, pagination pagination.Pagination) (int64, []*model.GroupInviteLink, error) {
+	return mongoutil.FindPage[*model.GroupInviteLink](ctx, g.coll.get(ctx), bson.M{"group_id": groupID}, pagination,
+		options.Find().SetSort(bson.M{"create_time": -1}))
+}
+
+func (g *groupInviteLinkMongo) Revoke(ctx context.Context, token string) error {
+	return mongoutil.UpdateOne(ctx, g.coll.get(ctx), bson.M{"token": token}, bson.M{"$set": bson.M{"revoked": true}}, true)
+}
+
+func (g *groupInviteLinkMongo) IncrUse(ctx context.Context, token string, now time.Time) (bool, error) {
+	filter := bson.M{
+		"token":   token,
+		"revoked": false,
+		"$and": bson.A{
+			bson.M{"$or": bson.A{
+				bson.M{"expire_time": time.Time{}},
+				bson.M{"expire_time": bson.M{"$gt": now}},
+			}},
+			bson.M{"$or": bson.A{
+				bson.M{"max_uses": 0},
+				bson.M{"$expr": bson.M{"$lt": bson.A{"$used_count", "$max_uses"}}},
+			}},
+		},
+	}
+	res, err := mongoutil.UpdateOneResult(ctx, g.coll.get(ctx), filter, bson.M{"$inc": bson.M{"used_count": 1}})
+	if err != nil {
+		return false, err
+	}
+	return res.ModifiedCount > 0, nil
+}
+
+func (g *groupInviteLinkMongo) DecrUse(ctx context.Context, token string) error {
+	filter := bson.M{"token": token, "used_count": bson.M{"$gt": 0}}
+	return mongoutil.UpdateOne(ctx, g.coll.get(ctx), filter, bson.M{"$inc": bson.M{"used_count": -1}}, false)
+}
+
+func NewGroupInviteRedemptionMongo(db *mongo.Database) (database.GroupInviteRedemption, error) {
+	coll, err := newCollection(db, database.GroupInviteRedeemName,
+		mongo.IndexModel{
+			Keys: bson.D{
+				{Key: "group_id", Value: 1},
+				{Key: "token", Value: 1},
+				{Key: "create_time", Value: -1},
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &groupInviteRedemptionMongo{coll: coll}, nil
+}
+
+type groupInviteRedemptionMongo struct {
+	coll *collection
+}
+
+func (g *groupInviteRedemptionMongo) Create(ctx context.Context, redemption *model.GroupInviteRedemption) error {
+	return mongoutil.InsertMany(ctx, g.coll.get(ctx), []*model.GroupInviteRedemption{redemption})
+}
+
+func (g *groupInviteRedemptionMongo) Find(ctx context.Context, groupID string, token string, pagination pagination.Pagination) (int64, []*model.GroupInviteRedemption, error) {
+	filter := bson.M{"group_id": groupID}
+	if token != "" {
+		filter["token"] = token
+	}
+	return mongoutil.FindPage[*model.GroupInviteRedemption](ctx, g.coll.get(ctx), filter, pagination,
+		options.Find().SetSort(bson.M{"create_time": -1}))
+}
@@ -0,0 +1,103 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewGroupJoinQuestionMongo(db *mongo.Database) (database.GroupJoinQuestion, error) {
+	coll, err := newCollection(db, database.GroupJoinQuestionName, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "group_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &groupJoinQuestionMongo{coll: coll}, nil
+}
+
+type groupJoinQuestionMongo struct {
+	coll *collection
+}
+
+func (g *groupJoinQuestionMongo) Set(ctx context.Context, questions *model.GroupJoinQuestions) error {
+	list := questions.Questions
+	if list == nil {
+		list = []model.GroupJoinQuestion{}
+	}
+	filter := bson.M{"group_id": questions.GroupID}
+	update := bson.M{"$set": bson.M{
+		"questions":   list,
+		"update_time": questions.UpdateTime,
+	}}
+	return mongoutil.UpdateOne(ctx, g.coll.get(ctx), filter, update, false, options.Update().SetUpsert(true))
+}
+
+func (g *groupJoinQuestionMongo) Take(ctx context.Context, groupID string) (*model.GroupJoinQuestions, error) {
+	questions, err := mongoutil.FindOne[*model.GroupJoinQuestions](ctx, g.coll.get(ctx), bson.M{"group_id": groupID})
+	if err == nil {
+		return questions, nil
+	} else if errors.Is(err, mongo.ErrNoDocuments) {
+		return &model.GroupJoinQuestions{GroupID: groupID}, nil
+	} else {
+		return nil, err
+	}
+}
+
+func NewGroupRequestAnswerMongo(db *mongo.Database) (database.GroupRequestAnswer, error) {
+	coll, err := newCollection(db, database.GroupRequestAnswerName, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "group_id", Value: 1},
+			{Key: "user_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &groupRequestAnswerMongo{coll: coll}, nil
+}
+
+type groupRequestAnswerMongo struct {
+	coll *collection
+}
+
+func (g *groupRequestAnswerMongo) Set(ctx context.Context, answers *model.GroupRequestAnswers) error {
+	list := answers.Answers
+	if list == nil {
+		list = []model.GroupJoinAnswer{}
+	}
+	filter := bson.M{"group_id": answers.GroupID, "user_id": answers.UserID}
+	update := bson.M{"$set": bson.M{
+		"answers":     list,
+		"create_time": answers.CreateTime,
+	}}
+	return mongoutil.UpdateOne(ctx, g.coll.get(ctx), filter, update, false, options.Update().SetUpsert(true))
+}
+
+func (g *groupRequestAnswerMongo) Find(ctx context.Context, groupID string, userIDs []string) ([]*model.GroupRequestAnswers, error) {
+	return mongoutil.Find[*model.GroupRequestAnswers](ctx, g.coll.get(ctx), bson.M{"group_id": groupID, "user_id": bson.M{"$in": userIDs}})
+}
@@ -0,0 +1,111 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewGroupMemberTagMongo(db *mongo.Database) (database.GroupMemberTag, error) {
+	coll, err := newCollection(db, database.GroupMemberTagName, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "group_id", Value: 1},
+			{Key: "tag", Value: 1},
+			{Key: "user_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	}, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "group_id", Value: 1},
+			{Key: "user_id", Value: 1},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &groupMemberTagMongo{coll: coll}, nil
+}
+
+type groupMemberTagMongo struct {
+	coll *collection
+}
+
+func (g *groupMemberTagMongo) Add(ctx context.Context, tags []*model.GroupMemberTag) error {
+	if len(tags) == 0 {
+		return nil
+	}
+	writes := make([]mongo.WriteModel, 0, len(tags))
+	for _, tag := range tags {
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"group_id": tag.GroupID, "tag": tag.Tag, "user_id": tag.UserID}).
+			SetUpdate(bson.M{"$setOnInsert": bson.M{"create_time": tag.CreateTime}}).
+			SetUpsert(true))
+	}
+	if _, err := g.coll.get(ctx).BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false)); err != nil {
+		return errs.Wrap(err)
+	}
+	return nil
+}
+
+func (g *groupMemberTagMongo) Delete(ctx context.Context, groupID string, tag string, userIDs []string) error {
+	filter := bson.M{"group_id": groupID, "tag": tag}
+	if userIDs != nil {
+		if len(userIDs) == 0 {
+			return nil
+		}
+		filter["user_id"] = bson.M{"$in": userIDs}
+	}
+	return mongoutil.DeleteMany(ctx, g.coll.get(ctx), filter)
+}
+
+func (g *groupMemberTagMongo) FindTags(ctx context.Context, groupID string) ([]string, error) {
+	values, err := g.coll.get(ctx).Distinct(ctx, "tag", bson.M{"group_id": groupID})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	tags := make([]string, 0, len(values))
+	for _, value := range values {
+		if tag, ok := value.(string); ok {
+			tags = append(tags, tag)
+		}
+	}
+	return tags, nil
+}
+
+func (g *groupMemberTagMongo) FindUserIDs(ctx context.Context, groupID string, tags []string) ([]string, error) {
+	values, err := g.coll.get(ctx).Distinct(ctx, "user_id", bson.M{"group_id": groupID, "tag": bson.M{"$in": tags}})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	userIDs := make([]string, 0, len(values))
+	for _, value := range values {
+		if userID, ok := value.(string); ok {
+			userIDs = append(userIDs, userID)
+		}
+	}
+	return userIDs, nil
+}
+
+func (g *groupMemberTagMongo) FindMemberTags(ctx context.Context, groupID string, userIDs []string) ([]*model.GroupMemberTag, error) {
+	return mongoutil.Find[*model.GroupMemberTag](ctx, g.coll.get(ctx), bson.M{"group_id": groupID, "user_id": bson.M{"$in": userIDs}})
+}
@@ -0,0 +1,70 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewGroupMuteRuleMongo(db *mongo.Database) (database.GroupMuteRule, error) {
+	coll, err := newCollection(db, database.GroupMuteRuleName, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "group_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &groupMuteRuleMongo{coll: coll}, nil
+}
+
+type groupMuteRuleMongo struct {
+	coll *collection
+}
+
+func (g *groupMuteRuleMongo) Set(ctx context.Context, rule *model.GroupMuteRule) error {
+	schedules := rule.Schedules
+	if schedules == nil {
+		schedules = []model.GroupMuteSchedule{}
+	}
+	filter := bson.M{"group_id": rule.GroupID}
+	update := bson.M{"$set": bson.M{
+		"slow_mode_interval": rule.SlowModeInterval,
+		"time_zone":          rule.TimeZone,
+		"schedules":          schedules,
+		"update_time":        rule.UpdateTime,
+	}}
+	return mongoutil.UpdateOne(ctx, g.coll.get(ctx), filter, update, false, options.Update().SetUpsert(true))
+}
+
+func (g *groupMuteRuleMongo) Take(ctx context.Context, groupID string) (*model.GroupMuteRule, error) {
+	rule, err := mongoutil.FindOne[*model.GroupMuteRule](ctx, g.coll.get(ctx), bson.M{"group_id": groupID})
+	if err == nil {
+		return rule, nil
+	} else if errors.Is(err, mongo.ErrNoDocuments) {
+		return &model.GroupMuteRule{GroupID: groupID}, nil
+	} else {
+		return nil, err
+	}
+}
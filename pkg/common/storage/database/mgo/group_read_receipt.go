@@ -0,0 +1,62 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewGroupReadReceiptMongo(db *mongo.Database) (database.GroupReadReceipt, error) {
+	coll, err := newCollection(db, database.GroupReadReceiptName, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "group_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &groupReadReceiptMongo{coll: coll}, nil
+}
+
+type groupReadReceiptMongo struct {
+	coll *collection
+}
+
+func (g *groupReadReceiptMongo) SetEnable(ctx context.Context, groupID string, enable bool) error {
+	filter := bson.M{"group_id": groupID}
+	update := bson.M{"$set": bson.M{"enable": enable, "update_time": time.Now()}}
+	return mongoutil.UpdateOne(ctx, g.coll.get(ctx), filter, update, false, options.Update().SetUpsert(true))
+}
+
+func (g *groupReadReceiptMongo) IsEnabled(ctx context.Context, groupID string) (bool, error) {
+	opt := options.FindOne().SetProjection(bson.M{"_id": 0, "enable": 1})
+	enable, err := mongoutil.FindOne[bool](ctx, g.coll.get(ctx), bson.M{"group_id": groupID}, opt)
+	if err == nil {
+		return enable, nil
+	} else if errors.Is(err, mongo.ErrNoDocuments) {
+		return false, nil
+	} else {
+		return false, err
+	}
+}
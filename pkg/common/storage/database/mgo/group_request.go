@@ -16,6 +16,8 @@ package mgo
 
 import (
 	"context"
+	"time"
+
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
 
@@ -28,13 +30,17 @@ import (
 )
 
 func NewGroupRequestMgo(db *mongo.Database) (database.GroupRequest, error) {
-	coll := db.Collection(database.GroupRequestName)
-	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+	coll, err := newCollection(db, database.GroupRequestName, mongo.IndexModel{
 		Keys: bson.D{
 			{Key: "group_id", Value: 1},
 			{Key: "user_id", Value: 1},
 		},
 		Options: options.Index().SetUnique(true),
+	}, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "handle_result", Value: 1},
+			{Key: "req_time", Value: 1},
+		},
 	})
 	if err != nil {
 		return nil, errs.Wrap(err)
@@ -43,33 +49,49 @@ func NewGroupRequestMgo(db *mongo.Database) (database.GroupRequest, error) {
 }
 
 type GroupRequestMgo struct {
-	coll *mongo.Collection
+	coll *collection
 }
 
 func (g *GroupRequestMgo) Create(ctx context.Context, groupRequests []*model.GroupRequest) (err error) {
-	return mongoutil.InsertMany(ctx, g.coll, groupRequests)
+	return mongoutil.InsertMany(ctx, g.coll.get(ctx), groupRequests)
 }
 
 func (g *GroupRequestMgo) Delete(ctx context.Context, groupID string, userID string) (err error) {
-	return mongoutil.DeleteOne(ctx, g.coll, bson.M{"group_id": groupID, "user_id": userID})
+	return mongoutil.DeleteOne(ctx, g.coll.get(ctx), bson.M{"group_id": groupID, "user_id": userID})
 }
 
 func (g *GroupRequestMgo) UpdateHandler(ctx context.Context, groupID string, userID string, handledMsg string, handleResult int32) (err error) {
-	return mongoutil.UpdateOne(ctx, g.coll, bson.M{"group_id": groupID, "user_id": userID}, bson.M{"$set": bson.M{"handle_msg": handledMsg, "handle_result": handleResult}}, true)
+	return mongoutil.UpdateOne(ctx, g.coll.get(ctx), bson.M{"group_id": groupID, "user_id": userID}, bson.M{"$set": bson.M{"handle_msg": handledMsg, "handle_result": handleResult}}, true)
 }
 
 func (g *GroupRequestMgo) Take(ctx context.Context, groupID string, userID string) (groupRequest *model.GroupRequest, err error) {
-	return mongoutil.FindOne[*model.GroupRequest](ctx, g.coll, bson.M{"group_id": groupID, "user_id": userID})
+	return mongoutil.FindOne[*model.GroupRequest](ctx, g.coll.get(ctx), bson.M{"group_id": groupID, "user_id": userID})
 }
 
 func (g *GroupRequestMgo) FindGroupRequests(ctx context.Context, groupID string, userIDs []string) ([]*model.GroupRequest, error) {
-	return mongoutil.Find[*model.GroupRequest](ctx, g.coll, bson.M{"group_id": groupID, "user_id": bson.M{"$in": userIDs}})
+	return mongoutil.Find[*model.GroupRequest](ctx, g.coll.get(ctx), bson.M{"group_id": groupID, "user_id": bson.M{"$in": userIDs}})
 }
 
 func (g *GroupRequestMgo) Page(ctx context.Context, userID string, pagination pagination.Pagination) (total int64, groups []*model.GroupRequest, err error) {
-	return mongoutil.FindPage[*model.GroupRequest](ctx, g.coll, bson.M{"user_id": userID}, pagination)
+	return mongoutil.FindPage[*model.GroupRequest](ctx, g.coll.get(ctx), bson.M{"user_id": userID}, pagination)
 }
 
 func (g *GroupRequestMgo) PageGroup(ctx context.Context, groupIDs []string, pagination pagination.Pagination) (total int64, groups []*model.GroupRequest, err error) {
-	return mongoutil.FindPage[*model.GroupRequest](ctx, g.coll, bson.M{"group_id": bson.M{"$in": groupIDs}}, pagination)
+	return mongoutil.FindPage[*model.GroupRequest](ctx, g.coll.get(ctx), bson.M{"group_id": bson.M{"$in": groupIDs}}, pagination)
+}
+
+func (g *GroupRequestMgo) FindExpired(ctx context.Context, before time.Time, limit int) ([]*model.GroupRequest, error) {
+	filter := bson.M{"handle_result": 0, "req_time": bson.M{"$lt": before}}
+	opts := options.Find().SetSort(bson.D{{Key: "req_time", Value: 1}}).SetLimit(int64(limit))
+	return mongoutil.Find[*model.GroupRequest](ctx, g.coll.get(ctx), filter, opts)
+}
+
+func (g *GroupRequestMgo) Expire(ctx context.Context, groupID string, userID string, before time.Time, now time.Time) (bool, error) {
+	filter := bson.M{"group_id": groupID, "user_id": userID, "handle_result": 0, "req_time": bson.M{"$lt": before}}
+	update := bson.M{"$set": bson.M{"handle_result": model.GroupRequestExpired, "handled_time": now}}
+	res, err := mongoutil.UpdateOneResult(ctx, g.coll.get(ctx), filter, update)
+	if err != nil {
+		return false, err
+	}
+	return res.ModifiedCount > 0, nil
 }
@@ -0,0 +1,65 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewGroupRoleMongo(db *mongo.Database) (database.GroupRole, error) {
+	coll, err := newCollection(db, database.GroupRoleName, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "group_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &groupRoleMongo{coll: coll}, nil
+}
+
+type groupRoleMongo struct {
+	coll *collection
+}
+
+func (g *groupRoleMongo) Set(ctx context.Context, roles *model.GroupRoles) error {
+	list := roles.Roles
+	if list == nil {
+		list = []*model.GroupRole{}
+	}
+	filter := bson.M{"group_id": roles.GroupID}
+	update := bson.M{"$set": bson.M{"roles": list, "update_time": roles.UpdateTime}}
+	return mongoutil.UpdateOne(ctx, g.coll.get(ctx), filter, update, false, options.Update().SetUpsert(true))
+}
+
+func (g *groupRoleMongo) Take(ctx context.Context, groupID string) (*model.GroupRoles, error) {
+	roles, err := mongoutil.FindOne[*model.GroupRoles](ctx, g.coll.get(ctx), bson.M{"group_id": groupID})
+	if err == nil {
+		return roles, nil
+	} else if errors.Is(err, mongo.ErrNoDocuments) {
+		return &model.GroupRoles{GroupID: groupID}, nil
+	} else {
+		return nil, err
+	}
+}
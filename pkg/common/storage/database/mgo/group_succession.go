@@ -0,0 +1,69 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewGroupSuccessionMongo(db *mongo.Database) (database.GroupSuccession, error) {
+	coll, err := newCollection(db, database.GroupSuccessionName, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "group_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &groupSuccessionMongo{coll: coll}, nil
+}
+
+type groupSuccessionMongo struct {
+	coll *collection
+}
+
+func (g *groupSuccessionMongo) Set(ctx context.Context, succession *model.GroupSuccession) error {
+	filter := bson.M{"group_id": succession.GroupID}
+	update := bson.M{"$set": bson.M{
+		"policy":            succession.Policy,
+		"successor_user_id": succession.SuccessorUserID,
+		"update_time":       succession.UpdateTime,
+	}}
+	return mongoutil.UpdateOne(ctx, g.coll.get(ctx), filter, update, false, options.Update().SetUpsert(true))
+}
+
+func (g *groupSuccessionMongo) Delete(ctx context.Context, groupID string) error {
+	return mongoutil.DeleteOne(ctx, g.coll.get(ctx), bson.M{"group_id": groupID})
+}
+
+func (g *groupSuccessionMongo) Take(ctx context.Context, groupID string) (*model.GroupSuccession, error) {
+	succession, err := mongoutil.FindOne[*model.GroupSuccession](ctx, g.coll.get(ctx), bson.M{"group_id": groupID})
+	if err == nil {
+		return succession, nil
+	} else if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	} else {
+		return nil, err
+	}
+}
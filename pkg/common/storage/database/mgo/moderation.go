@@ -0,0 +1,119 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewGroupSensitiveWordMongo(db *mongo.Database) (database.GroupSensitiveWord, error) {
+	coll, err := newCollection(db, database.GroupSensitiveWordName, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "group_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &groupSensitiveWordMongo{coll: coll}, nil
+}
+
+type groupSensitiveWordMongo struct {
+	coll *collection
+}
+
+func (g *groupSensitiveWordMongo) Set(ctx context.Context, word *model.GroupSensitiveWord) error {
+	filter := bson.M{"group_id": word.GroupID}
+	update := bson.M{"$set": bson.M{"words": word.Words, "action": word.Action, "update_time": word.UpdateTime}}
+	return mongoutil.UpdateOne(ctx, g.coll.get(ctx), filter, update, false, options.Update().SetUpsert(true))
+}
+
+func (g *groupSensitiveWordMongo) Take(ctx context.Context, groupID string) (*model.GroupSensitiveWord, error) {
+	word, err := mongoutil.FindOne[*model.GroupSensitiveWord](ctx, g.coll.get(ctx), bson.M{"group_id": groupID})
+	if err == nil {
+		return word, nil
+	} else if errors.Is(err, mongo.ErrNoDocuments) {
+		return &model.GroupSensitiveWord{GroupID: groupID}, nil
+	} else {
+		return nil, err
+	}
+}
+
+func NewModerationReviewMongo(db *mongo.Database) (database.ModerationReview, error) {
+	coll, err := newCollection(db, database.ModerationReviewName,
+		mongo.IndexModel{
+			Keys: bson.D{
+				{Key: "review_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		mongo.IndexModel{
+			Keys: bson.D{
+				{Key: "status", Value: 1},
+				{Key: "create_time", Value: -1},
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &moderationReviewMongo{coll: coll}, nil
+}
+
+type moderationReviewMongo struct {
+	coll *collection
+}
+
+func (m *moderationReviewMongo) Create(ctx context.Context, reviews []*model.ModerationReview) error {
+	return mongoutil.InsertMany(ctx, m.coll.get(ctx), reviews)
+}
+
+func (m *moderationReviewMongo) Search(ctx context.Context, status int32, sendID string, groupID string, pagination pagination.Pagination) (int64, []*model.ModerationReview, error) {
+	filter := bson.M{}
+	if status >= 0 {
+		filter["status"] = status
+	}
+	if sendID != "" {
+		filter["send_id"] = sendID
+	}
+	if groupID != "" {
+		filter["group_id"] = groupID
+	}
+	return mongoutil.FindPage[*model.ModerationReview](ctx, m.coll.get(ctx), filter, pagination, options.Find().SetSort(bson.M{"create_time": -1}))
+}
+
+func (m *moderationReviewMongo) SetStatus(ctx context.Context, reviewIDs []string, status int32, reviewer string) (int64, error) {
+	if len(reviewIDs) == 0 {
+		return 0, nil
+	}
+	filter := bson.M{"review_id": bson.M{"$in": reviewIDs}, "status": model.ModerationReviewPending}
+	update := bson.M{"$set": bson.M{"status": status, "reviewer": reviewer, "review_time": time.Now()}}
+	res, err := mongoutil.UpdateMany(ctx, m.coll.get(ctx), filter, update)
+	if err != nil {
+		return 0, err
+	}
+	return res.ModifiedCount, nil
+}
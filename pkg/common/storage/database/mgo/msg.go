@@ -2,21 +2,19 @@ package mgo
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"reflect"
 	"time"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
-	"github.com/openimsdk/tools/utils/datautil"
 	"golang.org/x/exp/rand"
 
-	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/msg"
-	"github.com/openimsdk/protocol/sdkws"
 	"github.com/openimsdk/tools/db/mongoutil"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/log"
-	"github.com/openimsdk/tools/utils/jsonutil"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -24,8 +22,7 @@ import (
 )
 
 func NewMsgMongo(db *mongo.Database) (database.Msg, error) {
-	coll := db.Collection(new(model.MsgDocModel).TableName())
-	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+	coll, err := newCollection(db, new(model.MsgDocModel).TableName(), mongo.IndexModel{
 		Keys: bson.D{
 			{Key: "doc_id", Value: 1},
 		},
@@ -38,18 +35,18 @@ func NewMsgMongo(db *mongo.Database) (database.Msg, error) {
 }
 
 type MsgMgo struct {
-	coll  *mongo.Collection
+	coll  *collection
 	model model.MsgDocModel
 }
 
 func (m *MsgMgo) PushMsgsToDoc(ctx context.Context, docID string, msgsToMongo []model.MsgInfoModel) error {
 	filter := bson.M{"doc_id": docID}
 	update := bson.M{"$push": bson.M{"msgs": bson.M{"$each": msgsToMongo}}}
-	return mongoutil.UpdateOne(ctx, m.coll, filter, update, false)
+	return mongoutil.UpdateOne(ctx, m.coll.get(ctx), filter, update, false)
 }
 
 func (m *MsgMgo) Create(ctx context.Context, msg *model.MsgDocModel) error {
-	return mongoutil.InsertMany(ctx, m.coll, []*model.MsgDocModel{msg})
+	return mongoutil.InsertMany(ctx, m.coll.get(ctx), []*model.MsgDocModel{msg})
 }
 
 func (m *MsgMgo) UpdateMsg(ctx context.Context, docID string, index int64, key string, value any) (*mongo.UpdateResult, error) {
@@ -61,7 +58,7 @@ func (m *MsgMgo) UpdateMsg(ctx context.Context, docID string, index int64, key s
 	}
 	filter := bson.M{"doc_id": docID}
 	update := bson.M{"$set": bson.M{field: value}}
-	return mongoutil.UpdateOneResult(ctx, m.coll, filter, update)
+	return mongoutil.UpdateOneResult(ctx, m.coll.get(ctx), filter, update)
 }
 
 func (m *MsgMgo) PushUnique(ctx context.Context, docID string, index int64, key string, value any) (*mongo.UpdateResult, error) {
@@ -77,21 +74,21 @@ func (m *MsgMgo) PushUnique(ctx context.Context, docID string, index int64, key
 			field: bson.M{"$each": value},
 		},
 	}
-	return mongoutil.UpdateOneResult(ctx, m.coll, filter, update)
+	return mongoutil.UpdateOneResult(ctx, m.coll.get(ctx), filter, update)
 }
 
 func (m *MsgMgo) UpdateMsgContent(ctx context.Context, docID string, index int64, msg []byte) error {
 	filter := bson.M{"doc_id": docID}
 	update := bson.M{"$set": bson.M{fmt.Sprintf("msgs.%d.msg", index): msg}}
-	return mongoutil.UpdateOne(ctx, m.coll, filter, update, false)
+	return mongoutil.UpdateOne(ctx, m.coll.get(ctx), filter, update, false)
 }
 
 func (m *MsgMgo) IsExistDocID(ctx context.Context, docID string) (bool, error) {
-	return mongoutil.Exist(ctx, m.coll, bson.M{"doc_id": docID})
+	return mongoutil.Exist(ctx, m.coll.get(ctx), bson.M{"doc_id": docID})
 }
 
 func (m *MsgMgo) FindOneByDocID(ctx context.Context, docID string) (*model.MsgDocModel, error) {
-	return mongoutil.FindOne[*model.MsgDocModel](ctx, m.coll, bson.M{"doc_id": docID})
+	return mongoutil.FindOne[*model.MsgDocModel](ctx, m.coll.get(ctx), bson.M{"doc_id": docID})
 }
 
 func (m *MsgMgo) GetMsgBySeqIndexIn1Doc(ctx context.Context, userID, docID string, seqs []int64) ([]*model.MsgInfoModel, error) {
@@ -99,22 +96,7 @@ func (m *MsgMgo) GetMsgBySeqIndexIn1Doc(ctx context.Context, userID, docID strin
 	if err != nil {
 		return nil, err
 	}
-	if len(msgs) == len(seqs) {
-		return msgs, nil
-	}
-	tmp := make(map[int64]*model.MsgInfoModel)
-	for i, val := range msgs {
-		tmp[val.Msg.Seq] = msgs[i]
-	}
-	res := make([]*model.MsgInfoModel, 0, len(seqs))
-	for _, seq := range seqs {
-		if val, ok := tmp[seq]; ok {
-			res = append(res, val)
-		} else {
-			res = append(res, &model.MsgInfoModel{Msg: &model.MsgDataModel{Seq: seq}})
-		}
-	}
-	return res, nil
+	return model.FillMissingSeqs(seqs, msgs), nil
 }
 
 func (m *MsgMgo) getMsgBySeqIndexIn1Doc(ctx context.Context, userID, docID string, seqs []int64) ([]*model.MsgInfoModel, error) {
@@ -140,52 +122,16 @@ func (m *MsgMgo) getMsgBySeqIndexIn1Doc(ctx context.Context, userID, docID strin
 			}},
 		}}},
 	}
-	msgDocModel, err := mongoutil.Aggregate[*model.MsgDocModel](ctx, m.coll, pipeline)
+	msgDocModel, err := mongoutil.Aggregate[*model.MsgDocModel](ctx, m.coll.get(ctx), pipeline)
 	if err != nil {
 		return nil, err
 	}
 	if len(msgDocModel) == 0 {
 		return nil, errs.Wrap(mongo.ErrNoDocuments)
 	}
-	msgs := make([]*model.MsgInfoModel, 0, len(msgDocModel[0].Msg))
-	for i := range msgDocModel[0].Msg {
-		msg := msgDocModel[0].Msg[i]
-		if msg == nil || msg.Msg == nil {
-			continue
-		}
-		if datautil.Contain(userID, msg.DelList...) {
-			msg.Msg.Content = ""
-			msg.Msg.Status = constant.MsgDeleted
-		}
-		if msg.Revoke != nil {
-			revokeContent := sdkws.MessageRevokedContent{
-				RevokerID:                   msg.Revoke.UserID,
-				RevokerRole:                 msg.Revoke.Role,
-				ClientMsgID:                 msg.Msg.ClientMsgID,
-				RevokerNickname:             msg.Revoke.Nickname,
-				RevokeTime:                  msg.Revoke.Time,
-				SourceMessageSendTime:       msg.Msg.SendTime,
-				SourceMessageSendID:         msg.Msg.SendID,
-				SourceMessageSenderNickname: msg.Msg.SenderNickname,
-				SessionType:                 msg.Msg.SessionType,
-				Seq:                         msg.Msg.Seq,
-				Ex:                          msg.Msg.Ex,
-			}
-			data, err := jsonutil.JsonMarshal(&revokeContent)
-			if err != nil {
-				return nil, errs.WrapMsg(err, fmt.Sprintf("docID is %s, seqs is %v", docID, seqs))
-			}
-			elem := sdkws.NotificationElem{
-				Detail: string(data),
-			}
-			content, err := jsonutil.JsonMarshal(&elem)
-			if err != nil {
-				return nil, errs.WrapMsg(err, fmt.Sprintf("docID is %s, seqs is %v", docID, seqs))
-			}
-			msg.Msg.ContentType = constant.MsgRevokeNotification
-			msg.Msg.Content = string(content)
-		}
-		msgs = append(msgs, msg)
+	msgs, err := model.UserViewMsgs(userID, msgDocModel[0].Msg)
+	if err != nil {
+		return nil, errs.WrapMsg(err, fmt.Sprintf("docID is %s, seqs is %v", docID, seqs))
 	}
 	return msgs, nil
 }
@@ -218,11 +164,17 @@ func (m *MsgMgo) GetOldestMsg(ctx context.Context, conversationID string) (*mode
 	}
 }
 
+func (m *MsgMgo) FindArchivable(ctx context.Context, ts int64, limit int) ([]*model.MsgDocModel, error) {
+	// Only full documents are archived, so the last slot is always set and the newest.
+	lastSendTime := fmt.Sprintf("msgs.%d.msg.send_time", m.model.GetSingleGocMsgNum()-1)
+	return mongoutil.Find[*model.MsgDocModel](ctx, m.coll.get(ctx), bson.M{lastSendTime: bson.M{"$lt": ts}}, options.Find().SetLimit(int64(limit)))
+}
+
 func (m *MsgMgo) DeleteDocs(ctx context.Context, docIDs []string) error {
 	if len(docIDs) == 0 {
 		return nil
 	}
-	return mongoutil.DeleteMany(ctx, m.coll, bson.M{"doc_id": bson.M{"$in": docIDs}})
+	return mongoutil.DeleteMany(ctx, m.coll.get(ctx), bson.M{"doc_id": bson.M{"$in": docIDs}})
 }
 
 func (m *MsgMgo) GetMsgDocModelByIndex(ctx context.Context, conversationID string, index, sort int64) (*model.MsgDocModel, error) {
@@ -231,7 +183,7 @@ func (m *MsgMgo) GetMsgDocModelByIndex(ctx context.Context, conversationID strin
 	}
 	opt := options.Find().SetSkip(index).SetSort(bson.M{"_id": sort}).SetLimit(1)
 	filter := bson.M{"doc_id": primitive.Regex{Pattern: fmt.Sprintf("^%s:", conversationID)}}
-	msgs, err := mongoutil.Find[*model.MsgDocModel](ctx, m.coll, filter, opt)
+	msgs, err := mongoutil.Find[*model.MsgDocModel](ctx, m.coll.get(ctx), filter, opt)
 	if err != nil {
 		return nil, err
 	}
@@ -250,7 +202,7 @@ func (m *MsgMgo) DeleteMsgsInOneDocByIndex(ctx context.Context, docID string, in
 			"msg": nil,
 		}
 	}
-	_, err := mongoutil.UpdateMany(ctx, m.coll, bson.M{"doc_id": docID}, update)
+	_, err := mongoutil.UpdateMany(ctx, m.coll.get(ctx), bson.M{"doc_id": docID}, update)
 	return err
 }
 
@@ -273,7 +225,7 @@ func (m *MsgMgo) MarkSingleChatMsgsAsRead(ctx context.Context, userID string, do
 			SetUpdate(update)
 		updates = append(updates, updateModel)
 	}
-	if _, err := m.coll.BulkWrite(ctx, updates); err != nil {
+	if _, err := m.coll.get(ctx).BulkWrite(ctx, updates); err != nil {
 		return errs.WrapMsg(err, fmt.Sprintf("docID is %s, indexes is %v", docID, indexes))
 	}
 	return nil
@@ -317,7 +269,7 @@ func (m *MsgMgo) MarkSingleChatMsgsAsRead(ctx context.Context, userID string, do
 //			ID    primitive.ObjectID `bson:"_id"`
 //			Count int64              `bson:"count"`
 //		}
-//		res, err := mongoutil.Aggregate[result](ctx, m.coll, pipeline)
+//		res, err := mongoutil.Aggregate[result](ctx, m.coll.get(ctx), pipeline)
 //		if err != nil {
 //			return 0, nil, primitive.ObjectID{}, err
 //		}
@@ -334,7 +286,7 @@ func (m *MsgMgo) MarkSingleChatMsgsAsRead(ctx context.Context, userID string, do
 //		ID  primitive.ObjectID    `bson:"_id"`
 //		Msg []*model.MsgInfoModel `bson:"msgs"`
 //	}
-//	res, err := mongoutil.Aggregate[result](ctx, m.coll, pipeline)
+//	res, err := mongoutil.Aggregate[result](ctx, m.coll.get(ctx), pipeline)
 //	if err != nil {
 //		return 0, nil, primitive.ObjectID{}, err
 //	}
@@ -432,7 +384,7 @@ func (m *MsgMgo) searchMessageIndex(ctx context.Context, filter any, nextID prim
 		},
 		bson.M{"$sort": bson.M{"_id": 1}},
 	)
-	return mongoutil.Aggregate[searchMessageIndex](ctx, m.coll, pipeline)
+	return mongoutil.Aggregate[searchMessageIndex](ctx, m.coll.get(ctx), pipeline)
 }
 
 func (m *MsgMgo) searchMessage(ctx context.Context, req *msg.SearchMessageReq) (int64, []searchMessageIndex, error) {
@@ -521,7 +473,7 @@ func (m *MsgMgo) getDocRange(ctx context.Context, id primitive.ObjectID, index [
 		bson.M{"$match": bson.M{"_id": id}},
 		bson.M{"$project": "$msgs"},
 	}
-	msgs, err := mongoutil.Aggregate[*model.MsgInfoModel](ctx, m.coll, pipeline)
+	msgs, err := mongoutil.Aggregate[*model.MsgInfoModel](ctx, m.coll.get(ctx), pipeline)
 	if err != nil {
 		return nil, err
 	}
@@ -542,7 +494,7 @@ func (m *MsgMgo) SearchMessage(ctx context.Context, req *msg.SearchMessageReq) (
 		msgs = make([]*model.MsgInfoModel, 0, n)
 	}
 	for _, val := range data {
-		res, err := mongoutil.FindOne[*model.MsgDocModel](ctx, m.coll, bson.M{"_id": val.ID})
+		res, err := mongoutil.FindOne[*model.MsgDocModel](ctx, m.coll.get(ctx), bson.M{"_id": val.ID})
 		if err != nil {
 			return 0, nil, err
 		}
@@ -556,6 +508,20 @@ func (m *MsgMgo) SearchMessage(ctx context.Context, req *msg.SearchMessageReq) (
 	return count, msgs, nil
 }
 
+func (m *MsgMgo) FindMsgsBySender(ctx context.Context, docIDs []string, sendID string) ([]*model.MsgInfoModel, error) {
+	if len(docIDs) == 0 {
+		return nil, nil
+	}
+	pipeline := bson.A{
+		bson.M{"$match": bson.M{"doc_id": bson.M{"$in": docIDs}}},
+		bson.M{"$unwind": "$msgs"},
+		bson.M{"$match": bson.M{"msgs.msg.send_id": sendID}},
+		bson.M{"$replaceRoot": bson.M{"newRoot": "$msgs"}},
+		bson.M{"$sort": bson.M{"msg.seq": 1}},
+	}
+	return mongoutil.Aggregate[*model.MsgInfoModel](ctx, m.coll.get(ctx), pipeline)
+}
+
 //func (m *MsgMgo) SearchMessage(ctx context.Context, req *msg.SearchMessageReq) (int32, []*model.MsgInfoModel, error) {
 //	where := make(bson.A, 0, 6)
 //	if req.RecvID != "" {
@@ -598,7 +564,7 @@ func (m *MsgMgo) SearchMessage(ctx context.Context, req *msg.SearchMessageReq) (
 //		)
 //	}
 //	opt := options.Find().SetLimit(100)
-//	res, err := mongoutil.Find[model.MsgDocModel](ctx, m.coll, bson.M{"$and": where}, opt)
+//	res, err := mongoutil.Find[model.MsgDocModel](ctx, m.coll.get(ctx), bson.M{"$and": where}, opt)
 //	if err != nil {
 //		return 0, nil, err
 //	}
@@ -627,7 +593,7 @@ func (m *MsgMgo) SearchMessage(ctx context.Context, req *msg.SearchMessageReq) (
 //			"$count": "count",
 //		},
 //	)
-//	//count, err := mongoutil.Aggregate[int32](ctx, m.coll, pipeline)
+//	//count, err := mongoutil.Aggregate[int32](ctx, m.coll.get(ctx), pipeline)
 //	//if err != nil {
 //	//	return 0, nil, err
 //	//}
@@ -644,7 +610,7 @@ func (m *MsgMgo) SearchMessage(ctx context.Context, req *msg.SearchMessageReq) (
 //			"$limit": req.Pagination.GetShowNumber(),
 //		},
 //	)
-//	msgs, err := mongoutil.Aggregate[*model.MsgInfoModel](ctx, m.coll, pipeline)
+//	msgs, err := mongoutil.Aggregate[*model.MsgInfoModel](ctx, m.coll.get(ctx), pipeline)
 //	if err != nil {
 //		return 0, nil, err
 //	}
@@ -920,7 +886,7 @@ func (m *MsgMgo) RangeUserSendCount(ctx context.Context, start time.Time, end ti
 			},
 		},
 	}
-	result, err := mongoutil.Aggregate[*Result](ctx, m.coll, pipeline, options.Aggregate().SetAllowDiskUse(true))
+	result, err := mongoutil.Aggregate[*Result](ctx, m.coll.get(ctx), pipeline, options.Aggregate().SetAllowDiskUse(true))
 	if err != nil {
 		return 0, 0, nil, nil, err
 	}
@@ -1157,7 +1123,7 @@ func (m *MsgMgo) RangeGroupSendCount(ctx context.Context, start time.Time, end t
 			},
 		},
 	}
-	result, err := mongoutil.Aggregate[*Result](ctx, m.coll, pipeline, options.Aggregate().SetAllowDiskUse(true))
+	result, err := mongoutil.Aggregate[*Result](ctx, m.coll.get(ctx), pipeline, options.Aggregate().SetAllowDiskUse(true))
 	if err != nil {
 		return 0, 0, nil, nil, err
 	}
@@ -1181,7 +1147,7 @@ func (m *MsgMgo) RangeGroupSendCount(ctx context.Context, start time.Time, end t
 func (m *MsgMgo) ConvertMsgsDocLen(ctx context.Context, conversationIDs []string) {
 	for _, conversationID := range conversationIDs {
 		regex := primitive.Regex{Pattern: fmt.Sprintf("^%s:", conversationID)}
-		msgDocs, err := mongoutil.Find[*model.MsgDocModel](ctx, m.coll, bson.M{"doc_id": regex})
+		msgDocs, err := mongoutil.Find[*model.MsgDocModel](ctx, m.coll.get(ctx), bson.M{"doc_id": regex})
 		if err != nil {
 			log.ZError(ctx, "convertAll find msg doc failed", err, "conversationID", conversationID)
 			continue
@@ -1191,7 +1157,7 @@ func (m *MsgMgo) ConvertMsgsDocLen(ctx context.Context, conversationIDs []string
 		}
 		log.ZDebug(ctx, "msg doc convert", "conversationID", conversationID, "len(msgDocs)", len(msgDocs))
 		if len(msgDocs[0].Msg) == int(m.model.GetSingleGocMsgNum5000()) {
-			if err := mongoutil.DeleteMany(ctx, m.coll, bson.M{"doc_id": regex}); err != nil {
+			if err := mongoutil.DeleteMany(ctx, m.coll.get(ctx), bson.M{"doc_id": regex}); err != nil {
 				log.ZError(ctx, "convertAll delete many failed", err, "conversationID", conversationID)
 				continue
 			}
@@ -1218,7 +1184,7 @@ func (m *MsgMgo) ConvertMsgsDocLen(ctx context.Context, conversationIDs []string
 					}
 				}
 			}
-			if err = mongoutil.InsertMany(ctx, m.coll, newMsgDocs); err != nil {
+			if err = mongoutil.InsertMany(ctx, m.coll.get(ctx), newMsgDocs); err != nil {
 				log.ZError(ctx, "convertAll insert many failed", err, "conversationID", conversationID, "len(newMsgDocs)", len(newMsgDocs))
 			} else {
 				log.ZDebug(ctx, "msg doc convert", "conversationID", conversationID, "len(newMsgDocs)", len(newMsgDocs))
@@ -1233,7 +1199,7 @@ func (m *MsgMgo) GetDocIDs(ctx context.Context) ([]string, error) {
 	var docIDs []string
 	var offset int
 
-	count, err := m.coll.CountDocuments(ctx, bson.M{})
+	count, err := m.coll.get(ctx).CountDocuments(ctx, bson.M{})
 	if err != nil {
 		return nil, err
 	}
@@ -1246,7 +1212,7 @@ func (m *MsgMgo) GetDocIDs(ctx context.Context) ([]string, error) {
 		offset = skip * limit
 	}
 	log.ZDebug(ctx, "offset", "skip", skip, "offset", offset)
-	res, err := mongoutil.Aggregate[*model.MsgDocModel](ctx, m.coll, []bson.M{
+	res, err := mongoutil.Aggregate[*model.MsgDocModel](ctx, m.coll.get(ctx), []bson.M{
 		{
 			"$project": bson.M{
 				"doc_id": 1,
@@ -1268,7 +1234,7 @@ func (m *MsgMgo) GetDocIDs(ctx context.Context) ([]string, error) {
 }
 
 func (m *MsgMgo) GetBeforeMsg(ctx context.Context, ts int64, docIDs []string, limit int) ([]*model.MsgDocModel, error) {
-	return mongoutil.Aggregate[*model.MsgDocModel](ctx, m.coll, []bson.M{
+	return mongoutil.Aggregate[*model.MsgDocModel](ctx, m.coll.get(ctx), []bson.M{
 		{
 			"$match": bson.M{
 				"doc_id": bson.M{
@@ -1302,7 +1268,7 @@ func (m *MsgMgo) DeleteMsgByIndex(ctx context.Context, docID string, index []int
 	for i := range index {
 		set[fmt.Sprintf("msgs.%d", i)] = model
 	}
-	return mongoutil.UpdateOne(ctx, m.coll, bson.M{"doc_id": docID}, bson.M{"$set": set}, true)
+	return mongoutil.UpdateOne(ctx, m.coll.get(ctx), bson.M{"doc_id": docID}, bson.M{"$set": set}, true)
 }
 
 //func (m *MsgMgo) ClearMsg(ctx context.Context, t time.Time) (int64, error) {
@@ -1327,7 +1293,32 @@ func (m *MsgMgo) DeleteMsgByIndex(ctx context.Context, docID string, index []int
 //}
 
 func (m *MsgMgo) DeleteDoc(ctx context.Context, docID string) error {
-	return mongoutil.DeleteOne(ctx, m.coll, bson.M{"doc_id": docID})
+	return mongoutil.DeleteOne(ctx, m.coll.get(ctx), bson.M{"doc_id": docID})
+}
+
+func (m *MsgMgo) DeleteDocIfUnchanged(ctx context.Context, doc *model.MsgDocModel) (bool, error) {
+	coll := m.coll.get(ctx)
+	raw, err := coll.FindOne(ctx, bson.M{"doc_id": doc.DocID}, options.FindOne().SetProjection(bson.M{"_id": 0, "msgs": 1})).Raw()
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return false, nil
+		}
+		return false, errs.Wrap(err)
+	}
+	var current model.MsgDocModel
+	if err := bson.Unmarshal(raw, &current); err != nil {
+		return false, errs.Wrap(err)
+	}
+	if !reflect.DeepEqual(current.Msg, doc.Msg) {
+		return false, nil
+	}
+	// The filter matches the stored array byte for byte, so any write since the read
+	// makes the delete miss.
+	res, err := coll.DeleteOne(ctx, bson.M{"doc_id": doc.DocID, "msgs": raw.Lookup("msgs")})
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+	return res.DeletedCount > 0, nil
 }
 
 //func (m *MsgMgo) DeleteDocMsg(ctx context.Context, ts int64, doc *relation.MsgDocModel) (int64, error) {
@@ -0,0 +1,70 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewMsgArchiveMongo(db *mongo.Database) (database.MsgArchive, error) {
+	coll, err := newCollection(db, database.MsgArchiveName,
+		mongo.IndexModel{
+			Keys: bson.D{
+				{Key: "doc_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		mongo.IndexModel{
+			Keys: bson.D{
+				{Key: "max_send_time", Value: 1},
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &msgArchiveMongo{coll: coll}, nil
+}
+
+type msgArchiveMongo struct {
+	coll *collection
+}
+
+func (m *msgArchiveMongo) Upsert(ctx context.Context, archive *model.MsgArchiveModel) error {
+	return mongoutil.UpdateOne(ctx, m.coll.get(ctx), bson.M{"doc_id": archive.DocID}, bson.M{"$set": archive}, false, options.Update().SetUpsert(true))
+}
+
+func (m *msgArchiveMongo) Take(ctx context.Context, docID string) (*model.MsgArchiveModel, error) {
+	return mongoutil.FindOne[*model.MsgArchiveModel](ctx, m.coll.get(ctx), bson.M{"doc_id": docID})
+}
+
+func (m *msgArchiveMongo) FindBefore(ctx context.Context, ts int64, limit int) ([]*model.MsgArchiveModel, error) {
+	return mongoutil.Find[*model.MsgArchiveModel](ctx, m.coll.get(ctx), bson.M{"max_send_time": bson.M{"$lt": ts}},
+		options.Find().SetSort(bson.M{"max_send_time": 1}).SetLimit(int64(limit)))
+}
+
+func (m *msgArchiveMongo) Delete(ctx context.Context, docIDs []string) error {
+	if len(docIDs) == 0 {
+		return nil
+	}
+	return mongoutil.DeleteMany(ctx, m.coll.get(ctx), bson.M{"doc_id": bson.M{"$in": docIDs}})
+}
@@ -30,13 +30,19 @@ import (
 )
 
 func NewS3Mongo(db *mongo.Database) (database.ObjectInfo, error) {
-	coll := db.Collection(database.ObjectName)
-	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
-		Keys: bson.D{
-			{Key: "name", Value: 1},
+	coll, err := newCollection(db, database.ObjectName,
+		mongo.IndexModel{
+			Keys: bson.D{
+				{Key: "name", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
 		},
-		Options: options.Index().SetUnique(true),
-	})
+		mongo.IndexModel{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+			},
+		},
+	)
 	if err != nil {
 		return nil, errs.Wrap(err)
 	}
@@ -44,13 +50,14 @@ func NewS3Mongo(db *mongo.Database) (database.ObjectInfo, error) {
 }
 
 type S3Mongo struct {
-	coll *mongo.Collection
+	coll *collection
 }
 
 func (o *S3Mongo) SetObject(ctx context.Context, obj *model.Object) error {
 	filter := bson.M{"name": obj.Name, "engine": obj.Engine}
 	update := bson.M{
 		"name":         obj.Name,
+		"user_id":      obj.UserID,
 		"engine":       obj.Engine,
 		"key":          obj.Key,
 		"size":         obj.Size,
@@ -58,27 +65,31 @@ func (o *S3Mongo) SetObject(ctx context.Context, obj *model.Object) error {
 		"group":        obj.Group,
 		"create_time":  obj.CreateTime,
 	}
-	return mongoutil.UpdateOne(ctx, o.coll, filter, bson.M{"$set": update}, false, options.Update().SetUpsert(true))
+	return mongoutil.UpdateOne(ctx, o.coll.get(ctx), filter, bson.M{"$set": update}, false, options.Update().SetUpsert(true))
 }
 
 func (o *S3Mongo) Take(ctx context.Context, engine string, name string) (*model.Object, error) {
 	if engine == "" {
-		return mongoutil.FindOne[*model.Object](ctx, o.coll, bson.M{"name": name})
+		return mongoutil.FindOne[*model.Object](ctx, o.coll.get(ctx), bson.M{"name": name})
 	}
-	return mongoutil.FindOne[*model.Object](ctx, o.coll, bson.M{"name": name, "engine": engine})
+	return mongoutil.FindOne[*model.Object](ctx, o.coll.get(ctx), bson.M{"name": name, "engine": engine})
 }
 
 func (o *S3Mongo) Delete(ctx context.Context, engine string, name string) error {
-	return mongoutil.DeleteOne(ctx, o.coll, bson.M{"name": name, "engine": engine})
+	return mongoutil.DeleteOne(ctx, o.coll.get(ctx), bson.M{"name": name, "engine": engine})
 }
 func (o *S3Mongo) FindByExpires(ctx context.Context, duration time.Time, pagination pagination.Pagination) (total int64, objects []*model.Object, err error) {
-	return mongoutil.FindPage[*model.Object](ctx, o.coll, bson.M{
+	return mongoutil.FindPage[*model.Object](ctx, o.coll.get(ctx), bson.M{
 		"create_time": bson.M{"$lt": duration},
 	}, pagination)
 }
 func (o *S3Mongo) FindNotDelByS3(ctx context.Context, key string, duration time.Time) (int64, error) {
-	return mongoutil.Count(ctx, o.coll, bson.M{
+	return mongoutil.Count(ctx, o.coll.get(ctx), bson.M{
 		"key":         key,
 		"create_time": bson.M{"$gt": duration},
 	})
 }
+
+func (o *S3Mongo) FindByUser(ctx context.Context, userID string, pagination pagination.Pagination) (total int64, objects []*model.Object, err error) {
+	return mongoutil.FindPage[*model.Object](ctx, o.coll.get(ctx), bson.M{"user_id": userID}, pagination)
+}
@@ -12,8 +12,7 @@ import (
 )
 
 func NewSeqConversationMongo(db *mongo.Database) (database.SeqConversation, error) {
-	coll := db.Collection(database.SeqConversationName)
-	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+	coll, err := newCollection(db, database.SeqConversationName, mongo.IndexModel{
 		Keys: bson.D{
 			{Key: "conversation_id", Value: 1},
 		},
@@ -25,7 +24,7 @@ func NewSeqConversationMongo(db *mongo.Database) (database.SeqConversation, erro
 }
 
 type seqConversationMongo struct {
-	coll *mongo.Collection
+	coll *collection
 }
 
 func (s *seqConversationMongo) setSeq(ctx context.Context, conversationID string, seq int64, field string) error {
@@ -45,7 +44,7 @@ func (s *seqConversationMongo) setSeq(ctx context.Context, conversationID string
 		"$setOnInsert": insert,
 	}
 	opt := options.Update().SetUpsert(true)
-	return mongoutil.UpdateOne(ctx, s.coll, filter, update, false, opt)
+	return mongoutil.UpdateOne(ctx, s.coll.get(ctx), filter, update, false, opt)
 }
 
 func (s *seqConversationMongo) Malloc(ctx context.Context, conversationID string, size int64) (int64, error) {
@@ -61,7 +60,7 @@ func (s *seqConversationMongo) Malloc(ctx context.Context, conversationID string
 		"$set": map[string]any{"min_seq": int64(0)},
 	}
 	opt := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After).SetProjection(map[string]any{"_id": 0, "max_seq": 1})
-	lastSeq, err := mongoutil.FindOneAndUpdate[int64](ctx, s.coll, filter, update, opt)
+	lastSeq, err := mongoutil.FindOneAndUpdate[int64](ctx, s.coll.get(ctx), filter, update, opt)
 	if err != nil {
 		return 0, err
 	}
@@ -73,7 +72,7 @@ func (s *seqConversationMongo) SetMaxSeq(ctx context.Context, conversationID str
 }
 
 func (s *seqConversationMongo) GetMaxSeq(ctx context.Context, conversationID string) (int64, error) {
-	seq, err := mongoutil.FindOne[int64](ctx, s.coll, bson.M{"conversation_id": conversationID}, options.FindOne().SetProjection(map[string]any{"_id": 0, "max_seq": 1}))
+	seq, err := mongoutil.FindOne[int64](ctx, s.coll.get(ctx), bson.M{"conversation_id": conversationID}, options.FindOne().SetProjection(map[string]any{"_id": 0, "max_seq": 1}))
 	if err == nil {
 		return seq, nil
 	} else if IsNotFound(err) {
@@ -84,7 +83,7 @@ func (s *seqConversationMongo) GetMaxSeq(ctx context.Context, conversationID str
 }
 
 func (s *seqConversationMongo) GetMinSeq(ctx context.Context, conversationID string) (int64, error) {
-	seq, err := mongoutil.FindOne[int64](ctx, s.coll, bson.M{"conversation_id": conversationID}, options.FindOne().SetProjection(map[string]any{"_id": 0, "min_seq": 1}))
+	seq, err := mongoutil.FindOne[int64](ctx, s.coll.get(ctx), bson.M{"conversation_id": conversationID}, options.FindOne().SetProjection(map[string]any{"_id": 0, "min_seq": 1}))
 	if err == nil {
 		return seq, nil
 	} else if IsNotFound(err) {
@@ -99,5 +98,25 @@ func (s *seqConversationMongo) SetMinSeq(ctx context.Context, conversationID str
 }
 
 func (s *seqConversationMongo) GetConversation(ctx context.Context, conversationID string) (*model.SeqConversation, error) {
-	return mongoutil.FindOne[*model.SeqConversation](ctx, s.coll, bson.M{"conversation_id": conversationID})
+	return mongoutil.FindOne[*model.SeqConversation](ctx, s.coll.get(ctx), bson.M{"conversation_id": conversationID})
+}
+
+func (s *seqConversationMongo) RaiseMaxSeq(ctx context.Context, conversationID string, seq int64) error {
+	filter := map[string]any{
+		"conversation_id": conversationID,
+	}
+	update := map[string]any{
+		"$max":         bson.M{"max_seq": seq},
+		"$setOnInsert": bson.M{"conversation_id": conversationID, "min_seq": 0},
+	}
+	return mongoutil.UpdateOne(ctx, s.coll.get(ctx), filter, update, false, options.Update().SetUpsert(true))
+}
+
+func (s *seqConversationMongo) FindSeqConversations(ctx context.Context, after string, limit int) ([]*model.SeqConversation, error) {
+	filter := bson.M{}
+	if after != "" {
+		filter["conversation_id"] = bson.M{"$gt": after}
+	}
+	opt := options.Find().SetSort(bson.M{"conversation_id": 1}).SetLimit(int64(limit)).SetProjection(bson.M{"_id": 0})
+	return mongoutil.Find[*model.SeqConversation](ctx, s.coll.get(ctx), filter, opt)
 }
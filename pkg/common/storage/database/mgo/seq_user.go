@@ -12,12 +12,15 @@ import (
 )
 
 func NewSeqUserMongo(db *mongo.Database) (database.SeqUser, error) {
-	coll := db.Collection(database.SeqUserName)
-	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+	coll, err := newCollection(db, database.SeqUserName, mongo.IndexModel{
 		Keys: bson.D{
 			{Key: "user_id", Value: 1},
 			{Key: "conversation_id", Value: 1},
 		},
+	}, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "conversation_id", Value: 1},
+		},
 	})
 	if err != nil {
 		return nil, err
@@ -26,7 +29,7 @@ func NewSeqUserMongo(db *mongo.Database) (database.SeqUser, error) {
 }
 
 type seqUserMongo struct {
-	coll *mongo.Collection
+	coll *collection
 }
 
 func (s *seqUserMongo) setSeq(ctx context.Context, conversationID string, userID string, seq int64, field string) error {
@@ -49,7 +52,7 @@ func (s *seqUserMongo) setSeq(ctx context.Context, conversationID string, userID
 		"$setOnInsert": insert,
 	}
 	opt := options.Update().SetUpsert(true)
-	return mongoutil.UpdateOne(ctx, s.coll, filter, update, false, opt)
+	return mongoutil.UpdateOne(ctx, s.coll.get(ctx), filter, update, false, opt)
 }
 
 func (s *seqUserMongo) getSeq(ctx context.Context, conversationID string, userID string, failed string) (int64, error) {
@@ -58,7 +61,7 @@ func (s *seqUserMongo) getSeq(ctx context.Context, conversationID string, userID
 		"conversation_id": conversationID,
 	}
 	opt := options.FindOne().SetProjection(bson.M{"_id": 0, failed: 1})
-	seq, err := mongoutil.FindOne[int64](ctx, s.coll, filter, opt)
+	seq, err := mongoutil.FindOne[int64](ctx, s.coll.get(ctx), filter, opt)
 	if err == nil {
 		return seq, nil
 	} else if errors.Is(err, mongo.ErrNoDocuments) {
@@ -102,7 +105,7 @@ func (s *seqUserMongo) GetUserReadSeqs(ctx context.Context, userID string, conve
 	}
 	filter := bson.M{"user_id": userID, "conversation_id": bson.M{"$in": conversationID}}
 	opt := options.Find().SetProjection(bson.M{"_id": 0, "conversation_id": 1, "read_seq": 1})
-	seqs, err := mongoutil.Find[*model.SeqUser](ctx, s.coll, filter, opt)
+	seqs, err := mongoutil.Find[*model.SeqUser](ctx, s.coll.get(ctx), filter, opt)
 	if err != nil {
 		return nil, err
 	}
@@ -117,3 +120,42 @@ func (s *seqUserMongo) GetUserReadSeqs(ctx context.Context, userID string, conve
 func (s *seqUserMongo) SetUserReadSeq(ctx context.Context, conversationID string, userID string, seq int64) error {
 	return s.setSeq(ctx, conversationID, userID, seq, "read_seq")
 }
+
+func (s *seqUserMongo) getUsersSeq(ctx context.Context, conversationID string, userIDs []string, field string) (map[string]int64, error) {
+	res := make(map[string]int64, len(userIDs))
+	if len(userIDs) == 0 {
+		return res, nil
+	}
+	filter := bson.M{"conversation_id": conversationID, "user_id": bson.M{"$in": userIDs}}
+	opt := options.Find().SetProjection(bson.M{"_id": 0, "user_id": 1, field: 1})
+	seqs, err := mongoutil.Find[*model.SeqUser](ctx, s.coll.get(ctx), filter, opt)
+	if err != nil {
+		return nil, err
+	}
+	for _, seq := range seqs {
+		switch field {
+		case "read_seq":
+			res[seq.UserID] = seq.ReadSeq
+		case "min_seq":
+			res[seq.UserID] = seq.MinSeq
+		}
+	}
+	s.notFoundSet0(res, userIDs)
+	return res, nil
+}
+
+func (s *seqUserMongo) GetUsersReadSeq(ctx context.Context, conversationID string, userIDs []string) (map[string]int64, error) {
+	return s.getUsersSeq(ctx, conversationID, userIDs, "read_seq")
+}
+
+func (s *seqUserMongo) GetUsersMinSeq(ctx context.Context, conversationID string, userIDs []string) (map[string]int64, error) {
+	return s.getUsersSeq(ctx, conversationID, userIDs, "min_seq")
+}
+
+func (s *seqUserMongo) DeleteUserSeqs(ctx context.Context, userID string) error {
+	return mongoutil.DeleteMany(ctx, s.coll.get(ctx), bson.M{"user_id": userID})
+}
+
+func (s *seqUserMongo) FindConversationSeqs(ctx context.Context, conversationID string) ([]*model.SeqUser, error) {
+	return mongoutil.Find[*model.SeqUser](ctx, s.coll.get(ctx), bson.M{"conversation_id": conversationID}, options.Find().SetProjection(bson.M{"_id": 0}))
+}
@@ -0,0 +1,69 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewTenantMongo(db *mongo.Database) (database.Tenant, error) {
+	coll := db.Collection(database.TenantName)
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "tenant_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &tenantMongo{coll: coll}, nil
+}
+
+// tenantMongo keeps the tenants in the collection of the default tenant.
+type tenantMongo struct {
+	coll *mongo.Collection
+}
+
+func (t *tenantMongo) Create(ctx context.Context, tenant *model.Tenant) error {
+	return mongoutil.InsertMany(ctx, t.coll, []*model.Tenant{tenant})
+}
+
+func (t *tenantMongo) Take(ctx context.Context, tenantID string) (*model.Tenant, error) {
+	return mongoutil.FindOne[*model.Tenant](ctx, t.coll, bson.M{"tenant_id": tenantID})
+}
+
+func (t *tenantMongo) Update(ctx context.Context, tenantID string, data map[string]any) error {
+	if len(data) == 0 {
+		return nil
+	}
+	return mongoutil.UpdateOne(ctx, t.coll, bson.M{"tenant_id": tenantID}, bson.M{"$set": data}, true)
+}
+
+func (t *tenantMongo) Delete(ctx context.Context, tenantID string) error {
+	return mongoutil.DeleteOne(ctx, t.coll, bson.M{"tenant_id": tenantID})
+}
+
+func (t *tenantMongo) Page(ctx context.Context, pagination pagination.Pagination) (int64, []*model.Tenant, error) {
+	return mongoutil.FindPage[*model.Tenant](ctx, t.coll, bson.M{}, pagination, options.Find().SetSort(bson.M{"create_time": 1}))
+}
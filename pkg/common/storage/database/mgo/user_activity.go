@@ -0,0 +1,70 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewUserActivityMongo(db *mongo.Database) (database.UserActivity, error) {
+	coll, err := newCollection(db, database.UserActivityName, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "user_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &userActivityMongo{coll: coll}, nil
+}
+
+type userActivityMongo struct {
+	coll *collection
+}
+
+func (u *userActivityMongo) Touch(ctx context.Context, userIDs []string, activeTime time.Time, since time.Time) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	writes := make([]mongo.WriteModel, 0, len(userIDs))
+	for _, userID := range userIDs {
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"user_id": userID, "active_time": bson.M{"$lt": since}}).
+			SetUpdate(bson.M{"$set": bson.M{"active_time": activeTime}}).
+			SetUpsert(true))
+	}
+	// Users active after since fail to upsert on the unique index, which keeps their time.
+	if _, err := u.coll.get(ctx).BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false)); err != nil && !mongo.IsDuplicateKeyError(err) {
+		return errs.Wrap(err)
+	}
+	return nil
+}
+
+func (u *userActivityMongo) Find(ctx context.Context, userIDs []string) ([]*model.UserActivity, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	return mongoutil.Find[*model.UserActivity](ctx, u.coll.get(ctx), bson.M{"user_id": bson.M{"$in": userIDs}})
+}
@@ -0,0 +1,81 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewUserJobMongo(db *mongo.Database) (database.UserJob, error) {
+	coll, err := newCollection(db, database.UserJobName,
+		mongo.IndexModel{
+			Keys: bson.D{
+				{Key: "job_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		mongo.IndexModel{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "type", Value: 1},
+				{Key: "create_time", Value: -1},
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &userJobMongo{coll: coll}, nil
+}
+
+type userJobMongo struct {
+	coll *collection
+}
+
+func (u *userJobMongo) Create(ctx context.Context, job *model.UserJob) error {
+	return mongoutil.InsertMany(ctx, u.coll.get(ctx), []*model.UserJob{job})
+}
+
+func (u *userJobMongo) Take(ctx context.Context, jobID string) (*model.UserJob, error) {
+	return mongoutil.FindOne[*model.UserJob](ctx, u.coll.get(ctx), bson.M{"job_id": jobID})
+}
+
+func (u *userJobMongo) TakeUnfinished(ctx context.Context, userID string, jobType string) (*model.UserJob, error) {
+	filter := bson.M{"user_id": userID, "type": jobType, "status": bson.M{"$ne": model.UserJobSucceeded}}
+	return mongoutil.FindOne[*model.UserJob](ctx, u.coll.get(ctx), filter, options.FindOne().SetSort(bson.M{"create_time": -1}))
+}
+
+func (u *userJobMongo) FindRunning(ctx context.Context, jobType string, limit int) ([]*model.UserJob, error) {
+	return mongoutil.Find[*model.UserJob](ctx, u.coll.get(ctx), bson.M{"type": jobType, "status": model.UserJobRunning},
+		options.Find().SetSort(bson.M{"create_time": 1}).SetLimit(int64(limit)))
+}
+
+func (u *userJobMongo) Save(ctx context.Context, job *model.UserJob) error {
+	update := bson.M{
+		"status":      job.Status,
+		"steps":       job.Steps,
+		"result":      job.Result,
+		"error":       job.Error,
+		"update_time": job.UpdateTime,
+	}
+	return mongoutil.UpdateOne(ctx, u.coll.get(ctx), bson.M{"job_id": job.JobID}, bson.M{"$set": update}, true)
+}
@@ -17,27 +17,21 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
-func NewVersionLog(coll *mongo.Collection) (database.VersionLog, error) {
-	lm := &VersionLogMgo{coll: coll}
-	if err := lm.initIndex(context.Background()); err != nil {
-		return nil, errs.WrapMsg(err, "init version log index failed", "coll", coll.Name())
-	}
-	return lm, nil
-}
-
-type VersionLogMgo struct {
-	coll *mongo.Collection
-}
-
-func (l *VersionLogMgo) initIndex(ctx context.Context) error {
-	_, err := l.coll.Indexes().CreateOne(ctx, mongo.IndexModel{
+func NewVersionLog(db *mongo.Database, name string) (database.VersionLog, error) {
+	coll, err := newCollection(db, name, mongo.IndexModel{
 		Keys: bson.M{
 			"d_id": 1,
 		},
 		Options: options.Index().SetUnique(true),
 	})
+	if err != nil {
+		return nil, errs.WrapMsg(err, "init version log index failed", "coll", name)
+	}
+	return &VersionLogMgo{coll: coll}, nil
+}
 
-	return err
+type VersionLogMgo struct {
+	coll *collection
 }
 
 func (l *VersionLogMgo) IncrVersion(ctx context.Context, dId string, eIds []string, state int32) error {
@@ -92,7 +86,7 @@ func (l *VersionLogMgo) initDoc(ctx context.Context, dId string, eIds []string,
 			LastUpdate: now,
 		})
 	}
-	if _, err := l.coll.InsertOne(ctx, &wl); err != nil {
+	if _, err := l.coll.get(ctx).InsertOne(ctx, &wl); err != nil {
 		return nil, err
 	}
 	return wl.VersionLog(), nil
@@ -159,7 +153,7 @@ func (l *VersionLogMgo) writeLogBatch2(ctx context.Context, dId string, eIds []s
 		"logs": 0,
 	}
 	opt := options.FindOneAndUpdate().SetUpsert(false).SetReturnDocument(options.After).SetProjection(projection)
-	res, err := mongoutil.FindOneAndUpdate[*model.VersionLog](ctx, l.coll, filter, pipeline, opt)
+	res, err := mongoutil.FindOneAndUpdate[*model.VersionLog](ctx, l.coll.get(ctx), filter, pipeline, opt)
 	if err != nil {
 		return nil, err
 	}
@@ -176,7 +170,7 @@ func (l *VersionLogMgo) writeLogBatch2(ctx context.Context, dId string, eIds []s
 }
 
 func (l *VersionLogMgo) findDoc(ctx context.Context, dId string) (*model.VersionLog, error) {
-	vl, err := mongoutil.FindOne[*model.VersionLogTable](ctx, l.coll, bson.M{"d_id": dId}, options.FindOne().SetProjection(bson.M{"logs": 0}))
+	vl, err := mongoutil.FindOne[*model.VersionLogTable](ctx, l.coll.get(ctx), bson.M{"d_id": dId}, options.FindOne().SetProjection(bson.M{"logs": 0}))
 	if err != nil {
 		return nil, err
 	}
@@ -281,7 +275,7 @@ func (l *VersionLogMgo) findChangeLog(ctx context.Context, dId string, version u
 	if limit <= 0 {
 		pipeline = pipeline[:len(pipeline)-1]
 	}
-	vl, err := mongoutil.Aggregate[*model.VersionLog](ctx, l.coll, pipeline)
+	vl, err := mongoutil.Aggregate[*model.VersionLog](ctx, l.coll.get(ctx), pipeline)
 	if err != nil {
 		return nil, err
 	}
@@ -292,7 +286,7 @@ func (l *VersionLogMgo) findChangeLog(ctx context.Context, dId string, version u
 }
 
 func (l *VersionLogMgo) DeleteAfterUnchangedLog(ctx context.Context, deadline time.Time) error {
-	return mongoutil.DeleteMany(ctx, l.coll, bson.M{
+	return mongoutil.DeleteMany(ctx, l.coll.get(ctx), bson.M{
 		"last_update": bson.M{
 			"$lt": deadline,
 		},
@@ -300,5 +294,5 @@ func (l *VersionLogMgo) DeleteAfterUnchangedLog(ctx context.Context, deadline ti
 }
 
 func (l *VersionLogMgo) Delete(ctx context.Context, dId string) error {
-	return mongoutil.DeleteOne(ctx, l.coll, bson.M{"d_id": dId})
+	return mongoutil.DeleteOne(ctx, l.coll.get(ctx), bson.M{"d_id": dId})
 }
@@ -0,0 +1,36 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+type GroupSensitiveWord interface {
+	Set(ctx context.Context, word *model.GroupSensitiveWord) error
+	// Take returns the group's list, an empty one when the group never set it.
+	Take(ctx context.Context, groupID string) (*model.GroupSensitiveWord, error)
+}
+
+type ModerationReview interface {
+	Create(ctx context.Context, reviews []*model.ModerationReview) error
+	// Search lists reviews newest first, status < 0 matches every status.
+	Search(ctx context.Context, status int32, sendID string, groupID string, pagination pagination.Pagination) (int64, []*model.ModerationReview, error)
+	// SetStatus records the outcome of pending reviews and returns how many were updated.
+	SetStatus(ctx context.Context, reviewIDs []string, status int32, reviewer string) (int64, error)
+}
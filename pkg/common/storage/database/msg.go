@@ -39,13 +39,20 @@ type Msg interface {
 	DeleteMsgsInOneDocByIndex(ctx context.Context, docID string, indexes []int) error
 	MarkSingleChatMsgsAsRead(ctx context.Context, userID string, docID string, indexes []int64) error
 	SearchMessage(ctx context.Context, req *msg.SearchMessageReq) (int64, []*model.MsgInfoModel, error)
+	// FindMsgsBySender returns the messages of the documents sent by sendID, ordered by seq.
+	FindMsgsBySender(ctx context.Context, docIDs []string, sendID string) ([]*model.MsgInfoModel, error)
 	RangeUserSendCount(ctx context.Context, start time.Time, end time.Time, group bool, ase bool, pageNumber int32, showNumber int32) (msgCount int64, userCount int64, users []*model.UserCount, dateCount map[string]int64, err error)
 	RangeGroupSendCount(ctx context.Context, start time.Time, end time.Time, ase bool, pageNumber int32, showNumber int32) (msgCount int64, userCount int64, groups []*model.GroupCount, dateCount map[string]int64, err error)
 	ConvertMsgsDocLen(ctx context.Context, conversationIDs []string)
 
 	DeleteDoc(ctx context.Context, docID string) error
+	// DeleteDocIfUnchanged deletes the document only while its content still equals doc,
+	// and reports whether it did.
+	DeleteDocIfUnchanged(ctx context.Context, doc *model.MsgDocModel) (bool, error)
 	DeleteMsgByIndex(ctx context.Context, docID string, index []int) error
 	GetBeforeMsg(ctx context.Context, ts int64, docIDs []string, limit int) ([]*model.MsgDocModel, error)
+	// FindArchivable returns full documents whose newest message was sent before ts.
+	FindArchivable(ctx context.Context, ts int64, limit int) ([]*model.MsgDocModel, error)
 
 	GetDocIDs(ctx context.Context) ([]string, error)
 }
@@ -0,0 +1,29 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type MsgArchive interface {
+	Upsert(ctx context.Context, archive *model.MsgArchiveModel) error
+	Take(ctx context.Context, docID string) (*model.MsgArchiveModel, error)
+	// FindBefore returns archives whose newest message was sent before ts.
+	FindBefore(ctx context.Context, ts int64, limit int) ([]*model.MsgArchiveModel, error)
+	Delete(ctx context.Context, docIDs []string) error
+}
@@ -1,20 +1,43 @@
 package database
 
 const (
-	BlackName               = "black"
-	ConversationName        = "conversation"
-	FriendName              = "friend"
-	FriendVersionName       = "friend_version"
-	FriendRequestName       = "friend_request"
-	GroupName               = "group"
-	GroupMemberName         = "group_member"
-	GroupMemberVersionName  = "group_member_version"
-	GroupJoinVersionName    = "group_join_version"
-	ConversationVersionName = "conversation_version"
-	GroupRequestName        = "group_request"
-	LogName                 = "log"
-	ObjectName              = "s3"
-	UserName                = "user"
-	SeqConversationName     = "seq"
-	SeqUserName             = "seq_user"
+	BlackName                = "black"
+	ConversationName         = "conversation"
+	FriendName               = "friend"
+	FriendVersionName        = "friend_version"
+	FriendRequestName        = "friend_request"
+	FriendGroupName          = "friend_group"
+	FriendGroupMemberName    = "friend_group_member"
+	GroupName                = "group"
+	GroupMemberName          = "group_member"
+	GroupMemberVersionName   = "group_member_version"
+	GroupJoinVersionName     = "group_join_version"
+	ConversationVersionName  = "conversation_version"
+	GroupRequestName         = "group_request"
+	LogName                  = "log"
+	ObjectName               = "s3"
+	UserName                 = "user"
+	SeqConversationName      = "seq"
+	SeqUserName              = "seq_user"
+	GroupReadReceiptName     = "group_read_receipt"
+	GroupSensitiveWordName   = "group_sensitive_word"
+	GroupMuteRuleName        = "group_mute_rule"
+	GroupJoinQuestionName    = "group_join_question"
+	GroupRequestAnswerName   = "group_request_answer"
+	GroupMemberTagName       = "group_member_tag"
+	GroupQuotaName           = "group_quota"
+	GroupSuccessionName      = "group_succession"
+	ChannelSubscriberName    = "channel_subscriber"
+	CommunityName            = "community"
+	CommunityMemberName      = "community_member"
+	CommunityGroupName       = "community_group"
+	CommunityJoinVersionName = "community_join_version"
+	GroupRoleName            = "group_role"
+	GroupInviteLinkName      = "group_invite_link"
+	GroupInviteRedeemName    = "group_invite_redemption"
+	ModerationReviewName     = "moderation_review"
+	MsgArchiveName           = "msg_archive"
+	UserJobName              = "user_job"
+	UserActivityName         = "user_activity"
+	TenantName               = "tenant"
 )
@@ -28,4 +28,5 @@ type ObjectInfo interface {
 	Delete(ctx context.Context, engine string, name string) error
 	FindByExpires(ctx context.Context, duration time.Time, pagination pagination.Pagination) (total int64, objects []*model.Object, err error)
 	FindNotDelByS3(ctx context.Context, key string, duration time.Time) (int64, error)
+	FindByUser(ctx context.Context, userID string, pagination pagination.Pagination) (total int64, objects []*model.Object, err error)
 }
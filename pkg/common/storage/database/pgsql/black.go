@@ -0,0 +1,90 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgsql
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+	"gorm.io/gorm"
+)
+
+func NewBlackPgsql(db *gorm.DB) database.Black {
+	return &BlackPgsql{db: db}
+}
+
+type BlackPgsql struct {
+	db *gorm.DB
+}
+
+func (b *BlackPgsql) table(ctx context.Context) *gorm.DB {
+	return conn(ctx, b.db).Table(database.BlackName)
+}
+
+// blacksFilter matches any of the (owner_user_id, block_user_id) pairs of blacks.
+func (b *BlackPgsql) blacksFilter(query *gorm.DB, blacks []*model.Black) *gorm.DB {
+	pairs := make([][]any, 0, len(blacks))
+	for _, black := range blacks {
+		pairs = append(pairs, []any{black.OwnerUserID, black.BlockUserID})
+	}
+	return query.Where("(owner_user_id, block_user_id) IN ?", pairs)
+}
+
+func (b *BlackPgsql) Create(ctx context.Context, blacks []*model.Black) (err error) {
+	if len(blacks) == 0 {
+		return nil
+	}
+	return wrapErr(b.table(ctx).Create(blacks).Error)
+}
+
+func (b *BlackPgsql) Delete(ctx context.Context, blacks []*model.Black) (err error) {
+	if len(blacks) == 0 {
+		return nil
+	}
+	return wrapErr(b.blacksFilter(b.table(ctx), blacks).Delete(nil).Error)
+}
+
+func (b *BlackPgsql) Find(ctx context.Context, blacks []*model.Black) (blackList []*model.Black, err error) {
+	if len(blacks) == 0 {
+		return nil, nil
+	}
+	return find[*model.Black](b.blacksFilter(b.table(ctx), blacks))
+}
+
+func (b *BlackPgsql) Take(ctx context.Context, ownerUserID, blockUserID string) (black *model.Black, err error) {
+	return takeOne[*model.Black](b.table(ctx).Where("owner_user_id = ? AND block_user_id = ?", ownerUserID, blockUserID))
+}
+
+func (b *BlackPgsql) FindOwnerBlacks(ctx context.Context, ownerUserID string, pagination pagination.Pagination) (total int64, blacks []*model.Black, err error) {
+	return findPage[*model.Black](b.table(ctx).Where("owner_user_id = ?", ownerUserID), pagination, "id")
+}
+
+func (b *BlackPgsql) FindOwnerBlackInfos(ctx context.Context, ownerUserID string, userIDs []string) (blacks []*model.Black, err error) {
+	query := b.table(ctx).Where("owner_user_id = ?", ownerUserID)
+	if len(userIDs) > 0 {
+		query = query.Where("block_user_id IN ?", userIDs)
+	}
+	return find[*model.Black](query)
+}
+
+func (b *BlackPgsql) FindBlackUserIDs(ctx context.Context, ownerUserID string) (blackUserIDs []string, err error) {
+	return pluck[string](b.table(ctx).Where("owner_user_id = ?", ownerUserID).Order("id"), "block_user_id")
+}
+
+func (b *BlackPgsql) FindByBlockUser(ctx context.Context, blockUserID string, pagination pagination.Pagination) (total int64, blacks []*model.Black, err error) {
+	return findPage[*model.Black](b.table(ctx).Where("block_user_id = ?", blockUserID), pagination, "id")
+}
@@ -0,0 +1,60 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgsql
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func NewChannelSubscriberPgsql(db *gorm.DB) database.ChannelSubscriber {
+	return &channelSubscriberPgsql{db: db}
+}
+
+type channelSubscriberPgsql struct {
+	db *gorm.DB
+}
+
+func (c *channelSubscriberPgsql) table(ctx context.Context) *gorm.DB {
+	return conn(ctx, c.db).Table(database.ChannelSubscriberName)
+}
+
+func (c *channelSubscriberPgsql) Add(ctx context.Context, subscribers []*model.ChannelSubscriber) error {
+	if len(subscribers) == 0 {
+		return nil
+	}
+	return wrapErr(c.table(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(subscribers).Error)
+}
+
+func (c *channelSubscriberPgsql) Delete(ctx context.Context, groupID string, userIDs []string) error {
+	query := c.table(ctx).Where("group_id = ?", groupID)
+	if userIDs != nil {
+		if len(userIDs) == 0 {
+			return nil
+		}
+		query = query.Where("user_id IN ?", userIDs)
+	}
+	return wrapErr(query.Delete(nil).Error)
+}
+
+func (c *channelSubscriberPgsql) FindUserIDs(ctx context.Context, groupID string, shard int32, afterUserID string, limit int) ([]string, error) {
+	query := c.table(ctx).Where("group_id = ? AND shard = ? AND user_id > ?", groupID, shard, afterUserID).
+		Order("user_id").Limit(limit)
+	return pluck[string](query, "user_id")
+}
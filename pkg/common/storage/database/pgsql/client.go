@@ -0,0 +1,171 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgsql
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/tools/db/tx"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+// Defaults of the tenant pool limits, see config/database.yml.
+const (
+	defaultTenantMaxOpenConns = 4
+	defaultTenantMaxPools     = 32
+)
+
+// Client is a PostgreSQL connection whose schema has been migrated to the latest version.
+type Client struct {
+	db *gorm.DB
+}
+
+// NewClient connects to PostgreSQL and applies the pending migrations.
+func NewClient(ctx context.Context, conf *config.Postgres) (*Client, error) {
+	db, err := open(ctx, conf, buildDSN(conf))
+	if err != nil {
+		return nil, err
+	}
+	if err := migrate(ctx, db); err != nil {
+		return nil, err
+	}
+	tenantConf := *conf
+	tenantConf.MaxOpenConns = conf.TenantMaxOpenConns
+	if tenantConf.MaxOpenConns <= 0 {
+		tenantConf.MaxOpenConns = defaultTenantMaxOpenConns
+	}
+	if tenantConf.MaxIdleConns <= 0 || tenantConf.MaxIdleConns > tenantConf.MaxOpenConns {
+		tenantConf.MaxIdleConns = tenantConf.MaxOpenConns
+	}
+	maxPools := conf.TenantMaxPools
+	if maxPools <= 0 {
+		maxPools = defaultTenantMaxPools
+	}
+	tenantOpeners.Store(db, &tenantOpener{
+		open: func(ctx context.Context, schema string) (*gorm.DB, error) {
+			return open(ctx, &tenantConf, withSearchPath(buildDSN(conf), schema))
+		},
+		maxPools: maxPools,
+	})
+	return &Client{db: db}, nil
+}
+
+func open(ctx context.Context, conf *config.Postgres, dsn string) (*gorm.DB, error) {
+	slowThreshold := time.Duration(conf.SlowThreshold) * time.Millisecond
+	if slowThreshold <= 0 {
+		slowThreshold = 500 * time.Millisecond
+	}
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
+		Logger:                 log.NewSqlLogger(gormlogger.Warn, true, slowThreshold),
+		TranslateError:         true,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		return nil, errs.WrapMsg(err, "connect postgres failed")
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if conf.MaxOpenConns > 0 {
+		sqlDB.SetMaxOpenConns(conf.MaxOpenConns)
+	}
+	if conf.MaxIdleConns > 0 {
+		sqlDB.SetMaxIdleConns(conf.MaxIdleConns)
+	}
+	if conf.MaxLifetime > 0 {
+		sqlDB.SetConnMaxLifetime(time.Duration(conf.MaxLifetime) * time.Second)
+	}
+	if err := sqlDB.PingContext(ctx); err != nil {
+		return nil, errs.WrapMsg(err, "ping postgres failed", "address", conf.Address, "database", conf.Database)
+	}
+	return db, nil
+}
+
+// withSearchPath sets the schema searched by the connections of dsn, which is either a
+// URL or a list of key=value settings.
+func withSearchPath(dsn string, schema string) string {
+	if strings.Contains(dsn, "://") {
+		if u, err := url.Parse(dsn); err == nil {
+			q := u.Query()
+			q.Set("search_path", schema)
+			u.RawQuery = q.Encode()
+			return u.String()
+		}
+	}
+	return dsn + " search_path=" + schema
+}
+
+func buildDSN(conf *config.Postgres) string {
+	if conf.DSN != "" {
+		return conf.DSN
+	}
+	sslMode := conf.SSLMode
+	if sslMode == "" {
+		sslMode = "disable"
+	}
+	u := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(conf.Username, conf.Password),
+		Host:     conf.Address,
+		Path:     conf.Database,
+		RawQuery: fmt.Sprintf("sslmode=%s", url.QueryEscape(sslMode)),
+	}
+	return u.String()
+}
+
+func (c *Client) GetDB() *gorm.DB {
+	return c.db
+}
+
+func (c *Client) GetTx() tx.Tx {
+	return &pgTx{db: c.db}
+}
+
+func (c *Client) Close() error {
+	tenantOpeners.Delete(c.db)
+	closeTenantPools(c.db)
+	sqlDB, err := c.db.DB()
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	return errs.Wrap(sqlDB.Close())
+}
+
+// Check verifies that PostgreSQL is reachable with the given configuration.
+func Check(ctx context.Context, conf *config.Postgres) error {
+	db, err := gorm.Open(postgres.Open(buildDSN(conf)), &gorm.Config{Logger: gormlogger.Discard})
+	if err != nil {
+		return errs.WrapMsg(err, "connect postgres failed")
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer sqlDB.Close()
+	if err := sqlDB.PingContext(ctx); err != nil {
+		return errs.WrapMsg(err, "ping postgres failed", "address", conf.Address, "database", conf.Database)
+	}
+	return nil
+}
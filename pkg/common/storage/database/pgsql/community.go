@@ -0,0 +1,195 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgsql
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+	"gorm.io/gorm"
+)
+
+func NewCommunityPgsql(db *gorm.DB) database.Community {
+	return &communityPgsql{db: db}
+}
+
+type communityPgsql struct {
+	db *gorm.DB
+}
+
+func (c *communityPgsql) table(ctx context.Context) *gorm.DB {
+	return conn(ctx, c.db).Table(database.CommunityName)
+}
+
+func (c *communityPgsql) Create(ctx context.Context, community *model.Community) error {
+	return wrapErr(c.table(ctx).Create(community).Error)
+}
+
+func (c *communityPgsql) Take(ctx context.Context, communityID string) (*model.Community, error) {
+	return takeOne[*model.Community](c.table(ctx).Where("community_id = ?", communityID))
+}
+
+func (c *communityPgsql) Find(ctx context.Context, communityIDs []string) ([]*model.Community, error) {
+	if len(communityIDs) == 0 {
+		return nil, nil
+	}
+	return find[*model.Community](c.table(ctx).Where("community_id IN ?", communityIDs))
+}
+
+func (c *communityPgsql) UpdateMap(ctx context.Context, communityID string, args map[string]any) error {
+	if len(args) == 0 {
+		return nil
+	}
+	return updateOne(c.table(ctx).Where("community_id = ?", communityID), args, true)
+}
+
+func (c *communityPgsql) Delete(ctx context.Context, communityID string) error {
+	return wrapErr(c.table(ctx).Where("community_id = ?", communityID).Delete(nil).Error)
+}
+
+func NewCommunityMemberPgsql(db *gorm.DB) database.CommunityMember {
+	return &communityMemberPgsql{db: db, join: NewVersionLog(db, database.CommunityJoinVersionName)}
+}
+
+type communityMemberPgsql struct {
+	db   *gorm.DB
+	join database.VersionLog
+}
+
+func (c *communityMemberPgsql) table(ctx context.Context) *gorm.DB {
+	return conn(ctx, c.db).Table(database.CommunityMemberName)
+}
+
+func (c *communityMemberPgsql) Create(ctx context.Context, members []*model.CommunityMember) error {
+	if len(members) == 0 {
+		return nil
+	}
+	return withTx(ctx, c.db, func(ctx context.Context) error {
+		return incrVersion(func() error {
+			return wrapErr(c.table(ctx).Create(members).Error)
+		}, func() error {
+			for _, member := range members {
+				if err := c.join.IncrVersion(ctx, member.UserID, []string{member.CommunityID}, model.VersionStateInsert); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	})
+}
+
+func (c *communityMemberPgsql) Delete(ctx context.Context, communityID string, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	return withTx(ctx, c.db, func(ctx context.Context) error {
+		return incrVersion(func() error {
+			return wrapErr(c.table(ctx).Where("community_id = ? AND user_id IN ?", communityID, userIDs).Delete(nil).Error)
+		}, func() error {
+			for _, userID := range userIDs {
+				if err := c.join.IncrVersion(ctx, userID, []string{communityID}, model.VersionStateDelete); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	})
+}
+
+func (c *communityMemberPgsql) Take(ctx context.Context, communityID string, userID string) (*model.CommunityMember, error) {
+	return takeOne[*model.CommunityMember](c.table(ctx).Where("community_id = ? AND user_id = ?", communityID, userID))
+}
+
+func (c *communityMemberPgsql) Find(ctx context.Context, communityID string, userIDs []string) ([]*model.CommunityMember, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	return find[*model.CommunityMember](c.table(ctx).Where("community_id = ? AND user_id IN ?", communityID, userIDs))
+}
+
+func (c *communityMemberPgsql) FindUserIDs(ctx context.Context, communityID string) ([]string, error) {
+	return pluck[string](c.table(ctx).Where("community_id = ?", communityID), "user_id")
+}
+
+func (c *communityMemberPgsql) FindPage(ctx context.Context, communityID string, pagination pagination.Pagination) (int64, []*model.CommunityMember, error) {
+	return findPage[*model.CommunityMember](c.table(ctx).Where("community_id = ?", communityID), pagination, "role_level DESC", "join_time")
+}
+
+func (c *communityMemberPgsql) UpdateRoleLevel(ctx context.Context, communityID string, userID string, roleLevel int32) error {
+	return withTx(ctx, c.db, func(ctx context.Context) error {
+		return incrVersion(func() error {
+			return updateOne(c.table(ctx).Where("community_id = ? AND user_id = ?", communityID, userID),
+				map[string]any{"role_level": roleLevel}, true)
+		}, func() error {
+			return c.join.IncrVersion(ctx, userID, []string{communityID}, model.VersionStateUpdate)
+		})
+	})
+}
+
+func (c *communityMemberPgsql) FindJoinCommunityIDs(ctx context.Context, userID string) ([]string, error) {
+	return pluck[string](c.table(ctx).Where("user_id = ?", userID), "community_id")
+}
+
+func (c *communityMemberPgsql) JoinCommunityIncrVersion(ctx context.Context, userID string, communityIDs []string, state int32) error {
+	return c.join.IncrVersion(ctx, userID, communityIDs, state)
+}
+
+func (c *communityMemberPgsql) FindJoinIncrVersion(ctx context.Context, userID string, version uint, limit int) (*model.VersionLog, error) {
+	return c.join.FindChangeLog(ctx, userID, version, limit)
+}
+
+func NewCommunityGroupPgsql(db *gorm.DB) database.CommunityGroup {
+	return &communityGroupPgsql{db: db}
+}
+
+type communityGroupPgsql struct {
+	db *gorm.DB
+}
+
+func (c *communityGroupPgsql) table(ctx context.Context) *gorm.DB {
+	return conn(ctx, c.db).Table(database.CommunityGroupName)
+}
+
+func (c *communityGroupPgsql) Create(ctx context.Context, groups []*model.CommunityGroup) error {
+	if len(groups) == 0 {
+		return nil
+	}
+	return wrapErr(c.table(ctx).Create(groups).Error)
+}
+
+func (c *communityGroupPgsql) Delete(ctx context.Context, communityID string, groupIDs []string) error {
+	query := c.table(ctx).Where("community_id = ?", communityID)
+	if groupIDs != nil {
+		if len(groupIDs) == 0 {
+			return nil
+		}
+		query = query.Where("group_id IN ?", groupIDs)
+	}
+	return wrapErr(query.Delete(nil).Error)
+}
+
+func (c *communityGroupPgsql) TakeCommunityID(ctx context.Context, groupID string) (string, error) {
+	communityIDs, err := pluck[string](c.table(ctx).Where("group_id = ?", groupID), "community_id")
+	if err != nil || len(communityIDs) == 0 {
+		return "", err
+	}
+	return communityIDs[0], nil
+}
+
+func (c *communityGroupPgsql) FindGroupIDs(ctx context.Context, communityID string) ([]string, error) {
+	return pluck[string](c.table(ctx).Where("community_id = ?", communityID).Order("create_time"), "group_id")
+}
@@ -0,0 +1,180 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgsql
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/db/pagination"
+	"gorm.io/gorm"
+)
+
+func NewConversationPgsql(db *gorm.DB) database.Conversation {
+	return &ConversationPgsql{db: db, version: NewVersionLog(db, database.ConversationVersionName)}
+}
+
+type ConversationPgsql struct {
+	db      *gorm.DB
+	version database.VersionLog
+}
+
+func (c *ConversationPgsql) table(ctx context.Context) *gorm.DB {
+	return conn(ctx, c.db).Table(database.ConversationName)
+}
+
+func (c *ConversationPgsql) Create(ctx context.Context, conversations []*model.Conversation) (err error) {
+	if len(conversations) == 0 {
+		return nil
+	}
+	return withTx(ctx, c.db, func(ctx context.Context) error {
+		return incrVersion(func() error {
+			return wrapErr(c.table(ctx).Create(conversations).Error)
+		}, func() error {
+			userConversation := make(map[string][]string)
+			for _, conversation := range conversations {
+				userConversation[conversation.OwnerUserID] = append(userConversation[conversation.OwnerUserID], conversation.ConversationID)
+			}
+			for userID, conversationIDs := range userConversation {
+				if err := c.version.IncrVersion(ctx, userID, conversationIDs, model.VersionStateInsert); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	})
+}
+
+func (c *ConversationPgsql) UpdateByMap(ctx context.Context, userIDs []string, conversationID string, args map[string]any) (int64, error) {
+	if len(args) == 0 || len(userIDs) == 0 {
+		return 0, nil
+	}
+	var rows int64
+	err := withTx(ctx, c.db, func(ctx context.Context) error {
+		return incrVersion(func() error {
+			res := c.table(ctx).Where("conversation_id = ? AND owner_user_id IN ?", conversationID, userIDs).Updates(args)
+			if res.Error != nil {
+				return wrapErr(res.Error)
+			}
+			rows = res.RowsAffected
+			return nil
+		}, func() error {
+			for _, userID := range userIDs {
+				if err := c.version.IncrVersion(ctx, userID, []string{conversationID}, model.VersionStateUpdate); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return 0, err
+	}
+	return rows, nil
+}
+
+func (c *ConversationPgsql) Update(ctx context.Context, conversation *model.Conversation) (err error) {
+	return withTx(ctx, c.db, func(ctx context.Context) error {
+		return incrVersion(func() error {
+			res := c.table(ctx).Where("owner_user_id = ? AND conversation_id = ?", conversation.OwnerUserID, conversation.ConversationID).
+				Select("*").Updates(conversation)
+			if res.Error != nil {
+				return wrapErr(res.Error)
+			}
+			if res.RowsAffected == 0 {
+				return wrapErr(gorm.ErrRecordNotFound)
+			}
+			return nil
+		}, func() error {
+			return c.version.IncrVersion(ctx, conversation.OwnerUserID, []string{conversation.ConversationID}, model.VersionStateUpdate)
+		})
+	})
+}
+
+func (c *ConversationPgsql) Find(ctx context.Context, ownerUserID string, conversationIDs []string) (conversations []*model.Conversation, err error) {
+	return find[*model.Conversation](c.table(ctx).Where("owner_user_id = ? AND conversation_id IN ?", ownerUserID, conversationIDs))
+}
+
+func (c *ConversationPgsql) FindUserID(ctx context.Context, userIDs []string, conversationIDs []string) ([]string, error) {
+	query := c.table(ctx).Where("owner_user_id IN ? AND conversation_id IN ?", userIDs, conversationIDs)
+	return pluck[string](query, "owner_user_id")
+}
+
+func (c *ConversationPgsql) FindUserIDAllConversationID(ctx context.Context, userID string) ([]string, error) {
+	return pluck[string](c.table(ctx).Where("owner_user_id = ?", userID), "conversation_id")
+}
+
+func (c *ConversationPgsql) Take(ctx context.Context, userID, conversationID string) (conversation *model.Conversation, err error) {
+	return takeOne[*model.Conversation](c.table(ctx).Where("owner_user_id = ? AND conversation_id = ?", userID, conversationID))
+}
+
+func (c *ConversationPgsql) FindConversationID(ctx context.Context, userID string, conversationIDs []string) (existConversationID []string, err error) {
+	query := c.table(ctx).Where("owner_user_id = ? AND conversation_id IN ?", userID, conversationIDs)
+	return pluck[string](query, "conversation_id")
+}
+
+func (c *ConversationPgsql) FindUserIDAllConversations(ctx context.Context, userID string) (conversations []*model.Conversation, err error) {
+	return find[*model.Conversation](c.table(ctx).Where("owner_user_id = ?", userID))
+}
+
+func (c *ConversationPgsql) FindRecvMsgUserIDs(ctx context.Context, conversationID string, recvOpts []int) ([]string, error) {
+	query := c.table(ctx).Where("conversation_id = ?", conversationID)
+	if len(recvOpts) > 0 {
+		query = query.Where("recv_msg_opt IN ?", recvOpts)
+	}
+	return pluck[string](query, "owner_user_id")
+}
+
+func (c *ConversationPgsql) GetUserRecvMsgOpt(ctx context.Context, ownerUserID, conversationID string) (opt int, err error) {
+	query := c.table(ctx).Select("recv_msg_opt").Where("owner_user_id = ? AND conversation_id = ?", ownerUserID, conversationID)
+	return takeOne[int](query)
+}
+
+func (c *ConversationPgsql) GetAllConversationIDs(ctx context.Context) ([]string, error) {
+	return pluck[string](c.table(ctx).Distinct("conversation_id"), "conversation_id")
+}
+
+func (c *ConversationPgsql) GetAllConversationIDsNumber(ctx context.Context) (int64, error) {
+	return countRows(c.table(ctx).Distinct("conversation_id"))
+}
+
+func (c *ConversationPgsql) PageConversationIDs(ctx context.Context, pagination pagination.Pagination) (conversationIDs []string, err error) {
+	return findPageOnly[string](c.table(ctx).Select("conversation_id"), pagination, "id")
+}
+
+func (c *ConversationPgsql) GetConversationsByConversationID(ctx context.Context, conversationIDs []string) ([]*model.Conversation, error) {
+	return find[*model.Conversation](c.table(ctx).Where("conversation_id IN ?", conversationIDs))
+}
+
+func (c *ConversationPgsql) GetConversationIDsNeedDestruct(ctx context.Context) ([]*model.Conversation, error) {
+	query := c.table(ctx).Where(`is_msg_destruct AND msg_destruct_time <> 0 AND (latest_msg_destruct_time IS NULL
+		OR latest_msg_destruct_time + msg_destruct_time * interval '1 second' < now())`)
+	return find[*model.Conversation](query)
+}
+
+func (c *ConversationPgsql) GetConversationNotReceiveMessageUserIDs(ctx context.Context, conversationID string) ([]string, error) {
+	query := c.table(ctx).Where("conversation_id = ? AND recv_msg_opt <> ?", conversationID, constant.ReceiveMessage)
+	return pluck[string](query, "owner_user_id")
+}
+
+func (c *ConversationPgsql) FindConversationUserVersion(ctx context.Context, userID string, version uint, limit int) (*model.VersionLog, error) {
+	return c.version.FindChangeLog(ctx, userID, version, limit)
+}
+
+func (c *ConversationPgsql) DeleteByOwner(ctx context.Context, ownerUserID string) error {
+	return wrapErr(c.table(ctx).Where("owner_user_id = ?", ownerUserID).Delete(nil).Error)
+}
@@ -0,0 +1,257 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgsql
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/utils/datautil"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"gorm.io/gorm"
+)
+
+// friendRow stores model.Friend with its ObjectID as hex, which keeps the creation order.
+type friendRow struct {
+	ID             string    `gorm:"column:id"`
+	OwnerUserID    string    `gorm:"column:owner_user_id"`
+	FriendUserID   string    `gorm:"column:friend_user_id"`
+	Remark         string    `gorm:"column:remark"`
+	CreateTime     time.Time `gorm:"column:create_time"`
+	AddSource      int32     `gorm:"column:add_source"`
+	OperatorUserID string    `gorm:"column:operator_user_id"`
+	Ex             string    `gorm:"column:ex"`
+	IsPinned       bool      `gorm:"column:is_pinned"`
+}
+
+func newFriendRow(friend *model.Friend) *friendRow {
+	return &friendRow{
+		ID:             friend.ID.Hex(),
+		OwnerUserID:    friend.OwnerUserID,
+		FriendUserID:   friend.FriendUserID,
+		Remark:         friend.Remark,
+		CreateTime:     friend.CreateTime,
+		AddSource:      friend.AddSource,
+		OperatorUserID: friend.OperatorUserID,
+		Ex:             friend.Ex,
+		IsPinned:       friend.IsPinned,
+	}
+}
+
+func (r *friendRow) Friend() *model.Friend {
+	id, _ := primitive.ObjectIDFromHex(r.ID)
+	friend := &model.Friend{
+		ID:             id,
+		OwnerUserID:    r.OwnerUserID,
+		FriendUserID:   r.FriendUserID,
+		Remark:         r.Remark,
+		CreateTime:     r.CreateTime,
+		AddSource:      r.AddSource,
+		OperatorUserID: r.OperatorUserID,
+		Ex:             r.Ex,
+		IsPinned:       r.IsPinned,
+	}
+	if friend.CreateTime.IsZero() {
+		friend.CreateTime = id.Timestamp()
+	}
+	return friend
+}
+
+func friendRows(rows []*friendRow) []*model.Friend {
+	return datautil.Slice(rows, (*friendRow).Friend)
+}
+
+func NewFriendPgsql(db *gorm.DB) database.Friend {
+	return &FriendPgsql{db: db, owner: NewVersionLog(db, database.FriendVersionName)}
+}
+
+type FriendPgsql struct {
+	db    *gorm.DB
+	owner database.VersionLog
+}
+
+func (f *FriendPgsql) table(ctx context.Context) *gorm.DB {
+	return conn(ctx, f.db).Table(database.FriendName)
+}
+
+func (f *FriendPgsql) sorted(query *gorm.DB) *gorm.DB {
+	return query.Order("is_pinned DESC").Order("id")
+}
+
+func (f *FriendPgsql) find(query *gorm.DB) ([]*model.Friend, error) {
+	rows, err := find[*friendRow](query)
+	if err != nil {
+		return nil, err
+	}
+	return friendRows(rows), nil
+}
+
+func (f *FriendPgsql) findPage(query *gorm.DB, pagination pagination.Pagination) (int64, []*model.Friend, error) {
+	total, rows, err := findPage[*friendRow](query, pagination, "is_pinned DESC", "id")
+	if err != nil {
+		return 0, nil, err
+	}
+	return total, friendRows(rows), nil
+}
+
+func (f *FriendPgsql) Create(ctx context.Context, friends []*model.Friend) error {
+	if len(friends) == 0 {
+		return nil
+	}
+	rows := make([]*friendRow, 0, len(friends))
+	for i, friend := range friends {
+		if friend.ID.IsZero() {
+			friends[i].ID = primitive.NewObjectID()
+		}
+		if friend.CreateTime.IsZero() {
+			friends[i].CreateTime = time.Now()
+		}
+		rows = append(rows, newFriendRow(friend))
+	}
+	return withTx(ctx, f.db, func(ctx context.Context) error {
+		return incrVersion(func() error {
+			return wrapErr(f.table(ctx).Create(rows).Error)
+		}, func() error {
+			mp := make(map[string][]string)
+			for _, friend := range friends {
+				mp[friend.OwnerUserID] = append(mp[friend.OwnerUserID], friend.FriendUserID)
+			}
+			for ownerUserID, friendUserIDs := range mp {
+				if err := f.owner.IncrVersion(ctx, ownerUserID, friendUserIDs, model.VersionStateInsert); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	})
+}
+
+func (f *FriendPgsql) Delete(ctx context.Context, ownerUserID string, friendUserIDs []string) error {
+	return withTx(ctx, f.db, func(ctx context.Context) error {
+		return incrVersion(func() error {
+			query := f.table(ctx).Where("owner_user_id = ? AND friend_user_id IN ?", ownerUserID, friendUserIDs)
+			return wrapErr(query.Delete(nil).Error)
+		}, func() error {
+			return f.owner.IncrVersion(ctx, ownerUserID, friendUserIDs, model.VersionStateDelete)
+		})
+	})
+}
+
+func (f *FriendPgsql) UpdateByMap(ctx context.Context, ownerUserID string, friendUserID string, args map[string]any) error {
+	if len(args) == 0 {
+		return nil
+	}
+	return withTx(ctx, f.db, func(ctx context.Context) error {
+		return incrVersion(func() error {
+			return updateOne(f.table(ctx).Where("owner_user_id = ? AND friend_user_id = ?", ownerUserID, friendUserID), args, true)
+		}, func() error {
+			var friendUserIDs []string
+			if f.IsUpdateIsPinned(args) {
+				friendUserIDs = []string{model.VersionSortChangeID, friendUserID}
+			} else {
+				friendUserIDs = []string{friendUserID}
+			}
+			return f.owner.IncrVersion(ctx, ownerUserID, friendUserIDs, model.VersionStateUpdate)
+		})
+	})
+}
+
+func (f *FriendPgsql) UpdateRemark(ctx context.Context, ownerUserID, friendUserID, remark string) error {
+	return f.UpdateByMap(ctx, ownerUserID, friendUserID, map[string]any{"remark": remark})
+}
+
+func (f *FriendPgsql) Take(ctx context.Context, ownerUserID, friendUserID string) (*model.Friend, error) {
+	row, err := takeOne[*friendRow](f.table(ctx).Where("owner_user_id = ? AND friend_user_id = ?", ownerUserID, friendUserID))
+	if err != nil {
+		return nil, err
+	}
+	return row.Friend(), nil
+}
+
+func (f *FriendPgsql) FindUserState(ctx context.Context, userID1, userID2 string) ([]*model.Friend, error) {
+	query := f.table(ctx).Where("(owner_user_id = ? AND friend_user_id = ?) OR (owner_user_id = ? AND friend_user_id = ?)",
+		userID1, userID2, userID2, userID1)
+	return f.find(query)
+}
+
+func (f *FriendPgsql) FindFriends(ctx context.Context, ownerUserID string, friendUserIDs []string) ([]*model.Friend, error) {
+	return f.find(f.table(ctx).Where("owner_user_id = ? AND friend_user_id IN ?", ownerUserID, friendUserIDs))
+}
+
+func (f *FriendPgsql) FindReversalFriends(ctx context.Context, friendUserID string, ownerUserIDs []string) ([]*model.Friend, error) {
+	return f.find(f.table(ctx).Where("owner_user_id IN ? AND friend_user_id = ?", ownerUserIDs, friendUserID))
+}
+
+func (f *FriendPgsql) FindOwnerFriends(ctx context.Context, ownerUserID string, pagination pagination.Pagination) (int64, []*model.Friend, error) {
+	return f.findPage(f.table(ctx).Where("owner_user_id = ?", ownerUserID), pagination)
+}
+
+func (f *FriendPgsql) FindOwnerFriendUserIds(ctx context.Context, ownerUserID string, limit int) ([]string, error) {
+	query := f.sorted(f.table(ctx).Where("owner_user_id = ?", ownerUserID))
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	return pluck[string](query, "friend_user_id")
+}
+
+func (f *FriendPgsql) FindInWhoseFriends(ctx context.Context, friendUserID string, pagination pagination.Pagination) (int64, []*model.Friend, error) {
+	return f.findPage(f.table(ctx).Where("friend_user_id = ?", friendUserID), pagination)
+}
+
+func (f *FriendPgsql) FindFriendUserIDs(ctx context.Context, ownerUserID string) ([]string, error) {
+	return pluck[string](f.sorted(f.table(ctx).Where("owner_user_id = ?", ownerUserID)), "friend_user_id")
+}
+
+func (f *FriendPgsql) UpdateFriends(ctx context.Context, ownerUserID string, friendUserIDs []string, val map[string]any) error {
+	if len(friendUserIDs) == 0 || len(val) == 0 {
+		return nil
+	}
+	return withTx(ctx, f.db, func(ctx context.Context) error {
+		return incrVersion(func() error {
+			return updateOne(f.table(ctx).Where("owner_user_id = ? AND friend_user_id IN ?", ownerUserID, friendUserIDs), val, false)
+		}, func() error {
+			var userIDs []string
+			if f.IsUpdateIsPinned(val) {
+				userIDs = append([]string{model.VersionSortChangeID}, friendUserIDs...)
+			} else {
+				userIDs = friendUserIDs
+			}
+			return f.owner.IncrVersion(ctx, ownerUserID, userIDs, model.VersionStateUpdate)
+		})
+	})
+}
+
+func (f *FriendPgsql) FindIncrVersion(ctx context.Context, ownerUserID string, version uint, limit int) (*model.VersionLog, error) {
+	return f.owner.FindChangeLog(ctx, ownerUserID, version, limit)
+}
+
+func (f *FriendPgsql) FindFriendUserID(ctx context.Context, friendUserID string) ([]string, error) {
+	return pluck[string](f.sorted(f.table(ctx).Where("friend_user_id = ?", friendUserID)), "owner_user_id")
+}
+
+func (f *FriendPgsql) IncrVersion(ctx context.Context, ownerUserID string, friendUserIDs []string, state int32) error {
+	return f.owner.IncrVersion(ctx, ownerUserID, friendUserIDs, state)
+}
+
+func (f *FriendPgsql) IsUpdateIsPinned(data map[string]any) bool {
+	if data == nil {
+		return false
+	}
+	_, ok := data["is_pinned"]
+	return ok
+}
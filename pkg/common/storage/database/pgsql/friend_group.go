@@ -0,0 +1,121 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgsql
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func NewFriendGroupPgsql(db *gorm.DB) database.FriendGroup {
+	return &friendGroupPgsql{db: db}
+}
+
+type friendGroupPgsql struct {
+	db *gorm.DB
+}
+
+func (f *friendGroupPgsql) table(ctx context.Context) *gorm.DB {
+	return conn(ctx, f.db).Table(database.FriendGroupName)
+}
+
+func (f *friendGroupPgsql) Create(ctx context.Context, group *model.FriendGroup) error {
+	return wrapErr(f.table(ctx).Create(group).Error)
+}
+
+func (f *friendGroupPgsql) UpdateName(ctx context.Context, ownerUserID string, groupID string, name string) error {
+	return wrapErr(f.table(ctx).Where("owner_user_id = ? AND group_id = ?", ownerUserID, groupID).Update("name", name).Error)
+}
+
+func (f *friendGroupPgsql) UpdateSortOrders(ctx context.Context, ownerUserID string, groupIDs []string) error {
+	return withTx(ctx, f.db, func(ctx context.Context) error {
+		for i, groupID := range groupIDs {
+			if err := f.table(ctx).Where("owner_user_id = ? AND group_id = ?", ownerUserID, groupID).Update("sort_order", i).Error; err != nil {
+				return wrapErr(err)
+			}
+		}
+		return nil
+	})
+}
+
+func (f *friendGroupPgsql) Delete(ctx context.Context, ownerUserID string, groupID string) error {
+	return wrapErr(f.table(ctx).Where("owner_user_id = ? AND group_id = ?", ownerUserID, groupID).Delete(nil).Error)
+}
+
+func (f *friendGroupPgsql) Find(ctx context.Context, ownerUserID string, groupIDs []string) ([]*model.FriendGroup, error) {
+	query := f.table(ctx).Where("owner_user_id = ?", ownerUserID)
+	if groupIDs != nil {
+		if len(groupIDs) == 0 {
+			return nil, nil
+		}
+		query = query.Where("group_id IN ?", groupIDs)
+	}
+	return find[*model.FriendGroup](query.Order("sort_order, create_time"))
+}
+
+func NewFriendGroupMemberPgsql(db *gorm.DB) database.FriendGroupMember {
+	return &friendGroupMemberPgsql{db: db}
+}
+
+type friendGroupMemberPgsql struct {
+	db *gorm.DB
+}
+
+func (f *friendGroupMemberPgsql) table(ctx context.Context) *gorm.DB {
+	return conn(ctx, f.db).Table(database.FriendGroupMemberName)
+}
+
+func (f *friendGroupMemberPgsql) Add(ctx context.Context, members []*model.FriendGroupMember) error {
+	if len(members) == 0 {
+		return nil
+	}
+	return wrapErr(f.table(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(members).Error)
+}
+
+func (f *friendGroupMemberPgsql) Delete(ctx context.Context, ownerUserID string, groupID string, friendUserIDs []string) error {
+	query := f.table(ctx).Where("owner_user_id = ? AND group_id = ?", ownerUserID, groupID)
+	if friendUserIDs != nil {
+		if len(friendUserIDs) == 0 {
+			return nil
+		}
+		query = query.Where("friend_user_id IN ?", friendUserIDs)
+	}
+	return wrapErr(query.Delete(nil).Error)
+}
+
+func (f *friendGroupMemberPgsql) DeleteFriends(ctx context.Context, ownerUserID string, friendUserIDs []string) error {
+	if len(friendUserIDs) == 0 {
+		return nil
+	}
+	return wrapErr(f.table(ctx).Where("owner_user_id = ? AND friend_user_id IN ?", ownerUserID, friendUserIDs).Delete(nil).Error)
+}
+
+func (f *friendGroupMemberPgsql) Find(ctx context.Context, ownerUserID string, groupIDs []string) ([]*model.FriendGroupMember, error) {
+	if len(groupIDs) == 0 {
+		return nil, nil
+	}
+	return find[*model.FriendGroupMember](f.table(ctx).Where("owner_user_id = ? AND group_id IN ?", ownerUserID, groupIDs).Order("friend_user_id"))
+}
+
+func (f *friendGroupMemberPgsql) FindGroupIDs(ctx context.Context, ownerUserID string, friendUserIDs []string) ([]string, error) {
+	if len(friendUserIDs) == 0 {
+		return nil, nil
+	}
+	return pluck[string](f.table(ctx).Distinct("group_id").Where("owner_user_id = ? AND friend_user_id IN ?", ownerUserID, friendUserIDs), "group_id")
+}
@@ -0,0 +1,99 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgsql
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+	"gorm.io/gorm"
+)
+
+func NewFriendRequestPgsql(db *gorm.DB) database.FriendRequest {
+	return &FriendRequestPgsql{db: db}
+}
+
+type FriendRequestPgsql struct {
+	db *gorm.DB
+}
+
+func (f *FriendRequestPgsql) table(ctx context.Context) *gorm.DB {
+	return conn(ctx, f.db).Table(database.FriendRequestName)
+}
+
+func (f *FriendRequestPgsql) FindToUserID(ctx context.Context, toUserID string, pagination pagination.Pagination) (total int64, friendRequests []*model.FriendRequest, err error) {
+	return findPage[*model.FriendRequest](f.table(ctx).Where("to_user_id = ?", toUserID), pagination, "id")
+}
+
+func (f *FriendRequestPgsql) FindFromUserID(ctx context.Context, fromUserID string, pagination pagination.Pagination) (total int64, friendRequests []*model.FriendRequest, err error) {
+	return findPage[*model.FriendRequest](f.table(ctx).Where("from_user_id = ?", fromUserID), pagination, "id")
+}
+
+func (f *FriendRequestPgsql) FindBothFriendRequests(ctx context.Context, fromUserID, toUserID string) (friends []*model.FriendRequest, err error) {
+	query := f.table(ctx).Where("(from_user_id = ? AND to_user_id = ?) OR (from_user_id = ? AND to_user_id = ?)",
+		fromUserID, toUserID, toUserID, fromUserID)
+	return find[*model.FriendRequest](query)
+}
+
+func (f *FriendRequestPgsql) Create(ctx context.Context, friendRequests []*model.FriendRequest) error {
+	if len(friendRequests) == 0 {
+		return nil
+	}
+	return wrapErr(f.table(ctx).Create(friendRequests).Error)
+}
+
+func (f *FriendRequestPgsql) Delete(ctx context.Context, fromUserID, toUserID string) (err error) {
+	return wrapErr(f.table(ctx).Where("from_user_id = ? AND to_user_id = ?", fromUserID, toUserID).Delete(nil).Error)
+}
+
+func (f *FriendRequestPgsql) UpdateByMap(ctx context.Context, formUserID, toUserID string, args map[string]any) (err error) {
+	if len(args) == 0 {
+		return nil
+	}
+	return updateOne(f.table(ctx).Where("from_user_id = ? AND to_user_id = ?", formUserID, toUserID), args, true)
+}
+
+func (f *FriendRequestPgsql) Update(ctx context.Context, friendRequest *model.FriendRequest) (err error) {
+	updater := map[string]any{}
+	if friendRequest.HandleResult != 0 {
+		updater["handle_result"] = friendRequest.HandleResult
+	}
+	if friendRequest.ReqMsg != "" {
+		updater["req_msg"] = friendRequest.ReqMsg
+	}
+	if friendRequest.HandlerUserID != "" {
+		updater["handler_user_id"] = friendRequest.HandlerUserID
+	}
+	if friendRequest.HandleMsg != "" {
+		updater["handle_msg"] = friendRequest.HandleMsg
+	}
+	if !friendRequest.HandleTime.IsZero() {
+		updater["handle_time"] = friendRequest.HandleTime
+	}
+	if friendRequest.Ex != "" {
+		updater["ex"] = friendRequest.Ex
+	}
+	return f.UpdateByMap(ctx, friendRequest.FromUserID, friendRequest.ToUserID, updater)
+}
+
+func (f *FriendRequestPgsql) Find(ctx context.Context, fromUserID, toUserID string) (friendRequest *model.FriendRequest, err error) {
+	return takeOne[*model.FriendRequest](f.table(ctx).Where("from_user_id = ? AND to_user_id = ?", fromUserID, toUserID))
+}
+
+func (f *FriendRequestPgsql) Take(ctx context.Context, fromUserID, toUserID string) (friendRequest *model.FriendRequest, err error) {
+	return f.Find(ctx, fromUserID, toUserID)
+}
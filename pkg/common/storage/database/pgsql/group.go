@@ -0,0 +1,107 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgsql
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/db/pagination"
+	"gorm.io/gorm"
+)
+
+func NewGroupPgsql(db *gorm.DB) database.Group {
+	return &GroupPgsql{db: db}
+}
+
+type GroupPgsql struct {
+	db *gorm.DB
+}
+
+func (g *GroupPgsql) table(ctx context.Context) *gorm.DB {
+	return conn(ctx, g.db).Table(database.GroupName)
+}
+
+func (g *GroupPgsql) sortGroup() []string {
+	return []string{"group_name", "create_time"}
+}
+
+func (g *GroupPgsql) Create(ctx context.Context, groups []*model.Group) (err error) {
+	if len(groups) == 0 {
+		return nil
+	}
+	return wrapErr(g.table(ctx).Create(groups).Error)
+}
+
+func (g *GroupPgsql) UpdateStatus(ctx context.Context, groupID string, status int32) (err error) {
+	return g.UpdateMap(ctx, groupID, map[string]any{"status": status})
+}
+
+func (g *GroupPgsql) UpdateMap(ctx context.Context, groupID string, args map[string]any) (err error) {
+	if len(args) == 0 {
+		return nil
+	}
+	return updateOne(g.table(ctx).Where("group_id = ?", groupID), args, true)
+}
+
+func (g *GroupPgsql) Find(ctx context.Context, groupIDs []string) (groups []*model.Group, err error) {
+	return find[*model.Group](g.table(ctx).Where("group_id IN ?", groupIDs))
+}
+
+func (g *GroupPgsql) Take(ctx context.Context, groupID string) (group *model.Group, err error) {
+	return takeOne[*model.Group](g.table(ctx).Where("group_id = ?", groupID))
+}
+
+func (g *GroupPgsql) Search(ctx context.Context, keyword string, pagination pagination.Pagination) (total int64, groups []*model.Group, err error) {
+	query := g.table(ctx).Where("group_name ~ ? AND status <> ?", keyword, constant.GroupStatusDismissed)
+	return findPage[*model.Group](query, pagination, "create_time DESC", "id")
+}
+
+func (g *GroupPgsql) CountTotal(ctx context.Context, before *time.Time) (count int64, err error) {
+	query := g.table(ctx)
+	if before != nil {
+		query = query.Where("create_time < ?", *before)
+	}
+	return countRows(query)
+}
+
+func (g *GroupPgsql) CountRangeEverydayTotal(ctx context.Context, start time.Time, end time.Time) (map[string]int64, error) {
+	return dailyCount(g.table(ctx).Where("create_time >= ? AND create_time < ?", start, end), "create_time")
+}
+
+func (g *GroupPgsql) FindJoinSortGroupID(ctx context.Context, groupIDs []string) ([]string, error) {
+	if len(groupIDs) < 2 {
+		return groupIDs, nil
+	}
+	query := g.table(ctx).Where("group_id IN ? AND status <> ?", groupIDs, constant.GroupStatusDismissed)
+	for _, order := range g.sortGroup() {
+		query = query.Order(order)
+	}
+	return pluck[string](query, "group_id")
+}
+
+func (g *GroupPgsql) SearchJoin(ctx context.Context, groupIDs []string, keyword string, pagination pagination.Pagination) (int64, []*model.Group, error) {
+	if len(groupIDs) == 0 {
+		return 0, nil, nil
+	}
+	query := g.table(ctx).Where("group_id IN ? AND status <> ?", groupIDs, constant.GroupStatusDismissed)
+	if keyword != "" {
+		query = query.Where("group_name ~ ?", keyword)
+	}
+	return findPage[*model.Group](query, pagination, g.sortGroup()...)
+}
@@ -0,0 +1,94 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgsql
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+	"gorm.io/gorm"
+)
+
+func NewGroupInviteLinkPgsql(db *gorm.DB) database.GroupInviteLink {
+	return &groupInviteLinkPgsql{db: db}
+}
+
+type groupInviteLinkPgsql struct {
+	db *gorm.DB
+}
+
+func (g *groupInviteLinkPgsql) table(ctx context.Context) *gorm.DB {
+	return conn(ctx, g.db).Table(database.GroupInviteLinkName)
+}
+
+func (g *groupInviteLinkPgsql) Create(ctx context.Context, link *model.GroupInviteLink) error {
+	return wrapErr(g.table(ctx).Create(link).Error)
+}
+
+func (g *groupInviteLinkPgsql) Take(ctx context.Context, token string) (*model.GroupInviteLink, error) {
+	return takeOne[*model.GroupInviteLink](g.table(ctx).Where("token = ?", token))
+}
+
+func (g *groupInviteLinkPgsql) FindByGroup(ctx context.Context, groupID string, pagination pagination.Pagination) (int64, []*model.GroupInviteLink, error) {
+	return findPage[*model.GroupInviteLink](g.table(ctx).Where("group_id = ?", groupID), pagination, "create_time DESC")
+}
+
+func (g *groupInviteLinkPgsql) Revoke(ctx context.Context, token string) error {
+	return updateOne(g.table(ctx).Where("token = ?", token), map[string]any{"revoked": true}, true)
+}
+
+func (g *groupInviteLinkPgsql) IncrUse(ctx context.Context, token string, now time.Time) (bool, error) {
+	res := g.table(ctx).
+		Where("token = ? AND NOT revoked", token).
+		Where("expire_time = ? OR expire_time > ?", time.Time{}, now).
+		Where("max_uses = 0 OR used_count < max_uses").
+		UpdateColumn("used_count", gorm.Expr("used_count + 1"))
+	if res.Error != nil {
+		return false, wrapErr(res.Error)
+	}
+	return res.RowsAffected > 0, nil
+}
+
+func (g *groupInviteLinkPgsql) DecrUse(ctx context.Context, token string) error {
+	return wrapErr(g.table(ctx).Where("token = ? AND used_count > 0", token).
+		UpdateColumn("used_count", gorm.Expr("used_count - 1")).Error)
+}
+
+func NewGroupInviteRedemptionPgsql(db *gorm.DB) database.GroupInviteRedemption {
+	return &groupInviteRedemptionPgsql{db: db}
+}
+
+type groupInviteRedemptionPgsql struct {
+	db *gorm.DB
+}
+
+func (g *groupInviteRedemptionPgsql) table(ctx context.Context) *gorm.DB {
+	return conn(ctx, g.db).Table(database.GroupInviteRedeemName)
+}
+
+func (g *groupInviteRedemptionPgsql) Create(ctx context.Context, redemption *model.GroupInviteRedemption) error {
+	return wrapErr(g.table(ctx).Create(redemption).Error)
+}
+
+func (g *groupInviteRedemptionPgsql) Find(ctx context.Context, groupID string, token string, pagination pagination.Pagination) (int64, []*model.GroupInviteRedemption, error) {
+	query := g.table(ctx).Where("group_id = ?", groupID)
+	if token != "" {
+		query = query.Where("token = ?", token)
+	}
+	return findPage[*model.GroupInviteRedemption](query, pagination, "id DESC")
+}
@@ -0,0 +1,143 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgsql
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/errs"
+	"gorm.io/gorm"
+)
+
+func NewGroupJoinQuestionPgsql(db *gorm.DB) database.GroupJoinQuestion {
+	return &groupJoinQuestionPgsql{db: db}
+}
+
+type groupJoinQuestionPgsql struct {
+	db *gorm.DB
+}
+
+type groupJoinQuestion struct {
+	QuestionID string `json:"questionID"`
+	Question   string `json:"question"`
+	Required   bool   `json:"required"`
+}
+
+type groupJoinQuestionRow struct {
+	GroupID    string    `gorm:"column:group_id"`
+	Questions  []byte    `gorm:"column:questions"`
+	UpdateTime time.Time `gorm:"column:update_time"`
+}
+
+func (g *groupJoinQuestionPgsql) Set(ctx context.Context, questions *model.GroupJoinQuestions) error {
+	list := make([]groupJoinQuestion, 0, len(questions.Questions))
+	for _, q := range questions.Questions {
+		list = append(list, groupJoinQuestion{QuestionID: q.QuestionID, Question: q.Question, Required: q.Required})
+	}
+	data, err := json.Marshal(list)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	return wrapErr(conn(ctx, g.db).Exec(`INSERT INTO `+database.GroupJoinQuestionName+` (group_id, questions, update_time) VALUES (?, ?, ?)
+		ON CONFLICT (group_id) DO UPDATE SET questions = EXCLUDED.questions, update_time = EXCLUDED.update_time`,
+		questions.GroupID, string(data), questions.UpdateTime).Error)
+}
+
+func (g *groupJoinQuestionPgsql) Take(ctx context.Context, groupID string) (*model.GroupJoinQuestions, error) {
+	row, err := takeOne[*groupJoinQuestionRow](conn(ctx, g.db).Table(database.GroupJoinQuestionName).Where("group_id = ?", groupID))
+	if err == nil {
+		var list []groupJoinQuestion
+		if err := json.Unmarshal(row.Questions, &list); err != nil {
+			return nil, errs.Wrap(err)
+		}
+		questions := &model.GroupJoinQuestions{
+			GroupID:    row.GroupID,
+			Questions:  make([]model.GroupJoinQuestion, 0, len(list)),
+			UpdateTime: row.UpdateTime,
+		}
+		for _, q := range list {
+			questions.Questions = append(questions.Questions, model.GroupJoinQuestion{QuestionID: q.QuestionID, Question: q.Question, Required: q.Required})
+		}
+		return questions, nil
+	} else if IsNotFound(err) {
+		return &model.GroupJoinQuestions{GroupID: groupID}, nil
+	} else {
+		return nil, err
+	}
+}
+
+func NewGroupRequestAnswerPgsql(db *gorm.DB) database.GroupRequestAnswer {
+	return &groupRequestAnswerPgsql{db: db}
+}
+
+type groupRequestAnswerPgsql struct {
+	db *gorm.DB
+}
+
+type groupJoinAnswer struct {
+	QuestionID string `json:"questionID"`
+	Question   string `json:"question"`
+	Answer     string `json:"answer"`
+}
+
+type groupRequestAnswerRow struct {
+	GroupID    string    `gorm:"column:group_id"`
+	UserID     string    `gorm:"column:user_id"`
+	Answers    []byte    `gorm:"column:answers"`
+	CreateTime time.Time `gorm:"column:create_time"`
+}
+
+func (g *groupRequestAnswerPgsql) Set(ctx context.Context, answers *model.GroupRequestAnswers) error {
+	list := make([]groupJoinAnswer, 0, len(answers.Answers))
+	for _, a := range answers.Answers {
+		list = append(list, groupJoinAnswer{QuestionID: a.QuestionID, Question: a.Question, Answer: a.Answer})
+	}
+	data, err := json.Marshal(list)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	return wrapErr(conn(ctx, g.db).Exec(`INSERT INTO `+database.GroupRequestAnswerName+` (group_id, user_id, answers, create_time) VALUES (?, ?, ?, ?)
+		ON CONFLICT (group_id, user_id) DO UPDATE SET answers = EXCLUDED.answers, create_time = EXCLUDED.create_time`,
+		answers.GroupID, answers.UserID, string(data), answers.CreateTime).Error)
+}
+
+func (g *groupRequestAnswerPgsql) Find(ctx context.Context, groupID string, userIDs []string) ([]*model.GroupRequestAnswers, error) {
+	rows, err := find[*groupRequestAnswerRow](conn(ctx, g.db).Table(database.GroupRequestAnswerName).Where("group_id = ? AND user_id IN ?", groupID, userIDs))
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*model.GroupRequestAnswers, 0, len(rows))
+	for _, row := range rows {
+		var list []groupJoinAnswer
+		if err := json.Unmarshal(row.Answers, &list); err != nil {
+			return nil, errs.Wrap(err)
+		}
+		answers := &model.GroupRequestAnswers{
+			GroupID:    row.GroupID,
+			UserID:     row.UserID,
+			Answers:    make([]model.GroupJoinAnswer, 0, len(list)),
+			CreateTime: row.CreateTime,
+		}
+		for _, a := range list {
+			answers.Answers = append(answers.Answers, model.GroupJoinAnswer{QuestionID: a.QuestionID, Question: a.Question, Answer: a.Answer})
+		}
+		res = append(res, answers)
+	}
+	return res, nil
+}
@@ -0,0 +1,240 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgsql
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/log"
+	"gorm.io/gorm"
+)
+
+func NewGroupMemberPgsql(db *gorm.DB) database.GroupMember {
+	return &GroupMemberPgsql{
+		db:     db,
+		member: NewVersionLog(db, database.GroupMemberVersionName),
+		join:   NewVersionLog(db, database.GroupJoinVersionName),
+	}
+}
+
+type GroupMemberPgsql struct {
+	db     *gorm.DB
+	member database.VersionLog
+	join   database.VersionLog
+}
+
+func (g *GroupMemberPgsql) table(ctx context.Context) *gorm.DB {
+	return conn(ctx, g.db).Table(database.GroupMemberName)
+}
+
+func (g *GroupMemberPgsql) sorted(query *gorm.DB) *gorm.DB {
+	return query.Order("role_level DESC").Order("id")
+}
+
+func (g *GroupMemberPgsql) Create(ctx context.Context, groupMembers []*model.GroupMember) (err error) {
+	if len(groupMembers) == 0 {
+		return nil
+	}
+	return withTx(ctx, g.db, func(ctx context.Context) error {
+		return incrVersion(func() error {
+			return wrapErr(g.table(ctx).Create(groupMembers).Error)
+		}, func() error {
+			gms := make(map[string][]string)
+			for _, member := range groupMembers {
+				gms[member.GroupID] = append(gms[member.GroupID], member.UserID)
+			}
+			for groupID, userIDs := range gms {
+				if err := g.member.IncrVersion(ctx, groupID, userIDs, model.VersionStateInsert); err != nil {
+					return err
+				}
+			}
+			return nil
+		}, func() error {
+			gms := make(map[string][]string)
+			for _, member := range groupMembers {
+				gms[member.UserID] = append(gms[member.UserID], member.GroupID)
+			}
+			for userID, groupIDs := range gms {
+				if err := g.join.IncrVersion(ctx, userID, groupIDs, model.VersionStateInsert); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	})
+}
+
+func (g *GroupMemberPgsql) Delete(ctx context.Context, groupID string, userIDs []string) (err error) {
+	return withTx(ctx, g.db, func(ctx context.Context) error {
+		return incrVersion(func() error {
+			query := g.table(ctx).Where("group_id = ?", groupID)
+			if len(userIDs) > 0 {
+				query = query.Where("user_id IN ?", userIDs)
+			}
+			return wrapErr(query.Delete(nil).Error)
+		}, func() error {
+			if len(userIDs) == 0 {
+				return g.member.Delete(ctx, groupID)
+			}
+			return g.member.IncrVersion(ctx, groupID, userIDs, model.VersionStateDelete)
+		}, func() error {
+			for _, userID := range userIDs {
+				if err := g.join.IncrVersion(ctx, userID, []string{groupID}, model.VersionStateDelete); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	})
+}
+
+func (g *GroupMemberPgsql) UpdateRoleLevel(ctx context.Context, groupID string, userID string, roleLevel int32) error {
+	return withTx(ctx, g.db, func(ctx context.Context) error {
+		return incrVersion(func() error {
+			return updateOne(g.table(ctx).Where("group_id = ? AND user_id = ?", groupID, userID),
+				map[string]any{"role_level": roleLevel}, true)
+		}, func() error {
+			return g.member.IncrVersion(ctx, groupID, []string{model.VersionSortChangeID, userID}, model.VersionStateUpdate)
+		})
+	})
+}
+
+func (g *GroupMemberPgsql) UpdateUserRoleLevels(ctx context.Context, groupID string, firstUserID string, firstUserRoleLevel int32, secondUserID string, secondUserRoleLevel int32) error {
+	return withTx(ctx, g.db, func(ctx context.Context) error {
+		return incrVersion(func() error {
+			if err := updateOne(g.table(ctx).Where("group_id = ? AND user_id = ?", groupID, firstUserID),
+				map[string]any{"role_level": firstUserRoleLevel}, true); err != nil {
+				return err
+			}
+			return updateOne(g.table(ctx).Where("group_id = ? AND user_id = ?", groupID, secondUserID),
+				map[string]any{"role_level": secondUserRoleLevel}, true)
+		}, func() error {
+			return g.member.IncrVersion(ctx, groupID, []string{model.VersionSortChangeID, firstUserID, secondUserID}, model.VersionStateUpdate)
+		})
+	})
+}
+
+func (g *GroupMemberPgsql) Update(ctx context.Context, groupID string, userID string, data map[string]any) (err error) {
+	if len(data) == 0 {
+		return nil
+	}
+	return withTx(ctx, g.db, func(ctx context.Context) error {
+		return incrVersion(func() error {
+			return updateOne(g.table(ctx).Where("group_id = ? AND user_id = ?", groupID, userID), data, true)
+		}, func() error {
+			var userIDs []string
+			if g.IsUpdateRoleLevel(data) {
+				userIDs = []string{model.VersionSortChangeID, userID}
+			} else {
+				userIDs = []string{userID}
+			}
+			return g.member.IncrVersion(ctx, groupID, userIDs, model.VersionStateUpdate)
+		})
+	})
+}
+
+func (g *GroupMemberPgsql) FindMemberUserID(ctx context.Context, groupID string) (userIDs []string, err error) {
+	return pluck[string](g.sorted(g.table(ctx).Where("group_id = ?", groupID)), "user_id")
+}
+
+func (g *GroupMemberPgsql) Find(ctx context.Context, groupID string, userIDs []string) ([]*model.GroupMember, error) {
+	query := g.table(ctx).Where("group_id = ?", groupID)
+	if len(userIDs) > 0 {
+		query = query.Where("user_id IN ?", userIDs)
+	}
+	return find[*model.GroupMember](query)
+}
+
+func (g *GroupMemberPgsql) FindInGroup(ctx context.Context, userID string, groupIDs []string) ([]*model.GroupMember, error) {
+	query := g.table(ctx).Where("user_id = ?", userID)
+	if len(groupIDs) > 0 {
+		query = query.Where("group_id IN ?", groupIDs)
+	}
+	return find[*model.GroupMember](query)
+}
+
+func (g *GroupMemberPgsql) Take(ctx context.Context, groupID string, userID string) (groupMember *model.GroupMember, err error) {
+	return takeOne[*model.GroupMember](g.table(ctx).Where("group_id = ? AND user_id = ?", groupID, userID))
+}
+
+func (g *GroupMemberPgsql) TakeOwner(ctx context.Context, groupID string) (groupMember *model.GroupMember, err error) {
+	return takeOne[*model.GroupMember](g.table(ctx).Where("group_id = ? AND role_level = ?", groupID, constant.GroupOwner))
+}
+
+func (g *GroupMemberPgsql) FindOwners(ctx context.Context, afterGroupID string, limit int) ([]*model.GroupMember, error) {
+	return find[*model.GroupMember](g.table(ctx).Where("group_id > ? AND role_level = ?", afterGroupID, constant.GroupOwner).
+		Order("group_id").Limit(limit))
+}
+
+func (g *GroupMemberPgsql) FindRoleLevelUserIDs(ctx context.Context, groupID string, roleLevel int32) ([]string, error) {
+	return pluck[string](g.table(ctx).Where("group_id = ? AND role_level = ?", groupID, roleLevel), "user_id")
+}
+
+func (g *GroupMemberPgsql) FindRoleIDUserIDs(ctx context.Context, groupID string, roleID string) ([]string, error) {
+	return pluck[string](g.table(ctx).Where("group_id = ? AND role_id = ?", groupID, roleID), "user_id")
+}
+
+func (g *GroupMemberPgsql) SearchMember(ctx context.Context, keyword string, groupID string, pagination pagination.Pagination) (int64, []*model.GroupMember, error) {
+	query := g.table(ctx).Where("group_id = ? AND nickname ~ ?", groupID, keyword)
+	return findPage[*model.GroupMember](query, pagination, "role_level DESC", "id")
+}
+
+func (g *GroupMemberPgsql) FindUserJoinedGroupID(ctx context.Context, userID string) (groupIDs []string, err error) {
+	return pluck[string](g.sorted(g.table(ctx).Where("user_id = ?", userID)), "group_id")
+}
+
+func (g *GroupMemberPgsql) TakeGroupMemberNum(ctx context.Context, groupID string) (count int64, err error) {
+	return countRows(g.table(ctx).Where("group_id = ?", groupID))
+}
+
+func (g *GroupMemberPgsql) FindUserManagedGroupID(ctx context.Context, userID string) (groupIDs []string, err error) {
+	query := g.table(ctx).Where("user_id = ? AND role_level IN ?", userID, []int{constant.GroupOwner, constant.GroupAdmin})
+	return pluck[string](query, "group_id")
+}
+
+func (g *GroupMemberPgsql) IsUpdateRoleLevel(data map[string]any) bool {
+	if len(data) == 0 {
+		return false
+	}
+	_, ok := data["role_level"]
+	return ok
+}
+
+func (g *GroupMemberPgsql) JoinGroupIncrVersion(ctx context.Context, userID string, groupIDs []string, state int32) error {
+	return g.join.IncrVersion(ctx, userID, groupIDs, state)
+}
+
+func (g *GroupMemberPgsql) MemberGroupIncrVersion(ctx context.Context, groupID string, userIDs []string, state int32) error {
+	return g.member.IncrVersion(ctx, groupID, userIDs, state)
+}
+
+func (g *GroupMemberPgsql) FindMemberIncrVersion(ctx context.Context, groupID string, version uint, limit int) (*model.VersionLog, error) {
+	log.ZDebug(ctx, "find member incr version", "groupID", groupID, "version", version)
+	return g.member.FindChangeLog(ctx, groupID, version, limit)
+}
+
+func (g *GroupMemberPgsql) BatchFindMemberIncrVersion(ctx context.Context, groupIDs []string, versions []uint, limits []int) ([]*model.VersionLog, error) {
+	log.ZDebug(ctx, "Batch find member incr version", "groupIDs", groupIDs, "versions", versions)
+	return g.member.BatchFindChangeLog(ctx, groupIDs, versions, limits)
+}
+
+func (g *GroupMemberPgsql) FindJoinIncrVersion(ctx context.Context, userID string, version uint, limit int) (*model.VersionLog, error) {
+	log.ZDebug(ctx, "find join incr version", "userID", userID, "version", version)
+	return g.join.FindChangeLog(ctx, userID, version, limit)
+}
@@ -0,0 +1,66 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgsql
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func NewGroupMemberTagPgsql(db *gorm.DB) database.GroupMemberTag {
+	return &groupMemberTagPgsql{db: db}
+}
+
+type groupMemberTagPgsql struct {
+	db *gorm.DB
+}
+
+func (g *groupMemberTagPgsql) table(ctx context.Context) *gorm.DB {
+	return conn(ctx, g.db).Table(database.GroupMemberTagName)
+}
+
+func (g *groupMemberTagPgsql) Add(ctx context.Context, tags []*model.GroupMemberTag) error {
+	if len(tags) == 0 {
+		return nil
+	}
+	return wrapErr(g.table(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(tags).Error)
+}
+
+func (g *groupMemberTagPgsql) Delete(ctx context.Context, groupID string, tag string, userIDs []string) error {
+	query := g.table(ctx).Where("group_id = ? AND tag = ?", groupID, tag)
+	if userIDs != nil {
+		if len(userIDs) == 0 {
+			return nil
+		}
+		query = query.Where("user_id IN ?", userIDs)
+	}
+	return wrapErr(query.Delete(nil).Error)
+}
+
+func (g *groupMemberTagPgsql) FindTags(ctx context.Context, groupID string) ([]string, error) {
+	return pluck[string](g.table(ctx).Distinct("tag").Where("group_id = ?", groupID).Order("tag"), "tag")
+}
+
+func (g *groupMemberTagPgsql) FindUserIDs(ctx context.Context, groupID string, tags []string) ([]string, error) {
+	return pluck[string](g.table(ctx).Distinct("user_id").Where("group_id = ? AND tag IN ?", groupID, tags), "user_id")
+}
+
+func (g *groupMemberTagPgsql) FindMemberTags(ctx context.Context, groupID string, userIDs []string) ([]*model.GroupMemberTag, error) {
+	return find[*model.GroupMemberTag](g.table(ctx).Where("group_id = ? AND user_id IN ?", groupID, userIDs))
+}
@@ -0,0 +1,88 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgsql
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/errs"
+	"gorm.io/gorm"
+)
+
+func NewGroupMuteRulePgsql(db *gorm.DB) database.GroupMuteRule {
+	return &groupMuteRulePgsql{db: db}
+}
+
+type groupMuteRulePgsql struct {
+	db *gorm.DB
+}
+
+type groupMuteSchedule struct {
+	StartMinute int32   `json:"startMinute"`
+	EndMinute   int32   `json:"endMinute"`
+	Weekdays    []int32 `json:"weekdays"`
+}
+
+type groupMuteRuleRow struct {
+	GroupID          string    `gorm:"column:group_id"`
+	SlowModeInterval int32     `gorm:"column:slow_mode_interval"`
+	TimeZone         string    `gorm:"column:time_zone"`
+	Schedules        []byte    `gorm:"column:schedules"`
+	UpdateTime       time.Time `gorm:"column:update_time"`
+}
+
+func (g *groupMuteRulePgsql) Set(ctx context.Context, rule *model.GroupMuteRule) error {
+	schedules := make([]groupMuteSchedule, 0, len(rule.Schedules))
+	for _, s := range rule.Schedules {
+		schedules = append(schedules, groupMuteSchedule{StartMinute: s.StartMinute, EndMinute: s.EndMinute, Weekdays: s.Weekdays})
+	}
+	data, err := json.Marshal(schedules)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	return wrapErr(conn(ctx, g.db).Exec(`INSERT INTO `+database.GroupMuteRuleName+` (group_id, slow_mode_interval, time_zone, schedules, update_time) VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT (group_id) DO UPDATE SET slow_mode_interval = EXCLUDED.slow_mode_interval, time_zone = EXCLUDED.time_zone,
+		schedules = EXCLUDED.schedules, update_time = EXCLUDED.update_time`,
+		rule.GroupID, rule.SlowModeInterval, rule.TimeZone, string(data), rule.UpdateTime).Error)
+}
+
+func (g *groupMuteRulePgsql) Take(ctx context.Context, groupID string) (*model.GroupMuteRule, error) {
+	row, err := takeOne[*groupMuteRuleRow](conn(ctx, g.db).Table(database.GroupMuteRuleName).Where("group_id = ?", groupID))
+	if err == nil {
+		var schedules []groupMuteSchedule
+		if err := json.Unmarshal(row.Schedules, &schedules); err != nil {
+			return nil, errs.Wrap(err)
+		}
+		rule := &model.GroupMuteRule{
+			GroupID:          row.GroupID,
+			SlowModeInterval: row.SlowModeInterval,
+			TimeZone:         row.TimeZone,
+			Schedules:        make([]model.GroupMuteSchedule, 0, len(schedules)),
+			UpdateTime:       row.UpdateTime,
+		}
+		for _, s := range schedules {
+			rule.Schedules = append(rule.Schedules, model.GroupMuteSchedule{StartMinute: s.StartMinute, EndMinute: s.EndMinute, Weekdays: s.Weekdays})
+		}
+		return rule, nil
+	} else if IsNotFound(err) {
+		return &model.GroupMuteRule{GroupID: groupID}, nil
+	} else {
+		return nil, err
+	}
+}
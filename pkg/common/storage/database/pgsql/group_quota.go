@@ -0,0 +1,56 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgsql
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"gorm.io/gorm"
+)
+
+func NewGroupQuotaPgsql(db *gorm.DB) database.GroupQuota {
+	return &groupQuotaPgsql{db: db}
+}
+
+type groupQuotaPgsql struct {
+	db *gorm.DB
+}
+
+func (g *groupQuotaPgsql) table(ctx context.Context) *gorm.DB {
+	return conn(ctx, g.db).Table(database.GroupQuotaName)
+}
+
+func (g *groupQuotaPgsql) Set(ctx context.Context, quota *model.GroupQuota) error {
+	return wrapErr(conn(ctx, g.db).Exec(`INSERT INTO `+database.GroupQuotaName+` (group_id, max_member_count, update_time) VALUES (?, ?, ?)
+		ON CONFLICT (group_id) DO UPDATE SET max_member_count = EXCLUDED.max_member_count, update_time = EXCLUDED.update_time`,
+		quota.GroupID, quota.MaxMemberCount, quota.UpdateTime).Error)
+}
+
+func (g *groupQuotaPgsql) Delete(ctx context.Context, groupID string) error {
+	return wrapErr(g.table(ctx).Where("group_id = ?", groupID).Delete(nil).Error)
+}
+
+func (g *groupQuotaPgsql) Take(ctx context.Context, groupID string) (*model.GroupQuota, error) {
+	quota, err := takeOne[*model.GroupQuota](g.table(ctx).Where("group_id = ?", groupID))
+	if err == nil {
+		return quota, nil
+	} else if IsNotFound(err) {
+		return nil, nil
+	} else {
+		return nil, err
+	}
+}
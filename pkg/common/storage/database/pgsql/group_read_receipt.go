@@ -0,0 +1,49 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgsql
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"gorm.io/gorm"
+)
+
+func NewGroupReadReceiptPgsql(db *gorm.DB) database.GroupReadReceipt {
+	return &groupReadReceiptPgsql{db: db}
+}
+
+type groupReadReceiptPgsql struct {
+	db *gorm.DB
+}
+
+func (g *groupReadReceiptPgsql) SetEnable(ctx context.Context, groupID string, enable bool) error {
+	return wrapErr(conn(ctx, g.db).Exec(`INSERT INTO `+database.GroupReadReceiptName+` (group_id, enable, update_time) VALUES (?, ?, ?)
+		ON CONFLICT (group_id) DO UPDATE SET enable = EXCLUDED.enable, update_time = EXCLUDED.update_time`,
+		groupID, enable, time.Now()).Error)
+}
+
+func (g *groupReadReceiptPgsql) IsEnabled(ctx context.Context, groupID string) (bool, error) {
+	query := conn(ctx, g.db).Table(database.GroupReadReceiptName).Select("enable").Where("group_id = ?", groupID)
+	enable, err := takeOne[bool](query)
+	if err == nil {
+		return enable, nil
+	} else if IsNotFound(err) {
+		return false, nil
+	} else {
+		return false, err
+	}
+}
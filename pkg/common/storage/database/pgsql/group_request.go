@@ -0,0 +1,83 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgsql
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+	"gorm.io/gorm"
+)
+
+func NewGroupRequestPgsql(db *gorm.DB) database.GroupRequest {
+	return &GroupRequestPgsql{db: db}
+}
+
+type GroupRequestPgsql struct {
+	db *gorm.DB
+}
+
+func (g *GroupRequestPgsql) table(ctx context.Context) *gorm.DB {
+	return conn(ctx, g.db).Table(database.GroupRequestName)
+}
+
+func (g *GroupRequestPgsql) Create(ctx context.Context, groupRequests []*model.GroupRequest) (err error) {
+	if len(groupRequests) == 0 {
+		return nil
+	}
+	return wrapErr(g.table(ctx).Create(groupRequests).Error)
+}
+
+func (g *GroupRequestPgsql) Delete(ctx context.Context, groupID string, userID string) (err error) {
+	return wrapErr(g.table(ctx).Where("group_id = ? AND user_id = ?", groupID, userID).Delete(nil).Error)
+}
+
+func (g *GroupRequestPgsql) UpdateHandler(ctx context.Context, groupID string, userID string, handledMsg string, handleResult int32) (err error) {
+	update := map[string]any{"handled_msg": handledMsg, "handle_result": handleResult}
+	return updateOne(g.table(ctx).Where("group_id = ? AND user_id = ?", groupID, userID), update, true)
+}
+
+func (g *GroupRequestPgsql) Take(ctx context.Context, groupID string, userID string) (groupRequest *model.GroupRequest, err error) {
+	return takeOne[*model.GroupRequest](g.table(ctx).Where("group_id = ? AND user_id = ?", groupID, userID))
+}
+
+func (g *GroupRequestPgsql) FindGroupRequests(ctx context.Context, groupID string, userIDs []string) ([]*model.GroupRequest, error) {
+	return find[*model.GroupRequest](g.table(ctx).Where("group_id = ? AND user_id IN ?", groupID, userIDs))
+}
+
+func (g *GroupRequestPgsql) Page(ctx context.Context, userID string, pagination pagination.Pagination) (total int64, groups []*model.GroupRequest, err error) {
+	return findPage[*model.GroupRequest](g.table(ctx).Where("user_id = ?", userID), pagination, "id")
+}
+
+func (g *GroupRequestPgsql) PageGroup(ctx context.Context, groupIDs []string, pagination pagination.Pagination) (total int64, groups []*model.GroupRequest, err error) {
+	return findPage[*model.GroupRequest](g.table(ctx).Where("group_id IN ?", groupIDs), pagination, "id")
+}
+
+func (g *GroupRequestPgsql) FindExpired(ctx context.Context, before time.Time, limit int) ([]*model.GroupRequest, error) {
+	return find[*model.GroupRequest](g.table(ctx).Where("handle_result = 0 AND req_time < ?", before).Order("req_time").Limit(limit))
+}
+
+func (g *GroupRequestPgsql) Expire(ctx context.Context, groupID string, userID string, before time.Time, now time.Time) (bool, error) {
+	res := g.table(ctx).
+		Where("group_id = ? AND user_id = ? AND handle_result = 0 AND req_time < ?", groupID, userID, before).
+		Updates(map[string]any{"handle_result": model.GroupRequestExpired, "handled_time": now})
+	if res.Error != nil {
+		return false, wrapErr(res.Error)
+	}
+	return res.RowsAffected > 0, nil
+}
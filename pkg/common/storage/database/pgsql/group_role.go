@@ -0,0 +1,81 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgsql
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/errs"
+	"gorm.io/gorm"
+)
+
+func NewGroupRolePgsql(db *gorm.DB) database.GroupRole {
+	return &groupRolePgsql{db: db}
+}
+
+type groupRolePgsql struct {
+	db *gorm.DB
+}
+
+type groupRole struct {
+	RoleID      string    `json:"roleID"`
+	Name        string    `json:"name"`
+	Permissions int64     `json:"permissions"`
+	Ex          string    `json:"ex"`
+	CreateTime  time.Time `json:"createTime"`
+}
+
+type groupRoleRow struct {
+	GroupID    string    `gorm:"column:group_id"`
+	Roles      []byte    `gorm:"column:roles"`
+	UpdateTime time.Time `gorm:"column:update_time"`
+}
+
+func (g *groupRolePgsql) Set(ctx context.Context, roles *model.GroupRoles) error {
+	list := make([]groupRole, 0, len(roles.Roles))
+	for _, role := range roles.Roles {
+		list = append(list, groupRole{RoleID: role.RoleID, Name: role.Name, Permissions: role.Permissions, Ex: role.Ex, CreateTime: role.CreateTime})
+	}
+	data, err := json.Marshal(list)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	return wrapErr(conn(ctx, g.db).Exec(`INSERT INTO `+database.GroupRoleName+` (group_id, roles, update_time) VALUES (?, ?, ?)
+		ON CONFLICT (group_id) DO UPDATE SET roles = EXCLUDED.roles, update_time = EXCLUDED.update_time`,
+		roles.GroupID, string(data), roles.UpdateTime).Error)
+}
+
+func (g *groupRolePgsql) Take(ctx context.Context, groupID string) (*model.GroupRoles, error) {
+	row, err := takeOne[*groupRoleRow](conn(ctx, g.db).Table(database.GroupRoleName).Where("group_id = ?", groupID))
+	if err == nil {
+		var list []groupRole
+		if err := json.Unmarshal(row.Roles, &list); err != nil {
+			return nil, errs.Wrap(err)
+		}
+		roles := &model.GroupRoles{GroupID: row.GroupID, Roles: make([]*model.GroupRole, 0, len(list)), UpdateTime: row.UpdateTime}
+		for _, role := range list {
+			roles.Roles = append(roles.Roles, &model.GroupRole{RoleID: role.RoleID, Name: role.Name, Permissions: role.Permissions, Ex: role.Ex, CreateTime: role.CreateTime})
+		}
+		return roles, nil
+	} else if IsNotFound(err) {
+		return &model.GroupRoles{GroupID: groupID}, nil
+	} else {
+		return nil, err
+	}
+}
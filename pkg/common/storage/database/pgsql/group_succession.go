@@ -0,0 +1,56 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgsql
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"gorm.io/gorm"
+)
+
+func NewGroupSuccessionPgsql(db *gorm.DB) database.GroupSuccession {
+	return &groupSuccessionPgsql{db: db}
+}
+
+type groupSuccessionPgsql struct {
+	db *gorm.DB
+}
+
+func (g *groupSuccessionPgsql) table(ctx context.Context) *gorm.DB {
+	return conn(ctx, g.db).Table(database.GroupSuccessionName)
+}
+
+func (g *groupSuccessionPgsql) Set(ctx context.Context, succession *model.GroupSuccession) error {
+	return wrapErr(conn(ctx, g.db).Exec(`INSERT INTO `+database.GroupSuccessionName+` (group_id, policy, successor_user_id, update_time) VALUES (?, ?, ?, ?)
+		ON CONFLICT (group_id) DO UPDATE SET policy = EXCLUDED.policy, successor_user_id = EXCLUDED.successor_user_id, update_time = EXCLUDED.update_time`,
+		succession.GroupID, succession.Policy, succession.SuccessorUserID, succession.UpdateTime).Error)
+}
+
+func (g *groupSuccessionPgsql) Delete(ctx context.Context, groupID string) error {
+	return wrapErr(g.table(ctx).Where("group_id = ?", groupID).Delete(nil).Error)
+}
+
+func (g *groupSuccessionPgsql) Take(ctx context.Context, groupID string) (*model.GroupSuccession, error) {
+	succession, err := takeOne[*model.GroupSuccession](g.table(ctx).Where("group_id = ?", groupID))
+	if err == nil {
+		return succession, nil
+	} else if IsNotFound(err) {
+		return nil, nil
+	} else {
+		return nil, err
+	}
+}
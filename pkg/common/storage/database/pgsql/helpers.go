@@ -0,0 +1,160 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgsql
+
+import (
+	"errors"
+
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/mongo"
+	"gorm.io/gorm"
+)
+
+// duplicateKeyCode is the MongoDB duplicate key error code, kept so callers can keep
+// detecting conflicts with mongo.IsDuplicateKeyError.
+const duplicateKeyCode = 11000
+
+// wrapErr reports missing rows as mongo.ErrNoDocuments and unique violations as Mongo
+// duplicate key errors, the errors the controllers and services already handle.
+func wrapErr(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return errs.Wrap(mongo.ErrNoDocuments)
+	case errors.Is(err, gorm.ErrDuplicatedKey):
+		return errs.Wrap(mongo.WriteException{
+			WriteErrors: mongo.WriteErrors{{Code: duplicateKeyCode, Message: err.Error()}},
+		})
+	default:
+		return errs.Wrap(err)
+	}
+}
+
+func IsNotFound(err error) bool {
+	return errs.Unwrap(err) == mongo.ErrNoDocuments
+}
+
+// updateOne applies update to the rows of query. With notMatchedErr set, matching no
+// row returns mongo.ErrNoDocuments like mongoutil.UpdateOne.
+func updateOne(query *gorm.DB, update map[string]any, notMatchedErr bool) error {
+	res := query.Updates(update)
+	if res.Error != nil {
+		return wrapErr(res.Error)
+	}
+	if notMatchedErr && res.RowsAffected == 0 {
+		return errs.Wrap(mongo.ErrNoDocuments)
+	}
+	return nil
+}
+
+func takeOne[T any](query *gorm.DB) (T, error) {
+	var res T
+	if err := query.Take(&res).Error; err != nil {
+		return res, wrapErr(err)
+	}
+	return res, nil
+}
+
+func find[T any](query *gorm.DB) ([]T, error) {
+	var res []T
+	if err := query.Find(&res).Error; err != nil {
+		return nil, wrapErr(err)
+	}
+	return res, nil
+}
+
+func pluck[T any](query *gorm.DB, column string) ([]T, error) {
+	var res []T
+	if err := query.Pluck(column, &res).Error; err != nil {
+		return nil, wrapErr(err)
+	}
+	return res, nil
+}
+
+func countRows(query *gorm.DB) (int64, error) {
+	var n int64
+	if err := query.Count(&n).Error; err != nil {
+		return 0, wrapErr(err)
+	}
+	return n, nil
+}
+
+// findPage counts the rows of query and returns one page of them, with the same
+// semantics as mongoutil.FindPage.
+func findPage[T any](query *gorm.DB, pagination pagination.Pagination, order ...string) (int64, []T, error) {
+	query = query.Session(&gorm.Session{})
+	total, err := countRows(query)
+	if err != nil {
+		return 0, nil, err
+	}
+	if total == 0 || pagination == nil {
+		return total, nil, nil
+	}
+	skip := int64(pagination.GetPageNumber()-1) * int64(pagination.GetShowNumber())
+	if skip < 0 || skip >= total || pagination.GetShowNumber() <= 0 {
+		return total, nil, nil
+	}
+	for _, o := range order {
+		query = query.Order(o)
+	}
+	res, err := find[T](query.Offset(int(skip)).Limit(int(pagination.GetShowNumber())))
+	if err != nil {
+		return 0, nil, err
+	}
+	return total, res, nil
+}
+
+// findPageOnly returns one page of query without counting, like mongoutil.FindPageOnly.
+func findPageOnly[T any](query *gorm.DB, pagination pagination.Pagination, order ...string) ([]T, error) {
+	skip := int64(pagination.GetPageNumber()-1) * int64(pagination.GetShowNumber())
+	if skip < 0 || pagination.GetShowNumber() <= 0 {
+		return nil, nil
+	}
+	for _, o := range order {
+		query = query.Order(o)
+	}
+	return find[T](query.Offset(int(skip)).Limit(int(pagination.GetShowNumber())))
+}
+
+// incrVersion runs the write and then the version log updates, stopping at the first error.
+func incrVersion(fns ...func() error) error {
+	for _, fn := range fns {
+		if err := fn(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dailyCount groups the rows of query by the UTC day of column.
+func dailyCount(query *gorm.DB, column string) (map[string]int64, error) {
+	type Item struct {
+		Date  string
+		Count int64
+	}
+	var items []Item
+	err := query.Select("to_char(" + column + " AT TIME ZONE 'UTC', 'YYYY-MM-DD') AS date, COUNT(*) AS count").
+		Group("date").Scan(&items).Error
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+	res := make(map[string]int64, len(items))
+	for _, item := range items {
+		res[item.Date] = item.Count
+	}
+	return res, nil
+}
@@ -0,0 +1,68 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgsql
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+	"gorm.io/gorm"
+)
+
+func NewLogPgsql(db *gorm.DB) database.Log {
+	return &LogPgsql{db: db}
+}
+
+type LogPgsql struct {
+	db *gorm.DB
+}
+
+func (l *LogPgsql) table(ctx context.Context) *gorm.DB {
+	return conn(ctx, l.db).Table(database.LogName)
+}
+
+func (l *LogPgsql) Create(ctx context.Context, log []*model.Log) error {
+	if len(log) == 0 {
+		return nil
+	}
+	return wrapErr(l.table(ctx).Create(log).Error)
+}
+
+func (l *LogPgsql) Search(ctx context.Context, keyword string, start time.Time, end time.Time, pagination pagination.Pagination) (int64, []*model.Log, error) {
+	query := l.table(ctx).Where("create_time >= ? AND create_time <= ?", start, end)
+	if keyword != "" {
+		query = query.Where("user_id ~ ?", keyword)
+	}
+	return findPage[*model.Log](query, pagination, "create_time DESC")
+}
+
+func (l *LogPgsql) Delete(ctx context.Context, logID []string, userID string) error {
+	query := l.table(ctx).Where("log_id IN ?", logID)
+	if userID != "" {
+		query = query.Where("user_id = ?", userID)
+	}
+	return wrapErr(query.Delete(nil).Error)
+}
+
+func (l *LogPgsql) Get(ctx context.Context, logIDs []string, userID string) ([]*model.Log, error) {
+	query := l.table(ctx).Where("log_id IN ?", logIDs)
+	if userID != "" {
+		query = query.Where("user_id = ?", userID)
+	}
+	return find[*model.Log](query)
+}
@@ -0,0 +1,117 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgsql
+
+import (
+	"context"
+	"embed"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"gorm.io/gorm"
+)
+
+//go:embed migrations/*.sql
+var migrationFS embed.FS
+
+// migrationLockID is the advisory lock held while migrating, so services starting
+// together apply each migration once.
+const migrationLockID = 0x6f70656e696d
+
+type migration struct {
+	version int
+	name    string
+	sql     string
+}
+
+// loadMigrations reads the embedded files named <version>_<name>.sql, ordered by version.
+func loadMigrations() ([]migration, error) {
+	entries, err := migrationFS.ReadDir("migrations")
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	migrations := make([]migration, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		prefix, _, ok := strings.Cut(name, "_")
+		if !ok {
+			return nil, errs.New("invalid migration file name", "name", name).Wrap()
+		}
+		version, err := strconv.Atoi(prefix)
+		if err != nil {
+			return nil, errs.WrapMsg(err, "invalid migration version", "name", name)
+		}
+		data, err := migrationFS.ReadFile(path.Join("migrations", name))
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		migrations = append(migrations, migration{version: version, name: name, sql: string(data)})
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].version < migrations[j].version
+	})
+	for i := 1; i < len(migrations); i++ {
+		if migrations[i].version == migrations[i-1].version {
+			return nil, errs.New("duplicate migration version", "name", migrations[i].name).Wrap()
+		}
+	}
+	return migrations, nil
+}
+
+// migrate applies the migrations newer than the version recorded in schema_migrations,
+// each in its own transaction.
+func migrate(ctx context.Context, db *gorm.DB) error {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return err
+	}
+	return db.WithContext(ctx).Connection(func(c *gorm.DB) error {
+		if err := c.Exec("SELECT pg_advisory_lock(?)", migrationLockID).Error; err != nil {
+			return errs.WrapMsg(err, "lock schema migrations failed")
+		}
+		defer c.Exec("SELECT pg_advisory_unlock(?)", migrationLockID)
+		if err := c.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+			version integer PRIMARY KEY,
+			name text NOT NULL,
+			applied_at timestamptz NOT NULL DEFAULT now()
+		)`).Error; err != nil {
+			return errs.WrapMsg(err, "create schema_migrations failed")
+		}
+		var current int
+		if err := c.Raw("SELECT COALESCE(MAX(version), 0) FROM schema_migrations").Scan(&current).Error; err != nil {
+			return errs.Wrap(err)
+		}
+		for _, m := range migrations {
+			if m.version <= current {
+				continue
+			}
+			err := c.Transaction(func(tx *gorm.DB) error {
+				if err := tx.Exec(m.sql).Error; err != nil {
+					return err
+				}
+				return tx.Exec("INSERT INTO schema_migrations (version, name) VALUES (?, ?)", m.version, m.name).Error
+			})
+			if err != nil {
+				return errs.WrapMsg(err, "apply migration failed", "name", m.name)
+			}
+			log.ZInfo(ctx, "postgres migration applied", "name", m.name)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,307 @@
+-- Tables mirror the MongoDB collections of the same name. Every table has a serial id,
+-- so results keep insertion order where the Mongo implementation sorted by _id.
+
+CREATE TABLE "user" (
+    id                  bigserial PRIMARY KEY,
+    user_id             text        NOT NULL UNIQUE,
+    nickname            text        NOT NULL DEFAULT '',
+    face_url            text        NOT NULL DEFAULT '',
+    ex                  text        NOT NULL DEFAULT '',
+    app_manger_level    integer     NOT NULL DEFAULT 0,
+    global_recv_msg_opt integer     NOT NULL DEFAULT 0,
+    create_time         timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX user_create_time_idx ON "user" (create_time);
+
+CREATE TABLE user_command (
+    id          bigserial PRIMARY KEY,
+    user_id     text    NOT NULL,
+    type        integer NOT NULL,
+    uuid        text    NOT NULL,
+    value       text    NOT NULL DEFAULT '',
+    ex          text    NOT NULL DEFAULT '',
+    create_time bigint  NOT NULL
+);
+CREATE INDEX user_command_user_id_idx ON user_command (user_id, type);
+
+CREATE TABLE "group" (
+    id                       bigserial PRIMARY KEY,
+    group_id                 text        NOT NULL UNIQUE,
+    group_name               text        NOT NULL DEFAULT '',
+    notification             text        NOT NULL DEFAULT '',
+    introduction             text        NOT NULL DEFAULT '',
+    face_url                 text        NOT NULL DEFAULT '',
+    create_time              timestamptz NOT NULL DEFAULT now(),
+    ex                       text        NOT NULL DEFAULT '',
+    status                   integer     NOT NULL DEFAULT 0,
+    creator_user_id          text        NOT NULL DEFAULT '',
+    group_type               integer     NOT NULL DEFAULT 0,
+    need_verification        integer     NOT NULL DEFAULT 0,
+    look_member_info         integer     NOT NULL DEFAULT 0,
+    apply_member_friend      integer     NOT NULL DEFAULT 0,
+    notification_update_time timestamptz NOT NULL DEFAULT now(),
+    notification_user_id     text        NOT NULL DEFAULT ''
+);
+CREATE INDEX group_create_time_idx ON "group" (create_time);
+
+CREATE TABLE group_member (
+    id               bigserial PRIMARY KEY,
+    group_id         text        NOT NULL,
+    user_id          text        NOT NULL,
+    nickname         text        NOT NULL DEFAULT '',
+    face_url         text        NOT NULL DEFAULT '',
+    role_level       integer     NOT NULL DEFAULT 0,
+    join_time        timestamptz NOT NULL DEFAULT now(),
+    join_source      integer     NOT NULL DEFAULT 0,
+    inviter_user_id  text        NOT NULL DEFAULT '',
+    operator_user_id text        NOT NULL DEFAULT '',
+    mute_end_time    timestamptz NOT NULL DEFAULT now(),
+    ex               text        NOT NULL DEFAULT '',
+    UNIQUE (group_id, user_id)
+);
+CREATE INDEX group_member_user_id_idx ON group_member (user_id);
+
+CREATE TABLE group_request (
+    id              bigserial PRIMARY KEY,
+    user_id         text        NOT NULL,
+    group_id        text        NOT NULL,
+    handle_result   integer     NOT NULL DEFAULT 0,
+    req_msg         text        NOT NULL DEFAULT '',
+    handled_msg     text        NOT NULL DEFAULT '',
+    req_time        timestamptz NOT NULL DEFAULT now(),
+    handle_user_id  text        NOT NULL DEFAULT '',
+    handled_time    timestamptz NOT NULL DEFAULT now(),
+    join_source     integer     NOT NULL DEFAULT 0,
+    inviter_user_id text        NOT NULL DEFAULT '',
+    ex              text        NOT NULL DEFAULT '',
+    UNIQUE (group_id, user_id)
+);
+CREATE INDEX group_request_user_id_idx ON group_request (user_id);
+
+-- id is the hex ObjectID returned to clients, it also orders friends by creation.
+CREATE TABLE friend (
+    id               char(24) PRIMARY KEY,
+    owner_user_id    text        NOT NULL,
+    friend_user_id   text        NOT NULL,
+    remark           text        NOT NULL DEFAULT '',
+    create_time      timestamptz NOT NULL DEFAULT now(),
+    add_source       integer     NOT NULL DEFAULT 0,
+    operator_user_id text        NOT NULL DEFAULT '',
+    ex               text        NOT NULL DEFAULT '',
+    is_pinned        boolean     NOT NULL DEFAULT false,
+    UNIQUE (owner_user_id, friend_user_id)
+);
+CREATE INDEX friend_friend_user_id_idx ON friend (friend_user_id);
+
+CREATE TABLE friend_request (
+    id              bigserial PRIMARY KEY,
+    from_user_id    text        NOT NULL,
+    to_user_id      text        NOT NULL,
+    handle_result   integer     NOT NULL DEFAULT 0,
+    req_msg         text        NOT NULL DEFAULT '',
+    create_time     timestamptz NOT NULL DEFAULT now(),
+    handler_user_id text        NOT NULL DEFAULT '',
+    handle_msg      text        NOT NULL DEFAULT '',
+    handle_time     timestamptz NOT NULL DEFAULT now(),
+    ex              text        NOT NULL DEFAULT '',
+    UNIQUE (from_user_id, to_user_id)
+);
+CREATE INDEX friend_request_to_user_id_idx ON friend_request (to_user_id);
+
+CREATE TABLE black (
+    id               bigserial PRIMARY KEY,
+    owner_user_id    text        NOT NULL,
+    block_user_id    text        NOT NULL,
+    create_time      timestamptz NOT NULL DEFAULT now(),
+    add_source       integer     NOT NULL DEFAULT 0,
+    operator_user_id text        NOT NULL DEFAULT '',
+    ex               text        NOT NULL DEFAULT '',
+    UNIQUE (owner_user_id, block_user_id)
+);
+
+CREATE TABLE conversation (
+    id                       bigserial PRIMARY KEY,
+    owner_user_id            text        NOT NULL,
+    conversation_id          text        NOT NULL,
+    conversation_type        integer     NOT NULL DEFAULT 0,
+    user_id                  text        NOT NULL DEFAULT '',
+    group_id                 text        NOT NULL DEFAULT '',
+    recv_msg_opt             integer     NOT NULL DEFAULT 0,
+    is_pinned                boolean     NOT NULL DEFAULT false,
+    is_private_chat          boolean     NOT NULL DEFAULT false,
+    burn_duration            integer     NOT NULL DEFAULT 0,
+    group_at_type            integer     NOT NULL DEFAULT 0,
+    attached_info            text        NOT NULL DEFAULT '',
+    ex                       text        NOT NULL DEFAULT '',
+    max_seq                  bigint      NOT NULL DEFAULT 0,
+    min_seq                  bigint      NOT NULL DEFAULT 0,
+    create_time              timestamptz NOT NULL DEFAULT now(),
+    is_msg_destruct          boolean     NOT NULL DEFAULT false,
+    msg_destruct_time        bigint      NOT NULL DEFAULT 0,
+    latest_msg_destruct_time timestamptz,
+    UNIQUE (owner_user_id, conversation_id)
+);
+CREATE INDEX conversation_conversation_id_idx ON conversation (conversation_id);
+
+CREATE TABLE log (
+    id          bigserial PRIMARY KEY,
+    log_id      text        NOT NULL UNIQUE,
+    platform    text        NOT NULL DEFAULT '',
+    user_id     text        NOT NULL DEFAULT '',
+    create_time timestamptz NOT NULL DEFAULT now(),
+    url         text        NOT NULL DEFAULT '',
+    file_name   text        NOT NULL DEFAULT '',
+    system_type text        NOT NULL DEFAULT '',
+    version     text        NOT NULL DEFAULT '',
+    ex          text        NOT NULL DEFAULT ''
+);
+CREATE INDEX log_user_id_idx ON log (user_id);
+CREATE INDEX log_create_time_idx ON log (create_time DESC);
+
+CREATE TABLE s3 (
+    id           bigserial PRIMARY KEY,
+    name         text        NOT NULL UNIQUE,
+    user_id      text        NOT NULL DEFAULT '',
+    hash         text        NOT NULL DEFAULT '',
+    engine       text        NOT NULL DEFAULT '',
+    key          text        NOT NULL DEFAULT '',
+    size         bigint      NOT NULL DEFAULT 0,
+    content_type text        NOT NULL DEFAULT '',
+    "group"      text        NOT NULL DEFAULT '',
+    create_time  timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX s3_key_idx ON s3 (key);
+CREATE INDEX s3_create_time_idx ON s3 (create_time);
+
+CREATE TABLE seq (
+    conversation_id text PRIMARY KEY,
+    max_seq         bigint NOT NULL DEFAULT 0,
+    min_seq         bigint NOT NULL DEFAULT 0
+);
+
+CREATE TABLE seq_user (
+    user_id         text   NOT NULL,
+    conversation_id text   NOT NULL,
+    min_seq         bigint NOT NULL DEFAULT 0,
+    max_seq         bigint NOT NULL DEFAULT 0,
+    read_seq        bigint NOT NULL DEFAULT 0,
+    PRIMARY KEY (user_id, conversation_id)
+);
+CREATE INDEX seq_user_conversation_id_idx ON seq_user (conversation_id);
+
+CREATE TABLE group_read_receipt (
+    group_id    text PRIMARY KEY,
+    enable      boolean     NOT NULL DEFAULT false,
+    update_time timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE TABLE group_sensitive_word (
+    group_id    text PRIMARY KEY,
+    words       jsonb       NOT NULL DEFAULT '[]',
+    action      text        NOT NULL DEFAULT '',
+    update_time timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE TABLE moderation_review (
+    id              bigserial PRIMARY KEY,
+    review_id       text        NOT NULL UNIQUE,
+    conversation_id text        NOT NULL DEFAULT '',
+    client_msg_id   text        NOT NULL DEFAULT '',
+    server_msg_id   text        NOT NULL DEFAULT '',
+    send_id         text        NOT NULL DEFAULT '',
+    recv_id         text        NOT NULL DEFAULT '',
+    group_id        text        NOT NULL DEFAULT '',
+    session_type    integer     NOT NULL DEFAULT 0,
+    content_type    integer     NOT NULL DEFAULT 0,
+    content         text        NOT NULL DEFAULT '',
+    send_time       bigint      NOT NULL DEFAULT 0,
+    verdicts        jsonb       NOT NULL DEFAULT '[]',
+    status          integer     NOT NULL DEFAULT 0,
+    reviewer        text        NOT NULL DEFAULT '',
+    create_time     timestamptz NOT NULL DEFAULT now(),
+    review_time     timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX moderation_review_status_idx ON moderation_review (status, create_time DESC);
+
+CREATE TABLE msg_archive (
+    id              bigserial PRIMARY KEY,
+    doc_id          text        NOT NULL UNIQUE,
+    conversation_id text        NOT NULL,
+    engine          text        NOT NULL DEFAULT '',
+    key             text        NOT NULL DEFAULT '',
+    size            bigint      NOT NULL DEFAULT 0,
+    max_seq         bigint      NOT NULL DEFAULT 0,
+    min_send_time   bigint      NOT NULL DEFAULT 0,
+    max_send_time   bigint      NOT NULL DEFAULT 0,
+    archive_time    timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX msg_archive_max_send_time_idx ON msg_archive (max_send_time);
+
+-- A message document holds 100 consecutive seqs of a conversation, one msg row per slot.
+CREATE TABLE msg_doc (
+    id              bigserial PRIMARY KEY,
+    doc_id          text NOT NULL UNIQUE,
+    conversation_id text NOT NULL
+);
+CREATE INDEX msg_doc_conversation_id_idx ON msg_doc (conversation_id, id);
+
+-- msg and revoke hold the documents with the field names used in MongoDB; the generated
+-- columns expose the fields that are searched and aggregated. msg is null for empty slots.
+CREATE TABLE msg (
+    doc_id       text    NOT NULL REFERENCES msg_doc (doc_id) ON DELETE CASCADE,
+    idx          integer NOT NULL,
+    msg          jsonb,
+    revoke       jsonb,
+    del_list     jsonb   NOT NULL DEFAULT '[]',
+    is_read      boolean NOT NULL DEFAULT false,
+    seq          bigint GENERATED ALWAYS AS ((msg ->> 'seq')::bigint) STORED,
+    send_id      text GENERATED ALWAYS AS (msg ->> 'send_id') STORED,
+    recv_id      text GENERATED ALWAYS AS (msg ->> 'recv_id') STORED,
+    group_id     text GENERATED ALWAYS AS (msg ->> 'group_id') STORED,
+    session_type integer GENERATED ALWAYS AS ((msg ->> 'session_type')::integer) STORED,
+    content_type integer GENERATED ALWAYS AS ((msg ->> 'content_type')::integer) STORED,
+    send_time    bigint GENERATED ALWAYS AS ((msg ->> 'send_time')::bigint) STORED,
+    PRIMARY KEY (doc_id, idx)
+);
+CREATE INDEX msg_send_time_idx ON msg (send_time);
+CREATE INDEX msg_send_id_idx ON msg (send_id, send_time);
+CREATE INDEX msg_recv_id_idx ON msg (recv_id, send_time);
+CREATE INDEX msg_group_id_idx ON msg (group_id, send_time);
+
+-- Version logs keep one header row per document and one row per changed element.
+CREATE TABLE friend_version (
+    id          char(24) PRIMARY KEY,
+    d_id        text        NOT NULL UNIQUE,
+    version     bigint      NOT NULL,
+    deleted     bigint      NOT NULL DEFAULT 0,
+    last_update timestamptz NOT NULL
+);
+CREATE INDEX friend_version_last_update_idx ON friend_version (last_update);
+CREATE TABLE friend_version_log (
+    id          bigserial PRIMARY KEY,
+    d_id        text        NOT NULL REFERENCES friend_version (d_id) ON DELETE CASCADE,
+    e_id        text        NOT NULL,
+    state       integer     NOT NULL,
+    version     bigint      NOT NULL,
+    last_update timestamptz NOT NULL,
+    UNIQUE (d_id, e_id)
+);
+CREATE INDEX friend_version_log_version_idx ON friend_version_log (d_id, version);
+
+CREATE TABLE group_member_version (LIKE friend_version INCLUDING ALL);
+CREATE TABLE group_member_version_log (
+    LIKE friend_version_log INCLUDING ALL,
+    FOREIGN KEY (d_id) REFERENCES group_member_version (d_id) ON DELETE CASCADE
+);
+
+CREATE TABLE group_join_version (LIKE friend_version INCLUDING ALL);
+CREATE TABLE group_join_version_log (
+    LIKE friend_version_log INCLUDING ALL,
+    FOREIGN KEY (d_id) REFERENCES group_join_version (d_id) ON DELETE CASCADE
+);
+
+CREATE TABLE conversation_version (LIKE friend_version INCLUDING ALL);
+CREATE TABLE conversation_version_log (
+    LIKE friend_version_log INCLUDING ALL,
+    FOREIGN KEY (d_id) REFERENCES conversation_version (d_id) ON DELETE CASCADE
+);
@@ -0,0 +1,17 @@
+CREATE TABLE user_job (
+    id               bigserial PRIMARY KEY,
+    job_id           text        NOT NULL UNIQUE,
+    type             text        NOT NULL,
+    user_id          text        NOT NULL,
+    operator_user_id text        NOT NULL DEFAULT '',
+    status           integer     NOT NULL DEFAULT 0,
+    steps            jsonb       NOT NULL DEFAULT '[]',
+    result           text        NOT NULL DEFAULT '',
+    error            text        NOT NULL DEFAULT '',
+    create_time      timestamptz NOT NULL DEFAULT now(),
+    update_time      timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX user_job_user_id_idx ON user_job (user_id, type, create_time DESC);
+CREATE INDEX user_job_status_idx ON user_job (type, status, create_time);
+
+CREATE INDEX s3_user_id_idx ON s3 (user_id);
@@ -0,0 +1 @@
+CREATE INDEX black_block_user_id_idx ON black (block_user_id);
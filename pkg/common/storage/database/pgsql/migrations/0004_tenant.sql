@@ -0,0 +1,14 @@
+-- Tenants live in the public schema, the data of each tenant in a schema of its own.
+CREATE TABLE IF NOT EXISTS public.tenant (
+    id             bigserial PRIMARY KEY,
+    tenant_id      text        NOT NULL UNIQUE,
+    name           text        NOT NULL DEFAULT '',
+    secret         text        NOT NULL,
+    admin_user_ids jsonb       NOT NULL DEFAULT '[]',
+    webhook_url    text        NOT NULL DEFAULT '',
+    notification   text        NOT NULL DEFAULT '',
+    status         integer     NOT NULL DEFAULT 0,
+    ex             text        NOT NULL DEFAULT '',
+    create_time    timestamptz NOT NULL DEFAULT now(),
+    update_time    timestamptz NOT NULL DEFAULT now()
+);
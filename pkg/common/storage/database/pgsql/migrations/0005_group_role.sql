@@ -0,0 +1,9 @@
+CREATE TABLE group_role (
+    group_id    text PRIMARY KEY,
+    roles       jsonb       NOT NULL DEFAULT '[]',
+    update_time timestamptz NOT NULL DEFAULT now()
+);
+
+ALTER TABLE group_member ADD COLUMN role_id text NOT NULL DEFAULT '';
+
+CREATE INDEX group_member_role_id_idx ON group_member (group_id, role_id);
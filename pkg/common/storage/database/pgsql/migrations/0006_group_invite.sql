@@ -0,0 +1,26 @@
+CREATE TABLE group_invite_link (
+    id              bigserial PRIMARY KEY,
+    token           text        NOT NULL UNIQUE,
+    group_id        text        NOT NULL,
+    creator_user_id text        NOT NULL DEFAULT '',
+    expire_time     timestamptz NOT NULL,
+    max_uses        integer     NOT NULL DEFAULT 0,
+    used_count      integer     NOT NULL DEFAULT 0,
+    approval        integer     NOT NULL DEFAULT 0,
+    join_source     integer     NOT NULL DEFAULT 0,
+    revoked         boolean     NOT NULL DEFAULT false,
+    ex              text        NOT NULL DEFAULT '',
+    create_time     timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX group_invite_link_group_id_idx ON group_invite_link (group_id, create_time DESC);
+
+CREATE TABLE group_invite_redemption (
+    id          bigserial PRIMARY KEY,
+    token       text        NOT NULL,
+    group_id    text        NOT NULL,
+    user_id     text        NOT NULL,
+    join_source integer     NOT NULL DEFAULT 0,
+    result      integer     NOT NULL DEFAULT 0,
+    create_time timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX group_invite_redemption_group_id_idx ON group_invite_redemption (group_id, token, id DESC);
@@ -0,0 +1,7 @@
+CREATE TABLE group_mute_rule (
+    group_id           text PRIMARY KEY,
+    slow_mode_interval integer     NOT NULL DEFAULT 0,
+    time_zone          text        NOT NULL DEFAULT '',
+    schedules          jsonb       NOT NULL DEFAULT '[]',
+    update_time        timestamptz NOT NULL DEFAULT now()
+);
@@ -0,0 +1,7 @@
+CREATE TABLE channel_subscriber (
+    group_id  text        NOT NULL,
+    shard     integer     NOT NULL,
+    user_id   text        NOT NULL,
+    join_time timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (group_id, shard, user_id)
+);
@@ -0,0 +1,37 @@
+CREATE TABLE community (
+    id                    bigserial PRIMARY KEY,
+    community_id          text        NOT NULL UNIQUE,
+    name                  text        NOT NULL DEFAULT '',
+    face_url              text        NOT NULL DEFAULT '',
+    introduction          text        NOT NULL DEFAULT '',
+    owner_user_id         text        NOT NULL,
+    announcement_group_id text        NOT NULL DEFAULT '',
+    creator_user_id       text        NOT NULL DEFAULT '',
+    ex                    text        NOT NULL DEFAULT '',
+    create_time           timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE TABLE community_member (
+    id              bigserial PRIMARY KEY,
+    community_id    text        NOT NULL,
+    user_id         text        NOT NULL,
+    role_level      integer     NOT NULL DEFAULT 0,
+    inviter_user_id text        NOT NULL DEFAULT '',
+    join_time       timestamptz NOT NULL DEFAULT now(),
+    UNIQUE (community_id, user_id)
+);
+CREATE INDEX community_member_user_id_idx ON community_member (user_id);
+
+CREATE TABLE community_group (
+    id           bigserial PRIMARY KEY,
+    community_id text        NOT NULL,
+    group_id     text        NOT NULL UNIQUE,
+    create_time  timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX community_group_community_id_idx ON community_group (community_id, create_time);
+
+CREATE TABLE community_join_version (LIKE friend_version INCLUDING ALL);
+CREATE TABLE community_join_version_log (
+    LIKE friend_version_log INCLUDING ALL,
+    FOREIGN KEY (d_id) REFERENCES community_join_version (d_id) ON DELETE CASCADE
+);
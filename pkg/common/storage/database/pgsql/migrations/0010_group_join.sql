@@ -0,0 +1,15 @@
+CREATE TABLE group_join_question (
+    group_id    text PRIMARY KEY,
+    questions   jsonb       NOT NULL DEFAULT '[]',
+    update_time timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE TABLE group_request_answer (
+    group_id    text        NOT NULL,
+    user_id     text        NOT NULL,
+    answers     jsonb       NOT NULL DEFAULT '[]',
+    create_time timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (group_id, user_id)
+);
+
+CREATE INDEX group_request_pending_idx ON group_request (req_time) WHERE handle_result = 0;
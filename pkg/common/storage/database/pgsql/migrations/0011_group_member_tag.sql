@@ -0,0 +1,8 @@
+CREATE TABLE group_member_tag (
+    group_id    text        NOT NULL,
+    tag         text        NOT NULL,
+    user_id     text        NOT NULL,
+    create_time timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (group_id, tag, user_id)
+);
+CREATE INDEX group_member_tag_user_id_idx ON group_member_tag (group_id, user_id);
@@ -0,0 +1,5 @@
+CREATE TABLE group_quota (
+    group_id         text PRIMARY KEY,
+    max_member_count integer     NOT NULL DEFAULT 0,
+    update_time      timestamptz NOT NULL DEFAULT now()
+);
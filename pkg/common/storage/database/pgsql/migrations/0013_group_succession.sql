@@ -0,0 +1,12 @@
+CREATE TABLE group_succession (
+    group_id          text PRIMARY KEY,
+    policy            integer     NOT NULL DEFAULT 0,
+    successor_user_id text        NOT NULL DEFAULT '',
+    update_time       timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE TABLE user_activity (
+    user_id     text PRIMARY KEY,
+    active_time timestamptz NOT NULL
+);
+CREATE INDEX user_activity_active_time_idx ON user_activity (active_time);
@@ -0,0 +1,16 @@
+CREATE TABLE friend_group (
+    owner_user_id text        NOT NULL,
+    group_id      text        NOT NULL,
+    name          text        NOT NULL DEFAULT '',
+    sort_order    integer     NOT NULL DEFAULT 0,
+    create_time   timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (owner_user_id, group_id)
+);
+
+CREATE TABLE friend_group_member (
+    owner_user_id  text NOT NULL,
+    group_id       text NOT NULL,
+    friend_user_id text NOT NULL,
+    PRIMARY KEY (owner_user_id, group_id, friend_user_id)
+);
+CREATE INDEX friend_group_member_friend_user_id_idx ON friend_group_member (owner_user_id, friend_user_id);
@@ -0,0 +1 @@
+ALTER TABLE "user" ADD COLUMN disabled boolean NOT NULL DEFAULT false;
@@ -0,0 +1,220 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgsql
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"gorm.io/gorm"
+)
+
+func NewGroupSensitiveWordPgsql(db *gorm.DB) database.GroupSensitiveWord {
+	return &groupSensitiveWordPgsql{db: db}
+}
+
+type groupSensitiveWordPgsql struct {
+	db *gorm.DB
+}
+
+type groupSensitiveWordRow struct {
+	GroupID    string    `gorm:"column:group_id"`
+	Words      []byte    `gorm:"column:words"`
+	Action     string    `gorm:"column:action"`
+	UpdateTime time.Time `gorm:"column:update_time"`
+}
+
+func (g *groupSensitiveWordPgsql) Set(ctx context.Context, word *model.GroupSensitiveWord) error {
+	words := word.Words
+	if words == nil {
+		words = []string{}
+	}
+	data, err := json.Marshal(words)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	return wrapErr(conn(ctx, g.db).Exec(`INSERT INTO `+database.GroupSensitiveWordName+` (group_id, words, action, update_time) VALUES (?, ?, ?, ?)
+		ON CONFLICT (group_id) DO UPDATE SET words = EXCLUDED.words, action = EXCLUDED.action, update_time = EXCLUDED.update_time`,
+		word.GroupID, string(data), word.Action, word.UpdateTime).Error)
+}
+
+func (g *groupSensitiveWordPgsql) Take(ctx context.Context, groupID string) (*model.GroupSensitiveWord, error) {
+	row, err := takeOne[*groupSensitiveWordRow](conn(ctx, g.db).Table(database.GroupSensitiveWordName).Where("group_id = ?", groupID))
+	if err == nil {
+		word := &model.GroupSensitiveWord{GroupID: row.GroupID, Action: row.Action, UpdateTime: row.UpdateTime}
+		if err := json.Unmarshal(row.Words, &word.Words); err != nil {
+			return nil, errs.Wrap(err)
+		}
+		return word, nil
+	} else if IsNotFound(err) {
+		return &model.GroupSensitiveWord{GroupID: groupID}, nil
+	} else {
+		return nil, err
+	}
+}
+
+func NewModerationReviewPgsql(db *gorm.DB) database.ModerationReview {
+	return &moderationReviewPgsql{db: db}
+}
+
+type moderationReviewPgsql struct {
+	db *gorm.DB
+}
+
+// moderationVerdict is the JSON form of model.ModerationVerdict in the verdicts column.
+type moderationVerdict struct {
+	Stage  string `json:"stage"`
+	Rule   string `json:"rule"`
+	Reason string `json:"reason"`
+}
+
+type moderationReviewRow struct {
+	ReviewID       string    `gorm:"column:review_id"`
+	ConversationID string    `gorm:"column:conversation_id"`
+	ClientMsgID    string    `gorm:"column:client_msg_id"`
+	ServerMsgID    string    `gorm:"column:server_msg_id"`
+	SendID         string    `gorm:"column:send_id"`
+	RecvID         string    `gorm:"column:recv_id"`
+	GroupID        string    `gorm:"column:group_id"`
+	SessionType    int32     `gorm:"column:session_type"`
+	ContentType    int32     `gorm:"column:content_type"`
+	Content        string    `gorm:"column:content"`
+	SendTime       int64     `gorm:"column:send_time"`
+	Verdicts       string    `gorm:"column:verdicts;type:jsonb"`
+	Status         int32     `gorm:"column:status"`
+	Reviewer       string    `gorm:"column:reviewer"`
+	CreateTime     time.Time `gorm:"column:create_time"`
+	ReviewTime     time.Time `gorm:"column:review_time"`
+}
+
+func newModerationReviewRow(review *model.ModerationReview) (*moderationReviewRow, error) {
+	verdicts := make([]moderationVerdict, 0, len(review.Verdicts))
+	for _, v := range review.Verdicts {
+		verdicts = append(verdicts, moderationVerdict{Stage: v.Stage, Rule: v.Rule, Reason: v.Reason})
+	}
+	data, err := json.Marshal(verdicts)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &moderationReviewRow{
+		ReviewID:       review.ReviewID,
+		ConversationID: review.ConversationID,
+		ClientMsgID:    review.ClientMsgID,
+		ServerMsgID:    review.ServerMsgID,
+		SendID:         review.SendID,
+		RecvID:         review.RecvID,
+		GroupID:        review.GroupID,
+		SessionType:    review.SessionType,
+		ContentType:    review.ContentType,
+		Content:        review.Content,
+		SendTime:       review.SendTime,
+		Verdicts:       string(data),
+		Status:         review.Status,
+		Reviewer:       review.Reviewer,
+		CreateTime:     review.CreateTime,
+		ReviewTime:     review.ReviewTime,
+	}, nil
+}
+
+func (r *moderationReviewRow) ModerationReview() (*model.ModerationReview, error) {
+	var verdicts []moderationVerdict
+	if err := json.Unmarshal([]byte(r.Verdicts), &verdicts); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	review := &model.ModerationReview{
+		ReviewID:       r.ReviewID,
+		ConversationID: r.ConversationID,
+		ClientMsgID:    r.ClientMsgID,
+		ServerMsgID:    r.ServerMsgID,
+		SendID:         r.SendID,
+		RecvID:         r.RecvID,
+		GroupID:        r.GroupID,
+		SessionType:    r.SessionType,
+		ContentType:    r.ContentType,
+		Content:        r.Content,
+		SendTime:       r.SendTime,
+		Verdicts:       make([]model.ModerationVerdict, 0, len(verdicts)),
+		Status:         r.Status,
+		Reviewer:       r.Reviewer,
+		CreateTime:     r.CreateTime,
+		ReviewTime:     r.ReviewTime,
+	}
+	for _, v := range verdicts {
+		review.Verdicts = append(review.Verdicts, model.ModerationVerdict{Stage: v.Stage, Rule: v.Rule, Reason: v.Reason})
+	}
+	return review, nil
+}
+
+func (m *moderationReviewPgsql) table(ctx context.Context) *gorm.DB {
+	return conn(ctx, m.db).Table(database.ModerationReviewName)
+}
+
+func (m *moderationReviewPgsql) Create(ctx context.Context, reviews []*model.ModerationReview) error {
+	if len(reviews) == 0 {
+		return nil
+	}
+	rows := make([]*moderationReviewRow, 0, len(reviews))
+	for _, review := range reviews {
+		row, err := newModerationReviewRow(review)
+		if err != nil {
+			return err
+		}
+		rows = append(rows, row)
+	}
+	return wrapErr(m.table(ctx).Create(rows).Error)
+}
+
+func (m *moderationReviewPgsql) Search(ctx context.Context, status int32, sendID string, groupID string, pagination pagination.Pagination) (int64, []*model.ModerationReview, error) {
+	query := m.table(ctx)
+	if status >= 0 {
+		query = query.Where("status = ?", status)
+	}
+	if sendID != "" {
+		query = query.Where("send_id = ?", sendID)
+	}
+	if groupID != "" {
+		query = query.Where("group_id = ?", groupID)
+	}
+	total, rows, err := findPage[*moderationReviewRow](query, pagination, "create_time DESC")
+	if err != nil {
+		return 0, nil, err
+	}
+	reviews := make([]*model.ModerationReview, 0, len(rows))
+	for _, row := range rows {
+		review, err := row.ModerationReview()
+		if err != nil {
+			return 0, nil, err
+		}
+		reviews = append(reviews, review)
+	}
+	return total, reviews, nil
+}
+
+func (m *moderationReviewPgsql) SetStatus(ctx context.Context, reviewIDs []string, status int32, reviewer string) (int64, error) {
+	if len(reviewIDs) == 0 {
+		return 0, nil
+	}
+	res := m.table(ctx).Where("review_id IN ? AND status = ?", reviewIDs, model.ModerationReviewPending).
+		Updates(map[string]any{"status": status, "reviewer": reviewer, "review_time": time.Now()})
+	if res.Error != nil {
+		return 0, wrapErr(res.Error)
+	}
+	return res.RowsAffected, nil
+}
@@ -0,0 +1,771 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgsql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	msgDocTable = "msg_doc"
+	msgTable    = model.MsgTableName
+)
+
+func NewMsgPgsql(db *gorm.DB) database.Msg {
+	return &MsgPgsql{db: db}
+}
+
+// MsgPgsql keeps each message document as a msg_doc row and one msg row per slot. The
+// msg and revoke columns hold the Mongo documents as relaxed extended JSON, so the
+// field names stay the bson ones.
+type MsgPgsql struct {
+	db    *gorm.DB
+	model model.MsgDocModel
+}
+
+type msgSlotRow struct {
+	DocID   string  `gorm:"column:doc_id"`
+	Idx     int64   `gorm:"column:idx"`
+	Msg     *string `gorm:"column:msg"`
+	Revoke  *string `gorm:"column:revoke"`
+	DelList string  `gorm:"column:del_list"`
+	IsRead  bool    `gorm:"column:is_read"`
+}
+
+func encodeExtJSON(v any) (*string, error) {
+	data, err := bson.MarshalExtJSON(v, false, false)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	s := string(data)
+	return &s, nil
+}
+
+func newMsgSlotRow(docID string, idx int64, info *model.MsgInfoModel) (*msgSlotRow, error) {
+	row := &msgSlotRow{DocID: docID, Idx: idx, DelList: "[]"}
+	if info == nil {
+		return row, nil
+	}
+	var err error
+	if info.Msg != nil {
+		if row.Msg, err = encodeExtJSON(info.Msg); err != nil {
+			return nil, err
+		}
+	}
+	if info.Revoke != nil {
+		if row.Revoke, err = encodeExtJSON(info.Revoke); err != nil {
+			return nil, err
+		}
+	}
+	if len(info.DelList) > 0 {
+		data, err := json.Marshal(info.DelList)
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		row.DelList = string(data)
+	}
+	row.IsRead = info.IsRead
+	return row, nil
+}
+
+func (r *msgSlotRow) MsgInfo() (*model.MsgInfoModel, error) {
+	info := &model.MsgInfoModel{IsRead: r.IsRead}
+	if r.Msg != nil {
+		info.Msg = &model.MsgDataModel{}
+		if err := bson.UnmarshalExtJSON([]byte(*r.Msg), false, info.Msg); err != nil {
+			return nil, errs.WrapMsg(err, "decode msg failed", "docID", r.DocID, "index", r.Idx)
+		}
+	}
+	if r.Revoke != nil {
+		info.Revoke = &model.RevokeModel{}
+		if err := bson.UnmarshalExtJSON([]byte(*r.Revoke), false, info.Revoke); err != nil {
+			return nil, errs.WrapMsg(err, "decode revoke failed", "docID", r.DocID, "index", r.Idx)
+		}
+	}
+	if err := json.Unmarshal([]byte(r.DelList), &info.DelList); err != nil {
+		return nil, errs.WrapMsg(err, "decode del_list failed", "docID", r.DocID, "index", r.Idx)
+	}
+	return info, nil
+}
+
+func (m *MsgPgsql) docTable(ctx context.Context) *gorm.DB {
+	return conn(ctx, m.db).Table(msgDocTable)
+}
+
+func (m *MsgPgsql) msgTable(ctx context.Context) *gorm.DB {
+	return conn(ctx, m.db).Table(msgTable)
+}
+
+func (m *MsgPgsql) conversationID(docID string) string {
+	if i := strings.LastIndex(docID, ":"); i >= 0 {
+		return docID[:i]
+	}
+	return docID
+}
+
+// loadDocs returns the documents of docIDs with all their slots, in the order of docIDs.
+func (m *MsgPgsql) loadDocs(ctx context.Context, docIDs []string) ([]*model.MsgDocModel, error) {
+	if len(docIDs) == 0 {
+		return nil, nil
+	}
+	rows, err := find[*msgSlotRow](m.msgTable(ctx).Where("doc_id IN ?", docIDs).Order("doc_id").Order("idx"))
+	if err != nil {
+		return nil, err
+	}
+	docs := make(map[string]*model.MsgDocModel, len(docIDs))
+	for _, docID := range docIDs {
+		docs[docID] = &model.MsgDocModel{DocID: docID, Msg: []*model.MsgInfoModel{}}
+	}
+	for _, row := range rows {
+		info, err := row.MsgInfo()
+		if err != nil {
+			return nil, err
+		}
+		doc := docs[row.DocID]
+		for int64(len(doc.Msg)) < row.Idx {
+			doc.Msg = append(doc.Msg, &model.MsgInfoModel{DelList: []string{}})
+		}
+		doc.Msg = append(doc.Msg, info)
+	}
+	res := make([]*model.MsgDocModel, 0, len(docIDs))
+	for _, docID := range docIDs {
+		res = append(res, docs[docID])
+	}
+	return res, nil
+}
+
+func (m *MsgPgsql) insertSlots(tx *gorm.DB, docID string, start int64, msgs []*model.MsgInfoModel) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+	rows := make([]*msgSlotRow, 0, len(msgs))
+	for i, info := range msgs {
+		row, err := newMsgSlotRow(docID, start+int64(i), info)
+		if err != nil {
+			return err
+		}
+		rows = append(rows, row)
+	}
+	return wrapErr(tx.Table(msgTable).Create(rows).Error)
+}
+
+func (m *MsgPgsql) PushMsgsToDoc(ctx context.Context, docID string, msgsToMongo []model.MsgInfoModel) error {
+	if len(msgsToMongo) == 0 {
+		return nil
+	}
+	msgs := make([]*model.MsgInfoModel, 0, len(msgsToMongo))
+	for i := range msgsToMongo {
+		msgs = append(msgs, &msgsToMongo[i])
+	}
+	return transaction(ctx, m.db, func(tx *gorm.DB) error {
+		var next *int64
+		err := tx.Raw(`SELECT (SELECT COALESCE(MAX(idx) + 1, 0) FROM `+msgTable+` WHERE doc_id = d.doc_id)
+			FROM `+msgDocTable+` d WHERE d.doc_id = ? FOR UPDATE`, docID).Scan(&next).Error
+		if err != nil {
+			return wrapErr(err)
+		}
+		if next == nil {
+			return nil
+		}
+		return m.insertSlots(tx, docID, *next, msgs)
+	})
+}
+
+func (m *MsgPgsql) Create(ctx context.Context, doc *model.MsgDocModel) error {
+	return transaction(ctx, m.db, func(tx *gorm.DB) error {
+		err := tx.Exec(`INSERT INTO `+msgDocTable+` (doc_id, conversation_id) VALUES (?, ?)`,
+			doc.DocID, m.conversationID(doc.DocID)).Error
+		if err != nil {
+			return wrapErr(err)
+		}
+		return m.insertSlots(tx, doc.DocID, 0, doc.Msg)
+	})
+}
+
+// setSlot writes columns of one slot, creating the slot when the document exists. The
+// result matches the document like the Mongo update does.
+func (m *MsgPgsql) setSlot(ctx context.Context, row *msgSlotRow, columns ...string) (*mongo.UpdateResult, error) {
+	values := map[string]any{
+		"msg":      row.Msg,
+		"revoke":   row.Revoke,
+		"del_list": row.DelList,
+		"is_read":  row.IsRead,
+	}
+	args := []any{row.DocID, row.Idx}
+	sets := make([]string, 0, len(columns))
+	for _, column := range columns {
+		args = append(args, values[column])
+		sets = append(sets, column+" = EXCLUDED."+column)
+	}
+	args = append(args, row.DocID)
+	sql := `INSERT INTO ` + msgTable + ` (doc_id, idx, ` + strings.Join(columns, ", ") + `)
+		SELECT ?, ?` + strings.Repeat(", ?", len(columns)) + ` WHERE EXISTS (SELECT 1 FROM ` + msgDocTable + ` WHERE doc_id = ?)
+		ON CONFLICT (doc_id, idx) DO UPDATE SET ` + strings.Join(sets, ", ")
+	res := conn(ctx, m.db).Exec(sql, args...)
+	if res.Error != nil {
+		return nil, wrapErr(res.Error)
+	}
+	return &mongo.UpdateResult{MatchedCount: res.RowsAffected, ModifiedCount: res.RowsAffected}, nil
+}
+
+func (m *MsgPgsql) UpdateMsg(ctx context.Context, docID string, index int64, key string, value any) (*mongo.UpdateResult, error) {
+	row := &msgSlotRow{DocID: docID, Idx: index, DelList: "[]"}
+	var err error
+	switch key {
+	case "":
+		info, ok := value.(*model.MsgInfoModel)
+		if !ok {
+			return nil, errs.ErrArgs.WrapMsg("msg info type is invalid", "type", fmt.Sprintf("%T", value))
+		}
+		if row, err = newMsgSlotRow(docID, index, info); err != nil {
+			return nil, err
+		}
+		return m.setSlot(ctx, row, "msg", "revoke", "del_list", "is_read")
+	case "msg", "revoke":
+		var encoded *string
+		switch v := value.(type) {
+		case *model.MsgDataModel:
+			if v != nil {
+				encoded, err = encodeExtJSON(v)
+			}
+		case *model.RevokeModel:
+			if v != nil {
+				encoded, err = encodeExtJSON(v)
+			}
+		default:
+			return nil, errs.ErrArgs.WrapMsg("msg field type is invalid", "key", key, "type", fmt.Sprintf("%T", value))
+		}
+		if err != nil {
+			return nil, err
+		}
+		if key == "msg" {
+			row.Msg = encoded
+		} else {
+			row.Revoke = encoded
+		}
+		return m.setSlot(ctx, row, key)
+	case "is_read":
+		isRead, ok := value.(bool)
+		if !ok {
+			return nil, errs.ErrArgs.WrapMsg("is_read type is invalid", "type", fmt.Sprintf("%T", value))
+		}
+		row.IsRead = isRead
+		return m.setSlot(ctx, row, key)
+	case "del_list":
+		delList, ok := value.([]string)
+		if !ok {
+			return nil, errs.ErrArgs.WrapMsg("del_list type is invalid", "type", fmt.Sprintf("%T", value))
+		}
+		if delList == nil {
+			delList = []string{}
+		}
+		data, err := json.Marshal(delList)
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		row.DelList = string(data)
+		return m.setSlot(ctx, row, key)
+	default:
+		return nil, errs.ErrArgs.WrapMsg("unsupported msg field", "key", key)
+	}
+}
+
+func (m *MsgPgsql) PushUnique(ctx context.Context, docID string, index int64, key string, value any) (*mongo.UpdateResult, error) {
+	if key != "del_list" {
+		return nil, errs.ErrArgs.WrapMsg("unsupported msg field", "key", key)
+	}
+	values, ok := value.([]string)
+	if !ok {
+		return nil, errs.ErrArgs.WrapMsg("del_list type is invalid", "type", fmt.Sprintf("%T", value))
+	}
+	data, err := json.Marshal(values)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	res := conn(ctx, m.db).Exec(`UPDATE `+msgTable+` SET del_list = del_list || (
+			SELECT COALESCE(jsonb_agg(DISTINCT v), '[]'::jsonb) FROM jsonb_array_elements(?::jsonb) v
+			WHERE NOT del_list @> jsonb_build_array(v)
+		) WHERE doc_id = ? AND idx = ?`, string(data), docID, index)
+	if res.Error != nil {
+		return nil, wrapErr(res.Error)
+	}
+	return &mongo.UpdateResult{MatchedCount: res.RowsAffected, ModifiedCount: res.RowsAffected}, nil
+}
+
+func (m *MsgPgsql) UpdateMsgContent(ctx context.Context, docID string, index int64, msg []byte) error {
+	return wrapErr(m.msgTable(ctx).Where("doc_id = ? AND idx = ?", docID, index).Update("msg", string(msg)).Error)
+}
+
+func (m *MsgPgsql) IsExistDocID(ctx context.Context, docID string) (bool, error) {
+	n, err := countRows(m.docTable(ctx).Where("doc_id = ?", docID))
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (m *MsgPgsql) FindOneByDocID(ctx context.Context, docID string) (*model.MsgDocModel, error) {
+	exist, err := m.IsExistDocID(ctx, docID)
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, errs.Wrap(mongo.ErrNoDocuments)
+	}
+	docs, err := m.loadDocs(ctx, []string{docID})
+	if err != nil {
+		return nil, err
+	}
+	return docs[0], nil
+}
+
+func (m *MsgPgsql) GetMsgBySeqIndexIn1Doc(ctx context.Context, userID, docID string, seqs []int64) ([]*model.MsgInfoModel, error) {
+	msgs, err := m.getMsgBySeqIndexIn1Doc(ctx, userID, docID, seqs)
+	if err != nil {
+		return nil, err
+	}
+	return model.FillMissingSeqs(seqs, msgs), nil
+}
+
+func (m *MsgPgsql) getMsgBySeqIndexIn1Doc(ctx context.Context, userID, docID string, seqs []int64) ([]*model.MsgInfoModel, error) {
+	exist, err := m.IsExistDocID(ctx, docID)
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, errs.Wrap(mongo.ErrNoDocuments)
+	}
+	indexes := make([]int64, 0, len(seqs))
+	for _, seq := range seqs {
+		indexes = append(indexes, m.model.GetMsgIndex(seq))
+	}
+	rows, err := find[*msgSlotRow](m.msgTable(ctx).Where("doc_id = ? AND idx IN ?", docID, indexes))
+	if err != nil {
+		return nil, err
+	}
+	slots := make(map[int64]*msgSlotRow, len(rows))
+	for _, row := range rows {
+		slots[row.Idx] = row
+	}
+	msgs := make([]*model.MsgInfoModel, 0, len(indexes))
+	for _, index := range indexes {
+		row, ok := slots[index]
+		if !ok {
+			continue
+		}
+		info, err := row.MsgInfo()
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, info)
+	}
+	msgs, err = model.UserViewMsgs(userID, msgs)
+	if err != nil {
+		return nil, errs.WrapMsg(err, fmt.Sprintf("docID is %s, seqs is %v", docID, seqs))
+	}
+	return msgs, nil
+}
+
+func (m *MsgPgsql) GetNewestMsg(ctx context.Context, conversationID string) (*model.MsgInfoModel, error) {
+	for skip := int64(0); ; skip++ {
+		msgDocModel, err := m.GetMsgDocModelByIndex(ctx, conversationID, skip, -1)
+		if err != nil {
+			return nil, err
+		}
+		for i := len(msgDocModel.Msg) - 1; i >= 0; i-- {
+			if msgDocModel.Msg[i].Msg != nil {
+				return msgDocModel.Msg[i], nil
+			}
+		}
+	}
+}
+
+func (m *MsgPgsql) GetOldestMsg(ctx context.Context, conversationID string) (*model.MsgInfoModel, error) {
+	for skip := int64(0); ; skip++ {
+		msgDocModel, err := m.GetMsgDocModelByIndex(ctx, conversationID, skip, 1)
+		if err != nil {
+			return nil, err
+		}
+		for i, v := range msgDocModel.Msg {
+			if v.Msg != nil {
+				return msgDocModel.Msg[i], nil
+			}
+		}
+	}
+}
+
+func (m *MsgPgsql) FindArchivable(ctx context.Context, ts int64, limit int) ([]*model.MsgDocModel, error) {
+	// Only full documents are archived, so the last slot is always set and the newest.
+	query := m.docTable(ctx).Select(msgDocTable+".doc_id").
+		Joins("JOIN "+msgTable+" ON "+msgTable+".doc_id = "+msgDocTable+".doc_id AND "+msgTable+".idx = ?", m.model.GetSingleGocMsgNum()-1).
+		Where(msgTable+".send_time < ?", ts).Order(msgDocTable + ".id")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	docIDs, err := find[string](query)
+	if err != nil {
+		return nil, err
+	}
+	return m.loadDocs(ctx, docIDs)
+}
+
+func (m *MsgPgsql) DeleteDocs(ctx context.Context, docIDs []string) error {
+	if len(docIDs) == 0 {
+		return nil
+	}
+	return wrapErr(m.docTable(ctx).Where("doc_id IN ?", docIDs).Delete(nil).Error)
+}
+
+func (m *MsgPgsql) GetMsgDocModelByIndex(ctx context.Context, conversationID string, index, sort int64) (*model.MsgDocModel, error) {
+	if sort != 1 && sort != -1 {
+		return nil, errs.ErrArgs.WrapMsg("mongo sort must be 1 or -1")
+	}
+	order := "id"
+	if sort == -1 {
+		order = "id DESC"
+	}
+	docIDs, err := pluck[string](m.docTable(ctx).Where("conversation_id = ?", conversationID).Order(order).Offset(int(index)).Limit(1), "doc_id")
+	if err != nil {
+		return nil, err
+	}
+	if len(docIDs) == 0 {
+		return nil, errs.Wrap(model.ErrMsgListNotExist)
+	}
+	docs, err := m.loadDocs(ctx, docIDs)
+	if err != nil {
+		return nil, err
+	}
+	return docs[0], nil
+}
+
+// clearSlots resets the slots of docID at indexes to empty ones.
+func (m *MsgPgsql) clearSlots(ctx context.Context, docID string, indexes []int) (int64, error) {
+	res := m.msgTable(ctx).Where("doc_id = ? AND idx IN ?", docID, indexes).Updates(map[string]any{
+		"msg":      nil,
+		"revoke":   nil,
+		"del_list": "[]",
+		"is_read":  false,
+	})
+	if res.Error != nil {
+		return 0, wrapErr(res.Error)
+	}
+	return res.RowsAffected, nil
+}
+
+func (m *MsgPgsql) DeleteMsgsInOneDocByIndex(ctx context.Context, docID string, indexes []int) error {
+	if len(indexes) == 0 {
+		return nil
+	}
+	_, err := m.clearSlots(ctx, docID, indexes)
+	return err
+}
+
+func (m *MsgPgsql) MarkSingleChatMsgsAsRead(ctx context.Context, userID string, docID string, indexes []int64) error {
+	if len(indexes) == 0 {
+		return nil
+	}
+	err := m.msgTable(ctx).Where("doc_id = ? AND idx IN ? AND send_id IS DISTINCT FROM ?", docID, indexes, userID).
+		Update("is_read", true).Error
+	if err != nil {
+		return errs.WrapMsg(err, fmt.Sprintf("docID is %s, indexes is %v", docID, indexes))
+	}
+	return nil
+}
+
+// searchable restricts query on the msg table to single and super group chats, like
+// the Mongo search.
+func (m *MsgPgsql) searchQuery(ctx context.Context, req *msg.SearchMessageReq) (*gorm.DB, error) {
+	query := m.msgTable(ctx).Where("msg IS NOT NULL AND left(doc_id, 3) IN ?", []string{"sg_", "si_"})
+	if req.RecvID != "" {
+		query = query.Where("(recv_id = ? OR group_id = ?)", req.RecvID, req.RecvID)
+	}
+	if req.SendID != "" {
+		query = query.Where("send_id = ?", req.SendID)
+	}
+	if req.ContentType != 0 {
+		query = query.Where("content_type = ?", req.ContentType)
+	}
+	if req.SessionType != 0 {
+		query = query.Where("session_type = ?", req.SessionType)
+	}
+	if req.SendTime != "" {
+		sendTime, err := time.Parse(time.DateOnly, req.SendTime)
+		if err != nil {
+			return nil, errs.ErrArgs.WrapMsg("invalid sendTime", "req", req.SendTime, "format", time.DateOnly, "cause", err.Error())
+		}
+		query = query.Where("send_time >= ? AND send_time < ?", sendTime.UnixMilli(), sendTime.Add(time.Hour*24).UnixMilli())
+	}
+	return query, nil
+}
+
+func (m *MsgPgsql) SearchMessage(ctx context.Context, req *msg.SearchMessageReq) (int64, []*model.MsgInfoModel, error) {
+	query, err := m.searchQuery(ctx, req)
+	if err != nil {
+		return 0, nil, err
+	}
+	// Documents are ordered by creation, then messages by slot.
+	order := fmt.Sprintf("(SELECT id FROM %s WHERE %s.doc_id = %s.doc_id)", msgDocTable, msgDocTable, msgTable)
+	total, rows, err := findPage[*msgSlotRow](query, req.Pagination, order, "idx")
+	if err != nil {
+		return 0, nil, err
+	}
+	msgs := make([]*model.MsgInfoModel, 0, len(rows))
+	for _, row := range rows {
+		info, err := row.MsgInfo()
+		if err != nil {
+			return 0, nil, err
+		}
+		msgs = append(msgs, info)
+	}
+	return total, msgs, nil
+}
+
+func (m *MsgPgsql) FindMsgsBySender(ctx context.Context, docIDs []string, sendID string) ([]*model.MsgInfoModel, error) {
+	if len(docIDs) == 0 {
+		return nil, nil
+	}
+	rows, err := find[*msgSlotRow](m.msgTable(ctx).Where("doc_id IN ? AND send_id = ?", docIDs, sendID).Order("seq"))
+	if err != nil {
+		return nil, err
+	}
+	msgs := make([]*model.MsgInfoModel, 0, len(rows))
+	for _, row := range rows {
+		info, err := row.MsgInfo()
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, info)
+	}
+	return msgs, nil
+}
+
+// sendCountQuery selects the messages sent in [start, end) in documents whose id starts
+// with one of prefixes.
+func (m *MsgPgsql) sendCountQuery(ctx context.Context, start time.Time, end time.Time, prefixes ...string) *gorm.DB {
+	return m.msgTable(ctx).Where("send_time >= ? AND send_time < ? AND doc_id ~* ?",
+		start.UnixMilli(), end.UnixMilli(), "^("+strings.Join(prefixes, "|")+")")
+}
+
+type sendCount struct {
+	ID    string `gorm:"column:id"`
+	Count int64  `gorm:"column:count"`
+}
+
+// rangeSendCount counts the messages of query, the distinct values of column, one page of
+// the values ordered by their message count, and the messages of each UTC day.
+func (m *MsgPgsql) rangeSendCount(query func() *gorm.DB, column string, ase bool, pageNumber int32, showNumber int32) (int64, int64, []*sendCount, map[string]int64, error) {
+	var total struct {
+		MsgCount  int64 `gorm:"column:msg_count"`
+		UserCount int64 `gorm:"column:user_count"`
+	}
+	if err := query().Select("COUNT(*) AS msg_count, COUNT(DISTINCT " + column + ") AS user_count").Scan(&total).Error; err != nil {
+		return 0, 0, nil, nil, wrapErr(err)
+	}
+	if total.MsgCount == 0 {
+		return 0, 0, nil, map[string]int64{}, nil
+	}
+	order := "count DESC"
+	if ase {
+		order = "count"
+	}
+	var counts []*sendCount
+	page := query().Select(column + " AS id, COUNT(*) AS count").Group(column).Order(order).Order("id")
+	if showNumber > 0 {
+		page = page.Offset(int((pageNumber - 1) * showNumber)).Limit(int(showNumber))
+	}
+	if err := page.Scan(&counts).Error; err != nil {
+		return 0, 0, nil, nil, wrapErr(err)
+	}
+	type dateItem struct {
+		Date  string `gorm:"column:date"`
+		Count int64  `gorm:"column:count"`
+	}
+	var dates []dateItem
+	err := query().Select("to_char(to_timestamp(send_time / 1000.0) AT TIME ZONE 'UTC', 'YYYY-MM-DD') AS date, COUNT(*) AS count").
+		Group("date").Scan(&dates).Error
+	if err != nil {
+		return 0, 0, nil, nil, wrapErr(err)
+	}
+	dateCount := make(map[string]int64, len(dates))
+	for _, item := range dates {
+		dateCount[item.Date] = item.Count
+	}
+	return total.MsgCount, total.UserCount, counts, dateCount, nil
+}
+
+func (m *MsgPgsql) RangeUserSendCount(ctx context.Context, start time.Time, end time.Time, group bool, ase bool, pageNumber int32, showNumber int32) (msgCount int64, userCount int64, users []*model.UserCount, dateCount map[string]int64, err error) {
+	prefixes := []string{"si_"}
+	if group {
+		prefixes = append(prefixes, "g_", "sg_")
+	}
+	query := func() *gorm.DB { return m.sendCountQuery(ctx, start, end, prefixes...) }
+	msgCount, userCount, counts, dateCount, err := m.rangeSendCount(query, "send_id", ase, pageNumber, showNumber)
+	if err != nil {
+		return 0, 0, nil, nil, err
+	}
+	users = make([]*model.UserCount, 0, len(counts))
+	for _, c := range counts {
+		users = append(users, &model.UserCount{UserID: c.ID, Count: c.Count})
+	}
+	return msgCount, userCount, users, dateCount, nil
+}
+
+func (m *MsgPgsql) RangeGroupSendCount(ctx context.Context, start time.Time, end time.Time, ase bool, pageNumber int32, showNumber int32) (msgCount int64, userCount int64, groups []*model.GroupCount, dateCount map[string]int64, err error) {
+	query := func() *gorm.DB { return m.sendCountQuery(ctx, start, end, "g_", "sg_") }
+	msgCount, userCount, counts, dateCount, err := m.rangeSendCount(query, "group_id", ase, pageNumber, showNumber)
+	if err != nil {
+		return 0, 0, nil, nil, err
+	}
+	groups = make([]*model.GroupCount, 0, len(counts))
+	for _, c := range counts {
+		groups = append(groups, &model.GroupCount{GroupID: c.ID, Count: c.Count})
+	}
+	return msgCount, userCount, groups, dateCount, nil
+}
+
+// ConvertMsgsDocLen converts documents of the old 5000 message layout, which never
+// existed in PostgreSQL.
+func (m *MsgPgsql) ConvertMsgsDocLen(ctx context.Context, conversationIDs []string) {}
+
+func (m *MsgPgsql) DeleteDoc(ctx context.Context, docID string) error {
+	return wrapErr(m.docTable(ctx).Where("doc_id = ?", docID).Delete(nil).Error)
+}
+
+func (m *MsgPgsql) DeleteDocIfUnchanged(ctx context.Context, doc *model.MsgDocModel) (bool, error) {
+	var deleted bool
+	err := withTx(ctx, m.db, func(ctx context.Context) error {
+		// Locking the document and its slots holds back writes until the delete commits.
+		var locked []string
+		err := m.docTable(ctx).Select("doc_id").Where("doc_id = ?", doc.DocID).Clauses(clause.Locking{Strength: "UPDATE"}).Find(&locked).Error
+		if err != nil || len(locked) == 0 {
+			return wrapErr(err)
+		}
+		var idx []int64
+		if err := m.msgTable(ctx).Select("idx").Where("doc_id = ?", doc.DocID).Clauses(clause.Locking{Strength: "UPDATE"}).Find(&idx).Error; err != nil {
+			return wrapErr(err)
+		}
+		current, err := m.loadDocs(ctx, []string{doc.DocID})
+		if err != nil {
+			return err
+		}
+		if !reflect.DeepEqual(current[0].Msg, doc.Msg) {
+			return nil
+		}
+		if err := m.DeleteDoc(ctx, doc.DocID); err != nil {
+			return err
+		}
+		deleted = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return deleted, nil
+}
+
+func (m *MsgPgsql) DeleteMsgByIndex(ctx context.Context, docID string, index []int) error {
+	if len(index) == 0 {
+		return nil
+	}
+	n, err := m.clearSlots(ctx, docID, index)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return errs.Wrap(mongo.ErrNoDocuments)
+	}
+	return nil
+}
+
+func (m *MsgPgsql) GetBeforeMsg(ctx context.Context, ts int64, docIDs []string, limit int) ([]*model.MsgDocModel, error) {
+	if len(docIDs) == 0 {
+		return nil, nil
+	}
+	query := m.msgTable(ctx).Distinct("doc_id").Where("doc_id IN ? AND send_time < ?", docIDs, ts)
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	matched, err := pluck[string](query, "doc_id")
+	if err != nil {
+		return nil, err
+	}
+	if len(matched) == 0 {
+		return nil, nil
+	}
+	type slot struct {
+		DocID    string `gorm:"column:doc_id"`
+		Idx      int64  `gorm:"column:idx"`
+		Seq      *int64 `gorm:"column:seq"`
+		SendTime *int64 `gorm:"column:send_time"`
+	}
+	var slots []slot
+	err = m.msgTable(ctx).Select("doc_id, idx, seq, send_time").Where("doc_id IN ?", matched).
+		Order("doc_id").Order("idx").Scan(&slots).Error
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+	docs := make(map[string]*model.MsgDocModel, len(matched))
+	res := make([]*model.MsgDocModel, 0, len(matched))
+	for _, docID := range matched {
+		doc := &model.MsgDocModel{DocID: docID}
+		docs[docID] = doc
+		res = append(res, doc)
+	}
+	for _, s := range slots {
+		doc := docs[s.DocID]
+		for int64(len(doc.Msg)) < s.Idx {
+			doc.Msg = append(doc.Msg, &model.MsgInfoModel{})
+		}
+		info := &model.MsgInfoModel{}
+		if s.SendTime != nil {
+			info.Msg = &model.MsgDataModel{SendTime: *s.SendTime}
+			if s.Seq != nil {
+				info.Msg.Seq = *s.Seq
+			}
+		}
+		doc.Msg = append(doc.Msg, info)
+	}
+	return res, nil
+}
+
+func (m *MsgPgsql) GetDocIDs(ctx context.Context) ([]string, error) {
+	const limit = 5000
+	count, err := countRows(m.docTable(ctx))
+	if err != nil {
+		return nil, err
+	}
+	var offset int
+	if count >= limit {
+		offset = rand.Intn(int(count/limit)) * limit
+	}
+	log.ZDebug(ctx, "offset", "offset", offset)
+	return pluck[string](m.docTable(ctx).Order("id").Offset(offset).Limit(limit), "doc_id")
+}
@@ -0,0 +1,64 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgsql
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func NewMsgArchivePgsql(db *gorm.DB) database.MsgArchive {
+	return &msgArchivePgsql{db: db}
+}
+
+type msgArchivePgsql struct {
+	db *gorm.DB
+}
+
+func (m *msgArchivePgsql) table(ctx context.Context) *gorm.DB {
+	return conn(ctx, m.db).Table(database.MsgArchiveName)
+}
+
+func (m *msgArchivePgsql) Upsert(ctx context.Context, archive *model.MsgArchiveModel) error {
+	upsert := clause.OnConflict{
+		Columns: []clause.Column{{Name: "doc_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"conversation_id", "engine", "key", "size", "max_seq",
+			"min_send_time", "max_send_time", "archive_time"}),
+	}
+	return wrapErr(m.table(ctx).Clauses(upsert).Create(archive).Error)
+}
+
+func (m *msgArchivePgsql) Take(ctx context.Context, docID string) (*model.MsgArchiveModel, error) {
+	return takeOne[*model.MsgArchiveModel](m.table(ctx).Where("doc_id = ?", docID))
+}
+
+func (m *msgArchivePgsql) FindBefore(ctx context.Context, ts int64, limit int) ([]*model.MsgArchiveModel, error) {
+	query := m.table(ctx).Where("max_send_time < ?", ts).Order("max_send_time")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	return find[*model.MsgArchiveModel](query)
+}
+
+func (m *msgArchivePgsql) Delete(ctx context.Context, docIDs []string) error {
+	if len(docIDs) == 0 {
+		return nil
+	}
+	return wrapErr(m.table(ctx).Where("doc_id IN ?", docIDs).Delete(nil).Error)
+}
@@ -0,0 +1,70 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgsql
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func NewS3Pgsql(db *gorm.DB) database.ObjectInfo {
+	return &S3Pgsql{db: db}
+}
+
+type S3Pgsql struct {
+	db *gorm.DB
+}
+
+func (o *S3Pgsql) table(ctx context.Context) *gorm.DB {
+	return conn(ctx, o.db).Table(database.ObjectName)
+}
+
+func (o *S3Pgsql) SetObject(ctx context.Context, obj *model.Object) error {
+	upsert := clause.OnConflict{
+		Columns:   []clause.Column{{Name: "name"}},
+		DoUpdates: clause.AssignmentColumns([]string{"user_id", "engine", "key", "size", "content_type", "group", "create_time"}),
+	}
+	return wrapErr(o.table(ctx).Clauses(upsert).Create(obj).Error)
+}
+
+func (o *S3Pgsql) Take(ctx context.Context, engine string, name string) (*model.Object, error) {
+	query := o.table(ctx).Where("name = ?", name)
+	if engine != "" {
+		query = query.Where("engine = ?", engine)
+	}
+	return takeOne[*model.Object](query)
+}
+
+func (o *S3Pgsql) Delete(ctx context.Context, engine string, name string) error {
+	return wrapErr(o.table(ctx).Where("name = ? AND engine = ?", name, engine).Delete(nil).Error)
+}
+
+func (o *S3Pgsql) FindByExpires(ctx context.Context, duration time.Time, pagination pagination.Pagination) (total int64, objects []*model.Object, err error) {
+	return findPage[*model.Object](o.table(ctx).Where("create_time < ?", duration), pagination, "id")
+}
+
+func (o *S3Pgsql) FindNotDelByS3(ctx context.Context, key string, duration time.Time) (int64, error) {
+	return countRows(o.table(ctx).Where("key = ? AND create_time > ?", key, duration))
+}
+
+func (o *S3Pgsql) FindByUser(ctx context.Context, userID string, pagination pagination.Pagination) (total int64, objects []*model.Object, err error) {
+	return findPage[*model.Object](o.table(ctx).Where("user_id = ?", userID), pagination, "id")
+}
@@ -0,0 +1,118 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgsql
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"gorm.io/gorm/schema"
+)
+
+var (
+	createTableRe = regexp.MustCompile(`(?s)CREATE TABLE "?(\w+)"? \((.*?)\n\);`)
+	addColumnRe   = regexp.MustCompile(`ALTER TABLE "?(\w+)"? ADD COLUMN (?:IF NOT EXISTS )?"?(\w+)"?`)
+)
+
+// migrationColumns returns the columns of each table created or altered by the migrations.
+func migrationColumns(t *testing.T) map[string]map[string]bool {
+	migrations, err := loadMigrations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tables := make(map[string]map[string]bool)
+	for _, m := range migrations {
+		for _, match := range createTableRe.FindAllStringSubmatch(m.sql, -1) {
+			columns := make(map[string]bool)
+			for _, line := range strings.Split(match[2], "\n") {
+				fields := strings.Fields(line)
+				if len(fields) == 0 {
+					continue
+				}
+				columns[strings.Trim(fields[0], `"`)] = true
+			}
+			tables[match[1]] = columns
+		}
+		for _, match := range addColumnRe.FindAllStringSubmatch(m.sql, -1) {
+			if columns, ok := tables[match[1]]; ok {
+				columns[match[2]] = true
+			}
+		}
+	}
+	return tables
+}
+
+// TestModelColumns checks that gorm maps every field of the models stored as they are to
+// the column named by its bson tag, and that the migrations create that column.
+func TestModelColumns(t *testing.T) {
+	tables := migrationColumns(t)
+	models := map[string]any{
+		database.UserName:              &model.User{},
+		database.GroupName:             &model.Group{},
+		database.GroupMemberName:       &model.GroupMember{},
+		database.GroupRequestName:      &model.GroupRequest{},
+		database.FriendRequestName:     &model.FriendRequest{},
+		database.BlackName:             &model.Black{},
+		database.ConversationName:      &model.Conversation{},
+		database.LogName:               &model.Log{},
+		database.ObjectName:            &model.Object{},
+		database.SeqUserName:           &model.SeqUser{},
+		database.MsgArchiveName:        &model.MsgArchiveModel{},
+		database.GroupInviteLinkName:   &model.GroupInviteLink{},
+		database.GroupInviteRedeemName: &model.GroupInviteRedemption{},
+		database.ChannelSubscriberName: &model.ChannelSubscriber{},
+		database.CommunityName:         &model.Community{},
+		database.CommunityMemberName:   &model.CommunityMember{},
+		database.CommunityGroupName:    &model.CommunityGroup{},
+		database.GroupMemberTagName:    &model.GroupMemberTag{},
+		database.GroupQuotaName:        &model.GroupQuota{},
+		database.GroupSuccessionName:   &model.GroupSuccession{},
+		database.UserActivityName:      &model.UserActivity{},
+		database.FriendGroupName:       &model.FriendGroup{},
+		database.FriendGroupMemberName: &model.FriendGroupMember{},
+	}
+	for table, m := range models {
+		columns, ok := tables[table]
+		if !ok {
+			t.Errorf("table %s is not created by the migrations", table)
+			continue
+		}
+		s, err := schema.Parse(m, &sync.Map{}, schema.NamingStrategy{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		typ := reflect.TypeOf(m).Elem()
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+			name, _, _ := strings.Cut(field.Tag.Get("bson"), ",")
+			if name == "" || name == "-" {
+				continue
+			}
+			f := s.LookUpField(field.Name)
+			if f == nil || f.DBName != name {
+				t.Errorf("%s.%s: gorm column does not match bson name %q", typ.Name(), field.Name, name)
+				continue
+			}
+			if !columns[name] {
+				t.Errorf("%s.%s: column %q is missing in table %s", typ.Name(), field.Name, name, table)
+			}
+		}
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgsql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"gorm.io/gorm"
+)
+
+func NewSeqConversationPgsql(db *gorm.DB) database.SeqConversation {
+	return &seqConversationPgsql{db: db}
+}
+
+type seqConversationPgsql struct {
+	db *gorm.DB
+}
+
+func (s *seqConversationPgsql) setSeq(ctx context.Context, conversationID string, seq int64, field string) error {
+	sql := `INSERT INTO ` + database.SeqConversationName + ` (conversation_id, ` + field + `) VALUES (?, ?)
+		ON CONFLICT (conversation_id) DO UPDATE SET ` + field + ` = EXCLUDED.` + field
+	return wrapErr(conn(ctx, s.db).Exec(sql, conversationID, seq).Error)
+}
+
+func (s *seqConversationPgsql) getSeq(ctx context.Context, conversationID string, field string) (int64, error) {
+	query := conn(ctx, s.db).Table(database.SeqConversationName).Select(field).Where("conversation_id = ?", conversationID)
+	seq, err := takeOne[int64](query)
+	if err == nil {
+		return seq, nil
+	} else if IsNotFound(err) {
+		return 0, nil
+	} else {
+		return 0, err
+	}
+}
+
+func (s *seqConversationPgsql) Malloc(ctx context.Context, conversationID string, size int64) (int64, error) {
+	if size < 0 {
+		return 0, errors.New("size must be greater than 0")
+	}
+	if size == 0 {
+		return s.GetMaxSeq(ctx, conversationID)
+	}
+	var lastSeq int64
+	err := conn(ctx, s.db).Raw(`INSERT INTO `+database.SeqConversationName+` AS s (conversation_id, max_seq, min_seq) VALUES (?, ?, 0)
+		ON CONFLICT (conversation_id) DO UPDATE SET max_seq = s.max_seq + EXCLUDED.max_seq, min_seq = 0
+		RETURNING max_seq`, conversationID, size).Scan(&lastSeq).Error
+	if err != nil {
+		return 0, wrapErr(err)
+	}
+	return lastSeq - size, nil
+}
+
+func (s *seqConversationPgsql) SetMaxSeq(ctx context.Context, conversationID string, seq int64) error {
+	return s.setSeq(ctx, conversationID, seq, "max_seq")
+}
+
+func (s *seqConversationPgsql) GetMaxSeq(ctx context.Context, conversationID string) (int64, error) {
+	return s.getSeq(ctx, conversationID, "max_seq")
+}
+
+func (s *seqConversationPgsql) GetMinSeq(ctx context.Context, conversationID string) (int64, error) {
+	return s.getSeq(ctx, conversationID, "min_seq")
+}
+
+func (s *seqConversationPgsql) SetMinSeq(ctx context.Context, conversationID string, seq int64) error {
+	return s.setSeq(ctx, conversationID, seq, "min_seq")
+}
+
+func (s *seqConversationPgsql) RaiseMaxSeq(ctx context.Context, conversationID string, seq int64) error {
+	sql := `INSERT INTO ` + database.SeqConversationName + ` AS s (conversation_id, max_seq) VALUES (?, ?)
+		ON CONFLICT (conversation_id) DO UPDATE SET max_seq = GREATEST(s.max_seq, EXCLUDED.max_seq)`
+	return wrapErr(conn(ctx, s.db).Exec(sql, conversationID, seq).Error)
+}
+
+func (s *seqConversationPgsql) FindSeqConversations(ctx context.Context, after string, limit int) ([]*model.SeqConversation, error) {
+	query := conn(ctx, s.db).Table(database.SeqConversationName).Order("conversation_id").Limit(limit)
+	if after != "" {
+		query = query.Where("conversation_id > ?", after)
+	}
+	return find[*model.SeqConversation](query)
+}
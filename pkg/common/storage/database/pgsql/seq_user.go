@@ -0,0 +1,138 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgsql
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"gorm.io/gorm"
+)
+
+func NewSeqUserPgsql(db *gorm.DB) database.SeqUser {
+	return &seqUserPgsql{db: db}
+}
+
+type seqUserPgsql struct {
+	db *gorm.DB
+}
+
+func (s *seqUserPgsql) table(ctx context.Context) *gorm.DB {
+	return conn(ctx, s.db).Table(database.SeqUserName)
+}
+
+func (s *seqUserPgsql) setSeq(ctx context.Context, conversationID string, userID string, seq int64, field string) error {
+	sql := `INSERT INTO ` + database.SeqUserName + ` (user_id, conversation_id, ` + field + `) VALUES (?, ?, ?)
+		ON CONFLICT (user_id, conversation_id) DO UPDATE SET ` + field + ` = EXCLUDED.` + field
+	return wrapErr(conn(ctx, s.db).Exec(sql, userID, conversationID, seq).Error)
+}
+
+func (s *seqUserPgsql) getSeq(ctx context.Context, conversationID string, userID string, field string) (int64, error) {
+	query := s.table(ctx).Select(field).Where("user_id = ? AND conversation_id = ?", userID, conversationID)
+	seq, err := takeOne[int64](query)
+	if err == nil {
+		return seq, nil
+	} else if IsNotFound(err) {
+		return 0, nil
+	} else {
+		return 0, err
+	}
+}
+
+func (s *seqUserPgsql) GetUserMaxSeq(ctx context.Context, conversationID string, userID string) (int64, error) {
+	return s.getSeq(ctx, conversationID, userID, "max_seq")
+}
+
+func (s *seqUserPgsql) SetUserMaxSeq(ctx context.Context, conversationID string, userID string, seq int64) error {
+	return s.setSeq(ctx, conversationID, userID, seq, "max_seq")
+}
+
+func (s *seqUserPgsql) GetUserMinSeq(ctx context.Context, conversationID string, userID string) (int64, error) {
+	return s.getSeq(ctx, conversationID, userID, "min_seq")
+}
+
+func (s *seqUserPgsql) SetUserMinSeq(ctx context.Context, conversationID string, userID string, seq int64) error {
+	return s.setSeq(ctx, conversationID, userID, seq, "min_seq")
+}
+
+func (s *seqUserPgsql) GetUserReadSeq(ctx context.Context, conversationID string, userID string) (int64, error) {
+	return s.getSeq(ctx, conversationID, userID, "read_seq")
+}
+
+func (s *seqUserPgsql) SetUserReadSeq(ctx context.Context, conversationID string, userID string, seq int64) error {
+	return s.setSeq(ctx, conversationID, userID, seq, "read_seq")
+}
+
+func (s *seqUserPgsql) notFoundSet0(seq map[string]int64, keys []string) {
+	for _, key := range keys {
+		if _, ok := seq[key]; !ok {
+			seq[key] = 0
+		}
+	}
+}
+
+func (s *seqUserPgsql) GetUserReadSeqs(ctx context.Context, userID string, conversationID []string) (map[string]int64, error) {
+	if len(conversationID) == 0 {
+		return map[string]int64{}, nil
+	}
+	seqs, err := find[*model.SeqUser](s.table(ctx).Where("user_id = ? AND conversation_id IN ?", userID, conversationID))
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[string]int64, len(conversationID))
+	for _, seq := range seqs {
+		res[seq.ConversationID] = seq.ReadSeq
+	}
+	s.notFoundSet0(res, conversationID)
+	return res, nil
+}
+
+func (s *seqUserPgsql) getUsersSeq(ctx context.Context, conversationID string, userIDs []string, field string) (map[string]int64, error) {
+	res := make(map[string]int64, len(userIDs))
+	if len(userIDs) == 0 {
+		return res, nil
+	}
+	seqs, err := find[*model.SeqUser](s.table(ctx).Where("conversation_id = ? AND user_id IN ?", conversationID, userIDs))
+	if err != nil {
+		return nil, err
+	}
+	for _, seq := range seqs {
+		switch field {
+		case "read_seq":
+			res[seq.UserID] = seq.ReadSeq
+		case "min_seq":
+			res[seq.UserID] = seq.MinSeq
+		}
+	}
+	s.notFoundSet0(res, userIDs)
+	return res, nil
+}
+
+func (s *seqUserPgsql) GetUsersReadSeq(ctx context.Context, conversationID string, userIDs []string) (map[string]int64, error) {
+	return s.getUsersSeq(ctx, conversationID, userIDs, "read_seq")
+}
+
+func (s *seqUserPgsql) GetUsersMinSeq(ctx context.Context, conversationID string, userIDs []string) (map[string]int64, error) {
+	return s.getUsersSeq(ctx, conversationID, userIDs, "min_seq")
+}
+
+func (s *seqUserPgsql) DeleteUserSeqs(ctx context.Context, userID string) error {
+	return wrapErr(s.table(ctx).Where("user_id = ?", userID).Delete(nil).Error)
+}
+
+func (s *seqUserPgsql) FindConversationSeqs(ctx context.Context, conversationID string) ([]*model.SeqUser, error) {
+	return find[*model.SeqUser](s.table(ctx).Where("conversation_id = ?", conversationID))
+}
@@ -0,0 +1,143 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgsql
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"gorm.io/gorm"
+)
+
+func NewTenantPgsql(db *gorm.DB) database.Tenant {
+	return &tenantPgsql{db: db}
+}
+
+type tenantPgsql struct {
+	db *gorm.DB
+}
+
+type tenantRow struct {
+	TenantID     string    `gorm:"column:tenant_id"`
+	Name         string    `gorm:"column:name"`
+	Secret       string    `gorm:"column:secret"`
+	AdminUserIDs []byte    `gorm:"column:admin_user_ids"`
+	WebhookURL   string    `gorm:"column:webhook_url"`
+	Notification string    `gorm:"column:notification"`
+	Status       int32     `gorm:"column:status"`
+	Ex           string    `gorm:"column:ex"`
+	CreateTime   time.Time `gorm:"column:create_time"`
+	UpdateTime   time.Time `gorm:"column:update_time"`
+}
+
+func marshalAdminUserIDs(userIDs []string) ([]byte, error) {
+	if userIDs == nil {
+		userIDs = []string{}
+	}
+	data, err := json.Marshal(userIDs)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return data, nil
+}
+
+func (r *tenantRow) Tenant() (*model.Tenant, error) {
+	tenant := &model.Tenant{
+		TenantID:     r.TenantID,
+		Name:         r.Name,
+		Secret:       r.Secret,
+		WebhookURL:   r.WebhookURL,
+		Notification: r.Notification,
+		Status:       r.Status,
+		Ex:           r.Ex,
+		CreateTime:   r.CreateTime,
+		UpdateTime:   r.UpdateTime,
+	}
+	if err := json.Unmarshal(r.AdminUserIDs, &tenant.AdminUserIDs); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return tenant, nil
+}
+
+// table always reads the public schema, the tenant of ctx does not apply to tenants.
+func (t *tenantPgsql) table(ctx context.Context) *gorm.DB {
+	return t.db.WithContext(ctx).Table("public." + database.TenantName)
+}
+
+func (t *tenantPgsql) Create(ctx context.Context, tenant *model.Tenant) error {
+	adminUserIDs, err := marshalAdminUserIDs(tenant.AdminUserIDs)
+	if err != nil {
+		return err
+	}
+	return wrapErr(t.table(ctx).Create(&tenantRow{
+		TenantID:     tenant.TenantID,
+		Name:         tenant.Name,
+		Secret:       tenant.Secret,
+		AdminUserIDs: adminUserIDs,
+		WebhookURL:   tenant.WebhookURL,
+		Notification: tenant.Notification,
+		Status:       tenant.Status,
+		Ex:           tenant.Ex,
+		CreateTime:   tenant.CreateTime,
+		UpdateTime:   tenant.UpdateTime,
+	}).Error)
+}
+
+func (t *tenantPgsql) Take(ctx context.Context, tenantID string) (*model.Tenant, error) {
+	row, err := takeOne[*tenantRow](t.table(ctx).Where("tenant_id = ?", tenantID))
+	if err != nil {
+		return nil, err
+	}
+	return row.Tenant()
+}
+
+func (t *tenantPgsql) Update(ctx context.Context, tenantID string, data map[string]any) error {
+	if len(data) == 0 {
+		return nil
+	}
+	if userIDs, ok := data["admin_user_ids"].([]string); ok {
+		adminUserIDs, err := marshalAdminUserIDs(userIDs)
+		if err != nil {
+			return err
+		}
+		data["admin_user_ids"] = adminUserIDs
+	}
+	return updateOne(t.table(ctx).Where("tenant_id = ?", tenantID), data, true)
+}
+
+func (t *tenantPgsql) Delete(ctx context.Context, tenantID string) error {
+	return wrapErr(t.table(ctx).Where("tenant_id = ?", tenantID).Delete(nil).Error)
+}
+
+func (t *tenantPgsql) Page(ctx context.Context, pagination pagination.Pagination) (int64, []*model.Tenant, error) {
+	total, rows, err := findPage[*tenantRow](t.table(ctx), pagination, "create_time", "id")
+	if err != nil {
+		return 0, nil, err
+	}
+	tenants := make([]*model.Tenant, 0, len(rows))
+	for _, row := range rows {
+		tenant, err := row.Tenant()
+		if err != nil {
+			return 0, nil, err
+		}
+		tenants = append(tenants, tenant)
+	}
+	return total, tenants, nil
+}
@@ -0,0 +1,192 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgsql
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"gorm.io/gorm"
+)
+
+// Each tenant keeps its tables in a schema of its own, reached through a small pool whose
+// connections have the schema as search_path. The default tenant uses the pool of the client.
+// Only the most recently used tenant pools stay open, so the connections of all tenants
+// stay bounded however many tenants there are.
+
+// tenantPoolCloseDelay is how long an evicted pool keeps serving the statements that took
+// it before the eviction.
+const tenantPoolCloseDelay = time.Minute
+
+type tenantDBKey struct {
+	base     *gorm.DB
+	tenantID string
+}
+
+// tenantOpener opens the pools of the tenants of a client.
+type tenantOpener struct {
+	open     func(ctx context.Context, schema string) (*gorm.DB, error)
+	maxPools int
+}
+
+type tenantPool struct {
+	key tenantDBKey
+	db  *gorm.DB
+}
+
+var (
+	tenantOpeners sync.Map // *gorm.DB -> *tenantOpener
+	// tenantPools indexes tenantLRU, whose front is the most recently used pool.
+	tenantPools    = make(map[tenantDBKey]*list.Element)
+	tenantLRU      = list.New()
+	tenantPoolLock sync.Mutex
+	// tenantOpenLock serializes opening pools, so a tenant is migrated once at a time
+	// without holding up the tenants whose pools are open.
+	tenantOpenLock sync.Mutex
+	tenantMigrated sync.Map // tenantDBKey -> struct{}
+)
+
+func takeTenantPool(key tenantDBKey) *gorm.DB {
+	tenantPoolLock.Lock()
+	defer tenantPoolLock.Unlock()
+	if e, ok := tenantPools[key]; ok {
+		tenantLRU.MoveToFront(e)
+		return e.Value.(*tenantPool).db
+	}
+	return nil
+}
+
+// putTenantPool adds the pool of key and evicts the least recently used pools of the
+// client beyond maxPools.
+func putTenantPool(key tenantDBKey, db *gorm.DB, maxPools int) {
+	tenantPoolLock.Lock()
+	defer tenantPoolLock.Unlock()
+	tenantPools[key] = tenantLRU.PushFront(&tenantPool{key: key, db: db})
+	if maxPools <= 0 {
+		return
+	}
+	var n int
+	for e := tenantLRU.Front(); e != nil; {
+		next := e.Next()
+		pool := e.Value.(*tenantPool)
+		if pool.key.base == key.base {
+			if n++; n > maxPools {
+				tenantLRU.Remove(e)
+				delete(tenantPools, pool.key)
+				closeTenantPool(pool, tenantPoolCloseDelay)
+			}
+		}
+		e = next
+	}
+}
+
+// closeTenantPool stops the pool from keeping idle connections and closes it after delay.
+func closeTenantPool(pool *tenantPool, delay time.Duration) {
+	sqlDB, err := pool.db.DB()
+	if err != nil {
+		return
+	}
+	sqlDB.SetMaxIdleConns(0)
+	closeDB := func() {
+		if err := sqlDB.Close(); err != nil {
+			log.ZWarn(context.Background(), "close tenant pool failed", err, "tenantID", pool.key.tenantID)
+		}
+	}
+	if delay <= 0 {
+		closeDB()
+		return
+	}
+	time.AfterFunc(delay, closeDB)
+}
+
+// closeTenantPools closes the tenant pools of the client whose pool is base.
+func closeTenantPools(base *gorm.DB) {
+	tenantPoolLock.Lock()
+	defer tenantPoolLock.Unlock()
+	for key, e := range tenantPools {
+		if key.base == base {
+			tenantLRU.Remove(e)
+			delete(tenantPools, key)
+			closeTenantPool(e.Value.(*tenantPool), 0)
+		}
+	}
+	tenantMigrated.Range(func(key, _ any) bool {
+		if key.(tenantDBKey).base == base {
+			tenantMigrated.Delete(key)
+		}
+		return true
+	})
+}
+
+// tenantDB returns the pool of the tenant of ctx for the client whose pool is base.
+func tenantDB(ctx context.Context, base *gorm.DB) (*gorm.DB, error) {
+	id := tenant.ID(ctx)
+	if id == "" {
+		return base, nil
+	}
+	key := tenantDBKey{base: base, tenantID: id}
+	if db := takeTenantPool(key); db != nil {
+		return db, nil
+	}
+	opener, ok := tenantOpeners.Load(base)
+	if !ok {
+		return nil, errs.ErrInternalServer.WrapMsg("postgres client does not serve tenants", "tenantID", id)
+	}
+	tenantOpenLock.Lock()
+	defer tenantOpenLock.Unlock()
+	if db := takeTenantPool(key); db != nil {
+		return db, nil
+	}
+	if err := tenant.CheckID(id); err != nil {
+		return nil, err
+	}
+	schema := tenant.Namespace(id)
+	if _, ok := tenantMigrated.Load(key); !ok {
+		if err := base.WithContext(ctx).Exec("CREATE SCHEMA IF NOT EXISTS " + schema).Error; err != nil {
+			return nil, errs.WrapMsg(err, "create tenant schema failed", "schema", schema)
+		}
+	}
+	o := opener.(*tenantOpener)
+	db, err := o.open(ctx, schema)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := tenantMigrated.Load(key); !ok {
+		if err := migrate(ctx, db); err != nil {
+			closeTenantPool(&tenantPool{key: key, db: db}, 0)
+			return nil, err
+		}
+		tenantMigrated.Store(key, struct{}{})
+	}
+	putTenantPool(key, db, o.maxPools)
+	return db, nil
+}
+
+// withTenant binds the pool of the tenant of ctx to ctx. Errors opening the pool are
+// returned by the statement run on the result.
+func withTenant(ctx context.Context, base *gorm.DB) *gorm.DB {
+	db, err := tenantDB(ctx, base)
+	if err != nil {
+		tx := base.WithContext(ctx)
+		_ = tx.AddError(err)
+		return tx
+	}
+	return db.WithContext(ctx)
+}
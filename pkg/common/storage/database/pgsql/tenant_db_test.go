@@ -0,0 +1,54 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgsql
+
+import (
+	"testing"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func lazyDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(postgres.Open("postgres://localhost:1/none"), &gorm.Config{DisableAutomaticPing: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func TestPutTenantPoolEvicts(t *testing.T) {
+	base, other := lazyDB(t), lazyDB(t)
+	defer closeTenantPools(base)
+	defer closeTenantPools(other)
+	putTenantPool(tenantDBKey{base: other, tenantID: "o"}, lazyDB(t), 1)
+	for _, id := range []string{"a", "b", "c"} {
+		putTenantPool(tenantDBKey{base: base, tenantID: id}, lazyDB(t), 2)
+	}
+	if db := takeTenantPool(tenantDBKey{base: base, tenantID: "a"}); db != nil {
+		t.Error("least recently used pool a was not evicted")
+	}
+	// Taking b makes c the least recently used one.
+	if db := takeTenantPool(tenantDBKey{base: base, tenantID: "b"}); db == nil {
+		t.Fatal("pool b was evicted")
+	}
+	putTenantPool(tenantDBKey{base: base, tenantID: "d"}, lazyDB(t), 2)
+	if db := takeTenantPool(tenantDBKey{base: base, tenantID: "c"}); db != nil {
+		t.Error("least recently used pool c was not evicted")
+	}
+	if db := takeTenantPool(tenantDBKey{base: other, tenantID: "o"}); db == nil {
+		t.Error("pool of another client was evicted")
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgsql
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type txKey struct{}
+
+// pgTx carries the open transaction in the context, so every repository called from fn
+// takes part in it. Nested transactions join the outer one.
+type pgTx struct {
+	db *gorm.DB
+}
+
+func (t *pgTx) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+	db, err := tenantDB(ctx, t.db)
+	if err != nil {
+		return err
+	}
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
+}
+
+// conn returns the transaction of ctx, or the pool of the tenant of ctx bound to ctx
+// outside a transaction.
+func conn(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return tx
+	}
+	return withTenant(ctx, db)
+}
+
+// transaction runs fn in the transaction of ctx, or in a new one.
+func transaction(ctx context.Context, db *gorm.DB, fn func(tx *gorm.DB) error) error {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return fn(tx)
+	}
+	db, err := tenantDB(ctx, db)
+	if err != nil {
+		return err
+	}
+	return db.WithContext(ctx).Transaction(fn)
+}
+
+// withTx runs fn with ctx carrying the transaction of ctx, or a new one, so a write and
+// its version log updates commit together.
+func withTx(ctx context.Context, db *gorm.DB, fn func(ctx context.Context) error) error {
+	return (&pgTx{db: db}).Transaction(ctx, fn)
+}
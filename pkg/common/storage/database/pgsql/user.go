@@ -0,0 +1,264 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgsql
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/protocol/user"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"gorm.io/gorm"
+)
+
+const userCommandTable = "user_command"
+
+func NewUserPgsql(db *gorm.DB) database.User {
+	return &UserPgsql{db: db}
+}
+
+type UserPgsql struct {
+	db *gorm.DB
+}
+
+func (u *UserPgsql) table(ctx context.Context) *gorm.DB {
+	return conn(ctx, u.db).Table(database.UserName)
+}
+
+func (u *UserPgsql) commandTable(ctx context.Context) *gorm.DB {
+	return conn(ctx, u.db).Table(userCommandTable)
+}
+
+func (u *UserPgsql) Create(ctx context.Context, users []*model.User) error {
+	if len(users) == 0 {
+		return nil
+	}
+	return wrapErr(u.table(ctx).Create(users).Error)
+}
+
+func (u *UserPgsql) UpdateByMap(ctx context.Context, userID string, args map[string]any) (err error) {
+	if len(args) == 0 {
+		return nil
+	}
+	return updateOne(u.table(ctx).Where("user_id = ?", userID), args, true)
+}
+
+func (u *UserPgsql) Find(ctx context.Context, userIDs []string) (users []*model.User, err error) {
+	return find[*model.User](u.table(ctx).Where("user_id IN ?", userIDs))
+}
+
+func (u *UserPgsql) Take(ctx context.Context, userID string) (user *model.User, err error) {
+	return takeOne[*model.User](u.table(ctx).Where("user_id = ?", userID))
+}
+
+func (u *UserPgsql) TakeNotification(ctx context.Context, level int64) (user []*model.User, err error) {
+	return find[*model.User](u.table(ctx).Where("app_manger_level = ?", level))
+}
+
+func (u *UserPgsql) TakeByNickname(ctx context.Context, nickname string) (user []*model.User, err error) {
+	return find[*model.User](u.table(ctx).Where("nickname = ?", nickname))
+}
+
+func (u *UserPgsql) Page(ctx context.Context, pagination pagination.Pagination) (count int64, users []*model.User, err error) {
+	return findPage[*model.User](u.table(ctx), pagination, "id")
+}
+
+func (u *UserPgsql) PageFindUser(ctx context.Context, level1 int64, level2 int64, pagination pagination.Pagination) (count int64, users []*model.User, err error) {
+	query := u.table(ctx).Where("app_manger_level IN ?", []int64{level1, level2})
+	return findPage[*model.User](query, pagination, "id")
+}
+
+func (u *UserPgsql) PageFindUserWithKeyword(
+	ctx context.Context,
+	level1 int64,
+	level2 int64,
+	userID string,
+	nickName string,
+	pagination pagination.Pagination,
+) (count int64, users []*model.User, err error) {
+	query := u.table(ctx).Where("app_manger_level IN ?", []int64{level1, level2})
+	// Keywords are case-insensitive regular expressions, as in the Mongo implementation.
+	switch {
+	case userID != "" && nickName != "":
+		query = query.Where("(user_id ~* ? OR nickname ~* ?)", userID, nickName)
+	case userID != "":
+		query = query.Where("user_id ~* ?", userID)
+	case nickName != "":
+		query = query.Where("nickname ~* ?", nickName)
+	}
+	return findPage[*model.User](query, pagination, "id")
+}
+
+func (u *UserPgsql) GetAllUserID(ctx context.Context, pagination pagination.Pagination) (int64, []string, error) {
+	total, err := countRows(u.table(ctx))
+	if err != nil {
+		return 0, nil, err
+	}
+	userIDs, err := findPageOnly[string](u.table(ctx).Select("user_id"), pagination, "id")
+	if err != nil {
+		return 0, nil, err
+	}
+	return total, userIDs, nil
+}
+
+func (u *UserPgsql) Exist(ctx context.Context, userID string) (exist bool, err error) {
+	n, err := countRows(u.table(ctx).Where("user_id = ?", userID).Limit(1))
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (u *UserPgsql) Delete(ctx context.Context, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	return wrapErr(u.table(ctx).Where("user_id IN ?", userIDs).Delete(nil).Error)
+}
+
+func (u *UserPgsql) GetUserGlobalRecvMsgOpt(ctx context.Context, userID string) (opt int, err error) {
+	return takeOne[int](u.table(ctx).Select("global_recv_msg_opt").Where("user_id = ?", userID))
+}
+
+func (u *UserPgsql) CountTotal(ctx context.Context, before *time.Time) (count int64, err error) {
+	query := u.table(ctx)
+	if before != nil {
+		query = query.Where("create_time < ?", *before)
+	}
+	return countRows(query)
+}
+
+func (u *UserPgsql) CountRangeEverydayTotal(ctx context.Context, start time.Time, end time.Time) (map[string]int64, error) {
+	return dailyCount(u.table(ctx).Where("create_time >= ? AND create_time < ?", start, end), "create_time")
+}
+
+func (u *UserPgsql) SortQuery(ctx context.Context, userIDName map[string]string, asc bool) ([]*model.User, error) {
+	if len(userIDName) == 0 {
+		return nil, nil
+	}
+	userIDs := make([]string, 0, len(userIDName))
+	for userID := range userIDName {
+		userIDs = append(userIDs, userID)
+	}
+	users, err := find[*model.User](u.table(ctx).Where("user_id IN ?", userIDs))
+	if err != nil {
+		return nil, err
+	}
+	// The attached name replaces the nickname as sort key, like the Mongo aggregation.
+	name := func(user *model.User) string {
+		if n := userIDName[user.UserID]; n != "" {
+			return n
+		}
+		return user.Nickname
+	}
+	sort.SliceStable(users, func(i, j int) bool {
+		if asc {
+			return strings.Compare(name(users[i]), name(users[j])) < 0
+		}
+		return strings.Compare(name(users[i]), name(users[j])) > 0
+	})
+	return users, nil
+}
+
+type userCommand struct {
+	UserID     string `gorm:"column:user_id"`
+	Type       int32  `gorm:"column:type"`
+	UUID       string `gorm:"column:uuid"`
+	Value      string `gorm:"column:value"`
+	Ex         string `gorm:"column:ex"`
+	CreateTime int64  `gorm:"column:create_time"`
+}
+
+func (u *UserPgsql) AddUserCommand(ctx context.Context, userID string, Type int32, UUID string, value string, ex string) error {
+	command := &userCommand{
+		UserID:     userID,
+		Type:       Type,
+		UUID:       UUID,
+		Value:      value,
+		Ex:         ex,
+		CreateTime: time.Now().Unix(),
+	}
+	return wrapErr(u.commandTable(ctx).Create(command).Error)
+}
+
+func (u *UserPgsql) DeleteUserCommand(ctx context.Context, userID string, Type int32, UUID string) error {
+	res := u.commandTable(ctx).Where("user_id = ? AND type = ? AND uuid = ?", userID, Type, UUID).Delete(nil)
+	if res.Error != nil {
+		return wrapErr(res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return errs.Wrap(errs.ErrRecordNotFound)
+	}
+	return nil
+}
+
+func (u *UserPgsql) DeleteAllUserCommand(ctx context.Context, userID string) error {
+	return wrapErr(u.commandTable(ctx).Where("user_id = ?", userID).Delete(nil).Error)
+}
+
+func (u *UserPgsql) UpdateUserCommand(ctx context.Context, userID string, Type int32, UUID string, val map[string]any) error {
+	if len(val) == 0 {
+		return nil
+	}
+	res := u.commandTable(ctx).Where("user_id = ? AND type = ? AND uuid = ?", userID, Type, UUID).Updates(val)
+	if res.Error != nil {
+		return wrapErr(res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return errs.Wrap(errs.ErrRecordNotFound)
+	}
+	return nil
+}
+
+func (u *UserPgsql) GetUserCommand(ctx context.Context, userID string, Type int32) ([]*user.CommandInfoResp, error) {
+	commands, err := find[*userCommand](u.commandTable(ctx).Where("user_id = ? AND type = ?", userID, Type).Order("id"))
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*user.CommandInfoResp, 0, len(commands))
+	for _, command := range commands {
+		res = append(res, &user.CommandInfoResp{
+			Type:       command.Type,
+			Uuid:       command.UUID,
+			Value:      command.Value,
+			CreateTime: command.CreateTime,
+			Ex:         command.Ex,
+		})
+	}
+	return res, nil
+}
+
+func (u *UserPgsql) GetAllUserCommand(ctx context.Context, userID string) ([]*user.AllCommandInfoResp, error) {
+	commands, err := find[*userCommand](u.commandTable(ctx).Where("user_id = ?", userID).Order("id"))
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*user.AllCommandInfoResp, 0, len(commands))
+	for _, command := range commands {
+		res = append(res, &user.AllCommandInfoResp{
+			Type:       command.Type,
+			Uuid:       command.UUID,
+			Value:      command.Value,
+			CreateTime: command.CreateTime,
+			Ex:         command.Ex,
+		})
+	}
+	return res, nil
+}
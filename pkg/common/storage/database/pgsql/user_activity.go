@@ -0,0 +1,59 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgsql
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func NewUserActivityPgsql(db *gorm.DB) database.UserActivity {
+	return &userActivityPgsql{db: db}
+}
+
+type userActivityPgsql struct {
+	db *gorm.DB
+}
+
+func (u *userActivityPgsql) table(ctx context.Context) *gorm.DB {
+	return conn(ctx, u.db).Table(database.UserActivityName)
+}
+
+func (u *userActivityPgsql) Touch(ctx context.Context, userIDs []string, activeTime time.Time, since time.Time) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	activities := make([]*model.UserActivity, 0, len(userIDs))
+	for _, userID := range userIDs {
+		activities = append(activities, &model.UserActivity{UserID: userID, ActiveTime: activeTime})
+	}
+	return wrapErr(u.table(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"active_time"}),
+		Where:     clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: database.UserActivityName + ".active_time < ?", Vars: []any{since}}}},
+	}).Create(activities).Error)
+}
+
+func (u *userActivityPgsql) Find(ctx context.Context, userIDs []string) ([]*model.UserActivity, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	return find[*model.UserActivity](u.table(ctx).Where("user_id IN ?", userIDs))
+}
@@ -0,0 +1,167 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgsql
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/errs"
+	"gorm.io/gorm"
+)
+
+func NewUserJobPgsql(db *gorm.DB) database.UserJob {
+	return &userJobPgsql{db: db}
+}
+
+type userJobPgsql struct {
+	db *gorm.DB
+}
+
+// userJobStep is the JSON form of model.UserJobStep in the steps column.
+type userJobStep struct {
+	Name       string    `json:"name"`
+	Status     int32     `json:"status"`
+	Cursor     string    `json:"cursor"`
+	Count      int64     `json:"count"`
+	Error      string    `json:"error"`
+	UpdateTime time.Time `json:"update_time"`
+}
+
+type userJobRow struct {
+	JobID          string    `gorm:"column:job_id"`
+	Type           string    `gorm:"column:type"`
+	UserID         string    `gorm:"column:user_id"`
+	OperatorUserID string    `gorm:"column:operator_user_id"`
+	Status         int32     `gorm:"column:status"`
+	Steps          string    `gorm:"column:steps;type:jsonb"`
+	Result         string    `gorm:"column:result"`
+	Error          string    `gorm:"column:error"`
+	CreateTime     time.Time `gorm:"column:create_time"`
+	UpdateTime     time.Time `gorm:"column:update_time"`
+}
+
+func marshalUserJobSteps(steps []model.UserJobStep) (string, error) {
+	rows := make([]userJobStep, 0, len(steps))
+	for _, s := range steps {
+		rows = append(rows, userJobStep{Name: s.Name, Status: s.Status, Cursor: s.Cursor, Count: s.Count, Error: s.Error, UpdateTime: s.UpdateTime})
+	}
+	data, err := json.Marshal(rows)
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	return string(data), nil
+}
+
+func (r *userJobRow) UserJob() (*model.UserJob, error) {
+	var steps []userJobStep
+	if err := json.Unmarshal([]byte(r.Steps), &steps); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	job := &model.UserJob{
+		JobID:          r.JobID,
+		Type:           r.Type,
+		UserID:         r.UserID,
+		OperatorUserID: r.OperatorUserID,
+		Status:         r.Status,
+		Steps:          make([]model.UserJobStep, 0, len(steps)),
+		Result:         r.Result,
+		Error:          r.Error,
+		CreateTime:     r.CreateTime,
+		UpdateTime:     r.UpdateTime,
+	}
+	for _, s := range steps {
+		job.Steps = append(job.Steps, model.UserJobStep{Name: s.Name, Status: s.Status, Cursor: s.Cursor, Count: s.Count, Error: s.Error, UpdateTime: s.UpdateTime})
+	}
+	return job, nil
+}
+
+func (u *userJobPgsql) table(ctx context.Context) *gorm.DB {
+	return conn(ctx, u.db).Table(database.UserJobName)
+}
+
+func (u *userJobPgsql) Create(ctx context.Context, job *model.UserJob) error {
+	steps, err := marshalUserJobSteps(job.Steps)
+	if err != nil {
+		return err
+	}
+	return wrapErr(u.table(ctx).Create(&userJobRow{
+		JobID:          job.JobID,
+		Type:           job.Type,
+		UserID:         job.UserID,
+		OperatorUserID: job.OperatorUserID,
+		Status:         job.Status,
+		Steps:          steps,
+		Result:         job.Result,
+		Error:          job.Error,
+		CreateTime:     job.CreateTime,
+		UpdateTime:     job.UpdateTime,
+	}).Error)
+}
+
+func (u *userJobPgsql) Take(ctx context.Context, jobID string) (*model.UserJob, error) {
+	row, err := takeOne[*userJobRow](u.table(ctx).Where("job_id = ?", jobID))
+	if err != nil {
+		return nil, err
+	}
+	return row.UserJob()
+}
+
+func (u *userJobPgsql) TakeUnfinished(ctx context.Context, userID string, jobType string) (*model.UserJob, error) {
+	query := u.table(ctx).Where("user_id = ? AND type = ? AND status <> ?", userID, jobType, model.UserJobSucceeded).
+		Order("create_time DESC")
+	row, err := takeOne[*userJobRow](query)
+	if err != nil {
+		return nil, err
+	}
+	return row.UserJob()
+}
+
+func (u *userJobPgsql) FindRunning(ctx context.Context, jobType string, limit int) ([]*model.UserJob, error) {
+	query := u.table(ctx).Where("type = ? AND status = ?", jobType, model.UserJobRunning).Order("create_time")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	rows, err := find[*userJobRow](query)
+	if err != nil {
+		return nil, err
+	}
+	jobs := make([]*model.UserJob, 0, len(rows))
+	for _, row := range rows {
+		job, err := row.UserJob()
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+func (u *userJobPgsql) Save(ctx context.Context, job *model.UserJob) error {
+	steps, err := marshalUserJobSteps(job.Steps)
+	if err != nil {
+		return err
+	}
+	return updateOne(u.table(ctx).Where("job_id = ?", job.JobID), map[string]any{
+		"status":      job.Status,
+		"steps":       steps,
+		"result":      job.Result,
+		"error":       job.Error,
+		"update_time": job.UpdateTime,
+	}, true)
+}
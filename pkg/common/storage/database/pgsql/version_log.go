@@ -0,0 +1,229 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgsql
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/versionctx"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// NewVersionLog stores the version log named name in the header table name and the
+// element table name_log.
+func NewVersionLog(db *gorm.DB, name string) *VersionLogPgsql {
+	return &VersionLogPgsql{db: db, name: name, logName: name + "_log"}
+}
+
+type VersionLogPgsql struct {
+	db      *gorm.DB
+	name    string
+	logName string
+}
+
+type versionHeader struct {
+	ID         string    `gorm:"column:id"`
+	DID        string    `gorm:"column:d_id"`
+	Version    uint      `gorm:"column:version"`
+	Deleted    uint      `gorm:"column:deleted"`
+	LastUpdate time.Time `gorm:"column:last_update"`
+}
+
+func (h *versionHeader) VersionLog(logs []model.VersionLogElem) *model.VersionLog {
+	id, _ := primitive.ObjectIDFromHex(h.ID)
+	return &model.VersionLog{
+		ID:         id,
+		DID:        h.DID,
+		Logs:       logs,
+		Version:    h.Version,
+		Deleted:    h.Deleted,
+		LastUpdate: h.LastUpdate,
+		LogLen:     len(logs),
+	}
+}
+
+type versionElem struct {
+	DID        string    `gorm:"column:d_id"`
+	EID        string    `gorm:"column:e_id"`
+	State      int32     `gorm:"column:state"`
+	Version    uint      `gorm:"column:version"`
+	LastUpdate time.Time `gorm:"column:last_update"`
+}
+
+func (l *VersionLogPgsql) IncrVersion(ctx context.Context, dId string, eIds []string, state int32) error {
+	_, err := l.IncrVersionResult(ctx, dId, eIds, state)
+	return err
+}
+
+func (l *VersionLogPgsql) IncrVersionResult(ctx context.Context, dId string, eIds []string, state int32) (*model.VersionLog, error) {
+	if len(eIds) == 0 {
+		return nil, errs.ErrArgs.WrapMsg("elem id is empty", "dId", dId)
+	}
+	var vl *model.VersionLog
+	err := transaction(ctx, l.db, func(tx *gorm.DB) error {
+		header, err := l.incrHeader(tx, dId, time.Now())
+		if err != nil {
+			return err
+		}
+		if err := tx.Table(l.logName).Where("d_id = ? AND e_id IN ?", dId, eIds).Delete(nil).Error; err != nil {
+			return wrapErr(err)
+		}
+		elems := make([]*versionElem, 0, len(eIds))
+		logs := make([]model.VersionLogElem, 0, len(eIds))
+		for _, eId := range eIds {
+			elems = append(elems, &versionElem{
+				DID:        dId,
+				EID:        eId,
+				State:      state,
+				Version:    header.Version,
+				LastUpdate: header.LastUpdate,
+			})
+			logs = append(logs, model.VersionLogElem{
+				EID:        eId,
+				State:      state,
+				Version:    header.Version,
+				LastUpdate: header.LastUpdate,
+			})
+		}
+		if err := tx.Table(l.logName).Create(elems).Error; err != nil {
+			return wrapErr(err)
+		}
+		vl = header.VersionLog(logs)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	versionctx.GetVersionLog(ctx).Append(versionctx.Collection{
+		Name: l.name,
+		Doc:  vl,
+	})
+	return vl, nil
+}
+
+// incrHeader bumps the version of dId, creating the header at FirstVersion when missing.
+func (l *VersionLogPgsql) incrHeader(tx *gorm.DB, dId string, now time.Time) (*versionHeader, error) {
+	var header versionHeader
+	err := tx.Raw(`INSERT INTO `+l.name+` AS v (id, d_id, version, deleted, last_update) VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT (d_id) DO UPDATE SET version = v.version + 1, last_update = EXCLUDED.last_update
+		RETURNING id, d_id, version, deleted, last_update`,
+		primitive.NewObjectID().Hex(), dId, database.FirstVersion, database.DefaultDeleteVersion, now).Scan(&header).Error
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+	return &header, nil
+}
+
+// initDoc creates the header of dId, reporting false when another writer created it first.
+func (l *VersionLogPgsql) initDoc(ctx context.Context, dId string) (*model.VersionLog, bool, error) {
+	header := &versionHeader{
+		ID:         primitive.NewObjectID().Hex(),
+		DID:        dId,
+		Version:    database.FirstVersion,
+		Deleted:    database.DefaultDeleteVersion,
+		LastUpdate: time.Now(),
+	}
+	res := conn(ctx, l.db).Table(l.name).Clauses(clause.OnConflict{DoNothing: true}).Create(header)
+	if res.Error != nil {
+		return nil, false, wrapErr(res.Error)
+	}
+	return header.VersionLog(nil), res.RowsAffected > 0, nil
+}
+
+func (l *VersionLogPgsql) FindChangeLog(ctx context.Context, dId string, version uint, limit int) (*model.VersionLog, error) {
+	if wl, err := l.findChangeLog(ctx, dId, version, limit); err == nil {
+		return wl, nil
+	} else if !IsNotFound(err) {
+		return nil, err
+	}
+	log.ZDebug(ctx, "init doc", "dId", dId)
+	res, created, err := l.initDoc(ctx, dId)
+	if err != nil {
+		return nil, err
+	}
+	if !created {
+		return l.findChangeLog(ctx, dId, version, limit)
+	}
+	log.ZDebug(ctx, "init doc success", "dId", dId)
+	return res, nil
+}
+
+func (l *VersionLogPgsql) BatchFindChangeLog(ctx context.Context, dIds []string, versions []uint, limits []int) ([]*model.VersionLog, error) {
+	vLogs := make([]*model.VersionLog, 0, len(dIds))
+	for i := 0; i < len(dIds); i++ {
+		vLog, err := l.FindChangeLog(ctx, dIds[i], versions[i], limits[i])
+		if err != nil {
+			log.ZError(ctx, "findChangeLog error:", err, "dId", dIds[i])
+			continue
+		}
+		vLogs = append(vLogs, vLog)
+	}
+	return vLogs, nil
+}
+
+// findChangeLog returns the elements changed after version. Like the Mongo pipeline, the
+// log is empty when version is outside the kept range, and dropped when it has more
+// than limit elements.
+func (l *VersionLogPgsql) findChangeLog(ctx context.Context, dId string, version uint, limit int) (*model.VersionLog, error) {
+	header, err := takeOne[*versionHeader](conn(ctx, l.db).Table(l.name).Where("d_id = ?", dId))
+	if err != nil {
+		return nil, err
+	}
+	if version == 0 && limit == 0 {
+		return header.VersionLog(nil), nil
+	}
+	if header.Version < version || header.Deleted >= version {
+		return header.VersionLog([]model.VersionLogElem{}), nil
+	}
+	query := conn(ctx, l.db).Table(l.logName).Where("d_id = ? AND version > ?", dId, version)
+	n, err := countRows(query.Session(&gorm.Session{}))
+	if err != nil {
+		return nil, err
+	}
+	if limit > 0 && n > int64(limit) {
+		vl := header.VersionLog([]model.VersionLogElem{})
+		vl.LogLen = int(n)
+		return vl, nil
+	}
+	elems, err := find[*versionElem](query.Order("id"))
+	if err != nil {
+		return nil, err
+	}
+	logs := make([]model.VersionLogElem, 0, len(elems))
+	for _, elem := range elems {
+		logs = append(logs, model.VersionLogElem{
+			EID:        elem.EID,
+			State:      elem.State,
+			Version:    elem.Version,
+			LastUpdate: elem.LastUpdate,
+		})
+	}
+	return header.VersionLog(logs), nil
+}
+
+func (l *VersionLogPgsql) DeleteAfterUnchangedLog(ctx context.Context, deadline time.Time) error {
+	return wrapErr(conn(ctx, l.db).Table(l.name).Where("last_update < ?", deadline).Delete(nil).Error)
+}
+
+func (l *VersionLogPgsql) Delete(ctx context.Context, dId string) error {
+	return wrapErr(conn(ctx, l.db).Table(l.name).Where("d_id = ?", dId).Delete(nil).Error)
+}
@@ -1,6 +1,10 @@
 package database
 
-import "context"
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
 
 type SeqConversation interface {
 	Malloc(ctx context.Context, conversationID string, size int64) (int64, error)
@@ -8,4 +12,8 @@ type SeqConversation interface {
 	SetMaxSeq(ctx context.Context, conversationID string, seq int64) error
 	GetMinSeq(ctx context.Context, conversationID string) (int64, error)
 	SetMinSeq(ctx context.Context, conversationID string, seq int64) error
+	// RaiseMaxSeq sets the max seq to seq unless it is already larger.
+	RaiseMaxSeq(ctx context.Context, conversationID string, seq int64) error
+	// FindSeqConversations returns up to limit conversations ordered by ID, starting after the given ID.
+	FindSeqConversations(ctx context.Context, after string, limit int) ([]*model.SeqConversation, error)
 }
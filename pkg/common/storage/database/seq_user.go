@@ -1,6 +1,10 @@
 package database
 
-import "context"
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
 
 type SeqUser interface {
 	GetUserMaxSeq(ctx context.Context, conversationID string, userID string) (int64, error)
@@ -10,4 +14,12 @@ type SeqUser interface {
 	GetUserReadSeq(ctx context.Context, conversationID string, userID string) (int64, error)
 	SetUserReadSeq(ctx context.Context, conversationID string, userID string, seq int64) error
 	GetUserReadSeqs(ctx context.Context, userID string, conversationID []string) (map[string]int64, error)
+	// GetUsersReadSeq returns the read seq of each user in one conversation, users without a record get 0.
+	GetUsersReadSeq(ctx context.Context, conversationID string, userIDs []string) (map[string]int64, error)
+	// GetUsersMinSeq returns the min seq of each user in one conversation, users without a record get 0.
+	GetUsersMinSeq(ctx context.Context, conversationID string, userIDs []string) (map[string]int64, error)
+	// DeleteUserSeqs removes the seqs of the user in every conversation.
+	DeleteUserSeqs(ctx context.Context, userID string) error
+	// FindConversationSeqs returns the seqs of every user with a record in the conversation.
+	FindConversationSeqs(ctx context.Context, conversationID string) ([]*model.SeqUser, error)
 }
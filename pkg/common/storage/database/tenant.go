@@ -0,0 +1,33 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+// Tenant stores the tenants of the deployment. Unlike the other repositories it is not
+// scoped to the tenant of the context.
+type Tenant interface {
+	Create(ctx context.Context, tenant *model.Tenant) error
+	Take(ctx context.Context, tenantID string) (*model.Tenant, error)
+	// Update sets the fields of data, keyed like the bson tags of model.Tenant.
+	Update(ctx context.Context, tenantID string, data map[string]any) error
+	Delete(ctx context.Context, tenantID string) error
+	Page(ctx context.Context, pagination pagination.Pagination) (int64, []*model.Tenant, error)
+}
@@ -48,4 +48,7 @@ type User interface {
 	UpdateUserCommand(ctx context.Context, userID string, Type int32, UUID string, val map[string]any) error
 	GetUserCommand(ctx context.Context, userID string, Type int32) ([]*user.CommandInfoResp, error)
 	GetAllUserCommand(ctx context.Context, userID string) ([]*user.AllCommandInfoResp, error)
+	DeleteAllUserCommand(ctx context.Context, userID string) error
+	// Delete removes the users, no error if they do not exist.
+	Delete(ctx context.Context, userIDs []string) error
 }
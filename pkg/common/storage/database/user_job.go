@@ -0,0 +1,32 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type UserJob interface {
+	Create(ctx context.Context, job *model.UserJob) error
+	Take(ctx context.Context, jobID string) (*model.UserJob, error)
+	// TakeUnfinished returns the newest job of the type for the user that has not succeeded.
+	TakeUnfinished(ctx context.Context, userID string, jobType string) (*model.UserJob, error)
+	// FindRunning returns the jobs of the type still marked as running.
+	FindRunning(ctx context.Context, jobType string, limit int) ([]*model.UserJob, error)
+	// Save replaces the status, steps, result and error of the job.
+	Save(ctx context.Context, job *model.UserJob) error
+}
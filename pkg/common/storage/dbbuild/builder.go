@@ -0,0 +1,402 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dbbuild creates the storage repositories of the backend selected in database.yml.
+package dbbuild
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database/mgo"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database/pgsql"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/tx"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	BackendMongo    = "mongo"
+	BackendPostgres = "postgres"
+)
+
+// Builder creates the repositories of one backend. Repositories created by the same
+// builder share its connection and take part in the transactions of Tx.
+type Builder interface {
+	User() (database.User, error)
+	Group() (database.Group, error)
+	GroupMember() (database.GroupMember, error)
+	GroupRequest() (database.GroupRequest, error)
+	Friend() (database.Friend, error)
+	FriendRequest() (database.FriendRequest, error)
+	FriendGroup() (database.FriendGroup, error)
+	FriendGroupMember() (database.FriendGroupMember, error)
+	Black() (database.Black, error)
+	Conversation() (database.Conversation, error)
+	Log() (database.Log, error)
+	Object() (database.ObjectInfo, error)
+	Msg() (database.Msg, error)
+	MsgArchive() (database.MsgArchive, error)
+	SeqConversation() (database.SeqConversation, error)
+	SeqUser() (database.SeqUser, error)
+	GroupReadReceipt() (database.GroupReadReceipt, error)
+	GroupSensitiveWord() (database.GroupSensitiveWord, error)
+	GroupMuteRule() (database.GroupMuteRule, error)
+	GroupJoinQuestion() (database.GroupJoinQuestion, error)
+	GroupRequestAnswer() (database.GroupRequestAnswer, error)
+	GroupMemberTag() (database.GroupMemberTag, error)
+	GroupQuota() (database.GroupQuota, error)
+	GroupSuccession() (database.GroupSuccession, error)
+	ChannelSubscriber() (database.ChannelSubscriber, error)
+	Community() (database.Community, error)
+	CommunityMember() (database.CommunityMember, error)
+	CommunityGroup() (database.CommunityGroup, error)
+	GroupRole() (database.GroupRole, error)
+	GroupInviteLink() (database.GroupInviteLink, error)
+	GroupInviteRedemption() (database.GroupInviteRedemption, error)
+	ModerationReview() (database.ModerationReview, error)
+	UserJob() (database.UserJob, error)
+	UserActivity() (database.UserActivity, error)
+	Tenant() (database.Tenant, error)
+	Tx() tx.Tx
+	// MongoDB returns the Mongo database, or nil when the backend is not Mongo.
+	MongoDB() *mongo.Database
+}
+
+// NewBuilder connects to the backend selected by conf. mongoConf is only used by the
+// Mongo backend.
+func NewBuilder(ctx context.Context, conf *config.Database, mongoConf *config.Mongo) (Builder, error) {
+	switch conf.Enable {
+	case BackendMongo, "":
+		cli, err := mongoutil.NewMongoDB(ctx, mongoConf.Build())
+		if err != nil {
+			return nil, err
+		}
+		return &mongoBuilder{cli: cli}, nil
+	case BackendPostgres:
+		cli, err := pgsql.NewClient(ctx, &conf.Postgres)
+		if err != nil {
+			return nil, err
+		}
+		return &pgsqlBuilder{cli: cli}, nil
+	default:
+		return nil, errs.ErrArgs.WrapMsg("unsupported database backend", "enable", conf.Enable)
+	}
+}
+
+type mongoBuilder struct {
+	cli *mongoutil.Client
+}
+
+func (b *mongoBuilder) User() (database.User, error) {
+	return mgo.NewUserMongo(b.cli.GetDB())
+}
+
+func (b *mongoBuilder) Group() (database.Group, error) {
+	return mgo.NewGroupMongo(b.cli.GetDB())
+}
+
+func (b *mongoBuilder) GroupMember() (database.GroupMember, error) {
+	return mgo.NewGroupMember(b.cli.GetDB())
+}
+
+func (b *mongoBuilder) GroupRequest() (database.GroupRequest, error) {
+	return mgo.NewGroupRequestMgo(b.cli.GetDB())
+}
+
+func (b *mongoBuilder) Friend() (database.Friend, error) {
+	return mgo.NewFriendMongo(b.cli.GetDB())
+}
+
+func (b *mongoBuilder) FriendRequest() (database.FriendRequest, error) {
+	return mgo.NewFriendRequestMongo(b.cli.GetDB())
+}
+
+func (b *mongoBuilder) FriendGroup() (database.FriendGroup, error) {
+	return mgo.NewFriendGroupMongo(b.cli.GetDB())
+}
+
+func (b *mongoBuilder) FriendGroupMember() (database.FriendGroupMember, error) {
+	return mgo.NewFriendGroupMemberMongo(b.cli.GetDB())
+}
+
+func (b *mongoBuilder) Black() (database.Black, error) {
+	return mgo.NewBlackMongo(b.cli.GetDB())
+}
+
+func (b *mongoBuilder) Conversation() (database.Conversation, error) {
+	return mgo.NewConversationMongo(b.cli.GetDB())
+}
+
+func (b *mongoBuilder) Log() (database.Log, error) {
+	return mgo.NewLogMongo(b.cli.GetDB())
+}
+
+func (b *mongoBuilder) Object() (database.ObjectInfo, error) {
+	return mgo.NewS3Mongo(b.cli.GetDB())
+}
+
+func (b *mongoBuilder) Msg() (database.Msg, error) {
+	return mgo.NewMsgMongo(b.cli.GetDB())
+}
+
+func (b *mongoBuilder) MsgArchive() (database.MsgArchive, error) {
+	return mgo.NewMsgArchiveMongo(b.cli.GetDB())
+}
+
+func (b *mongoBuilder) SeqConversation() (database.SeqConversation, error) {
+	return mgo.NewSeqConversationMongo(b.cli.GetDB())
+}
+
+func (b *mongoBuilder) SeqUser() (database.SeqUser, error) {
+	return mgo.NewSeqUserMongo(b.cli.GetDB())
+}
+
+func (b *mongoBuilder) GroupReadReceipt() (database.GroupReadReceipt, error) {
+	return mgo.NewGroupReadReceiptMongo(b.cli.GetDB())
+}
+
+func (b *mongoBuilder) GroupSensitiveWord() (database.GroupSensitiveWord, error) {
+	return mgo.NewGroupSensitiveWordMongo(b.cli.GetDB())
+}
+
+func (b *mongoBuilder) GroupMuteRule() (database.GroupMuteRule, error) {
+	return mgo.NewGroupMuteRuleMongo(b.cli.GetDB())
+}
+
+func (b *mongoBuilder) GroupJoinQuestion() (database.GroupJoinQuestion, error) {
+	return mgo.NewGroupJoinQuestionMongo(b.cli.GetDB())
+}
+
+func (b *mongoBuilder) GroupRequestAnswer() (database.GroupRequestAnswer, error) {
+	return mgo.NewGroupRequestAnswerMongo(b.cli.GetDB())
+}
+
+func (b *mongoBuilder) GroupMemberTag() (database.GroupMemberTag, error) {
+	return mgo.NewGroupMemberTagMongo(b.cli.GetDB())
+}
+
+func (b *mongoBuilder) GroupQuota() (database.GroupQuota, error) {
+	return mgo.NewGroupQuotaMongo(b.cli.GetDB())
+}
+
+func (b *mongoBuilder) GroupSuccession() (database.GroupSuccession, error) {
+	return mgo.NewGroupSuccessionMongo(b.cli.GetDB())
+}
+
+func (b *mongoBuilder) ChannelSubscriber() (database.ChannelSubscriber, error) {
+	return mgo.NewChannelSubscriberMongo(b.cli.GetDB())
+}
+
+func (b *mongoBuilder) Community() (database.Community, error) {
+	return mgo.NewCommunityMongo(b.cli.GetDB())
+}
+
+func (b *mongoBuilder) CommunityMember() (database.CommunityMember, error) {
+	return mgo.NewCommunityMemberMongo(b.cli.GetDB())
+}
+
+func (b *mongoBuilder) CommunityGroup() (database.CommunityGroup, error) {
+	return mgo.NewCommunityGroupMongo(b.cli.GetDB())
+}
+
+func (b *mongoBuilder) GroupRole() (database.GroupRole, error) {
+	return mgo.NewGroupRoleMongo(b.cli.GetDB())
+}
+
+func (b *mongoBuilder) GroupInviteLink() (database.GroupInviteLink, error) {
+	return mgo.NewGroupInviteLinkMongo(b.cli.GetDB())
+}
+
+func (b *mongoBuilder) GroupInviteRedemption() (database.GroupInviteRedemption, error) {
+	return mgo.NewGroupInviteRedemptionMongo(b.cli.GetDB())
+}
+
+func (b *mongoBuilder) ModerationReview() (database.ModerationReview, error) {
+	return mgo.NewModerationReviewMongo(b.cli.GetDB())
+}
+
+func (b *mongoBuilder) UserJob() (database.UserJob, error) {
+	return mgo.NewUserJobMongo(b.cli.GetDB())
+}
+
+func (b *mongoBuilder) UserActivity() (database.UserActivity, error) {
+	return mgo.NewUserActivityMongo(b.cli.GetDB())
+}
+
+func (b *mongoBuilder) Tenant() (database.Tenant, error) {
+	return mgo.NewTenantMongo(b.cli.GetDB())
+}
+
+func (b *mongoBuilder) Tx() tx.Tx {
+	return b.cli.GetTx()
+}
+
+func (b *mongoBuilder) MongoDB() *mongo.Database {
+	return b.cli.GetDB()
+}
+
+type pgsqlBuilder struct {
+	cli *pgsql.Client
+}
+
+func (b *pgsqlBuilder) User() (database.User, error) {
+	return pgsql.NewUserPgsql(b.cli.GetDB()), nil
+}
+
+func (b *pgsqlBuilder) Group() (database.Group, error) {
+	return pgsql.NewGroupPgsql(b.cli.GetDB()), nil
+}
+
+func (b *pgsqlBuilder) GroupMember() (database.GroupMember, error) {
+	return pgsql.NewGroupMemberPgsql(b.cli.GetDB()), nil
+}
+
+func (b *pgsqlBuilder) GroupRequest() (database.GroupRequest, error) {
+	return pgsql.NewGroupRequestPgsql(b.cli.GetDB()), nil
+}
+
+func (b *pgsqlBuilder) Friend() (database.Friend, error) {
+	return pgsql.NewFriendPgsql(b.cli.GetDB()), nil
+}
+
+func (b *pgsqlBuilder) FriendRequest() (database.FriendRequest, error) {
+	return pgsql.NewFriendRequestPgsql(b.cli.GetDB()), nil
+}
+
+func (b *pgsqlBuilder) FriendGroup() (database.FriendGroup, error) {
+	return pgsql.NewFriendGroupPgsql(b.cli.GetDB()), nil
+}
+
+func (b *pgsqlBuilder) FriendGroupMember() (database.FriendGroupMember, error) {
+	return pgsql.NewFriendGroupMemberPgsql(b.cli.GetDB()), nil
+}
+
+func (b *pgsqlBuilder) Black() (database.Black, error) {
+	return pgsql.NewBlackPgsql(b.cli.GetDB()), nil
+}
+
+func (b *pgsqlBuilder) Conversation() (database.Conversation, error) {
+	return pgsql.NewConversationPgsql(b.cli.GetDB()), nil
+}
+
+func (b *pgsqlBuilder) Log() (database.Log, error) {
+	return pgsql.NewLogPgsql(b.cli.GetDB()), nil
+}
+
+func (b *pgsqlBuilder) Object() (database.ObjectInfo, error) {
+	return pgsql.NewS3Pgsql(b.cli.GetDB()), nil
+}
+
+func (b *pgsqlBuilder) Msg() (database.Msg, error) {
+	return pgsql.NewMsgPgsql(b.cli.GetDB()), nil
+}
+
+func (b *pgsqlBuilder) MsgArchive() (database.MsgArchive, error) {
+	return pgsql.NewMsgArchivePgsql(b.cli.GetDB()), nil
+}
+
+func (b *pgsqlBuilder) SeqConversation() (database.SeqConversation, error) {
+	return pgsql.NewSeqConversationPgsql(b.cli.GetDB()), nil
+}
+
+func (b *pgsqlBuilder) SeqUser() (database.SeqUser, error) {
+	return pgsql.NewSeqUserPgsql(b.cli.GetDB()), nil
+}
+
+func (b *pgsqlBuilder) GroupReadReceipt() (database.GroupReadReceipt, error) {
+	return pgsql.NewGroupReadReceiptPgsql(b.cli.GetDB()), nil
+}
+
+func (b *pgsqlBuilder) GroupSensitiveWord() (database.GroupSensitiveWord, error) {
+	return pgsql.NewGroupSensitiveWordPgsql(b.cli.GetDB()), nil
+}
+
+func (b *pgsqlBuilder) GroupMuteRule() (database.GroupMuteRule, error) {
+	return pgsql.NewGroupMuteRulePgsql(b.cli.GetDB()), nil
+}
+
+func (b *pgsqlBuilder) GroupJoinQuestion() (database.GroupJoinQuestion, error) {
+	return pgsql.NewGroupJoinQuestionPgsql(b.cli.GetDB()), nil
+}
+
+func (b *pgsqlBuilder) GroupRequestAnswer() (database.GroupRequestAnswer, error) {
+	return pgsql.NewGroupRequestAnswerPgsql(b.cli.GetDB()), nil
+}
+
+func (b *pgsqlBuilder) GroupMemberTag() (database.GroupMemberTag, error) {
+	return pgsql.NewGroupMemberTagPgsql(b.cli.GetDB()), nil
+}
+
+func (b *pgsqlBuilder) GroupQuota() (database.GroupQuota, error) {
+	return pgsql.NewGroupQuotaPgsql(b.cli.GetDB()), nil
+}
+
+func (b *pgsqlBuilder) GroupSuccession() (database.GroupSuccession, error) {
+	return pgsql.NewGroupSuccessionPgsql(b.cli.GetDB()), nil
+}
+
+func (b *pgsqlBuilder) ChannelSubscriber() (database.ChannelSubscriber, error) {
+	return pgsql.NewChannelSubscriberPgsql(b.cli.GetDB()), nil
+}
+
+func (b *pgsqlBuilder) Community() (database.Community, error) {
+	return pgsql.NewCommunityPgsql(b.cli.GetDB()), nil
+}
+
+func (b *pgsqlBuilder) CommunityMember() (database.CommunityMember, error) {
+	return pgsql.NewCommunityMemberPgsql(b.cli.GetDB()), nil
+}
+
+func (b *pgsqlBuilder) CommunityGroup() (database.CommunityGroup, error) {
+	return pgsql.NewCommunityGroupPgsql(b.cli.GetDB()), nil
+}
+
+func (b *pgsqlBuilder) GroupRole() (database.GroupRole, error) {
+	return pgsql.NewGroupRolePgsql(b.cli.GetDB()), nil
+}
+
+func (b *pgsqlBuilder) GroupInviteLink() (database.GroupInviteLink, error) {
+	return pgsql.NewGroupInviteLinkPgsql(b.cli.GetDB()), nil
+}
+
+func (b *pgsqlBuilder) GroupInviteRedemption() (database.GroupInviteRedemption, error) {
+	return pgsql.NewGroupInviteRedemptionPgsql(b.cli.GetDB()), nil
+}
+
+func (b *pgsqlBuilder) ModerationReview() (database.ModerationReview, error) {
+	return pgsql.NewModerationReviewPgsql(b.cli.GetDB()), nil
+}
+
+func (b *pgsqlBuilder) UserJob() (database.UserJob, error) {
+	return pgsql.NewUserJobPgsql(b.cli.GetDB()), nil
+}
+
+func (b *pgsqlBuilder) UserActivity() (database.UserActivity, error) {
+	return pgsql.NewUserActivityPgsql(b.cli.GetDB()), nil
+}
+
+func (b *pgsqlBuilder) Tenant() (database.Tenant, error) {
+	return pgsql.NewTenantPgsql(b.cli.GetDB()), nil
+}
+
+func (b *pgsqlBuilder) Tx() tx.Tx {
+	return b.cli.GetTx()
+}
+
+func (b *pgsqlBuilder) MongoDB() *mongo.Database {
+	return nil
+}
@@ -0,0 +1,26 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// ChannelSubscriber indexes a member of a channel group for fan-out. Subscribers are spread
+// over shards so that a message is pushed to every shard concurrently.
+type ChannelSubscriber struct {
+	GroupID  string    `bson:"group_id"`
+	Shard    int32     `bson:"shard"`
+	UserID   string    `bson:"user_id"`
+	JoinTime time.Time `bson:"join_time"`
+}
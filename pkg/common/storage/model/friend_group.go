@@ -0,0 +1,51 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"strings"
+	"time"
+)
+
+// FriendGroupVersionPrefix starts the elements of the friend version log of a user that are
+// their friend groups instead of friends, followed by the group ID.
+const FriendGroupVersionPrefix = "____F_G_R_O_U_P____"
+
+// FriendGroupVersionID returns the friend version log element of a friend group.
+func FriendGroupVersionID(groupID string) string {
+	return FriendGroupVersionPrefix + groupID
+}
+
+// FriendGroupVersionGroupID returns the friend group of a friend version log element, false
+// when the element is not a friend group.
+func FriendGroupVersionGroupID(eID string) (string, bool) {
+	return strings.CutPrefix(eID, FriendGroupVersionPrefix)
+}
+
+// FriendGroup is a named group a user sorts their friends into.
+type FriendGroup struct {
+	OwnerUserID string    `bson:"owner_user_id"`
+	GroupID     string    `bson:"group_id"`
+	Name        string    `bson:"name"`
+	SortOrder   int32     `bson:"sort_order"`
+	CreateTime  time.Time `bson:"create_time"`
+}
+
+// FriendGroupMember puts a friend of the owner into one of their friend groups.
+type FriendGroupMember struct {
+	OwnerUserID  string `bson:"owner_user_id"`
+	GroupID      string `bson:"group_id"`
+	FriendUserID string `bson:"friend_user_id"`
+}
@@ -0,0 +1,57 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+const (
+	// GroupInviteApprovalDefault follows the verification setting of the group.
+	GroupInviteApprovalDefault = 0
+	// GroupInviteApprovalRequired always turns a redemption into a join request.
+	GroupInviteApprovalRequired = 1
+	// GroupInviteApprovalSkipped joins the group directly, whatever its verification setting.
+	GroupInviteApprovalSkipped = 2
+)
+
+const (
+	GroupInviteRedeemJoined  = 1
+	GroupInviteRedeemApplied = 2
+)
+
+// GroupInviteLink is a token that lets users join a group without being invited by a member.
+// A zero ExpireTime never expires and a zero MaxUses is not limited.
+type GroupInviteLink struct {
+	Token         string    `bson:"token"`
+	GroupID       string    `bson:"group_id"`
+	CreatorUserID string    `bson:"creator_user_id"`
+	ExpireTime    time.Time `bson:"expire_time"`
+	MaxUses       int32     `bson:"max_uses"`
+	UsedCount     int32     `bson:"used_count"`
+	Approval      int32     `bson:"approval"`
+	JoinSource    int32     `bson:"join_source"`
+	Revoked       bool      `bson:"revoked"`
+	Ex            string    `bson:"ex"`
+	CreateTime    time.Time `bson:"create_time"`
+}
+
+// GroupInviteRedemption records a user redeeming an invite link.
+type GroupInviteRedemption struct {
+	Token      string    `bson:"token"`
+	GroupID    string    `bson:"group_id"`
+	UserID     string    `bson:"user_id"`
+	JoinSource int32     `bson:"join_source"`
+	Result     int32     `bson:"result"`
+	CreateTime time.Time `bson:"create_time"`
+}
@@ -0,0 +1,50 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// GroupRequestExpired is the handle result of a join request nobody handled in time, next to
+// constant.GroupResponseAgree and constant.GroupResponseRefuse.
+const GroupRequestExpired = -2
+
+// GroupJoinQuestion is asked to users applying for joining a group.
+type GroupJoinQuestion struct {
+	QuestionID string `bson:"question_id"`
+	Question   string `bson:"question"`
+	Required   bool   `bson:"required"`
+}
+
+// GroupJoinQuestions are the questions of a group, in the order they are asked.
+type GroupJoinQuestions struct {
+	GroupID    string              `bson:"group_id"`
+	Questions  []GroupJoinQuestion `bson:"questions"`
+	UpdateTime time.Time           `bson:"update_time"`
+}
+
+// GroupJoinAnswer keeps the question as it was asked, the group may change it later.
+type GroupJoinAnswer struct {
+	QuestionID string `bson:"question_id"`
+	Question   string `bson:"question"`
+	Answer     string `bson:"answer"`
+}
+
+// GroupRequestAnswers are the answers of the latest join request of a user to a group.
+type GroupRequestAnswers struct {
+	GroupID    string            `bson:"group_id"`
+	UserID     string            `bson:"user_id"`
+	Answers    []GroupJoinAnswer `bson:"answers"`
+	CreateTime time.Time         `bson:"create_time"`
+}
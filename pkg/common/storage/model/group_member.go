@@ -30,4 +30,5 @@ type GroupMember struct {
 	OperatorUserID string    `bson:"operator_user_id"`
 	MuteEndTime    time.Time `bson:"mute_end_time"`
 	Ex             string    `bson:"ex"`
+	RoleID         string    `bson:"role_id"`
 }
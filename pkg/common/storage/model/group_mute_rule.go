@@ -0,0 +1,36 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// GroupMuteSchedule is a daily window, in minutes of the day, during which a group is muted.
+// A window whose end is before its start ends on the next day. Weekdays, 0 for Sunday, are
+// the days the window starts on, every day when empty.
+type GroupMuteSchedule struct {
+	StartMinute int32   `bson:"start_minute"`
+	EndMinute   int32   `bson:"end_minute"`
+	Weekdays    []int32 `bson:"weekdays"`
+}
+
+// GroupMuteRule limits how ordinary members of a group speak: at most one message every
+// SlowModeInterval seconds, and not at all during the mute schedules in TimeZone.
+type GroupMuteRule struct {
+	GroupID          string              `bson:"group_id"`
+	SlowModeInterval int32               `bson:"slow_mode_interval"`
+	TimeZone         string              `bson:"time_zone"`
+	Schedules        []GroupMuteSchedule `bson:"schedules"`
+	UpdateTime       time.Time           `bson:"update_time"`
+}
@@ -0,0 +1,25 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// GroupQuota replaces the configured member quota of the group type for one group.
+type GroupQuota struct {
+	GroupID string `bson:"group_id"`
+	// MaxMemberCount is 0 for no limit.
+	MaxMemberCount int32     `bson:"max_member_count"`
+	UpdateTime     time.Time `bson:"update_time"`
+}
@@ -0,0 +1,42 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// GroupRole is a custom role of a group, granting its members a set of group permissions.
+type GroupRole struct {
+	RoleID      string    `bson:"role_id"`
+	Name        string    `bson:"name"`
+	Permissions int64     `bson:"permissions"`
+	Ex          string    `bson:"ex"`
+	CreateTime  time.Time `bson:"create_time"`
+}
+
+type GroupRoles struct {
+	GroupID    string       `bson:"group_id"`
+	Roles      []*GroupRole `bson:"roles"`
+	UpdateTime time.Time    `bson:"update_time"`
+}
+
+// Role returns the role of roleID, nil when the group has no such role.
+func (g *GroupRoles) Role(roleID string) *GroupRole {
+	for _, role := range g.Roles {
+		if role.RoleID == roleID {
+			return role
+		}
+	}
+	return nil
+}
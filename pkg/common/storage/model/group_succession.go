@@ -0,0 +1,26 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// GroupSuccession decides who takes over the group when its owner leaves, groups without one
+// use the configured policy.
+type GroupSuccession struct {
+	GroupID         string    `bson:"group_id"`
+	Policy          int32     `bson:"policy"`
+	SuccessorUserID string    `bson:"successor_user_id"`
+	UpdateTime      time.Time `bson:"update_time"`
+}
@@ -0,0 +1,57 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+const (
+	ModerationReviewPending  = 0
+	ModerationReviewApproved = 1
+	ModerationReviewRejected = 2
+)
+
+// GroupSensitiveWord is the sensitive-word list a group adds on top of the global rules.
+type GroupSensitiveWord struct {
+	GroupID    string    `bson:"group_id"`
+	Words      []string  `bson:"words"`
+	Action     string    `bson:"action"`
+	UpdateTime time.Time `bson:"update_time"`
+}
+
+type ModerationVerdict struct {
+	Stage  string `bson:"stage"`
+	Rule   string `bson:"rule"`
+	Reason string `bson:"reason"`
+}
+
+// ModerationReview is a flagged message waiting for, or done with, a human review.
+type ModerationReview struct {
+	ReviewID       string              `bson:"review_id"`
+	ConversationID string              `bson:"conversation_id"`
+	ClientMsgID    string              `bson:"client_msg_id"`
+	ServerMsgID    string              `bson:"server_msg_id"`
+	SendID         string              `bson:"send_id"`
+	RecvID         string              `bson:"recv_id"`
+	GroupID        string              `bson:"group_id"`
+	SessionType    int32               `bson:"session_type"`
+	ContentType    int32               `bson:"content_type"`
+	Content        string              `bson:"content"`
+	SendTime       int64               `bson:"send_time"`
+	Verdicts       []ModerationVerdict `bson:"verdicts"`
+	Status         int32               `bson:"status"`
+	Reviewer       string              `bson:"reviewer"`
+	CreateTime     time.Time           `bson:"create_time"`
+	ReviewTime     time.Time           `bson:"review_time"`
+}
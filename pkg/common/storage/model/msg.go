@@ -15,8 +15,11 @@
 package model
 
 import (
+	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/sdkws"
 	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/openimsdk/tools/utils/jsonutil"
 	"strconv"
 )
 
@@ -143,3 +146,79 @@ func (*MsgDocModel) GenExceptionMessageBySeqs(seqs []int64) (exceptionMsg []*sdk
 	}
 	return exceptionMsg
 }
+
+// GetMsgsBySeqs picks the slots of seqs out of a whole document.
+func (m *MsgDocModel) GetMsgsBySeqs(seqs []int64) []*MsgInfoModel {
+	msgs := make([]*MsgInfoModel, 0, len(seqs))
+	for _, seq := range seqs {
+		if index := m.GetMsgIndex(seq); index >= 0 && index < int64(len(m.Msg)) {
+			msgs = append(msgs, m.Msg[index])
+		}
+	}
+	return msgs
+}
+
+// UserViewMsgs returns the messages the way userID sees them: the ones the user deleted lose
+// their content and revoked ones become revoke notifications. Empty slots are dropped.
+func UserViewMsgs(userID string, msgs []*MsgInfoModel) ([]*MsgInfoModel, error) {
+	res := make([]*MsgInfoModel, 0, len(msgs))
+	for _, msg := range msgs {
+		if msg == nil || msg.Msg == nil {
+			continue
+		}
+		if datautil.Contain(userID, msg.DelList...) {
+			msg.Msg.Content = ""
+			msg.Msg.Status = constant.MsgDeleted
+		}
+		if msg.Revoke != nil {
+			revokeContent := sdkws.MessageRevokedContent{
+				RevokerID:                   msg.Revoke.UserID,
+				RevokerRole:                 msg.Revoke.Role,
+				ClientMsgID:                 msg.Msg.ClientMsgID,
+				RevokerNickname:             msg.Revoke.Nickname,
+				RevokeTime:                  msg.Revoke.Time,
+				SourceMessageSendTime:       msg.Msg.SendTime,
+				SourceMessageSendID:         msg.Msg.SendID,
+				SourceMessageSenderNickname: msg.Msg.SenderNickname,
+				SessionType:                 msg.Msg.SessionType,
+				Seq:                         msg.Msg.Seq,
+				Ex:                          msg.Msg.Ex,
+			}
+			data, err := jsonutil.JsonMarshal(&revokeContent)
+			if err != nil {
+				return nil, err
+			}
+			elem := sdkws.NotificationElem{
+				Detail: string(data),
+			}
+			content, err := jsonutil.JsonMarshal(&elem)
+			if err != nil {
+				return nil, err
+			}
+			msg.Msg.ContentType = constant.MsgRevokeNotification
+			msg.Msg.Content = string(content)
+		}
+		res = append(res, msg)
+	}
+	return res, nil
+}
+
+// FillMissingSeqs orders msgs by seqs and puts an empty message in place of every missing seq.
+func FillMissingSeqs(seqs []int64, msgs []*MsgInfoModel) []*MsgInfoModel {
+	if len(msgs) == len(seqs) {
+		return msgs
+	}
+	tmp := make(map[int64]*MsgInfoModel)
+	for i, val := range msgs {
+		tmp[val.Msg.Seq] = msgs[i]
+	}
+	res := make([]*MsgInfoModel, 0, len(seqs))
+	for _, seq := range seqs {
+		if val, ok := tmp[seq]; ok {
+			res = append(res, val)
+		} else {
+			res = append(res, &MsgInfoModel{Msg: &MsgDataModel{Seq: seq}})
+		}
+	}
+	return res
+}
@@ -0,0 +1,30 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// MsgArchiveModel is the stub left in MongoDB for a message document moved to object storage.
+type MsgArchiveModel struct {
+	DocID          string    `bson:"doc_id"`
+	ConversationID string    `bson:"conversation_id"`
+	Engine         string    `bson:"engine"`
+	Key            string    `bson:"key"`
+	Size           int64     `bson:"size"`
+	MaxSeq         int64     `bson:"max_seq"`
+	MinSendTime    int64     `bson:"min_send_time"`
+	MaxSendTime    int64     `bson:"max_send_time"`
+	ArchiveTime    time.Time `bson:"archive_time"`
+}
@@ -0,0 +1,37 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+const (
+	TenantNormal   = 0
+	TenantDisabled = 1
+)
+
+// Tenant is an app served by the deployment next to the default one, with its own users,
+// secret, admins and webhooks. Its data is stored apart from the data of other tenants.
+type Tenant struct {
+	TenantID     string    `bson:"tenant_id"`
+	Name         string    `bson:"name"`
+	Secret       string    `bson:"secret"`
+	AdminUserIDs []string  `bson:"admin_user_ids"`
+	WebhookURL   string    `bson:"webhook_url"`
+	Notification string    `bson:"notification"`
+	Status       int32     `bson:"status"`
+	Ex           string    `bson:"ex"`
+	CreateTime   time.Time `bson:"create_time"`
+	UpdateTime   time.Time `bson:"update_time"`
+}
@@ -26,6 +26,8 @@ type User struct {
 	AppMangerLevel   int32     `bson:"app_manger_level"`
 	GlobalRecvMsgOpt int32     `bson:"global_recv_msg_opt"`
 	CreateTime       time.Time `bson:"create_time"`
+	// Disabled users can't get tokens, their groups were handed over when disabled.
+	Disabled bool `bson:"disabled"`
 }
 
 func (u *User) GetNickname() string {
@@ -0,0 +1,60 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+const (
+	UserJobExport = "export"
+	UserJobErase  = "erase"
+)
+
+const (
+	UserJobRunning   = 0
+	UserJobSucceeded = 1
+	UserJobFailed    = 2
+)
+
+const (
+	UserJobStepPending = 0
+	UserJobStepRunning = 1
+	UserJobStepDone    = 2
+	UserJobStepFailed  = 3
+)
+
+// UserJobStep is one stage of a user job. Cursor is where the step resumes after an
+// interruption, its format is up to the step.
+type UserJobStep struct {
+	Name       string    `bson:"name"`
+	Status     int32     `bson:"status"`
+	Cursor     string    `bson:"cursor"`
+	Count      int64     `bson:"count"`
+	Error      string    `bson:"error"`
+	UpdateTime time.Time `bson:"update_time"`
+}
+
+// UserJob is a long running job over everything stored about one user, such as a data export.
+type UserJob struct {
+	JobID          string        `bson:"job_id"`
+	Type           string        `bson:"type"`
+	UserID         string        `bson:"user_id"`
+	OperatorUserID string        `bson:"operator_user_id"`
+	Status         int32         `bson:"status"`
+	Steps          []UserJobStep `bson:"steps"`
+	Result         string        `bson:"result"`
+	Error          string        `bson:"error"`
+	CreateTime     time.Time     `bson:"create_time"`
+	UpdateTime     time.Time     `bson:"update_time"`
+}
@@ -0,0 +1,684 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgindex
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+const (
+	segmentSuffix          = ".seg"
+	snapshotPrefix         = "snapshot-"
+	compactLockName        = "compact.lock"
+	defaultSegmentMaxSize  = 64 << 20
+	defaultRefreshInterval = time.Second
+
+	// segmentMaxAge is how long a writer appends to one segment. Segments older than
+	// twice this are no longer written to by anyone and can be compacted.
+	segmentMaxAge = time.Hour
+	// tombstoneRetention is how long a deletion keeps a late re-index of the message out.
+	tombstoneRetention = 7 * 24 * time.Hour
+	// compactLockStale is after how long the compaction lock of a crashed process is broken.
+	compactLockStale = time.Hour
+
+	opIndex    = "i"
+	opDelete   = "d"
+	opSnapshot = "s"
+)
+
+type docKey struct {
+	conversationID string
+	seq            int64
+}
+
+// record is one line of a segment file.
+type record struct {
+	Op             string    `json:"op"`
+	Doc            *Document `json:"doc,omitempty"`
+	ConversationID string    `json:"cid,omitempty"`
+	Seqs           []int64   `json:"seqs,omitempty"`
+	// Time is when a deletion was made, in milliseconds.
+	Time int64 `json:"t,omitempty"`
+	// Replaces lists the segments a snapshot was compacted from.
+	Replaces []segmentSize `json:"replaces,omitempty"`
+}
+
+type segmentSize struct {
+	Name string `json:"name"`
+	Size int64  `json:"size"`
+}
+
+// embeddedIndexer is an inverted index kept in memory and persisted as append-only
+// segment files. Every process appends to its own segments, so several writers can
+// share one directory; readers replay all segments and then follow their growth.
+// Deletions are written as tombstones and win over documents regardless of the order
+// in which segments are replayed, which is safe because seqs are never reused.
+//
+// Writers that seal a segment compact all sealed segments into a snapshot, dropping
+// deleted documents and tombstones older than tombstoneRetention.
+type embeddedIndexer struct {
+	dir             string
+	segmentMaxSize  int64
+	refreshInterval time.Duration
+	// sealAge is the age after which a segment is no longer written to.
+	sealAge time.Duration
+
+	writeMu  sync.Mutex
+	segment  *os.File
+	written  int64
+	openedAt time.Time
+
+	compacting atomic.Bool
+	compactWg  sync.WaitGroup
+
+	mu          sync.RWMutex
+	lastRefresh time.Time
+	lastPrune   time.Time
+	offsets     map[string]int64
+	docs        map[docKey]*Document
+	// deleted holds the time of every deletion in milliseconds.
+	deleted  map[docKey]int64
+	postings map[string]map[docKey]struct{}
+}
+
+// NewEmbeddedIndexer opens the segment directory, creating it if necessary.
+//
+// The index is only as complete as the directory: openim-msgtransfer writes it and
+// openim-rpc-msg searches it, so every instance of both must mount the same directory.
+// An openim-rpc-msg on a host of its own finds nothing. Every process also keeps the index in
+// memory and follows the segments at its own pace, so only a single openim-rpc-msg instance is
+// supported; several replicas may answer the same search differently.
+func NewEmbeddedIndexer(dir string, segmentMaxSize int64, refreshInterval time.Duration) (MessageIndexer, error) {
+	if dir == "" {
+		return nil, errs.New("embedded index dir is empty").Wrap()
+	}
+	if err := os.MkdirAll(dir, config.DefaultDirPerm); err != nil {
+		return nil, errs.WrapMsg(err, "create index dir failed", "dir", dir)
+	}
+	if segmentMaxSize <= 0 {
+		segmentMaxSize = defaultSegmentMaxSize
+	}
+	if refreshInterval <= 0 {
+		refreshInterval = defaultRefreshInterval
+	}
+	names, err := filepath.Glob(filepath.Join(dir, "*"+segmentSuffix))
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if len(names) == 0 {
+		log.ZWarn(context.Background(), "embedded index dir has no segments yet, it must be shared by openim-msgtransfer and openim-rpc-msg", nil, "dir", dir)
+	}
+	return newEmbeddedIndexer(dir, segmentMaxSize, refreshInterval), nil
+}
+
+func newEmbeddedIndexer(dir string, segmentMaxSize int64, refreshInterval time.Duration) *embeddedIndexer {
+	return &embeddedIndexer{
+		dir:             dir,
+		segmentMaxSize:  segmentMaxSize,
+		refreshInterval: refreshInterval,
+		sealAge:         2 * segmentMaxAge,
+		offsets:         make(map[string]int64),
+		docs:            make(map[docKey]*Document),
+		deleted:         make(map[docKey]int64),
+		postings:        make(map[string]map[docKey]struct{}),
+	}
+}
+
+func (e *embeddedIndexer) Index(ctx context.Context, docs []*Document) error {
+	records := make([]*record, 0, len(docs))
+	for _, doc := range docs {
+		if doc == nil {
+			continue
+		}
+		records = append(records, &record{Op: opIndex, Doc: doc})
+	}
+	return e.append(records)
+}
+
+func (e *embeddedIndexer) Delete(ctx context.Context, conversationID string, seqs []int64) error {
+	if len(seqs) == 0 {
+		return nil
+	}
+	if err := e.append([]*record{{Op: opDelete, ConversationID: conversationID, Seqs: seqs, Time: time.Now().UnixMilli()}}); err != nil {
+		return err
+	}
+	e.mu.Lock()
+	e.lastRefresh = time.Time{}
+	e.mu.Unlock()
+	return nil
+}
+
+func (e *embeddedIndexer) append(records []*record) error {
+	if len(records) == 0 {
+		return nil
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, r := range records {
+		if err := enc.Encode(r); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	e.writeMu.Lock()
+	defer e.writeMu.Unlock()
+	if e.segment == nil || e.written >= e.segmentMaxSize || time.Since(e.openedAt) >= segmentMaxAge {
+		sealed := e.segment != nil
+		if err := e.rotate(); err != nil {
+			return err
+		}
+		if sealed {
+			e.compactAsync()
+		}
+	}
+	n, err := e.segment.Write(buf.Bytes())
+	e.written += int64(n)
+	if err != nil {
+		return errs.WrapMsg(err, "write index segment failed", "segment", e.segment.Name())
+	}
+	return nil
+}
+
+func (e *embeddedIndexer) rotate() error {
+	if e.segment != nil {
+		if err := e.segment.Close(); err != nil {
+			log.ZWarn(context.Background(), "close index segment failed", err, "segment", e.segment.Name())
+		}
+	}
+	name := fmt.Sprintf("%d-%d%s", time.Now().UnixNano(), os.Getpid(), segmentSuffix)
+	f, err := os.OpenFile(filepath.Join(e.dir, name), os.O_CREATE|os.O_WRONLY|os.O_APPEND, config.PrivateFilePerm)
+	if err != nil {
+		return errs.WrapMsg(err, "create index segment failed", "dir", e.dir)
+	}
+	e.segment = f
+	e.written = 0
+	e.openedAt = time.Now()
+	return nil
+}
+
+// refresh replays the bytes appended to every segment since the last refresh.
+func (e *embeddedIndexer) refresh(ctx context.Context) error {
+	e.mu.RLock()
+	fresh := time.Since(e.lastRefresh) < e.refreshInterval
+	e.mu.RUnlock()
+	if fresh {
+		return nil
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if time.Since(e.lastRefresh) < e.refreshInterval {
+		return nil
+	}
+	names, err := filepath.Glob(filepath.Join(e.dir, "*"+segmentSuffix))
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	sort.Strings(names)
+	exist := datautil.SliceSet(names)
+	for name := range e.offsets {
+		if _, ok := exist[name]; !ok {
+			delete(e.offsets, name)
+		}
+	}
+	for _, name := range names {
+		if _, ok := e.offsets[name]; !ok && isSnapshot(name) {
+			skipped, err := e.skipSnapshot(name)
+			if err != nil {
+				return err
+			}
+			if skipped {
+				continue
+			}
+		}
+		if err := e.replay(ctx, name); err != nil {
+			return err
+		}
+	}
+	now := time.Now()
+	if now.Sub(e.lastPrune) >= segmentMaxAge {
+		e.pruneTombstones(now)
+		e.lastPrune = now
+	}
+	e.lastRefresh = now
+	return nil
+}
+
+func isSnapshot(name string) bool {
+	return strings.HasPrefix(filepath.Base(name), snapshotPrefix)
+}
+
+// skipSnapshot marks a snapshot as replayed when every segment it was compacted from
+// has already been replayed in full, since it holds nothing new then.
+func (e *embeddedIndexer) skipSnapshot(name string) (bool, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return true, nil
+		}
+		return false, errs.WrapMsg(err, "open index snapshot failed", "snapshot", name)
+	}
+	defer f.Close()
+	line, err := bufio.NewReader(f).ReadBytes('\n')
+	if err != nil {
+		return false, nil
+	}
+	var header record
+	if err := json.Unmarshal(line, &header); err != nil || header.Op != opSnapshot {
+		return false, nil
+	}
+	for _, segment := range header.Replaces {
+		if e.offsets[filepath.Join(e.dir, segment.Name)] < segment.Size {
+			return false, nil
+		}
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return false, errs.WrapMsg(err, "stat index snapshot failed", "snapshot", name)
+	}
+	e.offsets[name] = info.Size()
+	return true, nil
+}
+
+// pruneTombstones forgets deletions older than tombstoneRetention.
+func (e *embeddedIndexer) pruneTombstones(now time.Time) {
+	before := now.Add(-tombstoneRetention).UnixMilli()
+	for key, t := range e.deleted {
+		if t < before {
+			delete(e.deleted, key)
+		}
+	}
+}
+
+func (e *embeddedIndexer) replay(ctx context.Context, name string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		if os.IsNotExist(err) {
+			// compacted into a snapshot since the directory was listed
+			delete(e.offsets, name)
+			return nil
+		}
+		return errs.WrapMsg(err, "open index segment failed", "segment", name)
+	}
+	defer f.Close()
+	offset := e.offsets[name]
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return errs.WrapMsg(err, "seek index segment failed", "segment", name)
+	}
+	reader := bufio.NewReader(f)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			// A partial line is still being written, pick it up on the next refresh.
+			break
+		}
+		if err != nil {
+			return errs.WrapMsg(err, "read index segment failed", "segment", name)
+		}
+		offset += int64(len(line))
+		var r record
+		if err := json.Unmarshal(line, &r); err != nil {
+			log.ZWarn(ctx, "skip corrupt index record", err, "segment", name, "offset", offset)
+			continue
+		}
+		e.apply(&r)
+	}
+	e.offsets[name] = offset
+	return nil
+}
+
+func (e *embeddedIndexer) apply(r *record) {
+	switch r.Op {
+	case opIndex:
+		if r.Doc == nil {
+			return
+		}
+		key := docKey{conversationID: r.Doc.ConversationID, seq: r.Doc.Seq}
+		if _, ok := e.deleted[key]; ok {
+			return
+		}
+		e.remove(key)
+		r.Doc.Tokens = Tokenize(r.Doc.Text)
+		e.docs[key] = r.Doc
+		for _, token := range r.Doc.Tokens {
+			keys, ok := e.postings[token]
+			if !ok {
+				keys = make(map[docKey]struct{})
+				e.postings[token] = keys
+			}
+			keys[key] = struct{}{}
+		}
+	case opDelete:
+		t := r.Time
+		if t == 0 {
+			t = time.Now().UnixMilli()
+		}
+		for _, seq := range r.Seqs {
+			key := docKey{conversationID: r.ConversationID, seq: seq}
+			e.deleted[key] = max(e.deleted[key], t)
+			e.remove(key)
+		}
+	}
+}
+
+func (e *embeddedIndexer) remove(key docKey) {
+	doc, ok := e.docs[key]
+	if !ok {
+		return
+	}
+	delete(e.docs, key)
+	for _, token := range doc.Tokens {
+		if keys, ok := e.postings[token]; ok {
+			delete(keys, key)
+			if len(keys) == 0 {
+				delete(e.postings, token)
+			}
+		}
+	}
+}
+
+func (e *embeddedIndexer) Search(ctx context.Context, query *Query) (*Result, error) {
+	after, err := decodeCursor(query.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	if err := e.refresh(ctx); err != nil {
+		return nil, err
+	}
+	e.mu.RLock()
+	matches := e.match(query)
+	e.mu.RUnlock()
+	sort.Slice(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if a.SendTime != b.SendTime {
+			return a.SendTime > b.SendTime
+		}
+		if a.ConversationID != b.ConversationID {
+			return a.ConversationID < b.ConversationID
+		}
+		return a.Seq > b.Seq
+	})
+	start := 0
+	if after != nil {
+		start = sort.Search(len(matches), func(i int) bool { return after.after(matches[i]) })
+	} else if query.Offset > 0 {
+		start = query.Offset
+	}
+	if start >= len(matches) {
+		return &Result{Total: int64(len(matches))}, nil
+	}
+	return newResult(int64(len(matches)), matches[start:], query.limit()), nil
+}
+
+// match must be called with e.mu held.
+func (e *embeddedIndexer) match(query *Query) []*Document {
+	var candidates map[docKey]struct{}
+	tokens := query.Keyword.Tokens()
+	if len(tokens) > 0 {
+		postings := make([]map[docKey]struct{}, 0, len(tokens))
+		for _, token := range tokens {
+			keys, ok := e.postings[token]
+			if !ok {
+				return nil
+			}
+			postings = append(postings, keys)
+		}
+		sort.Slice(postings, func(i, j int) bool { return len(postings[i]) < len(postings[j]) })
+		candidates = postings[0]
+		for _, keys := range postings[1:] {
+			next := make(map[docKey]struct{}, len(candidates))
+			for key := range candidates {
+				if _, ok := keys[key]; ok {
+					next[key] = struct{}{}
+				}
+			}
+			candidates = next
+		}
+	}
+	var conversationIDs map[string]struct{}
+	if len(query.ConversationIDs) > 0 {
+		conversationIDs = datautil.SliceSet(query.ConversationIDs)
+	} else if len(query.SeqRanges) > 0 {
+		conversationIDs = make(map[string]struct{}, len(query.SeqRanges))
+		for conversationID := range query.SeqRanges {
+			conversationIDs[conversationID] = struct{}{}
+		}
+	}
+	var matches []*Document
+	check := func(doc *Document) {
+		if conversationIDs != nil {
+			if _, ok := conversationIDs[doc.ConversationID]; !ok {
+				return
+			}
+		} else if !inTenant(query.TenantID, doc.ConversationID) {
+			return
+		}
+		if matchFields(query, doc) && query.Keyword.MatchPhrases(doc.Text) {
+			matches = append(matches, doc)
+		}
+	}
+	if candidates != nil {
+		for key := range candidates {
+			check(e.docs[key])
+		}
+	} else if len(tokens) == 0 && query.Keyword.IsEmpty() {
+		for _, doc := range e.docs {
+			check(doc)
+		}
+	}
+	return matches
+}
+
+// matchFields applies the non-text constraints of a query.
+func matchFields(query *Query, doc *Document) bool {
+	if r, ok := query.SeqRanges[doc.ConversationID]; ok && !r.contains(doc.Seq) {
+		return false
+	}
+	if query.SendID != "" && doc.SendID != query.SendID {
+		return false
+	}
+	if query.RecvID != "" && doc.RecvID != query.RecvID && doc.GroupID != query.RecvID {
+		return false
+	}
+	if query.SessionType != 0 && doc.SessionType != query.SessionType {
+		return false
+	}
+	if query.ContentType != 0 && doc.ContentType != query.ContentType {
+		return false
+	}
+	if query.StartTime != 0 && doc.SendTime < query.StartTime {
+		return false
+	}
+	if query.EndTime != 0 && doc.SendTime >= query.EndTime {
+		return false
+	}
+	return true
+}
+
+func (e *embeddedIndexer) compactAsync() {
+	if !e.compacting.CompareAndSwap(false, true) {
+		return
+	}
+	e.compactWg.Add(1)
+	go func() {
+		defer e.compactWg.Done()
+		defer e.compacting.Store(false)
+		ctx := context.Background()
+		if err := e.compact(ctx); err != nil {
+			log.ZError(ctx, "compact index segments failed", err, "dir", e.dir)
+		}
+	}()
+}
+
+// compact merges the sealed segments and snapshots into a new snapshot and removes them.
+// One process compacts a directory at a time.
+func (e *embeddedIndexer) compact(ctx context.Context) error {
+	unlock, ok, err := e.lockCompaction()
+	if err != nil || !ok {
+		return err
+	}
+	defer unlock()
+	names, err := filepath.Glob(filepath.Join(e.dir, "*"+segmentSuffix))
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	sort.Strings(names)
+	e.writeMu.Lock()
+	var active string
+	if e.segment != nil {
+		active = e.segment.Name()
+	}
+	e.writeMu.Unlock()
+	sealed := make([]string, 0, len(names))
+	for _, name := range names {
+		if name != active && e.sealed(name) {
+			sealed = append(sealed, name)
+		}
+	}
+	if len(sealed) < 2 {
+		return nil
+	}
+	state := newEmbeddedIndexer(e.dir, e.segmentMaxSize, e.refreshInterval)
+	replaces := make([]segmentSize, 0, len(sealed))
+	for _, name := range sealed {
+		if err := state.replay(ctx, name); err != nil {
+			return err
+		}
+		replaces = append(replaces, segmentSize{Name: filepath.Base(name), Size: state.offsets[name]})
+	}
+	state.pruneTombstones(time.Now())
+	if err := state.writeSnapshot(replaces); err != nil {
+		return err
+	}
+	for _, name := range sealed {
+		if err := os.Remove(name); err != nil && !os.IsNotExist(err) {
+			log.ZWarn(ctx, "remove compacted index segment failed", err, "segment", name)
+		}
+	}
+	log.ZInfo(ctx, "compacted index segments", "dir", e.dir, "segments", len(sealed), "docs", len(state.docs), "tombstones", len(state.deleted))
+	return nil
+}
+
+// sealed reports whether no writer appends to the segment anymore.
+func (e *embeddedIndexer) sealed(name string) bool {
+	base := filepath.Base(name)
+	if strings.HasPrefix(base, snapshotPrefix) {
+		return true
+	}
+	created, _, ok := strings.Cut(base, "-")
+	if !ok {
+		return false
+	}
+	nanos, err := strconv.ParseInt(created, 10, 64)
+	if err != nil {
+		return false
+	}
+	return time.Since(time.Unix(0, nanos)) >= e.sealAge
+}
+
+// lockCompaction takes the compaction lock of the directory, breaking it when its holder
+// is gone for longer than compactLockStale.
+func (e *embeddedIndexer) lockCompaction() (func(), bool, error) {
+	name := filepath.Join(e.dir, compactLockName)
+	for i := 0; i < 2; i++ {
+		f, err := os.OpenFile(name, os.O_CREATE|os.O_EXCL|os.O_WRONLY, config.PrivateFilePerm)
+		if err == nil {
+			_ = f.Close()
+			return func() { _ = os.Remove(name) }, true, nil
+		}
+		if !os.IsExist(err) {
+			return nil, false, errs.WrapMsg(err, "create index compaction lock failed", "dir", e.dir)
+		}
+		info, err := os.Stat(name)
+		if err != nil || time.Since(info.ModTime()) < compactLockStale {
+			return nil, false, nil
+		}
+		_ = os.Remove(name)
+	}
+	return nil, false, nil
+}
+
+// writeSnapshot writes the live documents and tombstones of the state as a new snapshot.
+func (e *embeddedIndexer) writeSnapshot(replaces []segmentSize) error {
+	name := fmt.Sprintf("%s%d%s", snapshotPrefix, time.Now().UnixNano(), segmentSuffix)
+	tmp := filepath.Join(e.dir, name+".tmp")
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, config.PrivateFilePerm)
+	if err != nil {
+		return errs.WrapMsg(err, "create index snapshot failed", "dir", e.dir)
+	}
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+	err = enc.Encode(&record{Op: opSnapshot, Replaces: replaces})
+	for key, t := range e.deleted {
+		if err != nil {
+			break
+		}
+		err = enc.Encode(&record{Op: opDelete, ConversationID: key.conversationID, Seqs: []int64{key.seq}, Time: t})
+	}
+	for _, doc := range e.docs {
+		if err != nil {
+			break
+		}
+		err = enc.Encode(&record{Op: opIndex, Doc: doc})
+	}
+	if err == nil {
+		err = w.Flush()
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp, filepath.Join(e.dir, name))
+	}
+	if err != nil {
+		_ = os.Remove(tmp)
+		return errs.WrapMsg(err, "write index snapshot failed", "snapshot", name)
+	}
+	return nil
+}
+
+func (e *embeddedIndexer) Close() error {
+	e.compactWg.Wait()
+	e.writeMu.Lock()
+	defer e.writeMu.Unlock()
+	if e.segment == nil {
+		return nil
+	}
+	err := e.segment.Close()
+	e.segment = nil
+	if err != nil && !strings.Contains(err.Error(), os.ErrClosed.Error()) {
+		return errs.Wrap(err)
+	}
+	return nil
+}
@@ -0,0 +1,208 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgindex
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/protocol/constant"
+)
+
+func TestExtractText(t *testing.T) {
+	tests := []struct {
+		contentType int32
+		content     string
+		want        string
+	}{
+		{constant.Text, `{"content":"hello world"}`, "hello world"},
+		{constant.Text, `plain`, "plain"},
+		{constant.File, `{"fileName":"report.pdf"}`, "report.pdf"},
+		{constant.Picture, `{"sourcePicture":{}}`, ""},
+	}
+	for _, tt := range tests {
+		if got := ExtractText(tt.contentType, tt.content); got != tt.want {
+			t.Errorf("ExtractText(%d, %q) = %q, want %q", tt.contentType, tt.content, got, tt.want)
+		}
+	}
+}
+
+func TestEmbeddedIndexer(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	writer, err := NewEmbeddedIndexer(dir, 256, time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer writer.Close()
+	docs := []*Document{
+		{ConversationID: "si_a_b", Seq: 1, SendID: "a", SendTime: 100, Text: "Meeting at noon"},
+		{ConversationID: "si_a_b", Seq: 2, SendID: "b", SendTime: 200, Text: "the meeting moved"},
+		{ConversationID: "sg_g1", Seq: 1, SendID: "a", SendTime: 300, Text: "明天开会"},
+		{ConversationID: "sg_g1", Seq: 2, SendID: "c", SendTime: 400, Text: "no meeting today"},
+	}
+	if err := writer.Index(ctx, docs); err != nil {
+		t.Fatal(err)
+	}
+
+	// A second instance on the same directory sees the writer's segments.
+	reader, err := NewEmbeddedIndexer(dir, 256, time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	res, err := reader.Search(ctx, &Query{Keyword: ParseKeyword("meeting"), Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Total != 3 || len(res.Hits) != 2 || res.Hits[0].SendTime != 400 || res.NextCursor == "" {
+		t.Fatalf("unexpected first page %+v", res)
+	}
+	res, err = reader.Search(ctx, &Query{Keyword: ParseKeyword("meeting"), Limit: 2, Cursor: res.NextCursor})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Hits) != 1 || res.Hits[0].SendTime != 100 || res.NextCursor != "" {
+		t.Fatalf("unexpected second page %+v", res)
+	}
+
+	res, err = reader.Search(ctx, &Query{Keyword: ParseKeyword("开会")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Total != 1 || res.Hits[0].ConversationID != "sg_g1" {
+		t.Fatalf("unexpected CJK result %+v", res)
+	}
+
+	res, err = reader.Search(ctx, &Query{Keyword: ParseKeyword(`"meeting moved"`), SendID: "b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Total != 1 || res.Hits[0].Seq != 2 {
+		t.Fatalf("unexpected phrase result %+v", res)
+	}
+
+	if err := writer.Delete(ctx, "si_a_b", []int64{2}); err != nil {
+		t.Fatal(err)
+	}
+	// Re-indexing a deleted message must not bring it back.
+	if err := writer.Index(ctx, docs[1:2]); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(2 * time.Millisecond)
+	res, err = reader.Search(ctx, &Query{Keyword: ParseKeyword("meeting")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Total != 2 {
+		t.Fatalf("deleted message still found %+v", res)
+	}
+}
+
+func TestEmbeddedIndexerSeqRanges(t *testing.T) {
+	ctx := context.Background()
+	indexer, err := NewEmbeddedIndexer(t.TempDir(), 0, time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer indexer.Close()
+	var docs []*Document
+	for seq := int64(1); seq <= 5; seq++ {
+		docs = append(docs, &Document{ConversationID: "sg_g1", Seq: seq, SendTime: seq, Text: "hello"})
+	}
+	docs = append(docs, &Document{ConversationID: "sg_g2", Seq: 1, SendTime: 10, Text: "hello"})
+	if err := indexer.Index(ctx, docs); err != nil {
+		t.Fatal(err)
+	}
+	res, err := indexer.Search(ctx, &Query{
+		Keyword:   ParseKeyword("hello"),
+		SeqRanges: map[string]SeqRange{"sg_g1": {Min: 3, Max: 4}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Total != 2 || res.Hits[0].Seq != 4 || res.Hits[1].Seq != 3 {
+		t.Fatalf("unexpected result %+v", res)
+	}
+}
+
+func TestEmbeddedIndexerCompact(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	writer := newEmbeddedIndexer(dir, 1, time.Millisecond)
+	writer.sealAge = 0
+	defer writer.Close()
+	reader := newEmbeddedIndexer(dir, 1, time.Millisecond)
+	defer reader.Close()
+	// Every append starts a new segment.
+	for seq := int64(1); seq <= 4; seq++ {
+		doc := &Document{ConversationID: "sg_g1", Seq: seq, SendTime: seq, Text: "hello"}
+		if err := writer.Index(ctx, []*Document{doc}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := writer.Delete(ctx, "sg_g1", []int64{2}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := reader.Search(ctx, &Query{Keyword: ParseKeyword("hello")}); err != nil {
+		t.Fatal(err)
+	}
+	writer.compactWg.Wait()
+	if err := writer.compact(ctx); err != nil {
+		t.Fatal(err)
+	}
+	names, err := filepath.Glob(filepath.Join(dir, "*"+segmentSuffix))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != 2 || !isSnapshot(names[1]) {
+		t.Fatalf("expected a snapshot and the active segment, got %v", names)
+	}
+	if len(writer.deleted) != 0 {
+		t.Fatalf("writer state changed by compaction")
+	}
+
+	for _, indexer := range []*embeddedIndexer{reader, newEmbeddedIndexer(dir, 1, time.Millisecond)} {
+		time.Sleep(2 * time.Millisecond)
+		res, err := indexer.Search(ctx, &Query{Keyword: ParseKeyword("hello")})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Total != 3 {
+			t.Fatalf("unexpected result after compaction %+v", res)
+		}
+		if _, ok := indexer.deleted[docKey{conversationID: "sg_g1", seq: 2}]; !ok {
+			t.Fatalf("tombstone lost by compaction")
+		}
+	}
+	// The reader had replayed every compacted segment, so it skipped the snapshot.
+	if _, ok := reader.offsets[names[1]]; !ok || len(reader.offsets) != 2 {
+		t.Fatalf("unexpected reader offsets %v", reader.offsets)
+	}
+}
+
+func TestPruneTombstones(t *testing.T) {
+	e := newEmbeddedIndexer(t.TempDir(), 0, 0)
+	now := time.Now()
+	e.deleted[docKey{conversationID: "c", seq: 1}] = now.Add(-tombstoneRetention - time.Minute).UnixMilli()
+	e.deleted[docKey{conversationID: "c", seq: 2}] = now.UnixMilli()
+	e.pruneTombstones(now)
+	if _, ok := e.deleted[docKey{conversationID: "c", seq: 2}]; len(e.deleted) != 1 || !ok {
+		t.Fatalf("unexpected tombstones %v", e.deleted)
+	}
+}
@@ -0,0 +1,220 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package msgindex maintains a searchable projection of chat messages. Documents are
+// written by openim-msgtransfer once a message is persisted and read by openim-rpc-msg.
+package msgindex
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	BackendEmbedded = "embedded"
+	BackendMongo    = "mongo"
+
+	defaultLimit = 20
+	maxLimit     = 1000
+)
+
+// MessageIndexer indexes messages for keyword search. Implementations must be safe for
+// concurrent use.
+type MessageIndexer interface {
+	// Index adds or replaces documents, keyed by conversation ID and seq.
+	Index(ctx context.Context, docs []*Document) error
+	// Delete removes the documents of the given seqs, used for revoked and physically deleted messages.
+	Delete(ctx context.Context, conversationID string, seqs []int64) error
+	// Search returns matching documents ordered from newest to oldest.
+	Search(ctx context.Context, query *Query) (*Result, error)
+	Close() error
+}
+
+// Document is the searchable projection of a chat message.
+type Document struct {
+	ConversationID string   `json:"cid"          bson:"conversation_id"`
+	Seq            int64    `json:"seq"          bson:"seq"`
+	SendID         string   `json:"sendID"       bson:"send_id"`
+	RecvID         string   `json:"recvID"       bson:"recv_id"`
+	GroupID        string   `json:"groupID"      bson:"group_id"`
+	SessionType    int32    `json:"sessionType"  bson:"session_type"`
+	ContentType    int32    `json:"contentType"  bson:"content_type"`
+	SendTime       int64    `json:"sendTime"     bson:"send_time"`
+	Text           string   `json:"text"         bson:"text"`
+	Tokens         []string `json:"-"          bson:"tokens"`
+}
+
+// NewDocument builds the index document of a persisted message. Messages without a
+// seq are not stored and cannot be found later, so they are skipped.
+func NewDocument(conversationID string, msg *sdkws.MsgData) *Document {
+	if msg == nil || msg.Seq == 0 {
+		return nil
+	}
+	return &Document{
+		ConversationID: conversationID,
+		Seq:            msg.Seq,
+		SendID:         msg.SendID,
+		RecvID:         msg.RecvID,
+		GroupID:        msg.GroupID,
+		SessionType:    msg.SessionType,
+		ContentType:    msg.ContentType,
+		SendTime:       msg.SendTime,
+		Text:           ExtractText(msg.ContentType, string(msg.Content)),
+	}
+}
+
+// Query describes a search. Zero values leave the corresponding field unconstrained.
+type Query struct {
+	Keyword         Keyword
+	ConversationIDs []string
+	// SeqRanges limits conversations to the seqs a user may see; conversations without a
+	// range are unrestricted.
+	SeqRanges map[string]SeqRange
+	SendID    string
+	// RecvID matches the receiver of single chats and the group of group chats.
+	RecvID      string
+	SessionType int32
+	ContentType int32
+	// StartTime is inclusive and EndTime exclusive, both in milliseconds.
+	StartTime int64
+	EndTime   int64
+	// Cursor continues a previous search; when empty Offset skips the first hits.
+	Cursor string
+	Offset int
+	Limit  int
+	// TenantID limits searches without conversations to the documents of the tenant. It is
+	// set from the context by the indexers returned by NewMessageIndexer.
+	TenantID string
+}
+
+// SeqRange bounds the seqs of a conversation, both ends inclusive. A zero Max is unbounded.
+type SeqRange struct {
+	Min int64
+	Max int64
+}
+
+func (r SeqRange) contains(seq int64) bool {
+	return seq >= r.Min && (r.Max == 0 || seq <= r.Max)
+}
+
+func (q *Query) limit() int {
+	switch {
+	case q.Limit <= 0:
+		return defaultLimit
+	case q.Limit > maxLimit:
+		return maxLimit
+	default:
+		return q.Limit
+	}
+}
+
+// Hit references a matching message.
+type Hit struct {
+	ConversationID string
+	Seq            int64
+	SendTime       int64
+}
+
+type Result struct {
+	Total      int64
+	Hits       []*Hit
+	NextCursor string
+}
+
+// cursor is the sort key of the last returned hit. Hits are ordered by send time
+// descending, then conversation ID ascending and seq descending.
+type cursor struct {
+	SendTime       int64
+	ConversationID string
+	Seq            int64
+}
+
+func (c *cursor) after(doc *Document) bool {
+	if doc.SendTime != c.SendTime {
+		return doc.SendTime < c.SendTime
+	}
+	if doc.ConversationID != c.ConversationID {
+		return doc.ConversationID > c.ConversationID
+	}
+	return doc.Seq < c.Seq
+}
+
+func encodeCursor(doc *Document) string {
+	s := strconv.FormatInt(doc.SendTime, 10) + ":" + strconv.FormatInt(doc.Seq, 10) + ":" + doc.ConversationID
+	return base64.RawURLEncoding.EncodeToString([]byte(s))
+}
+
+func decodeCursor(s string) (*cursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errs.ErrArgs.WrapMsg("invalid cursor")
+	}
+	parts := strings.SplitN(string(data), ":", 3)
+	if len(parts) != 3 {
+		return nil, errs.ErrArgs.WrapMsg("invalid cursor")
+	}
+	sendTime, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, errs.ErrArgs.WrapMsg("invalid cursor")
+	}
+	seq, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return nil, errs.ErrArgs.WrapMsg("invalid cursor")
+	}
+	return &cursor{SendTime: sendTime, Seq: seq, ConversationID: parts[2]}, nil
+}
+
+// IsEmbedded reports whether the search configuration selects the embedded backend.
+func IsEmbedded(conf *config.Search) bool {
+	return conf.Enable && (conf.Backend == BackendEmbedded || conf.Backend == "")
+}
+
+// NewMessageIndexer creates the indexer selected by the search configuration.
+// It returns nil when the index is disabled. db is nil when messages are not stored in Mongo.
+func NewMessageIndexer(conf *config.Search, db *mongo.Database) (MessageIndexer, error) {
+	if !conf.Enable {
+		return nil, nil
+	}
+	switch conf.Backend {
+	case BackendEmbedded, "":
+		indexer, err := NewEmbeddedIndexer(conf.Embedded.Dir, conf.Embedded.SegmentMaxSize,
+			time.Duration(conf.Embedded.RefreshInterval)*time.Millisecond)
+		if err != nil {
+			return nil, err
+		}
+		return tenantIndexer{indexer}, nil
+	case BackendMongo:
+		if db == nil {
+			return nil, errs.ErrArgs.WrapMsg("mongo search backend needs the mongo database backend")
+		}
+		indexer, err := NewMongoIndexer(db, conf.Mongo.AtlasSearchIndex)
+		if err != nil {
+			return nil, err
+		}
+		return tenantIndexer{indexer}, nil
+	default:
+		return nil, errs.New("unknown search backend", "backend", conf.Backend).Wrap()
+	}
+}
@@ -0,0 +1,331 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgindex
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	CollectionName = "msg_index"
+
+	duplicateKeyCode = 11000
+)
+
+// NewMongoIndexer stores the index in the msg_index collection. When atlasIndex names an
+// Atlas Search index on the text field, keywords are resolved by $search instead of the
+// token index.
+func NewMongoIndexer(db *mongo.Database, atlasIndex string) (MessageIndexer, error) {
+	if db == nil {
+		return nil, errs.New("mongo search backend requires a database").Wrap()
+	}
+	coll := db.Collection(CollectionName)
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "conversation_id", Value: 1},
+				{Key: "seq", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "tokens", Value: 1},
+				{Key: "send_time", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "send_time", Value: -1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &mongoIndexer{coll: coll, atlasIndex: atlasIndex}, nil
+}
+
+// mongoIndexer keeps deleted messages as tombstones so that a late Index call from
+// openim-msgtransfer cannot bring a revoked message back.
+type mongoIndexer struct {
+	coll       *mongo.Collection
+	atlasIndex string
+}
+
+func (m *mongoIndexer) Index(ctx context.Context, docs []*Document) error {
+	models := make([]mongo.WriteModel, 0, len(docs))
+	for _, doc := range docs {
+		if doc == nil {
+			continue
+		}
+		doc.Tokens = Tokenize(doc.Text)
+		filter := bson.M{"conversation_id": doc.ConversationID, "seq": doc.Seq, "deleted": bson.M{"$ne": true}}
+		models = append(models, mongo.NewReplaceOneModel().SetFilter(filter).SetReplacement(doc).SetUpsert(true))
+	}
+	if len(models) == 0 {
+		return nil
+	}
+	_, err := m.coll.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	if err == nil || onlyDuplicateKey(err) {
+		return nil
+	}
+	return errs.Wrap(err)
+}
+
+// onlyDuplicateKey reports whether every failed write hit a tombstone.
+func onlyDuplicateKey(err error) bool {
+	var bulkErr mongo.BulkWriteException
+	if !errors.As(err, &bulkErr) || bulkErr.WriteConcernError != nil || len(bulkErr.WriteErrors) == 0 {
+		return false
+	}
+	for _, writeErr := range bulkErr.WriteErrors {
+		if writeErr.Code != duplicateKeyCode {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *mongoIndexer) Delete(ctx context.Context, conversationID string, seqs []int64) error {
+	if len(seqs) == 0 {
+		return nil
+	}
+	models := make([]mongo.WriteModel, 0, len(seqs))
+	for _, seq := range seqs {
+		models = append(models, mongo.NewReplaceOneModel().
+			SetFilter(bson.M{"conversation_id": conversationID, "seq": seq}).
+			SetReplacement(bson.M{"conversation_id": conversationID, "seq": seq, "deleted": true}).
+			SetUpsert(true))
+	}
+	if _, err := m.coll.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false)); err != nil {
+		return errs.Wrap(err)
+	}
+	return nil
+}
+
+func (m *mongoIndexer) filter(query *Query, useTokens bool) bson.M {
+	filter := bson.M{"deleted": bson.M{"$ne": true}}
+	if useTokens {
+		if tokens := query.Keyword.Tokens(); len(tokens) > 0 {
+			filter["tokens"] = bson.M{"$all": tokens}
+		}
+	}
+	var and []bson.M
+	for _, phrase := range query.Keyword.Phrases {
+		pattern := strings.Join(quoteFields(phrase), `\s+`)
+		and = append(and, bson.M{"text": bson.M{"$regex": pattern, "$options": "i"}})
+	}
+	if len(query.SeqRanges) > 0 {
+		and = append(and, seqRangeFilter(query))
+	} else if len(query.ConversationIDs) > 0 {
+		filter["conversation_id"] = bson.M{"$in": query.ConversationIDs}
+	} else if query.TenantID != "" {
+		filter["conversation_id"] = bson.M{"$regex": "^" + regexp.QuoteMeta(tenant.JoinKey(query.TenantID, ""))}
+	} else {
+		filter["conversation_id"] = bson.M{"$not": primitive.Regex{Pattern: "^" + regexp.QuoteMeta(tenant.KeyPrefix)}}
+	}
+	if query.SendID != "" {
+		filter["send_id"] = query.SendID
+	}
+	if query.RecvID != "" {
+		and = append(and, bson.M{"$or": []bson.M{{"recv_id": query.RecvID}, {"group_id": query.RecvID}}})
+	}
+	if query.SessionType != 0 {
+		filter["session_type"] = query.SessionType
+	}
+	if query.ContentType != 0 {
+		filter["content_type"] = query.ContentType
+	}
+	if query.StartTime != 0 || query.EndTime != 0 {
+		sendTime := bson.M{}
+		if query.StartTime != 0 {
+			sendTime["$gte"] = query.StartTime
+		}
+		if query.EndTime != 0 {
+			sendTime["$lt"] = query.EndTime
+		}
+		filter["send_time"] = sendTime
+	}
+	if len(and) > 0 {
+		filter["$and"] = and
+	}
+	return filter
+}
+
+// seqRangeFilter restricts each conversation to its seq range. Conversations without a
+// range are matched as a whole.
+func seqRangeFilter(query *Query) bson.M {
+	var (
+		or        []bson.M
+		unbounded []string
+	)
+	conversationIDs := query.ConversationIDs
+	if len(conversationIDs) == 0 {
+		for conversationID := range query.SeqRanges {
+			conversationIDs = append(conversationIDs, conversationID)
+		}
+	}
+	for _, conversationID := range conversationIDs {
+		r, ok := query.SeqRanges[conversationID]
+		if !ok {
+			unbounded = append(unbounded, conversationID)
+			continue
+		}
+		seq := bson.M{"$gte": r.Min}
+		if r.Max != 0 {
+			seq["$lte"] = r.Max
+		}
+		or = append(or, bson.M{"conversation_id": conversationID, "seq": seq})
+	}
+	if len(unbounded) > 0 {
+		or = append(or, bson.M{"conversation_id": bson.M{"$in": unbounded}})
+	}
+	return bson.M{"$or": or}
+}
+
+func quoteFields(phrase string) []string {
+	fields := strings.Fields(phrase)
+	for i, field := range fields {
+		fields[i] = regexp.QuoteMeta(field)
+	}
+	return fields
+}
+
+func cursorFilter(c *cursor) bson.M {
+	return bson.M{"$or": []bson.M{
+		{"send_time": bson.M{"$lt": c.SendTime}},
+		{"send_time": c.SendTime, "conversation_id": bson.M{"$gt": c.ConversationID}},
+		{"send_time": c.SendTime, "conversation_id": c.ConversationID, "seq": bson.M{"$lt": c.Seq}},
+	}}
+}
+
+var sortOrder = bson.D{
+	{Key: "send_time", Value: -1},
+	{Key: "conversation_id", Value: 1},
+	{Key: "seq", Value: -1},
+}
+
+func (m *mongoIndexer) Search(ctx context.Context, query *Query) (*Result, error) {
+	after, err := decodeCursor(query.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	if m.atlasIndex != "" && !query.Keyword.IsEmpty() {
+		return m.atlasSearch(ctx, query, after)
+	}
+	filter := m.filter(query, true)
+	total, err := m.coll.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	opts := options.Find().SetSort(sortOrder).SetLimit(int64(query.limit()) + 1)
+	if after != nil {
+		filter = bson.M{"$and": []bson.M{filter, cursorFilter(after)}}
+	} else if query.Offset > 0 {
+		opts.SetSkip(int64(query.Offset))
+	}
+	cur, err := m.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	var docs []*Document
+	if err := cur.All(ctx, &docs); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return newResult(total, docs, query.limit()), nil
+}
+
+// atlasSearch resolves the keyword with Atlas Search and applies the remaining
+// constraints in the same pipeline.
+func (m *mongoIndexer) atlasSearch(ctx context.Context, query *Query, after *cursor) (*Result, error) {
+	var must []bson.M
+	if len(query.Keyword.Terms) > 0 {
+		must = append(must, bson.M{"text": bson.M{"query": strings.Join(query.Keyword.Terms, " "), "path": "text",
+			"matchCriteria": "all"}})
+	}
+	for _, phrase := range query.Keyword.Phrases {
+		must = append(must, bson.M{"phrase": bson.M{"query": phrase, "path": "text"}})
+	}
+	filter := m.filter(query, false)
+	page := []bson.M{}
+	if after != nil {
+		page = append(page, bson.M{"$match": cursorFilter(after)})
+	} else if query.Offset > 0 {
+		page = append(page, bson.M{"$skip": query.Offset})
+	}
+	page = append(page, bson.M{"$limit": query.limit() + 1})
+	pipeline := []bson.M{
+		{"$search": bson.M{"index": m.atlasIndex, "compound": bson.M{"must": must}}},
+		{"$match": filter},
+		{"$sort": sortOrder},
+		{"$facet": bson.M{
+			"total": []bson.M{{"$count": "n"}},
+			"docs":  page,
+		}},
+	}
+	cur, err := m.coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	var res []struct {
+		Total []struct {
+			N int64 `bson:"n"`
+		} `bson:"total"`
+		Docs []*Document `bson:"docs"`
+	}
+	if err := cur.All(ctx, &res); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if len(res) == 0 {
+		return &Result{}, nil
+	}
+	var total int64
+	if len(res[0].Total) > 0 {
+		total = res[0].Total[0].N
+	}
+	return newResult(total, res[0].Docs, query.limit()), nil
+}
+
+// newResult trims docs, fetched with one extra element, to limit and sets the cursor
+// when more hits remain.
+func newResult(total int64, docs []*Document, limit int) *Result {
+	res := &Result{Total: total}
+	more := len(docs) > limit
+	if more {
+		docs = docs[:limit]
+	}
+	for _, doc := range docs {
+		res.Hits = append(res.Hits, &Hit{ConversationID: doc.ConversationID, Seq: doc.Seq, SendTime: doc.SendTime})
+	}
+	if more {
+		res.NextCursor = encodeCursor(docs[len(docs)-1])
+	}
+	return res
+}
+
+func (m *mongoIndexer) Close() error {
+	return nil
+}
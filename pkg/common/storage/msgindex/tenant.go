@@ -0,0 +1,80 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgindex
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+)
+
+// tenantIndexer scopes the conversation IDs of documents to their tenant, so tenants
+// share an index without seeing each other's messages.
+type tenantIndexer struct {
+	MessageIndexer
+}
+
+func (t tenantIndexer) Index(ctx context.Context, docs []*Document) error {
+	id := tenant.ID(ctx)
+	if id == "" {
+		return t.MessageIndexer.Index(ctx, docs)
+	}
+	scoped := make([]*Document, 0, len(docs))
+	for _, doc := range docs {
+		if doc == nil {
+			continue
+		}
+		d := *doc
+		d.ConversationID = tenant.JoinKey(id, doc.ConversationID)
+		scoped = append(scoped, &d)
+	}
+	return t.MessageIndexer.Index(ctx, scoped)
+}
+
+func (t tenantIndexer) Delete(ctx context.Context, conversationID string, seqs []int64) error {
+	return t.MessageIndexer.Delete(ctx, tenant.WithKey(ctx, conversationID), seqs)
+}
+
+func (t tenantIndexer) Search(ctx context.Context, query *Query) (*Result, error) {
+	id := tenant.ID(ctx)
+	q := *query
+	q.TenantID = id
+	if id != "" {
+		q.ConversationIDs = make([]string, 0, len(query.ConversationIDs))
+		for _, conversationID := range query.ConversationIDs {
+			q.ConversationIDs = append(q.ConversationIDs, tenant.JoinKey(id, conversationID))
+		}
+		if query.SeqRanges != nil {
+			q.SeqRanges = make(map[string]SeqRange, len(query.SeqRanges))
+			for conversationID, r := range query.SeqRanges {
+				q.SeqRanges[tenant.JoinKey(id, conversationID)] = r
+			}
+		}
+	}
+	res, err := t.MessageIndexer.Search(ctx, &q)
+	if err != nil {
+		return nil, err
+	}
+	for _, hit := range res.Hits {
+		_, hit.ConversationID = tenant.SplitKey(hit.ConversationID)
+	}
+	return res, nil
+}
+
+// inTenant reports whether the document of conversationID belongs to the tenant.
+func inTenant(tenantID string, conversationID string) bool {
+	id, _ := tenant.SplitKey(conversationID)
+	return id == tenantID
+}
@@ -0,0 +1,205 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgindex
+
+import (
+	"encoding/json"
+	"strings"
+	"unicode"
+
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// ExtractText returns the human readable part of a message content, or an empty string
+// for content types that carry nothing worth searching.
+func ExtractText(contentType int32, content string) string {
+	if content == "" {
+		return ""
+	}
+	var elem struct {
+		Content      string   `json:"content"`
+		Text         string   `json:"text"`
+		FileName     string   `json:"fileName"`
+		Title        string   `json:"title"`
+		AbstractList []string `json:"abstractList"`
+		Nickname     string   `json:"nickname"`
+		Description  string   `json:"description"`
+		AtUsersInfo  []struct {
+			GroupNickname string `json:"groupNickname"`
+		} `json:"atUsersInfo"`
+	}
+	switch contentType {
+	case constant.Text, constant.AtText, constant.Quote, constant.AdvancedText, constant.File,
+		constant.Merger, constant.Card, constant.Location, constant.Custom:
+	default:
+		return ""
+	}
+	if err := json.Unmarshal([]byte(content), &elem); err != nil {
+		if contentType == constant.Text {
+			return content
+		}
+		return ""
+	}
+	parts := []string{elem.Content, elem.Text, elem.FileName, elem.Title, elem.Nickname, elem.Description}
+	parts = append(parts, elem.AbstractList...)
+	for _, info := range elem.AtUsersInfo {
+		parts = append(parts, info.GroupNickname)
+	}
+	var sb strings.Builder
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		if sb.Len() > 0 {
+			sb.WriteByte(' ')
+		}
+		sb.WriteString(part)
+	}
+	return sb.String()
+}
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+// Tokenize splits text into lower-cased index terms. Latin words are kept whole, runs of
+// CJK characters are indexed as unigrams and bigrams so that both single characters and
+// words can be found without a dictionary.
+func Tokenize(text string) []string {
+	return datautil.Distinct(tokenize(text, true))
+}
+
+// queryTokens tokenizes a query term; CJK runs longer than one character only produce
+// bigrams, which is enough to require every character pair to be present.
+func queryTokens(text string) []string {
+	return datautil.Distinct(tokenize(text, false))
+}
+
+func tokenize(text string, unigram bool) []string {
+	var (
+		tokens []string
+		word   []rune
+		cjk    []rune
+	)
+	flushWord := func() {
+		if len(word) > 0 {
+			tokens = append(tokens, string(word))
+			word = word[:0]
+		}
+	}
+	flushCJK := func() {
+		switch {
+		case len(cjk) == 1:
+			tokens = append(tokens, string(cjk))
+		case len(cjk) > 1:
+			for i := 0; i < len(cjk); i++ {
+				if unigram {
+					tokens = append(tokens, string(cjk[i]))
+				}
+				if i+1 < len(cjk) {
+					tokens = append(tokens, string(cjk[i:i+2]))
+				}
+			}
+		}
+		cjk = cjk[:0]
+	}
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case isCJK(r):
+			flushWord()
+			cjk = append(cjk, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			flushCJK()
+			word = append(word, r)
+		default:
+			flushWord()
+			flushCJK()
+		}
+	}
+	flushWord()
+	flushCJK()
+	return tokens
+}
+
+// Keyword is a parsed search expression. Every term and every phrase must match.
+type Keyword struct {
+	Terms   []string
+	Phrases []string
+}
+
+// ParseKeyword splits a keyword into bare terms and "quoted phrases".
+func ParseKeyword(keyword string) Keyword {
+	var (
+		k      Keyword
+		quoted bool
+		buf    strings.Builder
+	)
+	flush := func() {
+		s := strings.TrimSpace(buf.String())
+		buf.Reset()
+		if s == "" {
+			return
+		}
+		if quoted {
+			k.Phrases = append(k.Phrases, strings.ToLower(s))
+		} else {
+			k.Terms = append(k.Terms, strings.Fields(strings.ToLower(s))...)
+		}
+	}
+	for _, r := range keyword {
+		if r == '"' {
+			flush()
+			quoted = !quoted
+			continue
+		}
+		buf.WriteRune(r)
+	}
+	flush()
+	return k
+}
+
+// IsEmpty reports whether the keyword constrains nothing.
+func (k Keyword) IsEmpty() bool {
+	return len(k.Terms) == 0 && len(k.Phrases) == 0
+}
+
+// Tokens returns the index terms a document must contain to match the keyword.
+func (k Keyword) Tokens() []string {
+	var tokens []string
+	for _, term := range k.Terms {
+		tokens = append(tokens, queryTokens(term)...)
+	}
+	for _, phrase := range k.Phrases {
+		tokens = append(tokens, queryTokens(phrase)...)
+	}
+	return datautil.Distinct(tokens)
+}
+
+// MatchPhrases reports whether the text contains every phrase, ignoring case and
+// collapsing whitespace.
+func (k Keyword) MatchPhrases(text string) bool {
+	if len(k.Phrases) == 0 {
+		return true
+	}
+	normalized := strings.Join(strings.Fields(strings.ToLower(text)), " ")
+	for _, phrase := range k.Phrases {
+		if !strings.Contains(normalized, strings.Join(strings.Fields(phrase), " ")) {
+			return false
+		}
+	}
+	return true
+}
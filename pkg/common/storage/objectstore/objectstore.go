@@ -0,0 +1,53 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package objectstore builds the object storage selected in openim-rpc-third.yml.
+package objectstore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/redis"
+	"github.com/openimsdk/tools/s3"
+	"github.com/openimsdk/tools/s3/cos"
+	"github.com/openimsdk/tools/s3/kodo"
+	"github.com/openimsdk/tools/s3/minio"
+	"github.com/openimsdk/tools/s3/oss"
+	redisv9 "github.com/redis/go-redis/v9"
+)
+
+// New returns the object storage of object.enable. The *minio.Minio is only set for minio.
+func New(ctx context.Context, conf *config.Third, minioConf *minio.Config, rdb redisv9.UniversalClient) (s3.Interface, *minio.Minio, error) {
+	switch enable := conf.Object.Enable; enable {
+	case "minio":
+		minioCli, err := minio.NewMinio(ctx, redis.NewMinioCache(rdb), *minioConf)
+		if err != nil {
+			return nil, nil, err
+		}
+		return minioCli, minioCli, nil
+	case "cos":
+		o, err := cos.NewCos(*conf.Object.Cos.Build())
+		return o, nil, err
+	case "oss":
+		o, err := oss.NewOSS(*conf.Object.Oss.Build())
+		return o, nil, err
+	case "kodo":
+		o, err := kodo.NewKodo(*conf.Object.Kodo.Build())
+		return o, nil, err
+	default:
+		return nil, nil, fmt.Errorf("invalid object enable: %s", enable)
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectstore
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/s3"
+)
+
+// PutObject uploads size bytes of body to key through a presigned URL, the only upload
+// every s3.Interface backend offers.
+func PutObject(ctx context.Context, client *http.Client, o s3.Interface, key string, body io.Reader, size int64, expire time.Duration) error {
+	rawURL, err := o.PresignedPutObject(ctx, key, expire)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, rawURL, body)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	req.ContentLength = size
+	resp, err := do(client, req)
+	if err != nil {
+		return err
+	}
+	return errs.Wrap(resp.Close())
+}
+
+// OpenURL downloads an object through its access URL. The caller closes the body.
+func OpenURL(ctx context.Context, client *http.Client, rawURL string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return do(client, req)
+}
+
+func do(client *http.Client, req *http.Request) (io.ReadCloser, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if resp.StatusCode/100 != 2 {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return nil, errs.New(fmt.Sprintf("object %s %s: %s", req.Method, req.URL.Path, resp.Status), "body", string(body)).Wrap()
+	}
+	return resp.Body, nil
+}
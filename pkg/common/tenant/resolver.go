@@ -0,0 +1,138 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tenant
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/tools/errs"
+)
+
+// Info is the configuration of a tenant that replaces share.yml and webhooks.yml for it.
+type Info struct {
+	TenantID     string
+	Secret       string
+	AdminUserIDs []string
+	WebhookURL   string
+	// Notification overrides entries of notification.yml, as a JSON object keyed like the file.
+	Notification string
+	Disabled     bool
+}
+
+// Resolver looks up tenants by ID.
+type Resolver interface {
+	GetTenant(ctx context.Context, tenantID string) (*Info, error)
+}
+
+var resolver atomic.Pointer[Resolver]
+
+// SetResolver installs the resolver used by Get. Processes serving tenants install it at start.
+func SetResolver(r Resolver) {
+	resolver.Store(&r)
+}
+
+// Get returns the tenant of ctx, nil for the default tenant.
+func Get(ctx context.Context) (*Info, error) {
+	id := ID(ctx)
+	if id == "" {
+		return nil, nil
+	}
+	r := resolver.Load()
+	if r == nil {
+		return nil, errs.ErrInternalServer.WrapMsg("tenant resolver is not set", "tenantID", id)
+	}
+	info, err := (*r).GetTenant(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if info.Disabled {
+		return nil, servererrs.ErrTenantDisabled.WrapMsg("tenant is disabled", "tenantID", id)
+	}
+	return info, nil
+}
+
+// Secret returns the secret of the tenant of ctx, or def for the default tenant.
+func Secret(ctx context.Context, def string) (string, error) {
+	info, err := Get(ctx)
+	if err != nil || info == nil {
+		return def, err
+	}
+	return info.Secret, nil
+}
+
+// AdminUserIDs returns the app admins of the tenant of ctx, or def for the default tenant.
+// Admins of the default tenant are not admins of other tenants.
+func AdminUserIDs(ctx context.Context, def []string) ([]string, error) {
+	info, err := Get(ctx)
+	if err != nil || info == nil {
+		return def, err
+	}
+	return info.AdminUserIDs, nil
+}
+
+// WebhookURL returns the webhook URL of the tenant of ctx, or def for the default tenant.
+// An empty URL disables the webhooks of the tenant.
+func WebhookURL(ctx context.Context, def string) (string, error) {
+	info, err := Get(ctx)
+	if err != nil || info == nil {
+		return def, err
+	}
+	return info.WebhookURL, nil
+}
+
+// CacheTTL is how long services keep a tenant before reading it again, so it also bounds
+// how long changes to a tenant take to apply.
+const CacheTTL = time.Minute
+
+type cachedTenant struct {
+	info   *Info
+	err    error
+	expire time.Time
+}
+
+type cachedResolver struct {
+	fetch func(ctx context.Context, tenantID string) (*Info, error)
+	ttl   time.Duration
+	lock  sync.Mutex
+	cache map[string]*cachedTenant
+}
+
+// NewCachedResolver keeps the tenants returned by fetch, and the tenants it did not find,
+// for ttl. Changes to a tenant take up to ttl to reach every process.
+func NewCachedResolver(fetch func(ctx context.Context, tenantID string) (*Info, error), ttl time.Duration) Resolver {
+	return &cachedResolver{fetch: fetch, ttl: ttl, cache: make(map[string]*cachedTenant)}
+}
+
+func (r *cachedResolver) GetTenant(ctx context.Context, tenantID string) (*Info, error) {
+	now := time.Now()
+	r.lock.Lock()
+	c, ok := r.cache[tenantID]
+	r.lock.Unlock()
+	if ok && now.Before(c.expire) {
+		return c.info, c.err
+	}
+	info, err := r.fetch(ctx, tenantID)
+	if err != nil && !servererrs.ErrTenantNotFound.Is(err) {
+		return nil, err
+	}
+	r.lock.Lock()
+	r.cache[tenantID] = &cachedTenant{info: info, err: err, expire: now.Add(r.ttl)}
+	r.lock.Unlock()
+	return info, err
+}
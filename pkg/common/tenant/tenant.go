@@ -0,0 +1,106 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tenant carries the tenant of a request through contexts, RPC metadata, MQ keys
+// and cache keys, so several apps can share one deployment without seeing each other's data.
+// The empty tenant ID is the default tenant, whose data keeps the names used before tenants existed.
+package tenant
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// Key is the context value and RPC metadata key of the tenant ID.
+const Key = "tenantID"
+
+// KeyPrefix starts the keys scoped to a tenant other than the default one.
+const KeyPrefix = "T:"
+
+// idPattern keeps tenant IDs usable in collection, schema and key names.
+var idPattern = regexp.MustCompile(`^[a-z0-9]{1,32}$`)
+
+// CheckID verifies that id can name a tenant.
+func CheckID(id string) error {
+	if !idPattern.MatchString(id) {
+		return errs.ErrArgs.WrapMsg("tenantID must be 1 to 32 lowercase letters or digits", "tenantID", id)
+	}
+	return nil
+}
+
+// WithID returns ctx carrying the tenant ID. The ID is sent along with every RPC made with
+// the returned context.
+func WithID(ctx context.Context, id string) context.Context {
+	if id == "" && ID(ctx) == "" {
+		return ctx
+	}
+	keys, _ := ctx.Value(constant.RpcCustomHeader).([]string)
+	if !datautil.Contain(Key, keys...) {
+		ctx = context.WithValue(ctx, constant.RpcCustomHeader, append(append([]string(nil), keys...), Key))
+	}
+	return context.WithValue(ctx, Key, []string{id})
+}
+
+// ID returns the tenant ID of ctx, empty for the default tenant.
+func ID(ctx context.Context) string {
+	if ids, ok := ctx.Value(Key).([]string); ok && len(ids) > 0 {
+		return ids[0]
+	}
+	return ""
+}
+
+// Inherit returns dst carrying the tenant of src, for contexts rebuilt from operation info.
+func Inherit(dst context.Context, src context.Context) context.Context {
+	return WithID(dst, ID(src))
+}
+
+// JoinKey scopes key to the tenant, leaving keys of the default tenant unchanged.
+func JoinKey(id string, key string) string {
+	if id == "" {
+		return key
+	}
+	return KeyPrefix + id + ":" + key
+}
+
+// SplitKey reverses JoinKey.
+func SplitKey(s string) (id string, key string) {
+	rest, ok := strings.CutPrefix(s, KeyPrefix)
+	if !ok {
+		return "", s
+	}
+	id, key, ok = strings.Cut(rest, ":")
+	if !ok {
+		return "", s
+	}
+	return id, key
+}
+
+// WithKey scopes key to the tenant of ctx.
+func WithKey(ctx context.Context, key string) string {
+	return JoinKey(ID(ctx), key)
+}
+
+// Namespace is the prefix of the collections and the schema holding the data of the tenant,
+// empty for the default tenant.
+func Namespace(id string) string {
+	if id == "" {
+		return ""
+	}
+	return "t_" + id
+}
@@ -0,0 +1,91 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tenant
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithID(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, ctx, WithID(ctx, ""))
+	assert.Equal(t, "", ID(ctx))
+
+	ctx = context.WithValue(ctx, constant.RpcCustomHeader, []string{"other"})
+	ctx = WithID(ctx, "app1")
+	assert.Equal(t, "app1", ID(ctx))
+	assert.Equal(t, []string{"other", Key}, ctx.Value(constant.RpcCustomHeader))
+
+	ctx = WithID(ctx, "app2")
+	assert.Equal(t, "app2", ID(ctx))
+	assert.Equal(t, []string{"other", Key}, ctx.Value(constant.RpcCustomHeader))
+
+	assert.Equal(t, "app2", ID(Inherit(context.Background(), ctx)))
+}
+
+func TestKey(t *testing.T) {
+	assert.Equal(t, "si_a_b", JoinKey("", "si_a_b"))
+	assert.Equal(t, "T:app1:si_a_b", JoinKey("app1", "si_a_b"))
+	for _, s := range []string{"si_a_b", "T:app1:USER_INFO:u1", "T:broken"} {
+		id, key := SplitKey(s)
+		assert.Equal(t, s, JoinKey(id, key))
+	}
+	id, key := SplitKey("T:app1:USER_INFO:u1")
+	assert.Equal(t, "app1", id)
+	assert.Equal(t, "USER_INFO:u1", key)
+}
+
+func TestCheckID(t *testing.T) {
+	assert.NoError(t, CheckID("app1"))
+	for _, id := range []string{"", "App1", "app-1", "app_1", "a234567890123456789012345678901234"} {
+		assert.Error(t, CheckID(id), id)
+	}
+}
+
+func TestCachedResolver(t *testing.T) {
+	var calls int
+	r := NewCachedResolver(func(ctx context.Context, tenantID string) (*Info, error) {
+		calls++
+		if tenantID == "missing" {
+			return nil, servererrs.ErrTenantNotFound.Wrap()
+		}
+		return &Info{TenantID: tenantID, Disabled: tenantID == "off"}, nil
+	}, time.Minute)
+	SetResolver(r)
+
+	info, err := Get(WithID(context.Background(), "app1"))
+	assert.NoError(t, err)
+	assert.Equal(t, "app1", info.TenantID)
+	_, _ = Get(WithID(context.Background(), "app1"))
+	assert.Equal(t, 1, calls)
+
+	_, err = Get(WithID(context.Background(), "missing"))
+	assert.True(t, servererrs.ErrTenantNotFound.Is(err))
+	_, _ = Get(WithID(context.Background(), "missing"))
+	assert.Equal(t, 2, calls)
+
+	_, err = Get(WithID(context.Background(), "off"))
+	assert.True(t, servererrs.ErrTenantDisabled.Is(err))
+
+	info, err = Get(context.Background())
+	assert.NoError(t, err)
+	assert.Nil(t, info)
+}
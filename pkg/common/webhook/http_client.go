@@ -20,6 +20,7 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/callbackstruct"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/tools/log"
 	"github.com/openimsdk/tools/mcontext"
@@ -66,12 +67,25 @@ func (c *Client) AsyncPost(ctx context.Context, command string, req callbackstru
 	}
 }
 
+// post calls the webhook of the tenant of ctx. Tenants without a webhook URL skip their webhooks.
 func (c *Client) post(ctx context.Context, command string, input interface{}, output callbackstruct.CallbackResp, timeout int) error {
-	ctx = mcontext.WithMustInfoCtx([]string{mcontext.GetOperationID(ctx), mcontext.GetOpUserID(ctx), mcontext.GetOpUserPlatform(ctx), mcontext.GetConnID(ctx)})
-	fullURL := c.url + "/" + command
+	url, err := tenant.WebhookURL(ctx, c.url)
+	if err != nil {
+		return err
+	}
+	if url == "" {
+		return nil
+	}
+	tenantID := tenant.ID(ctx)
+	ctx = tenant.WithID(mcontext.WithMustInfoCtx([]string{mcontext.GetOperationID(ctx), mcontext.GetOpUserID(ctx), mcontext.GetOpUserPlatform(ctx), mcontext.GetConnID(ctx)}), tenantID)
+	fullURL := url + "/" + command
 	log.ZInfo(ctx, "webhook", "url", fullURL, "input", input, "config", timeout)
 	operationID, _ := ctx.Value(constant.OperationID).(string)
-	b, err := c.client.Post(ctx, fullURL, map[string]string{constant.OperationID: operationID}, input, timeout)
+	header := map[string]string{constant.OperationID: operationID}
+	if tenantID != "" {
+		header[tenant.Key] = tenantID
+	}
+	b, err := c.client.Post(ctx, fullURL, header, input, timeout)
 	if err != nil {
 		return servererrs.ErrNetwork.WrapMsg(err.Error(), "post url", fullURL)
 	}
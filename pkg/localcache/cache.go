@@ -19,8 +19,10 @@ import (
 	"hash/fnv"
 	"unsafe"
 
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/open-im-server/v3/pkg/localcache/link"
 	"github.com/openimsdk/open-im-server/v3/pkg/localcache/lru"
+	"github.com/openimsdk/tools/utils/datautil"
 )
 
 type Cache[V any] interface {
@@ -100,11 +102,13 @@ func (c *cache[V]) Get(ctx context.Context, key string, fetch func(ctx context.C
 	return c.GetLink(ctx, key, fetch)
 }
 
+// GetLink caches the value under key scoped to the tenant of ctx, the links are scoped too.
 func (c *cache[V]) GetLink(ctx context.Context, key string, fetch func(ctx context.Context) (V, error), link ...string) (V, error) {
 	if c.local != nil {
+		key = tenant.WithKey(ctx, key)
 		return c.local.Get(key, func() (V, error) {
 			if len(link) > 0 {
-				c.link.Link(key, link...)
+				c.link.Link(key, datautil.Slice(link, func(k string) string { return tenant.WithKey(ctx, k) })...)
 			}
 			return fetch(ctx)
 		})
@@ -117,9 +121,10 @@ func (c *cache[V]) Del(ctx context.Context, key ...string) {
 	for _, fn := range c.opt.delFn {
 		fn(ctx, key...)
 	}
-	c.del(key...)
+	c.del(datautil.Slice(key, func(k string) string { return tenant.WithKey(ctx, k) })...)
 }
 
+// DelLocal takes keys already scoped to their tenant, as published by the cache deleters.
 func (c *cache[V]) DelLocal(ctx context.Context, key ...string) {
 	c.del(key...)
 }
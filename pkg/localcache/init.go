@@ -17,6 +17,7 @@ package localcache
 import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"strings"
 	"sync"
 )
@@ -65,13 +66,15 @@ func GetPublishKeysByTopic(topics []string, keys []string) map[string][]string {
 	}
 
 	for _, key := range keys {
+		// Keys of other tenants are matched on the key they scope.
+		_, unscoped := tenant.SplitKey(key)
 		for _, topic := range topics {
 			prefixes, ok := subscribe[topic]
 			if !ok {
 				continue
 			}
 			for _, prefix := range prefixes {
-				if strings.HasPrefix(key, prefix) {
+				if strings.HasPrefix(unscoped, prefix) {
 					keysByTopic[topic] = append(keysByTopic[topic], key)
 					break
 				}
@@ -0,0 +1,497 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupext
+
+import (
+	"errors"
+	"hash/fnv"
+	"strings"
+
+	"github.com/openimsdk/protocol/constant"
+)
+
+// ChannelGroup is the group type of broadcast channels, where only members with the publish
+// permission post and the other members subscribe.
+const ChannelGroup = 3
+
+// ChannelSubscriberShards is how many shards the subscribers of a channel are spread over.
+const ChannelSubscriberShards = 16
+
+// ChannelSubscriberShard returns the shard of a subscriber of a channel.
+func ChannelSubscriberShard(userID string) int32 {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(userID))
+	return int32(h.Sum32() % ChannelSubscriberShards)
+}
+
+// Content types of the notifications of the group extensions, in the range of group
+// notifications after those of the upstream protocol.
+const (
+	GroupRoleChangedNotification        = 1530
+	GroupApplicationExpiredNotification = 1531
+)
+
+const (
+	GroupRoleCreated = 1
+	GroupRoleUpdated = 2
+	GroupRoleDeleted = 3
+)
+
+func (x *CreateGroupRoleReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if x.Name == "" {
+		return errors.New("name is empty")
+	}
+	return nil
+}
+
+func (x *UpdateGroupRoleReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if x.RoleID == "" {
+		return errors.New("roleID is empty")
+	}
+	if x.Name != nil && x.Name.Value == "" {
+		return errors.New("name is empty")
+	}
+	return nil
+}
+
+func (x *DeleteGroupRoleReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if x.RoleID == "" {
+		return errors.New("roleID is empty")
+	}
+	return nil
+}
+
+func (x *GetGroupRolesReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	return nil
+}
+
+func (x *SetGroupMemberRoleReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if len(x.UserIDs) == 0 {
+		return errors.New("userIDs is empty")
+	}
+	return nil
+}
+
+func (x *GetGroupMemberPermissionsReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if len(x.UserIDs) == 0 {
+		return errors.New("userIDs is empty")
+	}
+	return nil
+}
+
+func (x *CreateGroupInviteLinkReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if x.ExpireTime < 0 {
+		return errors.New("expireTime is negative")
+	}
+	if x.MaxUses < 0 {
+		return errors.New("maxUses is negative")
+	}
+	if x.Approval < 0 || x.Approval > 2 {
+		return errors.New("approval is invalid")
+	}
+	return nil
+}
+
+func (x *GetGroupInviteLinksReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	return nil
+}
+
+func (x *RevokeGroupInviteLinkReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if x.Token == "" {
+		return errors.New("token is empty")
+	}
+	return nil
+}
+
+func (x *GetGroupInviteRedemptionsReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	return nil
+}
+
+func (x *JoinGroupByInviteReq) Check() error {
+	if x.Token == "" {
+		return errors.New("token is empty")
+	}
+	return nil
+}
+
+func (x *GetChannelSubscribersReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if x.Shard < 0 || x.Shard >= ChannelSubscriberShards {
+		return errors.New("shard is invalid")
+	}
+	if x.Count <= 0 {
+		return errors.New("count is invalid")
+	}
+	return nil
+}
+
+func (x *CreateCommunityReq) Check() error {
+	if x.Name == "" {
+		return errors.New("name is empty")
+	}
+	if x.OwnerUserID == "" {
+		return errors.New("ownerUserID is empty")
+	}
+	return nil
+}
+
+func (x *SetCommunityInfoReq) Check() error {
+	if x.CommunityID == "" {
+		return errors.New("communityID is empty")
+	}
+	if x.Name != nil && x.Name.Value == "" {
+		return errors.New("name is empty")
+	}
+	return nil
+}
+
+func (x *DismissCommunityReq) Check() error {
+	if x.CommunityID == "" {
+		return errors.New("communityID is empty")
+	}
+	return nil
+}
+
+func (x *GetCommunitiesInfoReq) Check() error {
+	if len(x.CommunityIDs) == 0 {
+		return errors.New("communityIDs is empty")
+	}
+	return nil
+}
+
+func (x *InviteToCommunityReq) Check() error {
+	if x.CommunityID == "" {
+		return errors.New("communityID is empty")
+	}
+	if len(x.UserIDs) == 0 {
+		return errors.New("userIDs is empty")
+	}
+	return nil
+}
+
+func (x *KickCommunityMemberReq) Check() error {
+	if x.CommunityID == "" {
+		return errors.New("communityID is empty")
+	}
+	if len(x.UserIDs) == 0 {
+		return errors.New("userIDs is empty")
+	}
+	return nil
+}
+
+func (x *QuitCommunityReq) Check() error {
+	if x.CommunityID == "" {
+		return errors.New("communityID is empty")
+	}
+	return nil
+}
+
+func (x *SetCommunityMemberRoleReq) Check() error {
+	if x.CommunityID == "" {
+		return errors.New("communityID is empty")
+	}
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.RoleLevel != constant.GroupAdmin && x.RoleLevel != constant.GroupOrdinaryUsers {
+		return errors.New("roleLevel is invalid")
+	}
+	return nil
+}
+
+func (x *GetCommunityMembersReq) Check() error {
+	if x.CommunityID == "" {
+		return errors.New("communityID is empty")
+	}
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	return nil
+}
+
+func (x *AddCommunityGroupsReq) Check() error {
+	if x.CommunityID == "" {
+		return errors.New("communityID is empty")
+	}
+	if len(x.GroupIDs) == 0 {
+		return errors.New("groupIDs is empty")
+	}
+	return nil
+}
+
+func (x *RemoveCommunityGroupsReq) Check() error {
+	if x.CommunityID == "" {
+		return errors.New("communityID is empty")
+	}
+	if len(x.GroupIDs) == 0 {
+		return errors.New("groupIDs is empty")
+	}
+	return nil
+}
+
+func (x *GetIncrementalJoinCommunityReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *GetFullJoinCommunityIDsReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+// MaxGroupJoinQuestions is how many questions a group asks at most.
+const MaxGroupJoinQuestions = 10
+
+func (x *SetGroupJoinQuestionsReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if len(x.Questions) > MaxGroupJoinQuestions {
+		return errors.New("too many questions")
+	}
+	for _, q := range x.Questions {
+		if q.Question == "" {
+			return errors.New("question is empty")
+		}
+	}
+	return nil
+}
+
+func (x *GetGroupJoinQuestionsReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	return nil
+}
+
+func (x *JoinGroupWithAnswersReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if x.InviterUserID == "" {
+		return errors.New("inviterUserID is empty")
+	}
+	return nil
+}
+
+func (x *GetGroupApplicationAnswersReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if len(x.UserIDs) == 0 {
+		return errors.New("userIDs is empty")
+	}
+	return nil
+}
+
+func (x *BatchGroupApplicationResponseReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if len(x.FromUserIDs) == 0 {
+		return errors.New("fromUserIDs is empty")
+	}
+	if x.HandleResult != constant.GroupResponseAgree && x.HandleResult != constant.GroupResponseRefuse {
+		return errors.New("handleResult is invalid")
+	}
+	return nil
+}
+
+// AtTagPrefix marks the AtUserIDList entries mentioning a member tag instead of a user.
+const AtTagPrefix = "tag:"
+
+// MaxGroupMemberTagLen is the longest a member tag can be.
+const MaxGroupMemberTagLen = 32
+
+// AtTag returns the AtUserIDList entry mentioning every member under tag.
+func AtTag(tag string) string {
+	return AtTagPrefix + tag
+}
+
+// SplitAtTags splits an AtUserIDList into the mentioned user IDs and tags.
+func SplitAtTags(atUserIDList []string) (userIDs []string, tags []string) {
+	for _, id := range atUserIDList {
+		if tag, ok := strings.CutPrefix(id, AtTagPrefix); ok {
+			tags = append(tags, tag)
+		} else {
+			userIDs = append(userIDs, id)
+		}
+	}
+	return userIDs, tags
+}
+
+func checkGroupMemberTag(tag string) error {
+	if tag == "" {
+		return errors.New("tag is empty")
+	}
+	if len(tag) > MaxGroupMemberTagLen {
+		return errors.New("tag is too long")
+	}
+	return nil
+}
+
+func (x *AddGroupMemberTagReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if len(x.UserIDs) == 0 {
+		return errors.New("userIDs is empty")
+	}
+	return checkGroupMemberTag(x.Tag)
+}
+
+func (x *RemoveGroupMemberTagReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	return checkGroupMemberTag(x.Tag)
+}
+
+func (x *GetGroupTagsReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	return nil
+}
+
+func (x *GetGroupMemberTagsReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if len(x.UserIDs) == 0 {
+		return errors.New("userIDs is empty")
+	}
+	return nil
+}
+
+func (x *GetTaggedMemberUserIDsReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if len(x.Tags) == 0 {
+		return errors.New("tags is empty")
+	}
+	return nil
+}
+
+func (x *SetGroupQuotaReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if x.MaxMemberCount < -1 {
+		return errors.New("maxMemberCount is invalid")
+	}
+	return nil
+}
+
+func (x *GetGroupQuotaReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	return nil
+}
+
+// Succession policies, deciding who takes over a group when its owner leaves.
+const (
+	// SuccessionOldestAdmin hands the group to the admin in it for the longest, or to the
+	// oldest member when there is no admin.
+	SuccessionOldestAdmin = 1
+	// SuccessionOldestMember hands the group to the member in it for the longest.
+	SuccessionOldestMember = 2
+	// SuccessionDesignated hands the group to the designated successor, or as
+	// SuccessionOldestAdmin when the successor is not a member.
+	SuccessionDesignated = 3
+	// SuccessionDismiss dismisses the group.
+	SuccessionDismiss = 4
+)
+
+func (x *SetGroupSuccessionReq) Check() error {
+	if x.Succession == nil {
+		return errors.New("succession is empty")
+	}
+	if x.Succession.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if x.Succession.Policy < 0 || x.Succession.Policy > SuccessionDismiss {
+		return errors.New("policy is invalid")
+	}
+	if x.Succession.Policy == SuccessionDesignated && x.Succession.SuccessorUserID == "" {
+		return errors.New("successorUserID is empty")
+	}
+	return nil
+}
+
+func (x *GetGroupSuccessionReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	return nil
+}
+
+func (x *QuitGroupWithSuccessionReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	return nil
+}
+
+func (x *HandOverOwnedGroupsReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
@@ -0,0 +1,7943 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v5.27.1
+// source: groupext/groupext.proto
+
+package groupext
+
+import (
+	sdkws "github.com/openimsdk/protocol/sdkws"
+	wrapperspb "github.com/openimsdk/protocol/wrapperspb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GroupRole struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoleID      string `protobuf:"bytes,1,opt,name=roleID,proto3" json:"roleID"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	Permissions int64  `protobuf:"varint,3,opt,name=permissions,proto3" json:"permissions"`
+	Ex          string `protobuf:"bytes,4,opt,name=ex,proto3" json:"ex"`
+	CreateTime  int64  `protobuf:"varint,5,opt,name=createTime,proto3" json:"createTime"`
+}
+
+func (x *GroupRole) Reset() {
+	*x = GroupRole{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupRole) ProtoMessage() {}
+
+func (x *GroupRole) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupRole.ProtoReflect.Descriptor instead.
+func (*GroupRole) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GroupRole) GetRoleID() string {
+	if x != nil {
+		return x.RoleID
+	}
+	return ""
+}
+
+func (x *GroupRole) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GroupRole) GetPermissions() int64 {
+	if x != nil {
+		return x.Permissions
+	}
+	return 0
+}
+
+func (x *GroupRole) GetEx() string {
+	if x != nil {
+		return x.Ex
+	}
+	return ""
+}
+
+func (x *GroupRole) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type CreateGroupRoleReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID     string `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	Permissions int64  `protobuf:"varint,3,opt,name=permissions,proto3" json:"permissions"`
+	Ex          string `protobuf:"bytes,4,opt,name=ex,proto3" json:"ex"`
+}
+
+func (x *CreateGroupRoleReq) Reset() {
+	*x = CreateGroupRoleReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateGroupRoleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupRoleReq) ProtoMessage() {}
+
+func (x *CreateGroupRoleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupRoleReq.ProtoReflect.Descriptor instead.
+func (*CreateGroupRoleReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateGroupRoleReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *CreateGroupRoleReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateGroupRoleReq) GetPermissions() int64 {
+	if x != nil {
+		return x.Permissions
+	}
+	return 0
+}
+
+func (x *CreateGroupRoleReq) GetEx() string {
+	if x != nil {
+		return x.Ex
+	}
+	return ""
+}
+
+type CreateGroupRoleResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Role *GroupRole `protobuf:"bytes,1,opt,name=role,proto3" json:"role"`
+}
+
+func (x *CreateGroupRoleResp) Reset() {
+	*x = CreateGroupRoleResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateGroupRoleResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupRoleResp) ProtoMessage() {}
+
+func (x *CreateGroupRoleResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupRoleResp.ProtoReflect.Descriptor instead.
+func (*CreateGroupRoleResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateGroupRoleResp) GetRole() *GroupRole {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+type UpdateGroupRoleReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID     string                  `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	RoleID      string                  `protobuf:"bytes,2,opt,name=roleID,proto3" json:"roleID"`
+	Name        *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=name,proto3" json:"name"`
+	Permissions *wrapperspb.Int64Value  `protobuf:"bytes,4,opt,name=permissions,proto3" json:"permissions"`
+	Ex          *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=ex,proto3" json:"ex"`
+}
+
+func (x *UpdateGroupRoleReq) Reset() {
+	*x = UpdateGroupRoleReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateGroupRoleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupRoleReq) ProtoMessage() {}
+
+func (x *UpdateGroupRoleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupRoleReq.ProtoReflect.Descriptor instead.
+func (*UpdateGroupRoleReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateGroupRoleReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *UpdateGroupRoleReq) GetRoleID() string {
+	if x != nil {
+		return x.RoleID
+	}
+	return ""
+}
+
+func (x *UpdateGroupRoleReq) GetName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *UpdateGroupRoleReq) GetPermissions() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *UpdateGroupRoleReq) GetEx() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Ex
+	}
+	return nil
+}
+
+type UpdateGroupRoleResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateGroupRoleResp) Reset() {
+	*x = UpdateGroupRoleResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateGroupRoleResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupRoleResp) ProtoMessage() {}
+
+func (x *UpdateGroupRoleResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupRoleResp.ProtoReflect.Descriptor instead.
+func (*UpdateGroupRoleResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{4}
+}
+
+type DeleteGroupRoleReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID string `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	RoleID  string `protobuf:"bytes,2,opt,name=roleID,proto3" json:"roleID"`
+}
+
+func (x *DeleteGroupRoleReq) Reset() {
+	*x = DeleteGroupRoleReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteGroupRoleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGroupRoleReq) ProtoMessage() {}
+
+func (x *DeleteGroupRoleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGroupRoleReq.ProtoReflect.Descriptor instead.
+func (*DeleteGroupRoleReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteGroupRoleReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *DeleteGroupRoleReq) GetRoleID() string {
+	if x != nil {
+		return x.RoleID
+	}
+	return ""
+}
+
+type DeleteGroupRoleResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteGroupRoleResp) Reset() {
+	*x = DeleteGroupRoleResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteGroupRoleResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGroupRoleResp) ProtoMessage() {}
+
+func (x *DeleteGroupRoleResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGroupRoleResp.ProtoReflect.Descriptor instead.
+func (*DeleteGroupRoleResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{6}
+}
+
+type GetGroupRolesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID string `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+}
+
+func (x *GetGroupRolesReq) Reset() {
+	*x = GetGroupRolesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupRolesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupRolesReq) ProtoMessage() {}
+
+func (x *GetGroupRolesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupRolesReq.ProtoReflect.Descriptor instead.
+func (*GetGroupRolesReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetGroupRolesReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+type GetGroupRolesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Roles []*GroupRole `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles"`
+}
+
+func (x *GetGroupRolesResp) Reset() {
+	*x = GetGroupRolesResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupRolesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupRolesResp) ProtoMessage() {}
+
+func (x *GetGroupRolesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupRolesResp.ProtoReflect.Descriptor instead.
+func (*GetGroupRolesResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetGroupRolesResp) GetRoles() []*GroupRole {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type SetGroupMemberRoleReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID string   `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	UserIDs []string `protobuf:"bytes,2,rep,name=userIDs,proto3" json:"userIDs"`
+	RoleID  string   `protobuf:"bytes,3,opt,name=roleID,proto3" json:"roleID"`
+}
+
+func (x *SetGroupMemberRoleReq) Reset() {
+	*x = SetGroupMemberRoleReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetGroupMemberRoleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupMemberRoleReq) ProtoMessage() {}
+
+func (x *SetGroupMemberRoleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupMemberRoleReq.ProtoReflect.Descriptor instead.
+func (*SetGroupMemberRoleReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SetGroupMemberRoleReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *SetGroupMemberRoleReq) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+func (x *SetGroupMemberRoleReq) GetRoleID() string {
+	if x != nil {
+		return x.RoleID
+	}
+	return ""
+}
+
+type SetGroupMemberRoleResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetGroupMemberRoleResp) Reset() {
+	*x = SetGroupMemberRoleResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetGroupMemberRoleResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupMemberRoleResp) ProtoMessage() {}
+
+func (x *SetGroupMemberRoleResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupMemberRoleResp.ProtoReflect.Descriptor instead.
+func (*SetGroupMemberRoleResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{10}
+}
+
+type GroupMemberPermission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID      string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	RoleLevel   int32  `protobuf:"varint,2,opt,name=roleLevel,proto3" json:"roleLevel"`
+	RoleID      string `protobuf:"bytes,3,opt,name=roleID,proto3" json:"roleID"`
+	Permissions int64  `protobuf:"varint,4,opt,name=permissions,proto3" json:"permissions"`
+}
+
+func (x *GroupMemberPermission) Reset() {
+	*x = GroupMemberPermission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupMemberPermission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMemberPermission) ProtoMessage() {}
+
+func (x *GroupMemberPermission) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMemberPermission.ProtoReflect.Descriptor instead.
+func (*GroupMemberPermission) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GroupMemberPermission) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GroupMemberPermission) GetRoleLevel() int32 {
+	if x != nil {
+		return x.RoleLevel
+	}
+	return 0
+}
+
+func (x *GroupMemberPermission) GetRoleID() string {
+	if x != nil {
+		return x.RoleID
+	}
+	return ""
+}
+
+func (x *GroupMemberPermission) GetPermissions() int64 {
+	if x != nil {
+		return x.Permissions
+	}
+	return 0
+}
+
+type GetGroupMemberPermissionsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID string   `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	UserIDs []string `protobuf:"bytes,2,rep,name=userIDs,proto3" json:"userIDs"`
+}
+
+func (x *GetGroupMemberPermissionsReq) Reset() {
+	*x = GetGroupMemberPermissionsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupMemberPermissionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupMemberPermissionsReq) ProtoMessage() {}
+
+func (x *GetGroupMemberPermissionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupMemberPermissionsReq.ProtoReflect.Descriptor instead.
+func (*GetGroupMemberPermissionsReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetGroupMemberPermissionsReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *GetGroupMemberPermissionsReq) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type GetGroupMemberPermissionsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Members []*GroupMemberPermission `protobuf:"bytes,1,rep,name=members,proto3" json:"members"`
+}
+
+func (x *GetGroupMemberPermissionsResp) Reset() {
+	*x = GetGroupMemberPermissionsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupMemberPermissionsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupMemberPermissionsResp) ProtoMessage() {}
+
+func (x *GetGroupMemberPermissionsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupMemberPermissionsResp.ProtoReflect.Descriptor instead.
+func (*GetGroupMemberPermissionsResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetGroupMemberPermissionsResp) GetMembers() []*GroupMemberPermission {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type GroupRoleChangedTips struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Group         *sdkws.GroupInfo           `protobuf:"bytes,1,opt,name=group,proto3" json:"group"`
+	OpUser        *sdkws.GroupMemberFullInfo `protobuf:"bytes,2,opt,name=opUser,proto3" json:"opUser"`
+	Role          *GroupRole                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role"`
+	ChangeType    int32                      `protobuf:"varint,4,opt,name=changeType,proto3" json:"changeType"`
+	OperationTime int64                      `protobuf:"varint,5,opt,name=operationTime,proto3" json:"operationTime"`
+}
+
+func (x *GroupRoleChangedTips) Reset() {
+	*x = GroupRoleChangedTips{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupRoleChangedTips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupRoleChangedTips) ProtoMessage() {}
+
+func (x *GroupRoleChangedTips) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupRoleChangedTips.ProtoReflect.Descriptor instead.
+func (*GroupRoleChangedTips) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GroupRoleChangedTips) GetGroup() *sdkws.GroupInfo {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *GroupRoleChangedTips) GetOpUser() *sdkws.GroupMemberFullInfo {
+	if x != nil {
+		return x.OpUser
+	}
+	return nil
+}
+
+func (x *GroupRoleChangedTips) GetRole() *GroupRole {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+func (x *GroupRoleChangedTips) GetChangeType() int32 {
+	if x != nil {
+		return x.ChangeType
+	}
+	return 0
+}
+
+func (x *GroupRoleChangedTips) GetOperationTime() int64 {
+	if x != nil {
+		return x.OperationTime
+	}
+	return 0
+}
+
+type GroupInviteLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token"`
+	GroupID       string `protobuf:"bytes,2,opt,name=groupID,proto3" json:"groupID"`
+	CreatorUserID string `protobuf:"bytes,3,opt,name=creatorUserID,proto3" json:"creatorUserID"`
+	ExpireTime    int64  `protobuf:"varint,4,opt,name=expireTime,proto3" json:"expireTime"`
+	MaxUses       int32  `protobuf:"varint,5,opt,name=maxUses,proto3" json:"maxUses"`
+	UsedCount     int32  `protobuf:"varint,6,opt,name=usedCount,proto3" json:"usedCount"`
+	Approval      int32  `protobuf:"varint,7,opt,name=approval,proto3" json:"approval"`
+	JoinSource    int32  `protobuf:"varint,8,opt,name=joinSource,proto3" json:"joinSource"`
+	Revoked       bool   `protobuf:"varint,9,opt,name=revoked,proto3" json:"revoked"`
+	Ex            string `protobuf:"bytes,10,opt,name=ex,proto3" json:"ex"`
+	CreateTime    int64  `protobuf:"varint,11,opt,name=createTime,proto3" json:"createTime"`
+}
+
+func (x *GroupInviteLink) Reset() {
+	*x = GroupInviteLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupInviteLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupInviteLink) ProtoMessage() {}
+
+func (x *GroupInviteLink) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupInviteLink.ProtoReflect.Descriptor instead.
+func (*GroupInviteLink) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GroupInviteLink) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GroupInviteLink) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *GroupInviteLink) GetCreatorUserID() string {
+	if x != nil {
+		return x.CreatorUserID
+	}
+	return ""
+}
+
+func (x *GroupInviteLink) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *GroupInviteLink) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *GroupInviteLink) GetUsedCount() int32 {
+	if x != nil {
+		return x.UsedCount
+	}
+	return 0
+}
+
+func (x *GroupInviteLink) GetApproval() int32 {
+	if x != nil {
+		return x.Approval
+	}
+	return 0
+}
+
+func (x *GroupInviteLink) GetJoinSource() int32 {
+	if x != nil {
+		return x.JoinSource
+	}
+	return 0
+}
+
+func (x *GroupInviteLink) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
+func (x *GroupInviteLink) GetEx() string {
+	if x != nil {
+		return x.Ex
+	}
+	return ""
+}
+
+func (x *GroupInviteLink) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type GroupInviteRedemption struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token      string `protobuf:"bytes,1,opt,name=token,proto3" json:"token"`
+	GroupID    string `protobuf:"bytes,2,opt,name=groupID,proto3" json:"groupID"`
+	UserID     string `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID"`
+	JoinSource int32  `protobuf:"varint,4,opt,name=joinSource,proto3" json:"joinSource"`
+	Result     int32  `protobuf:"varint,5,opt,name=result,proto3" json:"result"`
+	CreateTime int64  `protobuf:"varint,6,opt,name=createTime,proto3" json:"createTime"`
+}
+
+func (x *GroupInviteRedemption) Reset() {
+	*x = GroupInviteRedemption{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupInviteRedemption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupInviteRedemption) ProtoMessage() {}
+
+func (x *GroupInviteRedemption) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupInviteRedemption.ProtoReflect.Descriptor instead.
+func (*GroupInviteRedemption) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GroupInviteRedemption) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GroupInviteRedemption) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *GroupInviteRedemption) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GroupInviteRedemption) GetJoinSource() int32 {
+	if x != nil {
+		return x.JoinSource
+	}
+	return 0
+}
+
+func (x *GroupInviteRedemption) GetResult() int32 {
+	if x != nil {
+		return x.Result
+	}
+	return 0
+}
+
+func (x *GroupInviteRedemption) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type CreateGroupInviteLinkReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID    string `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	ExpireTime int64  `protobuf:"varint,2,opt,name=expireTime,proto3" json:"expireTime"`
+	MaxUses    int32  `protobuf:"varint,3,opt,name=maxUses,proto3" json:"maxUses"`
+	Approval   int32  `protobuf:"varint,4,opt,name=approval,proto3" json:"approval"`
+	JoinSource int32  `protobuf:"varint,5,opt,name=joinSource,proto3" json:"joinSource"`
+	Ex         string `protobuf:"bytes,6,opt,name=ex,proto3" json:"ex"`
+}
+
+func (x *CreateGroupInviteLinkReq) Reset() {
+	*x = CreateGroupInviteLinkReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateGroupInviteLinkReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupInviteLinkReq) ProtoMessage() {}
+
+func (x *CreateGroupInviteLinkReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupInviteLinkReq.ProtoReflect.Descriptor instead.
+func (*CreateGroupInviteLinkReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateGroupInviteLinkReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *CreateGroupInviteLinkReq) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *CreateGroupInviteLinkReq) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *CreateGroupInviteLinkReq) GetApproval() int32 {
+	if x != nil {
+		return x.Approval
+	}
+	return 0
+}
+
+func (x *CreateGroupInviteLinkReq) GetJoinSource() int32 {
+	if x != nil {
+		return x.JoinSource
+	}
+	return 0
+}
+
+func (x *CreateGroupInviteLinkReq) GetEx() string {
+	if x != nil {
+		return x.Ex
+	}
+	return ""
+}
+
+type CreateGroupInviteLinkResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Link *GroupInviteLink `protobuf:"bytes,1,opt,name=link,proto3" json:"link"`
+}
+
+func (x *CreateGroupInviteLinkResp) Reset() {
+	*x = CreateGroupInviteLinkResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateGroupInviteLinkResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupInviteLinkResp) ProtoMessage() {}
+
+func (x *CreateGroupInviteLinkResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupInviteLinkResp.ProtoReflect.Descriptor instead.
+func (*CreateGroupInviteLinkResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateGroupInviteLinkResp) GetLink() *GroupInviteLink {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+type GetGroupInviteLinksReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID    string                   `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (x *GetGroupInviteLinksReq) Reset() {
+	*x = GetGroupInviteLinksReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupInviteLinksReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupInviteLinksReq) ProtoMessage() {}
+
+func (x *GetGroupInviteLinksReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupInviteLinksReq.ProtoReflect.Descriptor instead.
+func (*GetGroupInviteLinksReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetGroupInviteLinksReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *GetGroupInviteLinksReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetGroupInviteLinksResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total int64              `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Links []*GroupInviteLink `protobuf:"bytes,2,rep,name=links,proto3" json:"links"`
+}
+
+func (x *GetGroupInviteLinksResp) Reset() {
+	*x = GetGroupInviteLinksResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupInviteLinksResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupInviteLinksResp) ProtoMessage() {}
+
+func (x *GetGroupInviteLinksResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupInviteLinksResp.ProtoReflect.Descriptor instead.
+func (*GetGroupInviteLinksResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetGroupInviteLinksResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetGroupInviteLinksResp) GetLinks() []*GroupInviteLink {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+type RevokeGroupInviteLinkReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID string `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	Token   string `protobuf:"bytes,2,opt,name=token,proto3" json:"token"`
+}
+
+func (x *RevokeGroupInviteLinkReq) Reset() {
+	*x = RevokeGroupInviteLinkReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeGroupInviteLinkReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeGroupInviteLinkReq) ProtoMessage() {}
+
+func (x *RevokeGroupInviteLinkReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeGroupInviteLinkReq.ProtoReflect.Descriptor instead.
+func (*RevokeGroupInviteLinkReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RevokeGroupInviteLinkReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *RevokeGroupInviteLinkReq) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type RevokeGroupInviteLinkResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RevokeGroupInviteLinkResp) Reset() {
+	*x = RevokeGroupInviteLinkResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeGroupInviteLinkResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeGroupInviteLinkResp) ProtoMessage() {}
+
+func (x *RevokeGroupInviteLinkResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeGroupInviteLinkResp.ProtoReflect.Descriptor instead.
+func (*RevokeGroupInviteLinkResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{22}
+}
+
+type GetGroupInviteRedemptionsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID    string                   `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	Token      string                   `protobuf:"bytes,2,opt,name=token,proto3" json:"token"`
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (x *GetGroupInviteRedemptionsReq) Reset() {
+	*x = GetGroupInviteRedemptionsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupInviteRedemptionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupInviteRedemptionsReq) ProtoMessage() {}
+
+func (x *GetGroupInviteRedemptionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupInviteRedemptionsReq.ProtoReflect.Descriptor instead.
+func (*GetGroupInviteRedemptionsReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetGroupInviteRedemptionsReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *GetGroupInviteRedemptionsReq) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetGroupInviteRedemptionsReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetGroupInviteRedemptionsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total       int64                    `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Redemptions []*GroupInviteRedemption `protobuf:"bytes,2,rep,name=redemptions,proto3" json:"redemptions"`
+}
+
+func (x *GetGroupInviteRedemptionsResp) Reset() {
+	*x = GetGroupInviteRedemptionsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupInviteRedemptionsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupInviteRedemptionsResp) ProtoMessage() {}
+
+func (x *GetGroupInviteRedemptionsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupInviteRedemptionsResp.ProtoReflect.Descriptor instead.
+func (*GetGroupInviteRedemptionsResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetGroupInviteRedemptionsResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetGroupInviteRedemptionsResp) GetRedemptions() []*GroupInviteRedemption {
+	if x != nil {
+		return x.Redemptions
+	}
+	return nil
+}
+
+type JoinGroupByInviteReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token      string             `protobuf:"bytes,1,opt,name=token,proto3" json:"token"`
+	ReqMessage string             `protobuf:"bytes,2,opt,name=reqMessage,proto3" json:"reqMessage"`
+	Ex         string             `protobuf:"bytes,3,opt,name=ex,proto3" json:"ex"`
+	Answers    []*GroupJoinAnswer `protobuf:"bytes,4,rep,name=answers,proto3" json:"answers"`
+}
+
+func (x *JoinGroupByInviteReq) Reset() {
+	*x = JoinGroupByInviteReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinGroupByInviteReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinGroupByInviteReq) ProtoMessage() {}
+
+func (x *JoinGroupByInviteReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinGroupByInviteReq.ProtoReflect.Descriptor instead.
+func (*JoinGroupByInviteReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *JoinGroupByInviteReq) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *JoinGroupByInviteReq) GetReqMessage() string {
+	if x != nil {
+		return x.ReqMessage
+	}
+	return ""
+}
+
+func (x *JoinGroupByInviteReq) GetEx() string {
+	if x != nil {
+		return x.Ex
+	}
+	return ""
+}
+
+func (x *JoinGroupByInviteReq) GetAnswers() []*GroupJoinAnswer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+type JoinGroupByInviteResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID string `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	Result  int32  `protobuf:"varint,2,opt,name=result,proto3" json:"result"`
+}
+
+func (x *JoinGroupByInviteResp) Reset() {
+	*x = JoinGroupByInviteResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinGroupByInviteResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinGroupByInviteResp) ProtoMessage() {}
+
+func (x *JoinGroupByInviteResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinGroupByInviteResp.ProtoReflect.Descriptor instead.
+func (*JoinGroupByInviteResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *JoinGroupByInviteResp) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *JoinGroupByInviteResp) GetResult() int32 {
+	if x != nil {
+		return x.Result
+	}
+	return 0
+}
+
+type GetChannelSubscribersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID     string `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	Shard       int32  `protobuf:"varint,2,opt,name=shard,proto3" json:"shard"`
+	AfterUserID string `protobuf:"bytes,3,opt,name=afterUserID,proto3" json:"afterUserID"`
+	Count       int32  `protobuf:"varint,4,opt,name=count,proto3" json:"count"`
+}
+
+func (x *GetChannelSubscribersReq) Reset() {
+	*x = GetChannelSubscribersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChannelSubscribersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChannelSubscribersReq) ProtoMessage() {}
+
+func (x *GetChannelSubscribersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChannelSubscribersReq.ProtoReflect.Descriptor instead.
+func (*GetChannelSubscribersReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetChannelSubscribersReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *GetChannelSubscribersReq) GetShard() int32 {
+	if x != nil {
+		return x.Shard
+	}
+	return 0
+}
+
+func (x *GetChannelSubscribersReq) GetAfterUserID() string {
+	if x != nil {
+		return x.AfterUserID
+	}
+	return ""
+}
+
+func (x *GetChannelSubscribersReq) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetChannelSubscribersResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
+}
+
+func (x *GetChannelSubscribersResp) Reset() {
+	*x = GetChannelSubscribersResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChannelSubscribersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChannelSubscribersResp) ProtoMessage() {}
+
+func (x *GetChannelSubscribersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChannelSubscribersResp.ProtoReflect.Descriptor instead.
+func (*GetChannelSubscribersResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetChannelSubscribersResp) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type CommunityInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommunityID         string   `protobuf:"bytes,1,opt,name=communityID,proto3" json:"communityID"`
+	Name                string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	FaceURL             string   `protobuf:"bytes,3,opt,name=faceURL,proto3" json:"faceURL"`
+	Introduction        string   `protobuf:"bytes,4,opt,name=introduction,proto3" json:"introduction"`
+	OwnerUserID         string   `protobuf:"bytes,5,opt,name=ownerUserID,proto3" json:"ownerUserID"`
+	AnnouncementGroupID string   `protobuf:"bytes,6,opt,name=announcementGroupID,proto3" json:"announcementGroupID"`
+	GroupIDs            []string `protobuf:"bytes,7,rep,name=groupIDs,proto3" json:"groupIDs"`
+	Ex                  string   `protobuf:"bytes,8,opt,name=ex,proto3" json:"ex"`
+	CreateTime          int64    `protobuf:"varint,9,opt,name=createTime,proto3" json:"createTime"`
+}
+
+func (x *CommunityInfo) Reset() {
+	*x = CommunityInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommunityInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommunityInfo) ProtoMessage() {}
+
+func (x *CommunityInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommunityInfo.ProtoReflect.Descriptor instead.
+func (*CommunityInfo) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CommunityInfo) GetCommunityID() string {
+	if x != nil {
+		return x.CommunityID
+	}
+	return ""
+}
+
+func (x *CommunityInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CommunityInfo) GetFaceURL() string {
+	if x != nil {
+		return x.FaceURL
+	}
+	return ""
+}
+
+func (x *CommunityInfo) GetIntroduction() string {
+	if x != nil {
+		return x.Introduction
+	}
+	return ""
+}
+
+func (x *CommunityInfo) GetOwnerUserID() string {
+	if x != nil {
+		return x.OwnerUserID
+	}
+	return ""
+}
+
+func (x *CommunityInfo) GetAnnouncementGroupID() string {
+	if x != nil {
+		return x.AnnouncementGroupID
+	}
+	return ""
+}
+
+func (x *CommunityInfo) GetGroupIDs() []string {
+	if x != nil {
+		return x.GroupIDs
+	}
+	return nil
+}
+
+func (x *CommunityInfo) GetEx() string {
+	if x != nil {
+		return x.Ex
+	}
+	return ""
+}
+
+func (x *CommunityInfo) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type CommunityMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommunityID   string `protobuf:"bytes,1,opt,name=communityID,proto3" json:"communityID"`
+	UserID        string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	RoleLevel     int32  `protobuf:"varint,3,opt,name=roleLevel,proto3" json:"roleLevel"`
+	InviterUserID string `protobuf:"bytes,4,opt,name=inviterUserID,proto3" json:"inviterUserID"`
+	JoinTime      int64  `protobuf:"varint,5,opt,name=joinTime,proto3" json:"joinTime"`
+}
+
+func (x *CommunityMember) Reset() {
+	*x = CommunityMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommunityMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommunityMember) ProtoMessage() {}
+
+func (x *CommunityMember) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommunityMember.ProtoReflect.Descriptor instead.
+func (*CommunityMember) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CommunityMember) GetCommunityID() string {
+	if x != nil {
+		return x.CommunityID
+	}
+	return ""
+}
+
+func (x *CommunityMember) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *CommunityMember) GetRoleLevel() int32 {
+	if x != nil {
+		return x.RoleLevel
+	}
+	return 0
+}
+
+func (x *CommunityMember) GetInviterUserID() string {
+	if x != nil {
+		return x.InviterUserID
+	}
+	return ""
+}
+
+func (x *CommunityMember) GetJoinTime() int64 {
+	if x != nil {
+		return x.JoinTime
+	}
+	return 0
+}
+
+type CreateCommunityReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name          string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
+	FaceURL       string   `protobuf:"bytes,2,opt,name=faceURL,proto3" json:"faceURL"`
+	Introduction  string   `protobuf:"bytes,3,opt,name=introduction,proto3" json:"introduction"`
+	Ex            string   `protobuf:"bytes,4,opt,name=ex,proto3" json:"ex"`
+	OwnerUserID   string   `protobuf:"bytes,5,opt,name=ownerUserID,proto3" json:"ownerUserID"`
+	AdminUserIDs  []string `protobuf:"bytes,6,rep,name=adminUserIDs,proto3" json:"adminUserIDs"`
+	MemberUserIDs []string `protobuf:"bytes,7,rep,name=memberUserIDs,proto3" json:"memberUserIDs"`
+}
+
+func (x *CreateCommunityReq) Reset() {
+	*x = CreateCommunityReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCommunityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommunityReq) ProtoMessage() {}
+
+func (x *CreateCommunityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommunityReq.ProtoReflect.Descriptor instead.
+func (*CreateCommunityReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CreateCommunityReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCommunityReq) GetFaceURL() string {
+	if x != nil {
+		return x.FaceURL
+	}
+	return ""
+}
+
+func (x *CreateCommunityReq) GetIntroduction() string {
+	if x != nil {
+		return x.Introduction
+	}
+	return ""
+}
+
+func (x *CreateCommunityReq) GetEx() string {
+	if x != nil {
+		return x.Ex
+	}
+	return ""
+}
+
+func (x *CreateCommunityReq) GetOwnerUserID() string {
+	if x != nil {
+		return x.OwnerUserID
+	}
+	return ""
+}
+
+func (x *CreateCommunityReq) GetAdminUserIDs() []string {
+	if x != nil {
+		return x.AdminUserIDs
+	}
+	return nil
+}
+
+func (x *CreateCommunityReq) GetMemberUserIDs() []string {
+	if x != nil {
+		return x.MemberUserIDs
+	}
+	return nil
+}
+
+type CreateCommunityResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Community *CommunityInfo `protobuf:"bytes,1,opt,name=community,proto3" json:"community"`
+}
+
+func (x *CreateCommunityResp) Reset() {
+	*x = CreateCommunityResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCommunityResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommunityResp) ProtoMessage() {}
+
+func (x *CreateCommunityResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommunityResp.ProtoReflect.Descriptor instead.
+func (*CreateCommunityResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CreateCommunityResp) GetCommunity() *CommunityInfo {
+	if x != nil {
+		return x.Community
+	}
+	return nil
+}
+
+type SetCommunityInfoReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommunityID  string                  `protobuf:"bytes,1,opt,name=communityID,proto3" json:"communityID"`
+	Name         *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	FaceURL      *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=faceURL,proto3" json:"faceURL"`
+	Introduction *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=introduction,proto3" json:"introduction"`
+	Ex           *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=ex,proto3" json:"ex"`
+}
+
+func (x *SetCommunityInfoReq) Reset() {
+	*x = SetCommunityInfoReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCommunityInfoReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCommunityInfoReq) ProtoMessage() {}
+
+func (x *SetCommunityInfoReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCommunityInfoReq.ProtoReflect.Descriptor instead.
+func (*SetCommunityInfoReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SetCommunityInfoReq) GetCommunityID() string {
+	if x != nil {
+		return x.CommunityID
+	}
+	return ""
+}
+
+func (x *SetCommunityInfoReq) GetName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *SetCommunityInfoReq) GetFaceURL() *wrapperspb.StringValue {
+	if x != nil {
+		return x.FaceURL
+	}
+	return nil
+}
+
+func (x *SetCommunityInfoReq) GetIntroduction() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Introduction
+	}
+	return nil
+}
+
+func (x *SetCommunityInfoReq) GetEx() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Ex
+	}
+	return nil
+}
+
+type SetCommunityInfoResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetCommunityInfoResp) Reset() {
+	*x = SetCommunityInfoResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCommunityInfoResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCommunityInfoResp) ProtoMessage() {}
+
+func (x *SetCommunityInfoResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCommunityInfoResp.ProtoReflect.Descriptor instead.
+func (*SetCommunityInfoResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{34}
+}
+
+type DismissCommunityReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommunityID string `protobuf:"bytes,1,opt,name=communityID,proto3" json:"communityID"`
+}
+
+func (x *DismissCommunityReq) Reset() {
+	*x = DismissCommunityReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DismissCommunityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DismissCommunityReq) ProtoMessage() {}
+
+func (x *DismissCommunityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DismissCommunityReq.ProtoReflect.Descriptor instead.
+func (*DismissCommunityReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DismissCommunityReq) GetCommunityID() string {
+	if x != nil {
+		return x.CommunityID
+	}
+	return ""
+}
+
+type DismissCommunityResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DismissCommunityResp) Reset() {
+	*x = DismissCommunityResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DismissCommunityResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DismissCommunityResp) ProtoMessage() {}
+
+func (x *DismissCommunityResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DismissCommunityResp.ProtoReflect.Descriptor instead.
+func (*DismissCommunityResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{36}
+}
+
+type GetCommunitiesInfoReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommunityIDs []string `protobuf:"bytes,1,rep,name=communityIDs,proto3" json:"communityIDs"`
+}
+
+func (x *GetCommunitiesInfoReq) Reset() {
+	*x = GetCommunitiesInfoReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCommunitiesInfoReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommunitiesInfoReq) ProtoMessage() {}
+
+func (x *GetCommunitiesInfoReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommunitiesInfoReq.ProtoReflect.Descriptor instead.
+func (*GetCommunitiesInfoReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetCommunitiesInfoReq) GetCommunityIDs() []string {
+	if x != nil {
+		return x.CommunityIDs
+	}
+	return nil
+}
+
+type GetCommunitiesInfoResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Communities []*CommunityInfo `protobuf:"bytes,1,rep,name=communities,proto3" json:"communities"`
+}
+
+func (x *GetCommunitiesInfoResp) Reset() {
+	*x = GetCommunitiesInfoResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCommunitiesInfoResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommunitiesInfoResp) ProtoMessage() {}
+
+func (x *GetCommunitiesInfoResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommunitiesInfoResp.ProtoReflect.Descriptor instead.
+func (*GetCommunitiesInfoResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetCommunitiesInfoResp) GetCommunities() []*CommunityInfo {
+	if x != nil {
+		return x.Communities
+	}
+	return nil
+}
+
+type InviteToCommunityReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommunityID string   `protobuf:"bytes,1,opt,name=communityID,proto3" json:"communityID"`
+	UserIDs     []string `protobuf:"bytes,2,rep,name=userIDs,proto3" json:"userIDs"`
+}
+
+func (x *InviteToCommunityReq) Reset() {
+	*x = InviteToCommunityReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InviteToCommunityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteToCommunityReq) ProtoMessage() {}
+
+func (x *InviteToCommunityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteToCommunityReq.ProtoReflect.Descriptor instead.
+func (*InviteToCommunityReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *InviteToCommunityReq) GetCommunityID() string {
+	if x != nil {
+		return x.CommunityID
+	}
+	return ""
+}
+
+func (x *InviteToCommunityReq) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type InviteToCommunityResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *InviteToCommunityResp) Reset() {
+	*x = InviteToCommunityResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InviteToCommunityResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteToCommunityResp) ProtoMessage() {}
+
+func (x *InviteToCommunityResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteToCommunityResp.ProtoReflect.Descriptor instead.
+func (*InviteToCommunityResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{40}
+}
+
+type KickCommunityMemberReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommunityID string   `protobuf:"bytes,1,opt,name=communityID,proto3" json:"communityID"`
+	UserIDs     []string `protobuf:"bytes,2,rep,name=userIDs,proto3" json:"userIDs"`
+}
+
+func (x *KickCommunityMemberReq) Reset() {
+	*x = KickCommunityMemberReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KickCommunityMemberReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickCommunityMemberReq) ProtoMessage() {}
+
+func (x *KickCommunityMemberReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickCommunityMemberReq.ProtoReflect.Descriptor instead.
+func (*KickCommunityMemberReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *KickCommunityMemberReq) GetCommunityID() string {
+	if x != nil {
+		return x.CommunityID
+	}
+	return ""
+}
+
+func (x *KickCommunityMemberReq) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type KickCommunityMemberResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *KickCommunityMemberResp) Reset() {
+	*x = KickCommunityMemberResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KickCommunityMemberResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickCommunityMemberResp) ProtoMessage() {}
+
+func (x *KickCommunityMemberResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickCommunityMemberResp.ProtoReflect.Descriptor instead.
+func (*KickCommunityMemberResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{42}
+}
+
+type QuitCommunityReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommunityID string `protobuf:"bytes,1,opt,name=communityID,proto3" json:"communityID"`
+	UserID      string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+}
+
+func (x *QuitCommunityReq) Reset() {
+	*x = QuitCommunityReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuitCommunityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuitCommunityReq) ProtoMessage() {}
+
+func (x *QuitCommunityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuitCommunityReq.ProtoReflect.Descriptor instead.
+func (*QuitCommunityReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *QuitCommunityReq) GetCommunityID() string {
+	if x != nil {
+		return x.CommunityID
+	}
+	return ""
+}
+
+func (x *QuitCommunityReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type QuitCommunityResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QuitCommunityResp) Reset() {
+	*x = QuitCommunityResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuitCommunityResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuitCommunityResp) ProtoMessage() {}
+
+func (x *QuitCommunityResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuitCommunityResp.ProtoReflect.Descriptor instead.
+func (*QuitCommunityResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{44}
+}
+
+type SetCommunityMemberRoleReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommunityID string `protobuf:"bytes,1,opt,name=communityID,proto3" json:"communityID"`
+	UserID      string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	RoleLevel   int32  `protobuf:"varint,3,opt,name=roleLevel,proto3" json:"roleLevel"`
+}
+
+func (x *SetCommunityMemberRoleReq) Reset() {
+	*x = SetCommunityMemberRoleReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCommunityMemberRoleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCommunityMemberRoleReq) ProtoMessage() {}
+
+func (x *SetCommunityMemberRoleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCommunityMemberRoleReq.ProtoReflect.Descriptor instead.
+func (*SetCommunityMemberRoleReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SetCommunityMemberRoleReq) GetCommunityID() string {
+	if x != nil {
+		return x.CommunityID
+	}
+	return ""
+}
+
+func (x *SetCommunityMemberRoleReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SetCommunityMemberRoleReq) GetRoleLevel() int32 {
+	if x != nil {
+		return x.RoleLevel
+	}
+	return 0
+}
+
+type SetCommunityMemberRoleResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetCommunityMemberRoleResp) Reset() {
+	*x = SetCommunityMemberRoleResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCommunityMemberRoleResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCommunityMemberRoleResp) ProtoMessage() {}
+
+func (x *SetCommunityMemberRoleResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCommunityMemberRoleResp.ProtoReflect.Descriptor instead.
+func (*SetCommunityMemberRoleResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{46}
+}
+
+type GetCommunityMembersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommunityID string                   `protobuf:"bytes,1,opt,name=communityID,proto3" json:"communityID"`
+	Pagination  *sdkws.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (x *GetCommunityMembersReq) Reset() {
+	*x = GetCommunityMembersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCommunityMembersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommunityMembersReq) ProtoMessage() {}
+
+func (x *GetCommunityMembersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommunityMembersReq.ProtoReflect.Descriptor instead.
+func (*GetCommunityMembersReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetCommunityMembersReq) GetCommunityID() string {
+	if x != nil {
+		return x.CommunityID
+	}
+	return ""
+}
+
+func (x *GetCommunityMembersReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetCommunityMembersResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total   int64              `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Members []*CommunityMember `protobuf:"bytes,2,rep,name=members,proto3" json:"members"`
+}
+
+func (x *GetCommunityMembersResp) Reset() {
+	*x = GetCommunityMembersResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCommunityMembersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommunityMembersResp) ProtoMessage() {}
+
+func (x *GetCommunityMembersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommunityMembersResp.ProtoReflect.Descriptor instead.
+func (*GetCommunityMembersResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetCommunityMembersResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetCommunityMembersResp) GetMembers() []*CommunityMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type AddCommunityGroupsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommunityID string   `protobuf:"bytes,1,opt,name=communityID,proto3" json:"communityID"`
+	GroupIDs    []string `protobuf:"bytes,2,rep,name=groupIDs,proto3" json:"groupIDs"`
+}
+
+func (x *AddCommunityGroupsReq) Reset() {
+	*x = AddCommunityGroupsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddCommunityGroupsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCommunityGroupsReq) ProtoMessage() {}
+
+func (x *AddCommunityGroupsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCommunityGroupsReq.ProtoReflect.Descriptor instead.
+func (*AddCommunityGroupsReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *AddCommunityGroupsReq) GetCommunityID() string {
+	if x != nil {
+		return x.CommunityID
+	}
+	return ""
+}
+
+func (x *AddCommunityGroupsReq) GetGroupIDs() []string {
+	if x != nil {
+		return x.GroupIDs
+	}
+	return nil
+}
+
+type AddCommunityGroupsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AddCommunityGroupsResp) Reset() {
+	*x = AddCommunityGroupsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddCommunityGroupsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCommunityGroupsResp) ProtoMessage() {}
+
+func (x *AddCommunityGroupsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCommunityGroupsResp.ProtoReflect.Descriptor instead.
+func (*AddCommunityGroupsResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{50}
+}
+
+type RemoveCommunityGroupsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommunityID string   `protobuf:"bytes,1,opt,name=communityID,proto3" json:"communityID"`
+	GroupIDs    []string `protobuf:"bytes,2,rep,name=groupIDs,proto3" json:"groupIDs"`
+}
+
+func (x *RemoveCommunityGroupsReq) Reset() {
+	*x = RemoveCommunityGroupsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveCommunityGroupsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCommunityGroupsReq) ProtoMessage() {}
+
+func (x *RemoveCommunityGroupsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCommunityGroupsReq.ProtoReflect.Descriptor instead.
+func (*RemoveCommunityGroupsReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RemoveCommunityGroupsReq) GetCommunityID() string {
+	if x != nil {
+		return x.CommunityID
+	}
+	return ""
+}
+
+func (x *RemoveCommunityGroupsReq) GetGroupIDs() []string {
+	if x != nil {
+		return x.GroupIDs
+	}
+	return nil
+}
+
+type RemoveCommunityGroupsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveCommunityGroupsResp) Reset() {
+	*x = RemoveCommunityGroupsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveCommunityGroupsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCommunityGroupsResp) ProtoMessage() {}
+
+func (x *RemoveCommunityGroupsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCommunityGroupsResp.ProtoReflect.Descriptor instead.
+func (*RemoveCommunityGroupsResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{52}
+}
+
+type GetIncrementalJoinCommunityReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID    string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	VersionID string `protobuf:"bytes,2,opt,name=versionID,proto3" json:"versionID"`
+	Version   uint64 `protobuf:"varint,3,opt,name=version,proto3" json:"version"`
+}
+
+func (x *GetIncrementalJoinCommunityReq) Reset() {
+	*x = GetIncrementalJoinCommunityReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetIncrementalJoinCommunityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIncrementalJoinCommunityReq) ProtoMessage() {}
+
+func (x *GetIncrementalJoinCommunityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIncrementalJoinCommunityReq.ProtoReflect.Descriptor instead.
+func (*GetIncrementalJoinCommunityReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetIncrementalJoinCommunityReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetIncrementalJoinCommunityReq) GetVersionID() string {
+	if x != nil {
+		return x.VersionID
+	}
+	return ""
+}
+
+func (x *GetIncrementalJoinCommunityReq) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type GetIncrementalJoinCommunityResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VersionID string           `protobuf:"bytes,1,opt,name=versionID,proto3" json:"versionID"`
+	Version   uint64           `protobuf:"varint,2,opt,name=version,proto3" json:"version"`
+	Full      bool             `protobuf:"varint,3,opt,name=full,proto3" json:"full"`
+	Delete    []string         `protobuf:"bytes,4,rep,name=delete,proto3" json:"delete"`
+	Insert    []*CommunityInfo `protobuf:"bytes,5,rep,name=insert,proto3" json:"insert"`
+	Update    []*CommunityInfo `protobuf:"bytes,6,rep,name=update,proto3" json:"update"`
+}
+
+func (x *GetIncrementalJoinCommunityResp) Reset() {
+	*x = GetIncrementalJoinCommunityResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetIncrementalJoinCommunityResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIncrementalJoinCommunityResp) ProtoMessage() {}
+
+func (x *GetIncrementalJoinCommunityResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIncrementalJoinCommunityResp.ProtoReflect.Descriptor instead.
+func (*GetIncrementalJoinCommunityResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetIncrementalJoinCommunityResp) GetVersionID() string {
+	if x != nil {
+		return x.VersionID
+	}
+	return ""
+}
+
+func (x *GetIncrementalJoinCommunityResp) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GetIncrementalJoinCommunityResp) GetFull() bool {
+	if x != nil {
+		return x.Full
+	}
+	return false
+}
+
+func (x *GetIncrementalJoinCommunityResp) GetDelete() []string {
+	if x != nil {
+		return x.Delete
+	}
+	return nil
+}
+
+func (x *GetIncrementalJoinCommunityResp) GetInsert() []*CommunityInfo {
+	if x != nil {
+		return x.Insert
+	}
+	return nil
+}
+
+func (x *GetIncrementalJoinCommunityResp) GetUpdate() []*CommunityInfo {
+	if x != nil {
+		return x.Update
+	}
+	return nil
+}
+
+type GetFullJoinCommunityIDsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	IdHash uint64 `protobuf:"varint,2,opt,name=idHash,proto3" json:"idHash"`
+}
+
+func (x *GetFullJoinCommunityIDsReq) Reset() {
+	*x = GetFullJoinCommunityIDsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFullJoinCommunityIDsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFullJoinCommunityIDsReq) ProtoMessage() {}
+
+func (x *GetFullJoinCommunityIDsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFullJoinCommunityIDsReq.ProtoReflect.Descriptor instead.
+func (*GetFullJoinCommunityIDsReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetFullJoinCommunityIDsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetFullJoinCommunityIDsReq) GetIdHash() uint64 {
+	if x != nil {
+		return x.IdHash
+	}
+	return 0
+}
+
+type GetFullJoinCommunityIDsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VersionID    string   `protobuf:"bytes,1,opt,name=versionID,proto3" json:"versionID"`
+	Version      uint64   `protobuf:"varint,2,opt,name=version,proto3" json:"version"`
+	Equal        bool     `protobuf:"varint,3,opt,name=equal,proto3" json:"equal"`
+	CommunityIDs []string `protobuf:"bytes,4,rep,name=communityIDs,proto3" json:"communityIDs"`
+}
+
+func (x *GetFullJoinCommunityIDsResp) Reset() {
+	*x = GetFullJoinCommunityIDsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFullJoinCommunityIDsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFullJoinCommunityIDsResp) ProtoMessage() {}
+
+func (x *GetFullJoinCommunityIDsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFullJoinCommunityIDsResp.ProtoReflect.Descriptor instead.
+func (*GetFullJoinCommunityIDsResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetFullJoinCommunityIDsResp) GetVersionID() string {
+	if x != nil {
+		return x.VersionID
+	}
+	return ""
+}
+
+func (x *GetFullJoinCommunityIDsResp) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GetFullJoinCommunityIDsResp) GetEqual() bool {
+	if x != nil {
+		return x.Equal
+	}
+	return false
+}
+
+func (x *GetFullJoinCommunityIDsResp) GetCommunityIDs() []string {
+	if x != nil {
+		return x.CommunityIDs
+	}
+	return nil
+}
+
+type GroupJoinQuestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QuestionID string `protobuf:"bytes,1,opt,name=questionID,proto3" json:"questionID"`
+	Question   string `protobuf:"bytes,2,opt,name=question,proto3" json:"question"`
+	Required   bool   `protobuf:"varint,3,opt,name=required,proto3" json:"required"`
+}
+
+func (x *GroupJoinQuestion) Reset() {
+	*x = GroupJoinQuestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupJoinQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupJoinQuestion) ProtoMessage() {}
+
+func (x *GroupJoinQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupJoinQuestion.ProtoReflect.Descriptor instead.
+func (*GroupJoinQuestion) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GroupJoinQuestion) GetQuestionID() string {
+	if x != nil {
+		return x.QuestionID
+	}
+	return ""
+}
+
+func (x *GroupJoinQuestion) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *GroupJoinQuestion) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+type GroupJoinAnswer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QuestionID string `protobuf:"bytes,1,opt,name=questionID,proto3" json:"questionID"`
+	Question   string `protobuf:"bytes,2,opt,name=question,proto3" json:"question"`
+	Answer     string `protobuf:"bytes,3,opt,name=answer,proto3" json:"answer"`
+}
+
+func (x *GroupJoinAnswer) Reset() {
+	*x = GroupJoinAnswer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupJoinAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupJoinAnswer) ProtoMessage() {}
+
+func (x *GroupJoinAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupJoinAnswer.ProtoReflect.Descriptor instead.
+func (*GroupJoinAnswer) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GroupJoinAnswer) GetQuestionID() string {
+	if x != nil {
+		return x.QuestionID
+	}
+	return ""
+}
+
+func (x *GroupJoinAnswer) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *GroupJoinAnswer) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+type SetGroupJoinQuestionsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID   string               `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	Questions []*GroupJoinQuestion `protobuf:"bytes,2,rep,name=questions,proto3" json:"questions"`
+}
+
+func (x *SetGroupJoinQuestionsReq) Reset() {
+	*x = SetGroupJoinQuestionsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetGroupJoinQuestionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupJoinQuestionsReq) ProtoMessage() {}
+
+func (x *SetGroupJoinQuestionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupJoinQuestionsReq.ProtoReflect.Descriptor instead.
+func (*SetGroupJoinQuestionsReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SetGroupJoinQuestionsReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *SetGroupJoinQuestionsReq) GetQuestions() []*GroupJoinQuestion {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+type SetGroupJoinQuestionsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetGroupJoinQuestionsResp) Reset() {
+	*x = SetGroupJoinQuestionsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetGroupJoinQuestionsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupJoinQuestionsResp) ProtoMessage() {}
+
+func (x *SetGroupJoinQuestionsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupJoinQuestionsResp.ProtoReflect.Descriptor instead.
+func (*SetGroupJoinQuestionsResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{60}
+}
+
+type GetGroupJoinQuestionsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID string `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+}
+
+func (x *GetGroupJoinQuestionsReq) Reset() {
+	*x = GetGroupJoinQuestionsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupJoinQuestionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupJoinQuestionsReq) ProtoMessage() {}
+
+func (x *GetGroupJoinQuestionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupJoinQuestionsReq.ProtoReflect.Descriptor instead.
+func (*GetGroupJoinQuestionsReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetGroupJoinQuestionsReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+type GetGroupJoinQuestionsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Questions []*GroupJoinQuestion `protobuf:"bytes,1,rep,name=questions,proto3" json:"questions"`
+}
+
+func (x *GetGroupJoinQuestionsResp) Reset() {
+	*x = GetGroupJoinQuestionsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupJoinQuestionsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupJoinQuestionsResp) ProtoMessage() {}
+
+func (x *GetGroupJoinQuestionsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupJoinQuestionsResp.ProtoReflect.Descriptor instead.
+func (*GetGroupJoinQuestionsResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetGroupJoinQuestionsResp) GetQuestions() []*GroupJoinQuestion {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+type JoinGroupWithAnswersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID       string             `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	ReqMessage    string             `protobuf:"bytes,2,opt,name=reqMessage,proto3" json:"reqMessage"`
+	JoinSource    int32              `protobuf:"varint,3,opt,name=joinSource,proto3" json:"joinSource"`
+	InviterUserID string             `protobuf:"bytes,4,opt,name=inviterUserID,proto3" json:"inviterUserID"`
+	Ex            string             `protobuf:"bytes,5,opt,name=ex,proto3" json:"ex"`
+	Answers       []*GroupJoinAnswer `protobuf:"bytes,6,rep,name=answers,proto3" json:"answers"`
+}
+
+func (x *JoinGroupWithAnswersReq) Reset() {
+	*x = JoinGroupWithAnswersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinGroupWithAnswersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinGroupWithAnswersReq) ProtoMessage() {}
+
+func (x *JoinGroupWithAnswersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinGroupWithAnswersReq.ProtoReflect.Descriptor instead.
+func (*JoinGroupWithAnswersReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *JoinGroupWithAnswersReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *JoinGroupWithAnswersReq) GetReqMessage() string {
+	if x != nil {
+		return x.ReqMessage
+	}
+	return ""
+}
+
+func (x *JoinGroupWithAnswersReq) GetJoinSource() int32 {
+	if x != nil {
+		return x.JoinSource
+	}
+	return 0
+}
+
+func (x *JoinGroupWithAnswersReq) GetInviterUserID() string {
+	if x != nil {
+		return x.InviterUserID
+	}
+	return ""
+}
+
+func (x *JoinGroupWithAnswersReq) GetEx() string {
+	if x != nil {
+		return x.Ex
+	}
+	return ""
+}
+
+func (x *JoinGroupWithAnswersReq) GetAnswers() []*GroupJoinAnswer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+type JoinGroupWithAnswersResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Joined bool `protobuf:"varint,1,opt,name=joined,proto3" json:"joined"`
+}
+
+func (x *JoinGroupWithAnswersResp) Reset() {
+	*x = JoinGroupWithAnswersResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinGroupWithAnswersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinGroupWithAnswersResp) ProtoMessage() {}
+
+func (x *JoinGroupWithAnswersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinGroupWithAnswersResp.ProtoReflect.Descriptor instead.
+func (*JoinGroupWithAnswersResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *JoinGroupWithAnswersResp) GetJoined() bool {
+	if x != nil {
+		return x.Joined
+	}
+	return false
+}
+
+type GroupApplicationAnswers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID    string             `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	UserID     string             `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	Answers    []*GroupJoinAnswer `protobuf:"bytes,3,rep,name=answers,proto3" json:"answers"`
+	CreateTime int64              `protobuf:"varint,4,opt,name=createTime,proto3" json:"createTime"`
+}
+
+func (x *GroupApplicationAnswers) Reset() {
+	*x = GroupApplicationAnswers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupApplicationAnswers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupApplicationAnswers) ProtoMessage() {}
+
+func (x *GroupApplicationAnswers) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupApplicationAnswers.ProtoReflect.Descriptor instead.
+func (*GroupApplicationAnswers) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GroupApplicationAnswers) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *GroupApplicationAnswers) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GroupApplicationAnswers) GetAnswers() []*GroupJoinAnswer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+func (x *GroupApplicationAnswers) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type GetGroupApplicationAnswersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID string   `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	UserIDs []string `protobuf:"bytes,2,rep,name=userIDs,proto3" json:"userIDs"`
+}
+
+func (x *GetGroupApplicationAnswersReq) Reset() {
+	*x = GetGroupApplicationAnswersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupApplicationAnswersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupApplicationAnswersReq) ProtoMessage() {}
+
+func (x *GetGroupApplicationAnswersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupApplicationAnswersReq.ProtoReflect.Descriptor instead.
+func (*GetGroupApplicationAnswersReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetGroupApplicationAnswersReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *GetGroupApplicationAnswersReq) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type GetGroupApplicationAnswersResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Applications []*GroupApplicationAnswers `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications"`
+}
+
+func (x *GetGroupApplicationAnswersResp) Reset() {
+	*x = GetGroupApplicationAnswersResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupApplicationAnswersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupApplicationAnswersResp) ProtoMessage() {}
+
+func (x *GetGroupApplicationAnswersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupApplicationAnswersResp.ProtoReflect.Descriptor instead.
+func (*GetGroupApplicationAnswersResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetGroupApplicationAnswersResp) GetApplications() []*GroupApplicationAnswers {
+	if x != nil {
+		return x.Applications
+	}
+	return nil
+}
+
+type BatchGroupApplicationResponseReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID      string   `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	FromUserIDs  []string `protobuf:"bytes,2,rep,name=fromUserIDs,proto3" json:"fromUserIDs"`
+	HandledMsg   string   `protobuf:"bytes,3,opt,name=handledMsg,proto3" json:"handledMsg"`
+	HandleResult int32    `protobuf:"varint,4,opt,name=handleResult,proto3" json:"handleResult"`
+}
+
+func (x *BatchGroupApplicationResponseReq) Reset() {
+	*x = BatchGroupApplicationResponseReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGroupApplicationResponseReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGroupApplicationResponseReq) ProtoMessage() {}
+
+func (x *BatchGroupApplicationResponseReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGroupApplicationResponseReq.ProtoReflect.Descriptor instead.
+func (*BatchGroupApplicationResponseReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *BatchGroupApplicationResponseReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *BatchGroupApplicationResponseReq) GetFromUserIDs() []string {
+	if x != nil {
+		return x.FromUserIDs
+	}
+	return nil
+}
+
+func (x *BatchGroupApplicationResponseReq) GetHandledMsg() string {
+	if x != nil {
+		return x.HandledMsg
+	}
+	return ""
+}
+
+func (x *BatchGroupApplicationResponseReq) GetHandleResult() int32 {
+	if x != nil {
+		return x.HandleResult
+	}
+	return 0
+}
+
+type BatchGroupApplicationResponseResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FailedUserIDs []string `protobuf:"bytes,1,rep,name=failedUserIDs,proto3" json:"failedUserIDs"`
+}
+
+func (x *BatchGroupApplicationResponseResp) Reset() {
+	*x = BatchGroupApplicationResponseResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGroupApplicationResponseResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGroupApplicationResponseResp) ProtoMessage() {}
+
+func (x *BatchGroupApplicationResponseResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGroupApplicationResponseResp.ProtoReflect.Descriptor instead.
+func (*BatchGroupApplicationResponseResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *BatchGroupApplicationResponseResp) GetFailedUserIDs() []string {
+	if x != nil {
+		return x.FailedUserIDs
+	}
+	return nil
+}
+
+type ExpireGroupApplicationsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ExpireGroupApplicationsReq) Reset() {
+	*x = ExpireGroupApplicationsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpireGroupApplicationsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpireGroupApplicationsReq) ProtoMessage() {}
+
+func (x *ExpireGroupApplicationsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpireGroupApplicationsReq.ProtoReflect.Descriptor instead.
+func (*ExpireGroupApplicationsReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{70}
+}
+
+type ExpireGroupApplicationsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+}
+
+func (x *ExpireGroupApplicationsResp) Reset() {
+	*x = ExpireGroupApplicationsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpireGroupApplicationsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpireGroupApplicationsResp) ProtoMessage() {}
+
+func (x *ExpireGroupApplicationsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpireGroupApplicationsResp.ProtoReflect.Descriptor instead.
+func (*ExpireGroupApplicationsResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ExpireGroupApplicationsResp) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GroupApplicationExpiredTips struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Group      *sdkws.GroupInfo `protobuf:"bytes,1,opt,name=group,proto3" json:"group"`
+	ReqMsg     string           `protobuf:"bytes,2,opt,name=reqMsg,proto3" json:"reqMsg"`
+	ReqTime    int64            `protobuf:"varint,3,opt,name=reqTime,proto3" json:"reqTime"`
+	ExpireTime int64            `protobuf:"varint,4,opt,name=expireTime,proto3" json:"expireTime"`
+}
+
+func (x *GroupApplicationExpiredTips) Reset() {
+	*x = GroupApplicationExpiredTips{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupApplicationExpiredTips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupApplicationExpiredTips) ProtoMessage() {}
+
+func (x *GroupApplicationExpiredTips) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupApplicationExpiredTips.ProtoReflect.Descriptor instead.
+func (*GroupApplicationExpiredTips) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GroupApplicationExpiredTips) GetGroup() *sdkws.GroupInfo {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *GroupApplicationExpiredTips) GetReqMsg() string {
+	if x != nil {
+		return x.ReqMsg
+	}
+	return ""
+}
+
+func (x *GroupApplicationExpiredTips) GetReqTime() int64 {
+	if x != nil {
+		return x.ReqTime
+	}
+	return 0
+}
+
+func (x *GroupApplicationExpiredTips) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+type GroupMemberTags struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Tags   []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags"`
+}
+
+func (x *GroupMemberTags) Reset() {
+	*x = GroupMemberTags{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupMemberTags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMemberTags) ProtoMessage() {}
+
+func (x *GroupMemberTags) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMemberTags.ProtoReflect.Descriptor instead.
+func (*GroupMemberTags) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GroupMemberTags) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GroupMemberTags) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type AddGroupMemberTagReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID string   `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	Tag     string   `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag"`
+	UserIDs []string `protobuf:"bytes,3,rep,name=userIDs,proto3" json:"userIDs"`
+}
+
+func (x *AddGroupMemberTagReq) Reset() {
+	*x = AddGroupMemberTagReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddGroupMemberTagReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddGroupMemberTagReq) ProtoMessage() {}
+
+func (x *AddGroupMemberTagReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddGroupMemberTagReq.ProtoReflect.Descriptor instead.
+func (*AddGroupMemberTagReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *AddGroupMemberTagReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *AddGroupMemberTagReq) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *AddGroupMemberTagReq) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type AddGroupMemberTagResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AddGroupMemberTagResp) Reset() {
+	*x = AddGroupMemberTagResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddGroupMemberTagResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddGroupMemberTagResp) ProtoMessage() {}
+
+func (x *AddGroupMemberTagResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddGroupMemberTagResp.ProtoReflect.Descriptor instead.
+func (*AddGroupMemberTagResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{75}
+}
+
+type RemoveGroupMemberTagReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID string   `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	Tag     string   `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag"`
+	UserIDs []string `protobuf:"bytes,3,rep,name=userIDs,proto3" json:"userIDs"`
+}
+
+func (x *RemoveGroupMemberTagReq) Reset() {
+	*x = RemoveGroupMemberTagReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveGroupMemberTagReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveGroupMemberTagReq) ProtoMessage() {}
+
+func (x *RemoveGroupMemberTagReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveGroupMemberTagReq.ProtoReflect.Descriptor instead.
+func (*RemoveGroupMemberTagReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *RemoveGroupMemberTagReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *RemoveGroupMemberTagReq) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *RemoveGroupMemberTagReq) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type RemoveGroupMemberTagResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveGroupMemberTagResp) Reset() {
+	*x = RemoveGroupMemberTagResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveGroupMemberTagResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveGroupMemberTagResp) ProtoMessage() {}
+
+func (x *RemoveGroupMemberTagResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveGroupMemberTagResp.ProtoReflect.Descriptor instead.
+func (*RemoveGroupMemberTagResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{77}
+}
+
+type GetGroupTagsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID string `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+}
+
+func (x *GetGroupTagsReq) Reset() {
+	*x = GetGroupTagsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupTagsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupTagsReq) ProtoMessage() {}
+
+func (x *GetGroupTagsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupTagsReq.ProtoReflect.Descriptor instead.
+func (*GetGroupTagsReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetGroupTagsReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+type GetGroupTagsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tags []string `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags"`
+}
+
+func (x *GetGroupTagsResp) Reset() {
+	*x = GetGroupTagsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupTagsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupTagsResp) ProtoMessage() {}
+
+func (x *GetGroupTagsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupTagsResp.ProtoReflect.Descriptor instead.
+func (*GetGroupTagsResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetGroupTagsResp) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type GetGroupMemberTagsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID string   `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	UserIDs []string `protobuf:"bytes,2,rep,name=userIDs,proto3" json:"userIDs"`
+}
+
+func (x *GetGroupMemberTagsReq) Reset() {
+	*x = GetGroupMemberTagsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupMemberTagsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupMemberTagsReq) ProtoMessage() {}
+
+func (x *GetGroupMemberTagsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupMemberTagsReq.ProtoReflect.Descriptor instead.
+func (*GetGroupMemberTagsReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetGroupMemberTagsReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *GetGroupMemberTagsReq) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type GetGroupMemberTagsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Members []*GroupMemberTags `protobuf:"bytes,1,rep,name=members,proto3" json:"members"`
+}
+
+func (x *GetGroupMemberTagsResp) Reset() {
+	*x = GetGroupMemberTagsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupMemberTagsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupMemberTagsResp) ProtoMessage() {}
+
+func (x *GetGroupMemberTagsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupMemberTagsResp.ProtoReflect.Descriptor instead.
+func (*GetGroupMemberTagsResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetGroupMemberTagsResp) GetMembers() []*GroupMemberTags {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type GetTaggedMemberUserIDsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID string   `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	Tags    []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags"`
+}
+
+func (x *GetTaggedMemberUserIDsReq) Reset() {
+	*x = GetTaggedMemberUserIDsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTaggedMemberUserIDsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaggedMemberUserIDsReq) ProtoMessage() {}
+
+func (x *GetTaggedMemberUserIDsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaggedMemberUserIDsReq.ProtoReflect.Descriptor instead.
+func (*GetTaggedMemberUserIDsReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetTaggedMemberUserIDsReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *GetTaggedMemberUserIDsReq) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type GetTaggedMemberUserIDsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
+}
+
+func (x *GetTaggedMemberUserIDsResp) Reset() {
+	*x = GetTaggedMemberUserIDsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTaggedMemberUserIDsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaggedMemberUserIDsResp) ProtoMessage() {}
+
+func (x *GetTaggedMemberUserIDsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaggedMemberUserIDsResp.ProtoReflect.Descriptor instead.
+func (*GetTaggedMemberUserIDsResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetTaggedMemberUserIDsResp) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type SetGroupQuotaReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID        string `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	MaxMemberCount int32  `protobuf:"varint,2,opt,name=maxMemberCount,proto3" json:"maxMemberCount"`
+}
+
+func (x *SetGroupQuotaReq) Reset() {
+	*x = SetGroupQuotaReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetGroupQuotaReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupQuotaReq) ProtoMessage() {}
+
+func (x *SetGroupQuotaReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupQuotaReq.ProtoReflect.Descriptor instead.
+func (*SetGroupQuotaReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *SetGroupQuotaReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *SetGroupQuotaReq) GetMaxMemberCount() int32 {
+	if x != nil {
+		return x.MaxMemberCount
+	}
+	return 0
+}
+
+type SetGroupQuotaResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetGroupQuotaResp) Reset() {
+	*x = SetGroupQuotaResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetGroupQuotaResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupQuotaResp) ProtoMessage() {}
+
+func (x *SetGroupQuotaResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupQuotaResp.ProtoReflect.Descriptor instead.
+func (*SetGroupQuotaResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{85}
+}
+
+type GetGroupQuotaReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID string `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+}
+
+func (x *GetGroupQuotaReq) Reset() {
+	*x = GetGroupQuotaReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupQuotaReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupQuotaReq) ProtoMessage() {}
+
+func (x *GetGroupQuotaReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupQuotaReq.ProtoReflect.Descriptor instead.
+func (*GetGroupQuotaReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GetGroupQuotaReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+type GetGroupQuotaResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxMemberCount int32  `protobuf:"varint,1,opt,name=maxMemberCount,proto3" json:"maxMemberCount"`
+	Custom         bool   `protobuf:"varint,2,opt,name=custom,proto3" json:"custom"`
+	MemberCount    uint32 `protobuf:"varint,3,opt,name=memberCount,proto3" json:"memberCount"`
+}
+
+func (x *GetGroupQuotaResp) Reset() {
+	*x = GetGroupQuotaResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupQuotaResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupQuotaResp) ProtoMessage() {}
+
+func (x *GetGroupQuotaResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupQuotaResp.ProtoReflect.Descriptor instead.
+func (*GetGroupQuotaResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GetGroupQuotaResp) GetMaxMemberCount() int32 {
+	if x != nil {
+		return x.MaxMemberCount
+	}
+	return 0
+}
+
+func (x *GetGroupQuotaResp) GetCustom() bool {
+	if x != nil {
+		return x.Custom
+	}
+	return false
+}
+
+func (x *GetGroupQuotaResp) GetMemberCount() uint32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+type GroupSuccession struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID         string `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	Policy          int32  `protobuf:"varint,2,opt,name=policy,proto3" json:"policy"`
+	SuccessorUserID string `protobuf:"bytes,3,opt,name=successorUserID,proto3" json:"successorUserID"`
+}
+
+func (x *GroupSuccession) Reset() {
+	*x = GroupSuccession{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupSuccession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupSuccession) ProtoMessage() {}
+
+func (x *GroupSuccession) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupSuccession.ProtoReflect.Descriptor instead.
+func (*GroupSuccession) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GroupSuccession) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *GroupSuccession) GetPolicy() int32 {
+	if x != nil {
+		return x.Policy
+	}
+	return 0
+}
+
+func (x *GroupSuccession) GetSuccessorUserID() string {
+	if x != nil {
+		return x.SuccessorUserID
+	}
+	return ""
+}
+
+type SetGroupSuccessionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Succession *GroupSuccession `protobuf:"bytes,1,opt,name=succession,proto3" json:"succession"`
+}
+
+func (x *SetGroupSuccessionReq) Reset() {
+	*x = SetGroupSuccessionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetGroupSuccessionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupSuccessionReq) ProtoMessage() {}
+
+func (x *SetGroupSuccessionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupSuccessionReq.ProtoReflect.Descriptor instead.
+func (*SetGroupSuccessionReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *SetGroupSuccessionReq) GetSuccession() *GroupSuccession {
+	if x != nil {
+		return x.Succession
+	}
+	return nil
+}
+
+type SetGroupSuccessionResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetGroupSuccessionResp) Reset() {
+	*x = SetGroupSuccessionResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetGroupSuccessionResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupSuccessionResp) ProtoMessage() {}
+
+func (x *SetGroupSuccessionResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupSuccessionResp.ProtoReflect.Descriptor instead.
+func (*SetGroupSuccessionResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{90}
+}
+
+type GetGroupSuccessionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID string `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+}
+
+func (x *GetGroupSuccessionReq) Reset() {
+	*x = GetGroupSuccessionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupSuccessionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupSuccessionReq) ProtoMessage() {}
+
+func (x *GetGroupSuccessionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupSuccessionReq.ProtoReflect.Descriptor instead.
+func (*GetGroupSuccessionReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *GetGroupSuccessionReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+type GetGroupSuccessionResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Succession      *GroupSuccession `protobuf:"bytes,1,opt,name=succession,proto3" json:"succession"`
+	SuccessorUserID string           `protobuf:"bytes,2,opt,name=successorUserID,proto3" json:"successorUserID"`
+}
+
+func (x *GetGroupSuccessionResp) Reset() {
+	*x = GetGroupSuccessionResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupSuccessionResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupSuccessionResp) ProtoMessage() {}
+
+func (x *GetGroupSuccessionResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupSuccessionResp.ProtoReflect.Descriptor instead.
+func (*GetGroupSuccessionResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *GetGroupSuccessionResp) GetSuccession() *GroupSuccession {
+	if x != nil {
+		return x.Succession
+	}
+	return nil
+}
+
+func (x *GetGroupSuccessionResp) GetSuccessorUserID() string {
+	if x != nil {
+		return x.SuccessorUserID
+	}
+	return ""
+}
+
+type QuitGroupWithSuccessionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID string `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	UserID  string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+}
+
+func (x *QuitGroupWithSuccessionReq) Reset() {
+	*x = QuitGroupWithSuccessionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuitGroupWithSuccessionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuitGroupWithSuccessionReq) ProtoMessage() {}
+
+func (x *QuitGroupWithSuccessionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuitGroupWithSuccessionReq.ProtoReflect.Descriptor instead.
+func (*QuitGroupWithSuccessionReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *QuitGroupWithSuccessionReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *QuitGroupWithSuccessionReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type QuitGroupWithSuccessionResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NewOwnerUserID string `protobuf:"bytes,1,opt,name=newOwnerUserID,proto3" json:"newOwnerUserID"`
+	Dismissed      bool   `protobuf:"varint,2,opt,name=dismissed,proto3" json:"dismissed"`
+}
+
+func (x *QuitGroupWithSuccessionResp) Reset() {
+	*x = QuitGroupWithSuccessionResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuitGroupWithSuccessionResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuitGroupWithSuccessionResp) ProtoMessage() {}
+
+func (x *QuitGroupWithSuccessionResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuitGroupWithSuccessionResp.ProtoReflect.Descriptor instead.
+func (*QuitGroupWithSuccessionResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *QuitGroupWithSuccessionResp) GetNewOwnerUserID() string {
+	if x != nil {
+		return x.NewOwnerUserID
+	}
+	return ""
+}
+
+func (x *QuitGroupWithSuccessionResp) GetDismissed() bool {
+	if x != nil {
+		return x.Dismissed
+	}
+	return false
+}
+
+type HandOverOwnedGroupsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+}
+
+func (x *HandOverOwnedGroupsReq) Reset() {
+	*x = HandOverOwnedGroupsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HandOverOwnedGroupsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandOverOwnedGroupsReq) ProtoMessage() {}
+
+func (x *HandOverOwnedGroupsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandOverOwnedGroupsReq.ProtoReflect.Descriptor instead.
+func (*HandOverOwnedGroupsReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *HandOverOwnedGroupsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type HandOverOwnedGroupsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transferred int64 `protobuf:"varint,1,opt,name=transferred,proto3" json:"transferred"`
+	Dismissed   int64 `protobuf:"varint,2,opt,name=dismissed,proto3" json:"dismissed"`
+}
+
+func (x *HandOverOwnedGroupsResp) Reset() {
+	*x = HandOverOwnedGroupsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HandOverOwnedGroupsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandOverOwnedGroupsResp) ProtoMessage() {}
+
+func (x *HandOverOwnedGroupsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandOverOwnedGroupsResp.ProtoReflect.Descriptor instead.
+func (*HandOverOwnedGroupsResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *HandOverOwnedGroupsResp) GetTransferred() int64 {
+	if x != nil {
+		return x.Transferred
+	}
+	return 0
+}
+
+func (x *HandOverOwnedGroupsResp) GetDismissed() int64 {
+	if x != nil {
+		return x.Dismissed
+	}
+	return 0
+}
+
+type SucceedInactiveGroupOwnersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SucceedInactiveGroupOwnersReq) Reset() {
+	*x = SucceedInactiveGroupOwnersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SucceedInactiveGroupOwnersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SucceedInactiveGroupOwnersReq) ProtoMessage() {}
+
+func (x *SucceedInactiveGroupOwnersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SucceedInactiveGroupOwnersReq.ProtoReflect.Descriptor instead.
+func (*SucceedInactiveGroupOwnersReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{97}
+}
+
+type SucceedInactiveGroupOwnersResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transferred int64 `protobuf:"varint,1,opt,name=transferred,proto3" json:"transferred"`
+	Dismissed   int64 `protobuf:"varint,2,opt,name=dismissed,proto3" json:"dismissed"`
+}
+
+func (x *SucceedInactiveGroupOwnersResp) Reset() {
+	*x = SucceedInactiveGroupOwnersResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SucceedInactiveGroupOwnersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SucceedInactiveGroupOwnersResp) ProtoMessage() {}
+
+func (x *SucceedInactiveGroupOwnersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SucceedInactiveGroupOwnersResp.ProtoReflect.Descriptor instead.
+func (*SucceedInactiveGroupOwnersResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *SucceedInactiveGroupOwnersResp) GetTransferred() int64 {
+	if x != nil {
+		return x.Transferred
+	}
+	return 0
+}
+
+func (x *SucceedInactiveGroupOwnersResp) GetDismissed() int64 {
+	if x != nil {
+		return x.Dismissed
+	}
+	return 0
+}
+
+var File_groupext_groupext_proto protoreflect.FileDescriptor
+
+var file_groupext_groupext_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x1a, 0x11, 0x73, 0x64, 0x6b, 0x77,
+	0x73, 0x2f, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x77,
+	0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x70, 0x62, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65,
+	0x72, 0x73, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x89, 0x01, 0x0a, 0x09, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6c, 0x65,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x65, 0x78, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x74, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0e, 0x0a, 0x02,
+	0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x65, 0x78, 0x22, 0x45, 0x0a, 0x13,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x2e, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x65, 0x78, 0x74, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72,
+	0x6f, 0x6c, 0x65, 0x22, 0xe5, 0x01, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x12, 0x30, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3d,
+	0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x0a,
+	0x02, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x65, 0x78, 0x22, 0x15, 0x0a, 0x13, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x46, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x22, 0x15, 0x0a, 0x13, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x2c, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x22,
+	0x45, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x30, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x52,
+	0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0x63, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12,
+	0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x22, 0x18, 0x0a, 0x16, 0x53,
+	0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x87, 0x01, 0x0a, 0x15, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x6f, 0x6c, 0x65, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x6f, 0x6c, 0x65,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x12, 0x20, 0x0a,
+	0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x52, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x73, 0x22, 0x61, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x40, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0xf6, 0x01, 0x0a, 0x14, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x6f, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x54, 0x69, 0x70, 0x73, 0x12,
+	0x2d, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x39,
+	0x0a, 0x06, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x06, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0xc5, 0x02, 0x0a, 0x0f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c,
+	0x69, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x78,
+	0x55, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x55,
+	0x73, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x75, 0x73, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x12, 0x1e, 0x0a,
+	0x0a, 0x6a, 0x6f, 0x69, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x6a, 0x6f, 0x69, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x65, 0x78, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x65, 0x78, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xb7, 0x01, 0x0a, 0x15, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x6a, 0x6f, 0x69,
+	0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6a,
+	0x6f, 0x69, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0xba, 0x01, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x18,
+	0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x55,
+	0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x55, 0x73,
+	0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x12, 0x1e,
+	0x0a, 0x0a, 0x6a, 0x6f, 0x69, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x6a, 0x6f, 0x69, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x65, 0x78, 0x22, 0x51,
+	0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x12, 0x34, 0x0a, 0x04, 0x6c,
+	0x69, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x04, 0x6c, 0x69, 0x6e,
+	0x6b, 0x22, 0x73, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x3f, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x67, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x36, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x22,
+	0x4a, 0x0a, 0x18, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x1b, 0x0a, 0x19, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x22, 0x8f, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x64, 0x65, 0x6d,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3f, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x7f, 0x0a, 0x1d, 0x47, 0x65,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x64, 0x65,
+	0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x48, 0x0a, 0x0b, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b,
+	0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x98, 0x01, 0x0a, 0x14,
+	0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65,
+	0x71, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x72, 0x65, 0x71, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x65, 0x78,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x65, 0x78, 0x12, 0x3a, 0x0a, 0x07, 0x61, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x4a, 0x6f, 0x69, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x07, 0x61,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x22, 0x49, 0x0a, 0x15, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x42, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x22, 0x82, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x18,
+	0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x72,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72, 0x64, 0x12, 0x20,
+	0x0a, 0x0b, 0x61, 0x66, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x66, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x35, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0xa3, 0x02,
+	0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49,
+	0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x12,
+	0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x30, 0x0a, 0x13, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x13, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x44, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x44, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x65, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x65, 0x78, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x22, 0xab, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74,
+	0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75,
+	0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f,
+	0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x6f, 0x6c, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x6f, 0x6c, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0xe2, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x75, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66,
+	0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e,
+	0x74, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x65, 0x78,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x65, 0x78, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0c, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73,
+	0x12, 0x24, 0x0a, 0x0d, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x53, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3c, 0x0a,
+	0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65,
+	0x78, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x22, 0x91, 0x02, 0x0a, 0x13,
+	0x53, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e,
+	0x69, 0x74, 0x79, 0x49, 0x44, 0x12, 0x30, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55,
+	0x52, 0x4c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x12,
+	0x40, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x2c, 0x0a, 0x02, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x65, 0x78, 0x22,
+	0x16, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x22, 0x37, 0x0a, 0x13, 0x44, 0x69, 0x73, 0x6d, 0x69,
+	0x73, 0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x12, 0x20,
+	0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44,
+	0x22, 0x16, 0x0a, 0x14, 0x44, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x75,
+	0x6e, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x3b, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x71, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69,
+	0x74, 0x79, 0x49, 0x44, 0x73, 0x22, 0x5a, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x40, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x22, 0x52, 0x0a, 0x14, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x43, 0x6f, 0x6d,
+	0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d,
+	0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54,
+	0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x54,
+	0x0a, 0x16, 0x4b, 0x69, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d,
+	0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
+	0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x4b, 0x69, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x6d,
+	0x75, 0x6e, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x4c, 0x0a, 0x10, 0x51, 0x75, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e,
+	0x69, 0x74, 0x79, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x13, 0x0a,
+	0x11, 0x51, 0x75, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x73, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69,
+	0x74, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12,
+	0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x6f, 0x6c,
+	0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x6f,
+	0x6c, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x1c, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x43, 0x6f,
+	0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x7b, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x75, 0x6e, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49,
+	0x44, 0x12, 0x3f, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73,
+	0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x6b, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69,
+	0x74, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x12, 0x3a, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22,
+	0x55, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d,
+	0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
+	0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d,
+	0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x58, 0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e,
+	0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x12, 0x1a,
+	0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x22, 0x1b, 0x0a, 0x19, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x70, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x49, 0x6e,
+	0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xf5, 0x01, 0x0a, 0x1f, 0x47, 0x65,
+	0x74, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x4a, 0x6f, 0x69, 0x6e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1c, 0x0a,
+	0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x75, 0x6c, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x04, 0x66, 0x75, 0x6c, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x12, 0x36, 0x0a, 0x06, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x65, 0x78, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x06, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x12, 0x36, 0x0a, 0x06, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x22, 0x4c, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x46, 0x75, 0x6c, 0x6c, 0x4a, 0x6f, 0x69, 0x6e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x64, 0x48, 0x61, 0x73,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x69, 0x64, 0x48, 0x61, 0x73, 0x68, 0x22,
+	0x8f, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x46, 0x75, 0x6c, 0x6c, 0x4a, 0x6f, 0x69, 0x6e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x1c, 0x0a, 0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x71, 0x75, 0x61, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x12, 0x22, 0x0a,
+	0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44,
+	0x73, 0x22, 0x6b, 0x0a, 0x11, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4a, 0x6f, 0x69, 0x6e, 0x51, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x65,
+	0x0a, 0x0f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4a, 0x6f, 0x69, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65,
+	0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x76, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x4a, 0x6f, 0x69, 0x6e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x40, 0x0a, 0x09, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74,
+	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4a, 0x6f, 0x69, 0x6e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x1b, 0x0a,
+	0x19, 0x53, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4a, 0x6f, 0x69, 0x6e, 0x51, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x34, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4a, 0x6f, 0x69, 0x6e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44,
+	0x22, 0x5d, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4a, 0x6f, 0x69, 0x6e,
+	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x40, 0x0a,
+	0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65,
+	0x78, 0x74, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4a, 0x6f, 0x69, 0x6e, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0xe5, 0x01, 0x0a, 0x17, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x69, 0x74,
+	0x68, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6a, 0x6f, 0x69, 0x6e, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6a, 0x6f, 0x69, 0x6e, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x65,
+	0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x65, 0x78, 0x12, 0x3a, 0x0a, 0x07, 0x61,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x4a, 0x6f, 0x69, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x07,
+	0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x22, 0x32, 0x0a, 0x18, 0x4a, 0x6f, 0x69, 0x6e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x57, 0x69, 0x74, 0x68, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x22, 0xa7, 0x01, 0x0a, 0x17,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x3a, 0x0a, 0x07, 0x61, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x4a, 0x6f, 0x69, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x07, 0x61, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x53, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44,
+	0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x6e, 0x0a, 0x1e, 0x47, 0x65,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4c, 0x0a, 0x0c,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x52, 0x0c, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xa2, 0x01, 0x0a, 0x20, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x71, 0x12,
+	0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x72, 0x6f,
+	0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
+	0x66, 0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x68,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x22, 0x0a, 0x0c, 0x68,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0c, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0x49, 0x0a, 0x21, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x1c, 0x0a, 0x1a, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x22, 0x33, 0x0a, 0x1b, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9e, 0x01,
+	0x0a, 0x1b, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x54, 0x69, 0x70, 0x73, 0x12, 0x2d, 0x0a,
+	0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x71, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x71, 0x4d, 0x73, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x65, 0x71, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x3d,
+	0x0a, 0x0f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x54, 0x61, 0x67,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x5c, 0x0a,
+	0x14, 0x41, 0x64, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x54,
+	0x61, 0x67, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12,
+	0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61,
+	0x67, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x41,
+	0x64, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x54, 0x61, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x5f, 0x0a, 0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x12,
+	0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x2b, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x61, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x22, 0x26,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x4b, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x73, 0x22, 0x54, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a,
+	0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74,
+	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x54, 0x61, 0x67, 0x73,
+	0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x49, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x54, 0x61, 0x67, 0x67, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x22, 0x36, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67, 0x67, 0x65,
+	0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x54, 0x0a, 0x10,
+	0x53, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71,
+	0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x61,
+	0x78, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x13, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x51, 0x75,
+	0x6f, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x22, 0x2c, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x44, 0x22, 0x75, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x61,
+	0x78, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x6d, 0x0a, 0x0f,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x59, 0x0a, 0x15, 0x53,
+	0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x12, 0x40, 0x0a, 0x0a, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x18, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x31, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x44, 0x22, 0x84, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x40,
+	0x0a, 0x0a, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x28, 0x0a, 0x0f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x4e, 0x0a, 0x1a, 0x51, 0x75,
+	0x69, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x69, 0x74, 0x68, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x63, 0x0a, 0x1b, 0x51, 0x75,
+	0x69, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x69, 0x74, 0x68, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x26, 0x0a, 0x0e, 0x6e, 0x65, 0x77,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x6e, 0x65, 0x77, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x64, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x22,
+	0x30, 0x0a, 0x16, 0x48, 0x61, 0x6e, 0x64, 0x4f, 0x76, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x64,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x22, 0x59, 0x0a, 0x17, 0x48, 0x61, 0x6e, 0x64, 0x4f, 0x76, 0x65, 0x72, 0x4f, 0x77, 0x6e,
+	0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x20, 0x0a, 0x0b,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x64, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x64, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x22, 0x1f, 0x0a, 0x1d,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x49, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x22, 0x60, 0x0a,
+	0x1e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x49, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x32,
+	0xfb, 0x23, 0x0a, 0x08, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x78, 0x74, 0x12, 0x5c, 0x0a, 0x0f,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x12,
+	0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78,
+	0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5c, 0x0a, 0x0f, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x23, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x1a, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x65, 0x78, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5c, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x23, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x1a, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65,
+	0x78, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x56, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x65,
+	0x0a, 0x12, 0x53, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x52, 0x6f, 0x6c, 0x65, 0x12, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x53,
+	0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x7a, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x2d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x2e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x6e, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x29, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69,
+	0x6e, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x68, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6e, 0x0a, 0x15, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x29, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x1a,
+	0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78,
+	0x74, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x12, 0x7a, 0x0a, 0x19, 0x47,
+	0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x64,
+	0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x62, 0x0a, 0x11, 0x4a, 0x6f, 0x69, 0x6e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x25, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x4a,
+	0x6f, 0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42,
+	0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6e, 0x0a, 0x15, 0x47,
+	0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x72, 0x73, 0x12, 0x29, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78,
+	0x74, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5c, 0x0a, 0x0f, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x12, 0x23,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x75, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5f, 0x0a, 0x10, 0x53, 0x65, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e,
+	0x53, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69,
+	0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5f, 0x0a, 0x10, 0x44, 0x69,
+	0x73, 0x6d, 0x69, 0x73, 0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x12, 0x24,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74,
+	0x2e, 0x44, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x44, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x43, 0x6f,
+	0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x65, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x62, 0x0a, 0x11, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x43, 0x6f,
+	0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x12, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x54, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x26,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74,
+	0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x68, 0x0a, 0x13, 0x4b, 0x69, 0x63, 0x6b, 0x43, 0x6f,
+	0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x27, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e,
+	0x4b, 0x69, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x4b, 0x69, 0x63, 0x6b, 0x43, 0x6f, 0x6d,
+	0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x56, 0x0a, 0x0d, 0x51, 0x75, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74,
+	0x79, 0x12, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x65, 0x78, 0x74, 0x2e, 0x51, 0x75, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x51, 0x75, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75,
+	0x6e, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x71, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x6f,
+	0x6c, 0x65, 0x12, 0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74,
+	0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x2b,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74,
+	0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x68, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x12, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74,
+	0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x65, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d,
+	0x75, 0x6e, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x26, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x41, 0x64,
+	0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69,
+	0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6e, 0x0a, 0x15,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x29, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f,
+	0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71,
+	0x1a, 0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65,
+	0x78, 0x74, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69,
+	0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x80, 0x01, 0x0a,
+	0x1b, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x4a,
+	0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x12, 0x2f, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x47,
+	0x65, 0x74, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x4a, 0x6f, 0x69,
+	0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x30, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e,
+	0x47, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x4a, 0x6f,
+	0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x74, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x46, 0x75, 0x6c, 0x6c, 0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x73, 0x12, 0x2b, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74,
+	0x46, 0x75, 0x6c, 0x6c, 0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74,
+	0x79, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x75, 0x6c,
+	0x6c, 0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6e, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x4a, 0x6f, 0x69, 0x6e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74,
+	0x2e, 0x53, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4a, 0x6f, 0x69, 0x6e, 0x51, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x4a, 0x6f, 0x69, 0x6e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6e, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x4a, 0x6f, 0x69, 0x6e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74,
+	0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4a, 0x6f, 0x69, 0x6e, 0x51, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x4a, 0x6f, 0x69, 0x6e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6b, 0x0a, 0x14, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x57, 0x69, 0x74, 0x68, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x28, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e,
+	0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x69, 0x74, 0x68, 0x41, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x57, 0x69, 0x74, 0x68, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x7d, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73,
+	0x12, 0x2e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65,
+	0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x1a, 0x2f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65,
+	0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x86, 0x01, 0x0a, 0x1d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x31, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x32, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x74, 0x0a, 0x17, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x1a, 0x2c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x65, 0x78, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x62, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x54, 0x61, 0x67, 0x12, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x41,
+	0x64, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x54, 0x61, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x6b, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x54, 0x61, 0x67, 0x12, 0x28, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x53, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x61, 0x67,
+	0x73, 0x12, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x61, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x61,
+	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x65, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x54, 0x61, 0x67, 0x73, 0x12, 0x26, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x47,
+	0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x54, 0x61, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x71, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67, 0x67, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x12, 0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67,
+	0x67, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67, 0x67, 0x65, 0x64, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x56, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x51, 0x75, 0x6f, 0x74,
+	0x61, 0x12, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x51, 0x75, 0x6f, 0x74,
+	0x61, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x51,
+	0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x12, 0x56, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x47,
+	0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x65, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x27,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74,
+	0x2e, 0x53, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x65, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e,
+	0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x74,
+	0x0a, 0x17, 0x51, 0x75, 0x69, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x69, 0x74, 0x68, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x51, 0x75, 0x69, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x69, 0x74, 0x68, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x2c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x51, 0x75, 0x69, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x57, 0x69, 0x74, 0x68, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x68, 0x0a, 0x13, 0x48, 0x61, 0x6e, 0x64, 0x4f, 0x76, 0x65, 0x72,
+	0x4f, 0x77, 0x6e, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x27, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x48, 0x61,
+	0x6e, 0x64, 0x4f, 0x76, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x4f, 0x76, 0x65, 0x72, 0x4f,
+	0x77, 0x6e, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x7d,
+	0x0a, 0x1a, 0x53, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x49, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x2e, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x49, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2f, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x49, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x42, 0x3e, 0x5a,
+	0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x73, 0x64, 0x6b, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x2d, 0x69, 0x6d, 0x2d, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_groupext_groupext_proto_rawDescOnce sync.Once
+	file_groupext_groupext_proto_rawDescData = file_groupext_groupext_proto_rawDesc
+)
+
+func file_groupext_groupext_proto_rawDescGZIP() []byte {
+	file_groupext_groupext_proto_rawDescOnce.Do(func() {
+		file_groupext_groupext_proto_rawDescData = protoimpl.X.CompressGZIP(file_groupext_groupext_proto_rawDescData)
+	})
+	return file_groupext_groupext_proto_rawDescData
+}
+
+var file_groupext_groupext_proto_msgTypes = make([]protoimpl.MessageInfo, 99)
+var file_groupext_groupext_proto_goTypes = []interface{}{
+	(*GroupRole)(nil),                         // 0: openim.groupext.GroupRole
+	(*CreateGroupRoleReq)(nil),                // 1: openim.groupext.CreateGroupRoleReq
+	(*CreateGroupRoleResp)(nil),               // 2: openim.groupext.CreateGroupRoleResp
+	(*UpdateGroupRoleReq)(nil),                // 3: openim.groupext.UpdateGroupRoleReq
+	(*UpdateGroupRoleResp)(nil),               // 4: openim.groupext.UpdateGroupRoleResp
+	(*DeleteGroupRoleReq)(nil),                // 5: openim.groupext.DeleteGroupRoleReq
+	(*DeleteGroupRoleResp)(nil),               // 6: openim.groupext.DeleteGroupRoleResp
+	(*GetGroupRolesReq)(nil),                  // 7: openim.groupext.GetGroupRolesReq
+	(*GetGroupRolesResp)(nil),                 // 8: openim.groupext.GetGroupRolesResp
+	(*SetGroupMemberRoleReq)(nil),             // 9: openim.groupext.SetGroupMemberRoleReq
+	(*SetGroupMemberRoleResp)(nil),            // 10: openim.groupext.SetGroupMemberRoleResp
+	(*GroupMemberPermission)(nil),             // 11: openim.groupext.GroupMemberPermission
+	(*GetGroupMemberPermissionsReq)(nil),      // 12: openim.groupext.GetGroupMemberPermissionsReq
+	(*GetGroupMemberPermissionsResp)(nil),     // 13: openim.groupext.GetGroupMemberPermissionsResp
+	(*GroupRoleChangedTips)(nil),              // 14: openim.groupext.GroupRoleChangedTips
+	(*GroupInviteLink)(nil),                   // 15: openim.groupext.GroupInviteLink
+	(*GroupInviteRedemption)(nil),             // 16: openim.groupext.GroupInviteRedemption
+	(*CreateGroupInviteLinkReq)(nil),          // 17: openim.groupext.CreateGroupInviteLinkReq
+	(*CreateGroupInviteLinkResp)(nil),         // 18: openim.groupext.CreateGroupInviteLinkResp
+	(*GetGroupInviteLinksReq)(nil),            // 19: openim.groupext.GetGroupInviteLinksReq
+	(*GetGroupInviteLinksResp)(nil),           // 20: openim.groupext.GetGroupInviteLinksResp
+	(*RevokeGroupInviteLinkReq)(nil),          // 21: openim.groupext.RevokeGroupInviteLinkReq
+	(*RevokeGroupInviteLinkResp)(nil),         // 22: openim.groupext.RevokeGroupInviteLinkResp
+	(*GetGroupInviteRedemptionsReq)(nil),      // 23: openim.groupext.GetGroupInviteRedemptionsReq
+	(*GetGroupInviteRedemptionsResp)(nil),     // 24: openim.groupext.GetGroupInviteRedemptionsResp
+	(*JoinGroupByInviteReq)(nil),              // 25: openim.groupext.JoinGroupByInviteReq
+	(*JoinGroupByInviteResp)(nil),             // 26: openim.groupext.JoinGroupByInviteResp
+	(*GetChannelSubscribersReq)(nil),          // 27: openim.groupext.GetChannelSubscribersReq
+	(*GetChannelSubscribersResp)(nil),         // 28: openim.groupext.GetChannelSubscribersResp
+	(*CommunityInfo)(nil),                     // 29: openim.groupext.CommunityInfo
+	(*CommunityMember)(nil),                   // 30: openim.groupext.CommunityMember
+	(*CreateCommunityReq)(nil),                // 31: openim.groupext.CreateCommunityReq
+	(*CreateCommunityResp)(nil),               // 32: openim.groupext.CreateCommunityResp
+	(*SetCommunityInfoReq)(nil),               // 33: openim.groupext.SetCommunityInfoReq
+	(*SetCommunityInfoResp)(nil),              // 34: openim.groupext.SetCommunityInfoResp
+	(*DismissCommunityReq)(nil),               // 35: openim.groupext.DismissCommunityReq
+	(*DismissCommunityResp)(nil),              // 36: openim.groupext.DismissCommunityResp
+	(*GetCommunitiesInfoReq)(nil),             // 37: openim.groupext.GetCommunitiesInfoReq
+	(*GetCommunitiesInfoResp)(nil),            // 38: openim.groupext.GetCommunitiesInfoResp
+	(*InviteToCommunityReq)(nil),              // 39: openim.groupext.InviteToCommunityReq
+	(*InviteToCommunityResp)(nil),             // 40: openim.groupext.InviteToCommunityResp
+	(*KickCommunityMemberReq)(nil),            // 41: openim.groupext.KickCommunityMemberReq
+	(*KickCommunityMemberResp)(nil),           // 42: openim.groupext.KickCommunityMemberResp
+	(*QuitCommunityReq)(nil),                  // 43: openim.groupext.QuitCommunityReq
+	(*QuitCommunityResp)(nil),                 // 44: openim.groupext.QuitCommunityResp
+	(*SetCommunityMemberRoleReq)(nil),         // 45: openim.groupext.SetCommunityMemberRoleReq
+	(*SetCommunityMemberRoleResp)(nil),        // 46: openim.groupext.SetCommunityMemberRoleResp
+	(*GetCommunityMembersReq)(nil),            // 47: openim.groupext.GetCommunityMembersReq
+	(*GetCommunityMembersResp)(nil),           // 48: openim.groupext.GetCommunityMembersResp
+	(*AddCommunityGroupsReq)(nil),             // 49: openim.groupext.AddCommunityGroupsReq
+	(*AddCommunityGroupsResp)(nil),            // 50: openim.groupext.AddCommunityGroupsResp
+	(*RemoveCommunityGroupsReq)(nil),          // 51: openim.groupext.RemoveCommunityGroupsReq
+	(*RemoveCommunityGroupsResp)(nil),         // 52: openim.groupext.RemoveCommunityGroupsResp
+	(*GetIncrementalJoinCommunityReq)(nil),    // 53: openim.groupext.GetIncrementalJoinCommunityReq
+	(*GetIncrementalJoinCommunityResp)(nil),   // 54: openim.groupext.GetIncrementalJoinCommunityResp
+	(*GetFullJoinCommunityIDsReq)(nil),        // 55: openim.groupext.GetFullJoinCommunityIDsReq
+	(*GetFullJoinCommunityIDsResp)(nil),       // 56: openim.groupext.GetFullJoinCommunityIDsResp
+	(*GroupJoinQuestion)(nil),                 // 57: openim.groupext.GroupJoinQuestion
+	(*GroupJoinAnswer)(nil),                   // 58: openim.groupext.GroupJoinAnswer
+	(*SetGroupJoinQuestionsReq)(nil),          // 59: openim.groupext.SetGroupJoinQuestionsReq
+	(*SetGroupJoinQuestionsResp)(nil),         // 60: openim.groupext.SetGroupJoinQuestionsResp
+	(*GetGroupJoinQuestionsReq)(nil),          // 61: openim.groupext.GetGroupJoinQuestionsReq
+	(*GetGroupJoinQuestionsResp)(nil),         // 62: openim.groupext.GetGroupJoinQuestionsResp
+	(*JoinGroupWithAnswersReq)(nil),           // 63: openim.groupext.JoinGroupWithAnswersReq
+	(*JoinGroupWithAnswersResp)(nil),          // 64: openim.groupext.JoinGroupWithAnswersResp
+	(*GroupApplicationAnswers)(nil),           // 65: openim.groupext.GroupApplicationAnswers
+	(*GetGroupApplicationAnswersReq)(nil),     // 66: openim.groupext.GetGroupApplicationAnswersReq
+	(*GetGroupApplicationAnswersResp)(nil),    // 67: openim.groupext.GetGroupApplicationAnswersResp
+	(*BatchGroupApplicationResponseReq)(nil),  // 68: openim.groupext.BatchGroupApplicationResponseReq
+	(*BatchGroupApplicationResponseResp)(nil), // 69: openim.groupext.BatchGroupApplicationResponseResp
+	(*ExpireGroupApplicationsReq)(nil),        // 70: openim.groupext.ExpireGroupApplicationsReq
+	(*ExpireGroupApplicationsResp)(nil),       // 71: openim.groupext.ExpireGroupApplicationsResp
+	(*GroupApplicationExpiredTips)(nil),       // 72: openim.groupext.GroupApplicationExpiredTips
+	(*GroupMemberTags)(nil),                   // 73: openim.groupext.GroupMemberTags
+	(*AddGroupMemberTagReq)(nil),              // 74: openim.groupext.AddGroupMemberTagReq
+	(*AddGroupMemberTagResp)(nil),             // 75: openim.groupext.AddGroupMemberTagResp
+	(*RemoveGroupMemberTagReq)(nil),           // 76: openim.groupext.RemoveGroupMemberTagReq
+	(*RemoveGroupMemberTagResp)(nil),          // 77: openim.groupext.RemoveGroupMemberTagResp
+	(*GetGroupTagsReq)(nil),                   // 78: openim.groupext.GetGroupTagsReq
+	(*GetGroupTagsResp)(nil),                  // 79: openim.groupext.GetGroupTagsResp
+	(*GetGroupMemberTagsReq)(nil),             // 80: openim.groupext.GetGroupMemberTagsReq
+	(*GetGroupMemberTagsResp)(nil),            // 81: openim.groupext.GetGroupMemberTagsResp
+	(*GetTaggedMemberUserIDsReq)(nil),         // 82: openim.groupext.GetTaggedMemberUserIDsReq
+	(*GetTaggedMemberUserIDsResp)(nil),        // 83: openim.groupext.GetTaggedMemberUserIDsResp
+	(*SetGroupQuotaReq)(nil),                  // 84: openim.groupext.SetGroupQuotaReq
+	(*SetGroupQuotaResp)(nil),                 // 85: openim.groupext.SetGroupQuotaResp
+	(*GetGroupQuotaReq)(nil),                  // 86: openim.groupext.GetGroupQuotaReq
+	(*GetGroupQuotaResp)(nil),                 // 87: openim.groupext.GetGroupQuotaResp
+	(*GroupSuccession)(nil),                   // 88: openim.groupext.GroupSuccession
+	(*SetGroupSuccessionReq)(nil),             // 89: openim.groupext.SetGroupSuccessionReq
+	(*SetGroupSuccessionResp)(nil),            // 90: openim.groupext.SetGroupSuccessionResp
+	(*GetGroupSuccessionReq)(nil),             // 91: openim.groupext.GetGroupSuccessionReq
+	(*GetGroupSuccessionResp)(nil),            // 92: openim.groupext.GetGroupSuccessionResp
+	(*QuitGroupWithSuccessionReq)(nil),        // 93: openim.groupext.QuitGroupWithSuccessionReq
+	(*QuitGroupWithSuccessionResp)(nil),       // 94: openim.groupext.QuitGroupWithSuccessionResp
+	(*HandOverOwnedGroupsReq)(nil),            // 95: openim.groupext.HandOverOwnedGroupsReq
+	(*HandOverOwnedGroupsResp)(nil),           // 96: openim.groupext.HandOverOwnedGroupsResp
+	(*SucceedInactiveGroupOwnersReq)(nil),     // 97: openim.groupext.SucceedInactiveGroupOwnersReq
+	(*SucceedInactiveGroupOwnersResp)(nil),    // 98: openim.groupext.SucceedInactiveGroupOwnersResp
+	(*wrapperspb.StringValue)(nil),            // 99: openim.protobuf.StringValue
+	(*wrapperspb.Int64Value)(nil),             // 100: openim.protobuf.Int64Value
+	(*sdkws.GroupInfo)(nil),                   // 101: openim.sdkws.GroupInfo
+	(*sdkws.GroupMemberFullInfo)(nil),         // 102: openim.sdkws.GroupMemberFullInfo
+	(*sdkws.RequestPagination)(nil),           // 103: openim.sdkws.RequestPagination
+}
+var file_groupext_groupext_proto_depIdxs = []int32{
+	0,   // 0: openim.groupext.CreateGroupRoleResp.role:type_name -> openim.groupext.GroupRole
+	99,  // 1: openim.groupext.UpdateGroupRoleReq.name:type_name -> openim.protobuf.StringValue
+	100, // 2: openim.groupext.UpdateGroupRoleReq.permissions:type_name -> openim.protobuf.Int64Value
+	99,  // 3: openim.groupext.UpdateGroupRoleReq.ex:type_name -> openim.protobuf.StringValue
+	0,   // 4: openim.groupext.GetGroupRolesResp.roles:type_name -> openim.groupext.GroupRole
+	11,  // 5: openim.groupext.GetGroupMemberPermissionsResp.members:type_name -> openim.groupext.GroupMemberPermission
+	101, // 6: openim.groupext.GroupRoleChangedTips.group:type_name -> openim.sdkws.GroupInfo
+	102, // 7: openim.groupext.GroupRoleChangedTips.opUser:type_name -> openim.sdkws.GroupMemberFullInfo
+	0,   // 8: openim.groupext.GroupRoleChangedTips.role:type_name -> openim.groupext.GroupRole
+	15,  // 9: openim.groupext.CreateGroupInviteLinkResp.link:type_name -> openim.groupext.GroupInviteLink
+	103, // 10: openim.groupext.GetGroupInviteLinksReq.pagination:type_name -> openim.sdkws.RequestPagination
+	15,  // 11: openim.groupext.GetGroupInviteLinksResp.links:type_name -> openim.groupext.GroupInviteLink
+	103, // 12: openim.groupext.GetGroupInviteRedemptionsReq.pagination:type_name -> openim.sdkws.RequestPagination
+	16,  // 13: openim.groupext.GetGroupInviteRedemptionsResp.redemptions:type_name -> openim.groupext.GroupInviteRedemption
+	58,  // 14: openim.groupext.JoinGroupByInviteReq.answers:type_name -> openim.groupext.GroupJoinAnswer
+	29,  // 15: openim.groupext.CreateCommunityResp.community:type_name -> openim.groupext.CommunityInfo
+	99,  // 16: openim.groupext.SetCommunityInfoReq.name:type_name -> openim.protobuf.StringValue
+	99,  // 17: openim.groupext.SetCommunityInfoReq.faceURL:type_name -> openim.protobuf.StringValue
+	99,  // 18: openim.groupext.SetCommunityInfoReq.introduction:type_name -> openim.protobuf.StringValue
+	99,  // 19: openim.groupext.SetCommunityInfoReq.ex:type_name -> openim.protobuf.StringValue
+	29,  // 20: openim.groupext.GetCommunitiesInfoResp.communities:type_name -> openim.groupext.CommunityInfo
+	103, // 21: openim.groupext.GetCommunityMembersReq.pagination:type_name -> openim.sdkws.RequestPagination
+	30,  // 22: openim.groupext.GetCommunityMembersResp.members:type_name -> openim.groupext.CommunityMember
+	29,  // 23: openim.groupext.GetIncrementalJoinCommunityResp.insert:type_name -> openim.groupext.CommunityInfo
+	29,  // 24: openim.groupext.GetIncrementalJoinCommunityResp.update:type_name -> openim.groupext.CommunityInfo
+	57,  // 25: openim.groupext.SetGroupJoinQuestionsReq.questions:type_name -> openim.groupext.GroupJoinQuestion
+	57,  // 26: openim.groupext.GetGroupJoinQuestionsResp.questions:type_name -> openim.groupext.GroupJoinQuestion
+	58,  // 27: openim.groupext.JoinGroupWithAnswersReq.answers:type_name -> openim.groupext.GroupJoinAnswer
+	58,  // 28: openim.groupext.GroupApplicationAnswers.answers:type_name -> openim.groupext.GroupJoinAnswer
+	65,  // 29: openim.groupext.GetGroupApplicationAnswersResp.applications:type_name -> openim.groupext.GroupApplicationAnswers
+	101, // 30: openim.groupext.GroupApplicationExpiredTips.group:type_name -> openim.sdkws.GroupInfo
+	73,  // 31: openim.groupext.GetGroupMemberTagsResp.members:type_name -> openim.groupext.GroupMemberTags
+	88,  // 32: openim.groupext.SetGroupSuccessionReq.succession:type_name -> openim.groupext.GroupSuccession
+	88,  // 33: openim.groupext.GetGroupSuccessionResp.succession:type_name -> openim.groupext.GroupSuccession
+	1,   // 34: openim.groupext.GroupExt.CreateGroupRole:input_type -> openim.groupext.CreateGroupRoleReq
+	3,   // 35: openim.groupext.GroupExt.UpdateGroupRole:input_type -> openim.groupext.UpdateGroupRoleReq
+	5,   // 36: openim.groupext.GroupExt.DeleteGroupRole:input_type -> openim.groupext.DeleteGroupRoleReq
+	7,   // 37: openim.groupext.GroupExt.GetGroupRoles:input_type -> openim.groupext.GetGroupRolesReq
+	9,   // 38: openim.groupext.GroupExt.SetGroupMemberRole:input_type -> openim.groupext.SetGroupMemberRoleReq
+	12,  // 39: openim.groupext.GroupExt.GetGroupMemberPermissions:input_type -> openim.groupext.GetGroupMemberPermissionsReq
+	17,  // 40: openim.groupext.GroupExt.CreateGroupInviteLink:input_type -> openim.groupext.CreateGroupInviteLinkReq
+	19,  // 41: openim.groupext.GroupExt.GetGroupInviteLinks:input_type -> openim.groupext.GetGroupInviteLinksReq
+	21,  // 42: openim.groupext.GroupExt.RevokeGroupInviteLink:input_type -> openim.groupext.RevokeGroupInviteLinkReq
+	23,  // 43: openim.groupext.GroupExt.GetGroupInviteRedemptions:input_type -> openim.groupext.GetGroupInviteRedemptionsReq
+	25,  // 44: openim.groupext.GroupExt.JoinGroupByInvite:input_type -> openim.groupext.JoinGroupByInviteReq
+	27,  // 45: openim.groupext.GroupExt.GetChannelSubscribers:input_type -> openim.groupext.GetChannelSubscribersReq
+	31,  // 46: openim.groupext.GroupExt.CreateCommunity:input_type -> openim.groupext.CreateCommunityReq
+	33,  // 47: openim.groupext.GroupExt.SetCommunityInfo:input_type -> openim.groupext.SetCommunityInfoReq
+	35,  // 48: openim.groupext.GroupExt.DismissCommunity:input_type -> openim.groupext.DismissCommunityReq
+	37,  // 49: openim.groupext.GroupExt.GetCommunitiesInfo:input_type -> openim.groupext.GetCommunitiesInfoReq
+	39,  // 50: openim.groupext.GroupExt.InviteToCommunity:input_type -> openim.groupext.InviteToCommunityReq
+	41,  // 51: openim.groupext.GroupExt.KickCommunityMember:input_type -> openim.groupext.KickCommunityMemberReq
+	43,  // 52: openim.groupext.GroupExt.QuitCommunity:input_type -> openim.groupext.QuitCommunityReq
+	45,  // 53: openim.groupext.GroupExt.SetCommunityMemberRole:input_type -> openim.groupext.SetCommunityMemberRoleReq
+	47,  // 54: openim.groupext.GroupExt.GetCommunityMembers:input_type -> openim.groupext.GetCommunityMembersReq
+	49,  // 55: openim.groupext.GroupExt.AddCommunityGroups:input_type -> openim.groupext.AddCommunityGroupsReq
+	51,  // 56: openim.groupext.GroupExt.RemoveCommunityGroups:input_type -> openim.groupext.RemoveCommunityGroupsReq
+	53,  // 57: openim.groupext.GroupExt.GetIncrementalJoinCommunity:input_type -> openim.groupext.GetIncrementalJoinCommunityReq
+	55,  // 58: openim.groupext.GroupExt.GetFullJoinCommunityIDs:input_type -> openim.groupext.GetFullJoinCommunityIDsReq
+	59,  // 59: openim.groupext.GroupExt.SetGroupJoinQuestions:input_type -> openim.groupext.SetGroupJoinQuestionsReq
+	61,  // 60: openim.groupext.GroupExt.GetGroupJoinQuestions:input_type -> openim.groupext.GetGroupJoinQuestionsReq
+	63,  // 61: openim.groupext.GroupExt.JoinGroupWithAnswers:input_type -> openim.groupext.JoinGroupWithAnswersReq
+	66,  // 62: openim.groupext.GroupExt.GetGroupApplicationAnswers:input_type -> openim.groupext.GetGroupApplicationAnswersReq
+	68,  // 63: openim.groupext.GroupExt.BatchGroupApplicationResponse:input_type -> openim.groupext.BatchGroupApplicationResponseReq
+	70,  // 64: openim.groupext.GroupExt.ExpireGroupApplications:input_type -> openim.groupext.ExpireGroupApplicationsReq
+	74,  // 65: openim.groupext.GroupExt.AddGroupMemberTag:input_type -> openim.groupext.AddGroupMemberTagReq
+	76,  // 66: openim.groupext.GroupExt.RemoveGroupMemberTag:input_type -> openim.groupext.RemoveGroupMemberTagReq
+	78,  // 67: openim.groupext.GroupExt.GetGroupTags:input_type -> openim.groupext.GetGroupTagsReq
+	80,  // 68: openim.groupext.GroupExt.GetGroupMemberTags:input_type -> openim.groupext.GetGroupMemberTagsReq
+	82,  // 69: openim.groupext.GroupExt.GetTaggedMemberUserIDs:input_type -> openim.groupext.GetTaggedMemberUserIDsReq
+	84,  // 70: openim.groupext.GroupExt.SetGroupQuota:input_type -> openim.groupext.SetGroupQuotaReq
+	86,  // 71: openim.groupext.GroupExt.GetGroupQuota:input_type -> openim.groupext.GetGroupQuotaReq
+	89,  // 72: openim.groupext.GroupExt.SetGroupSuccession:input_type -> openim.groupext.SetGroupSuccessionReq
+	91,  // 73: openim.groupext.GroupExt.GetGroupSuccession:input_type -> openim.groupext.GetGroupSuccessionReq
+	93,  // 74: openim.groupext.GroupExt.QuitGroupWithSuccession:input_type -> openim.groupext.QuitGroupWithSuccessionReq
+	95,  // 75: openim.groupext.GroupExt.HandOverOwnedGroups:input_type -> openim.groupext.HandOverOwnedGroupsReq
+	97,  // 76: openim.groupext.GroupExt.SucceedInactiveGroupOwners:input_type -> openim.groupext.SucceedInactiveGroupOwnersReq
+	2,   // 77: openim.groupext.GroupExt.CreateGroupRole:output_type -> openim.groupext.CreateGroupRoleResp
+	4,   // 78: openim.groupext.GroupExt.UpdateGroupRole:output_type -> openim.groupext.UpdateGroupRoleResp
+	6,   // 79: openim.groupext.GroupExt.DeleteGroupRole:output_type -> openim.groupext.DeleteGroupRoleResp
+	8,   // 80: openim.groupext.GroupExt.GetGroupRoles:output_type -> openim.groupext.GetGroupRolesResp
+	10,  // 81: openim.groupext.GroupExt.SetGroupMemberRole:output_type -> openim.groupext.SetGroupMemberRoleResp
+	13,  // 82: openim.groupext.GroupExt.GetGroupMemberPermissions:output_type -> openim.groupext.GetGroupMemberPermissionsResp
+	18,  // 83: openim.groupext.GroupExt.CreateGroupInviteLink:output_type -> openim.groupext.CreateGroupInviteLinkResp
+	20,  // 84: openim.groupext.GroupExt.GetGroupInviteLinks:output_type -> openim.groupext.GetGroupInviteLinksResp
+	22,  // 85: openim.groupext.GroupExt.RevokeGroupInviteLink:output_type -> openim.groupext.RevokeGroupInviteLinkResp
+	24,  // 86: openim.groupext.GroupExt.GetGroupInviteRedemptions:output_type -> openim.groupext.GetGroupInviteRedemptionsResp
+	26,  // 87: openim.groupext.GroupExt.JoinGroupByInvite:output_type -> openim.groupext.JoinGroupByInviteResp
+	28,  // 88: openim.groupext.GroupExt.GetChannelSubscribers:output_type -> openim.groupext.GetChannelSubscribersResp
+	32,  // 89: openim.groupext.GroupExt.CreateCommunity:output_type -> openim.groupext.CreateCommunityResp
+	34,  // 90: openim.groupext.GroupExt.SetCommunityInfo:output_type -> openim.groupext.SetCommunityInfoResp
+	36,  // 91: openim.groupext.GroupExt.DismissCommunity:output_type -> openim.groupext.DismissCommunityResp
+	38,  // 92: openim.groupext.GroupExt.GetCommunitiesInfo:output_type -> openim.groupext.GetCommunitiesInfoResp
+	40,  // 93: openim.groupext.GroupExt.InviteToCommunity:output_type -> openim.groupext.InviteToCommunityResp
+	42,  // 94: openim.groupext.GroupExt.KickCommunityMember:output_type -> openim.groupext.KickCommunityMemberResp
+	44,  // 95: openim.groupext.GroupExt.QuitCommunity:output_type -> openim.groupext.QuitCommunityResp
+	46,  // 96: openim.groupext.GroupExt.SetCommunityMemberRole:output_type -> openim.groupext.SetCommunityMemberRoleResp
+	48,  // 97: openim.groupext.GroupExt.GetCommunityMembers:output_type -> openim.groupext.GetCommunityMembersResp
+	50,  // 98: openim.groupext.GroupExt.AddCommunityGroups:output_type -> openim.groupext.AddCommunityGroupsResp
+	52,  // 99: openim.groupext.GroupExt.RemoveCommunityGroups:output_type -> openim.groupext.RemoveCommunityGroupsResp
+	54,  // 100: openim.groupext.GroupExt.GetIncrementalJoinCommunity:output_type -> openim.groupext.GetIncrementalJoinCommunityResp
+	56,  // 101: openim.groupext.GroupExt.GetFullJoinCommunityIDs:output_type -> openim.groupext.GetFullJoinCommunityIDsResp
+	60,  // 102: openim.groupext.GroupExt.SetGroupJoinQuestions:output_type -> openim.groupext.SetGroupJoinQuestionsResp
+	62,  // 103: openim.groupext.GroupExt.GetGroupJoinQuestions:output_type -> openim.groupext.GetGroupJoinQuestionsResp
+	64,  // 104: openim.groupext.GroupExt.JoinGroupWithAnswers:output_type -> openim.groupext.JoinGroupWithAnswersResp
+	67,  // 105: openim.groupext.GroupExt.GetGroupApplicationAnswers:output_type -> openim.groupext.GetGroupApplicationAnswersResp
+	69,  // 106: openim.groupext.GroupExt.BatchGroupApplicationResponse:output_type -> openim.groupext.BatchGroupApplicationResponseResp
+	71,  // 107: openim.groupext.GroupExt.ExpireGroupApplications:output_type -> openim.groupext.ExpireGroupApplicationsResp
+	75,  // 108: openim.groupext.GroupExt.AddGroupMemberTag:output_type -> openim.groupext.AddGroupMemberTagResp
+	77,  // 109: openim.groupext.GroupExt.RemoveGroupMemberTag:output_type -> openim.groupext.RemoveGroupMemberTagResp
+	79,  // 110: openim.groupext.GroupExt.GetGroupTags:output_type -> openim.groupext.GetGroupTagsResp
+	81,  // 111: openim.groupext.GroupExt.GetGroupMemberTags:output_type -> openim.groupext.GetGroupMemberTagsResp
+	83,  // 112: openim.groupext.GroupExt.GetTaggedMemberUserIDs:output_type -> openim.groupext.GetTaggedMemberUserIDsResp
+	85,  // 113: openim.groupext.GroupExt.SetGroupQuota:output_type -> openim.groupext.SetGroupQuotaResp
+	87,  // 114: openim.groupext.GroupExt.GetGroupQuota:output_type -> openim.groupext.GetGroupQuotaResp
+	90,  // 115: openim.groupext.GroupExt.SetGroupSuccession:output_type -> openim.groupext.SetGroupSuccessionResp
+	92,  // 116: openim.groupext.GroupExt.GetGroupSuccession:output_type -> openim.groupext.GetGroupSuccessionResp
+	94,  // 117: openim.groupext.GroupExt.QuitGroupWithSuccession:output_type -> openim.groupext.QuitGroupWithSuccessionResp
+	96,  // 118: openim.groupext.GroupExt.HandOverOwnedGroups:output_type -> openim.groupext.HandOverOwnedGroupsResp
+	98,  // 119: openim.groupext.GroupExt.SucceedInactiveGroupOwners:output_type -> openim.groupext.SucceedInactiveGroupOwnersResp
+	77,  // [77:120] is the sub-list for method output_type
+	34,  // [34:77] is the sub-list for method input_type
+	34,  // [34:34] is the sub-list for extension type_name
+	34,  // [34:34] is the sub-list for extension extendee
+	0,   // [0:34] is the sub-list for field type_name
+}
+
+func init() { file_groupext_groupext_proto_init() }
+func file_groupext_groupext_proto_init() {
+	if File_groupext_groupext_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_groupext_groupext_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupRole); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGroupRoleReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGroupRoleResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateGroupRoleReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateGroupRoleResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteGroupRoleReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteGroupRoleResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupRolesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupRolesResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetGroupMemberRoleReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetGroupMemberRoleResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupMemberPermission); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupMemberPermissionsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupMemberPermissionsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupRoleChangedTips); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupInviteLink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupInviteRedemption); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGroupInviteLinkReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGroupInviteLinkResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupInviteLinksReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupInviteLinksResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeGroupInviteLinkReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeGroupInviteLinkResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupInviteRedemptionsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupInviteRedemptionsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinGroupByInviteReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinGroupByInviteResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChannelSubscribersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChannelSubscribersResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommunityInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommunityMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCommunityReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCommunityResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCommunityInfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCommunityInfoResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DismissCommunityReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DismissCommunityResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCommunitiesInfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCommunitiesInfoResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteToCommunityReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteToCommunityResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KickCommunityMemberReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KickCommunityMemberResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuitCommunityReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuitCommunityResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCommunityMemberRoleReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCommunityMemberRoleResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCommunityMembersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCommunityMembersResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddCommunityGroupsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddCommunityGroupsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveCommunityGroupsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveCommunityGroupsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetIncrementalJoinCommunityReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetIncrementalJoinCommunityResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFullJoinCommunityIDsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFullJoinCommunityIDsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupJoinQuestion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupJoinAnswer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetGroupJoinQuestionsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetGroupJoinQuestionsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupJoinQuestionsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupJoinQuestionsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinGroupWithAnswersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinGroupWithAnswersResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupApplicationAnswers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupApplicationAnswersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupApplicationAnswersResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGroupApplicationResponseReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGroupApplicationResponseResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpireGroupApplicationsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpireGroupApplicationsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupApplicationExpiredTips); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupMemberTags); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddGroupMemberTagReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddGroupMemberTagResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveGroupMemberTagReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveGroupMemberTagResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupTagsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupTagsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupMemberTagsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupMemberTagsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTaggedMemberUserIDsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTaggedMemberUserIDsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetGroupQuotaReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetGroupQuotaResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupQuotaReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupQuotaResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupSuccession); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetGroupSuccessionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetGroupSuccessionResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupSuccessionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupSuccessionResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuitGroupWithSuccessionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuitGroupWithSuccessionResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HandOverOwnedGroupsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HandOverOwnedGroupsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SucceedInactiveGroupOwnersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SucceedInactiveGroupOwnersResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_groupext_groupext_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   99,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_groupext_groupext_proto_goTypes,
+		DependencyIndexes: file_groupext_groupext_proto_depIdxs,
+		MessageInfos:      file_groupext_groupext_proto_msgTypes,
+	}.Build()
+	File_groupext_groupext_proto = out.File
+	file_groupext_groupext_proto_rawDesc = nil
+	file_groupext_groupext_proto_goTypes = nil
+	file_groupext_groupext_proto_depIdxs = nil
+}
@@ -0,0 +1,1661 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.27.1
+// source: groupext/groupext.proto
+
+package groupext
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	GroupExt_CreateGroupRole_FullMethodName               = "/openim.groupext.GroupExt/CreateGroupRole"
+	GroupExt_UpdateGroupRole_FullMethodName               = "/openim.groupext.GroupExt/UpdateGroupRole"
+	GroupExt_DeleteGroupRole_FullMethodName               = "/openim.groupext.GroupExt/DeleteGroupRole"
+	GroupExt_GetGroupRoles_FullMethodName                 = "/openim.groupext.GroupExt/GetGroupRoles"
+	GroupExt_SetGroupMemberRole_FullMethodName            = "/openim.groupext.GroupExt/SetGroupMemberRole"
+	GroupExt_GetGroupMemberPermissions_FullMethodName     = "/openim.groupext.GroupExt/GetGroupMemberPermissions"
+	GroupExt_CreateGroupInviteLink_FullMethodName         = "/openim.groupext.GroupExt/CreateGroupInviteLink"
+	GroupExt_GetGroupInviteLinks_FullMethodName           = "/openim.groupext.GroupExt/GetGroupInviteLinks"
+	GroupExt_RevokeGroupInviteLink_FullMethodName         = "/openim.groupext.GroupExt/RevokeGroupInviteLink"
+	GroupExt_GetGroupInviteRedemptions_FullMethodName     = "/openim.groupext.GroupExt/GetGroupInviteRedemptions"
+	GroupExt_JoinGroupByInvite_FullMethodName             = "/openim.groupext.GroupExt/JoinGroupByInvite"
+	GroupExt_GetChannelSubscribers_FullMethodName         = "/openim.groupext.GroupExt/GetChannelSubscribers"
+	GroupExt_CreateCommunity_FullMethodName               = "/openim.groupext.GroupExt/CreateCommunity"
+	GroupExt_SetCommunityInfo_FullMethodName              = "/openim.groupext.GroupExt/SetCommunityInfo"
+	GroupExt_DismissCommunity_FullMethodName              = "/openim.groupext.GroupExt/DismissCommunity"
+	GroupExt_GetCommunitiesInfo_FullMethodName            = "/openim.groupext.GroupExt/GetCommunitiesInfo"
+	GroupExt_InviteToCommunity_FullMethodName             = "/openim.groupext.GroupExt/InviteToCommunity"
+	GroupExt_KickCommunityMember_FullMethodName           = "/openim.groupext.GroupExt/KickCommunityMember"
+	GroupExt_QuitCommunity_FullMethodName                 = "/openim.groupext.GroupExt/QuitCommunity"
+	GroupExt_SetCommunityMemberRole_FullMethodName        = "/openim.groupext.GroupExt/SetCommunityMemberRole"
+	GroupExt_GetCommunityMembers_FullMethodName           = "/openim.groupext.GroupExt/GetCommunityMembers"
+	GroupExt_AddCommunityGroups_FullMethodName            = "/openim.groupext.GroupExt/AddCommunityGroups"
+	GroupExt_RemoveCommunityGroups_FullMethodName         = "/openim.groupext.GroupExt/RemoveCommunityGroups"
+	GroupExt_GetIncrementalJoinCommunity_FullMethodName   = "/openim.groupext.GroupExt/GetIncrementalJoinCommunity"
+	GroupExt_GetFullJoinCommunityIDs_FullMethodName       = "/openim.groupext.GroupExt/GetFullJoinCommunityIDs"
+	GroupExt_SetGroupJoinQuestions_FullMethodName         = "/openim.groupext.GroupExt/SetGroupJoinQuestions"
+	GroupExt_GetGroupJoinQuestions_FullMethodName         = "/openim.groupext.GroupExt/GetGroupJoinQuestions"
+	GroupExt_JoinGroupWithAnswers_FullMethodName          = "/openim.groupext.GroupExt/JoinGroupWithAnswers"
+	GroupExt_GetGroupApplicationAnswers_FullMethodName    = "/openim.groupext.GroupExt/GetGroupApplicationAnswers"
+	GroupExt_BatchGroupApplicationResponse_FullMethodName = "/openim.groupext.GroupExt/BatchGroupApplicationResponse"
+	GroupExt_ExpireGroupApplications_FullMethodName       = "/openim.groupext.GroupExt/ExpireGroupApplications"
+	GroupExt_AddGroupMemberTag_FullMethodName             = "/openim.groupext.GroupExt/AddGroupMemberTag"
+	GroupExt_RemoveGroupMemberTag_FullMethodName          = "/openim.groupext.GroupExt/RemoveGroupMemberTag"
+	GroupExt_GetGroupTags_FullMethodName                  = "/openim.groupext.GroupExt/GetGroupTags"
+	GroupExt_GetGroupMemberTags_FullMethodName            = "/openim.groupext.GroupExt/GetGroupMemberTags"
+	GroupExt_GetTaggedMemberUserIDs_FullMethodName        = "/openim.groupext.GroupExt/GetTaggedMemberUserIDs"
+	GroupExt_SetGroupQuota_FullMethodName                 = "/openim.groupext.GroupExt/SetGroupQuota"
+	GroupExt_GetGroupQuota_FullMethodName                 = "/openim.groupext.GroupExt/GetGroupQuota"
+	GroupExt_SetGroupSuccession_FullMethodName            = "/openim.groupext.GroupExt/SetGroupSuccession"
+	GroupExt_GetGroupSuccession_FullMethodName            = "/openim.groupext.GroupExt/GetGroupSuccession"
+	GroupExt_QuitGroupWithSuccession_FullMethodName       = "/openim.groupext.GroupExt/QuitGroupWithSuccession"
+	GroupExt_HandOverOwnedGroups_FullMethodName           = "/openim.groupext.GroupExt/HandOverOwnedGroups"
+	GroupExt_SucceedInactiveGroupOwners_FullMethodName    = "/openim.groupext.GroupExt/SucceedInactiveGroupOwners"
+)
+
+// GroupExtClient is the client API for GroupExt service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GroupExtClient interface {
+	CreateGroupRole(ctx context.Context, in *CreateGroupRoleReq, opts ...grpc.CallOption) (*CreateGroupRoleResp, error)
+	UpdateGroupRole(ctx context.Context, in *UpdateGroupRoleReq, opts ...grpc.CallOption) (*UpdateGroupRoleResp, error)
+	DeleteGroupRole(ctx context.Context, in *DeleteGroupRoleReq, opts ...grpc.CallOption) (*DeleteGroupRoleResp, error)
+	GetGroupRoles(ctx context.Context, in *GetGroupRolesReq, opts ...grpc.CallOption) (*GetGroupRolesResp, error)
+	SetGroupMemberRole(ctx context.Context, in *SetGroupMemberRoleReq, opts ...grpc.CallOption) (*SetGroupMemberRoleResp, error)
+	GetGroupMemberPermissions(ctx context.Context, in *GetGroupMemberPermissionsReq, opts ...grpc.CallOption) (*GetGroupMemberPermissionsResp, error)
+	CreateGroupInviteLink(ctx context.Context, in *CreateGroupInviteLinkReq, opts ...grpc.CallOption) (*CreateGroupInviteLinkResp, error)
+	GetGroupInviteLinks(ctx context.Context, in *GetGroupInviteLinksReq, opts ...grpc.CallOption) (*GetGroupInviteLinksResp, error)
+	RevokeGroupInviteLink(ctx context.Context, in *RevokeGroupInviteLinkReq, opts ...grpc.CallOption) (*RevokeGroupInviteLinkResp, error)
+	GetGroupInviteRedemptions(ctx context.Context, in *GetGroupInviteRedemptionsReq, opts ...grpc.CallOption) (*GetGroupInviteRedemptionsResp, error)
+	JoinGroupByInvite(ctx context.Context, in *JoinGroupByInviteReq, opts ...grpc.CallOption) (*JoinGroupByInviteResp, error)
+	GetChannelSubscribers(ctx context.Context, in *GetChannelSubscribersReq, opts ...grpc.CallOption) (*GetChannelSubscribersResp, error)
+	CreateCommunity(ctx context.Context, in *CreateCommunityReq, opts ...grpc.CallOption) (*CreateCommunityResp, error)
+	SetCommunityInfo(ctx context.Context, in *SetCommunityInfoReq, opts ...grpc.CallOption) (*SetCommunityInfoResp, error)
+	DismissCommunity(ctx context.Context, in *DismissCommunityReq, opts ...grpc.CallOption) (*DismissCommunityResp, error)
+	GetCommunitiesInfo(ctx context.Context, in *GetCommunitiesInfoReq, opts ...grpc.CallOption) (*GetCommunitiesInfoResp, error)
+	InviteToCommunity(ctx context.Context, in *InviteToCommunityReq, opts ...grpc.CallOption) (*InviteToCommunityResp, error)
+	KickCommunityMember(ctx context.Context, in *KickCommunityMemberReq, opts ...grpc.CallOption) (*KickCommunityMemberResp, error)
+	QuitCommunity(ctx context.Context, in *QuitCommunityReq, opts ...grpc.CallOption) (*QuitCommunityResp, error)
+	SetCommunityMemberRole(ctx context.Context, in *SetCommunityMemberRoleReq, opts ...grpc.CallOption) (*SetCommunityMemberRoleResp, error)
+	GetCommunityMembers(ctx context.Context, in *GetCommunityMembersReq, opts ...grpc.CallOption) (*GetCommunityMembersResp, error)
+	AddCommunityGroups(ctx context.Context, in *AddCommunityGroupsReq, opts ...grpc.CallOption) (*AddCommunityGroupsResp, error)
+	RemoveCommunityGroups(ctx context.Context, in *RemoveCommunityGroupsReq, opts ...grpc.CallOption) (*RemoveCommunityGroupsResp, error)
+	GetIncrementalJoinCommunity(ctx context.Context, in *GetIncrementalJoinCommunityReq, opts ...grpc.CallOption) (*GetIncrementalJoinCommunityResp, error)
+	GetFullJoinCommunityIDs(ctx context.Context, in *GetFullJoinCommunityIDsReq, opts ...grpc.CallOption) (*GetFullJoinCommunityIDsResp, error)
+	SetGroupJoinQuestions(ctx context.Context, in *SetGroupJoinQuestionsReq, opts ...grpc.CallOption) (*SetGroupJoinQuestionsResp, error)
+	GetGroupJoinQuestions(ctx context.Context, in *GetGroupJoinQuestionsReq, opts ...grpc.CallOption) (*GetGroupJoinQuestionsResp, error)
+	JoinGroupWithAnswers(ctx context.Context, in *JoinGroupWithAnswersReq, opts ...grpc.CallOption) (*JoinGroupWithAnswersResp, error)
+	GetGroupApplicationAnswers(ctx context.Context, in *GetGroupApplicationAnswersReq, opts ...grpc.CallOption) (*GetGroupApplicationAnswersResp, error)
+	BatchGroupApplicationResponse(ctx context.Context, in *BatchGroupApplicationResponseReq, opts ...grpc.CallOption) (*BatchGroupApplicationResponseResp, error)
+	ExpireGroupApplications(ctx context.Context, in *ExpireGroupApplicationsReq, opts ...grpc.CallOption) (*ExpireGroupApplicationsResp, error)
+	AddGroupMemberTag(ctx context.Context, in *AddGroupMemberTagReq, opts ...grpc.CallOption) (*AddGroupMemberTagResp, error)
+	RemoveGroupMemberTag(ctx context.Context, in *RemoveGroupMemberTagReq, opts ...grpc.CallOption) (*RemoveGroupMemberTagResp, error)
+	GetGroupTags(ctx context.Context, in *GetGroupTagsReq, opts ...grpc.CallOption) (*GetGroupTagsResp, error)
+	GetGroupMemberTags(ctx context.Context, in *GetGroupMemberTagsReq, opts ...grpc.CallOption) (*GetGroupMemberTagsResp, error)
+	GetTaggedMemberUserIDs(ctx context.Context, in *GetTaggedMemberUserIDsReq, opts ...grpc.CallOption) (*GetTaggedMemberUserIDsResp, error)
+	SetGroupQuota(ctx context.Context, in *SetGroupQuotaReq, opts ...grpc.CallOption) (*SetGroupQuotaResp, error)
+	GetGroupQuota(ctx context.Context, in *GetGroupQuotaReq, opts ...grpc.CallOption) (*GetGroupQuotaResp, error)
+	SetGroupSuccession(ctx context.Context, in *SetGroupSuccessionReq, opts ...grpc.CallOption) (*SetGroupSuccessionResp, error)
+	GetGroupSuccession(ctx context.Context, in *GetGroupSuccessionReq, opts ...grpc.CallOption) (*GetGroupSuccessionResp, error)
+	QuitGroupWithSuccession(ctx context.Context, in *QuitGroupWithSuccessionReq, opts ...grpc.CallOption) (*QuitGroupWithSuccessionResp, error)
+	HandOverOwnedGroups(ctx context.Context, in *HandOverOwnedGroupsReq, opts ...grpc.CallOption) (*HandOverOwnedGroupsResp, error)
+	SucceedInactiveGroupOwners(ctx context.Context, in *SucceedInactiveGroupOwnersReq, opts ...grpc.CallOption) (*SucceedInactiveGroupOwnersResp, error)
+}
+
+type groupExtClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGroupExtClient(cc grpc.ClientConnInterface) GroupExtClient {
+	return &groupExtClient{cc}
+}
+
+func (c *groupExtClient) CreateGroupRole(ctx context.Context, in *CreateGroupRoleReq, opts ...grpc.CallOption) (*CreateGroupRoleResp, error) {
+	out := new(CreateGroupRoleResp)
+	err := c.cc.Invoke(ctx, GroupExt_CreateGroupRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) UpdateGroupRole(ctx context.Context, in *UpdateGroupRoleReq, opts ...grpc.CallOption) (*UpdateGroupRoleResp, error) {
+	out := new(UpdateGroupRoleResp)
+	err := c.cc.Invoke(ctx, GroupExt_UpdateGroupRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) DeleteGroupRole(ctx context.Context, in *DeleteGroupRoleReq, opts ...grpc.CallOption) (*DeleteGroupRoleResp, error) {
+	out := new(DeleteGroupRoleResp)
+	err := c.cc.Invoke(ctx, GroupExt_DeleteGroupRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) GetGroupRoles(ctx context.Context, in *GetGroupRolesReq, opts ...grpc.CallOption) (*GetGroupRolesResp, error) {
+	out := new(GetGroupRolesResp)
+	err := c.cc.Invoke(ctx, GroupExt_GetGroupRoles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) SetGroupMemberRole(ctx context.Context, in *SetGroupMemberRoleReq, opts ...grpc.CallOption) (*SetGroupMemberRoleResp, error) {
+	out := new(SetGroupMemberRoleResp)
+	err := c.cc.Invoke(ctx, GroupExt_SetGroupMemberRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) GetGroupMemberPermissions(ctx context.Context, in *GetGroupMemberPermissionsReq, opts ...grpc.CallOption) (*GetGroupMemberPermissionsResp, error) {
+	out := new(GetGroupMemberPermissionsResp)
+	err := c.cc.Invoke(ctx, GroupExt_GetGroupMemberPermissions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) CreateGroupInviteLink(ctx context.Context, in *CreateGroupInviteLinkReq, opts ...grpc.CallOption) (*CreateGroupInviteLinkResp, error) {
+	out := new(CreateGroupInviteLinkResp)
+	err := c.cc.Invoke(ctx, GroupExt_CreateGroupInviteLink_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) GetGroupInviteLinks(ctx context.Context, in *GetGroupInviteLinksReq, opts ...grpc.CallOption) (*GetGroupInviteLinksResp, error) {
+	out := new(GetGroupInviteLinksResp)
+	err := c.cc.Invoke(ctx, GroupExt_GetGroupInviteLinks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) RevokeGroupInviteLink(ctx context.Context, in *RevokeGroupInviteLinkReq, opts ...grpc.CallOption) (*RevokeGroupInviteLinkResp, error) {
+	out := new(RevokeGroupInviteLinkResp)
+	err := c.cc.Invoke(ctx, GroupExt_RevokeGroupInviteLink_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) GetGroupInviteRedemptions(ctx context.Context, in *GetGroupInviteRedemptionsReq, opts ...grpc.CallOption) (*GetGroupInviteRedemptionsResp, error) {
+	out := new(GetGroupInviteRedemptionsResp)
+	err := c.cc.Invoke(ctx, GroupExt_GetGroupInviteRedemptions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) JoinGroupByInvite(ctx context.Context, in *JoinGroupByInviteReq, opts ...grpc.CallOption) (*JoinGroupByInviteResp, error) {
+	out := new(JoinGroupByInviteResp)
+	err := c.cc.Invoke(ctx, GroupExt_JoinGroupByInvite_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) GetChannelSubscribers(ctx context.Context, in *GetChannelSubscribersReq, opts ...grpc.CallOption) (*GetChannelSubscribersResp, error) {
+	out := new(GetChannelSubscribersResp)
+	err := c.cc.Invoke(ctx, GroupExt_GetChannelSubscribers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) CreateCommunity(ctx context.Context, in *CreateCommunityReq, opts ...grpc.CallOption) (*CreateCommunityResp, error) {
+	out := new(CreateCommunityResp)
+	err := c.cc.Invoke(ctx, GroupExt_CreateCommunity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) SetCommunityInfo(ctx context.Context, in *SetCommunityInfoReq, opts ...grpc.CallOption) (*SetCommunityInfoResp, error) {
+	out := new(SetCommunityInfoResp)
+	err := c.cc.Invoke(ctx, GroupExt_SetCommunityInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) DismissCommunity(ctx context.Context, in *DismissCommunityReq, opts ...grpc.CallOption) (*DismissCommunityResp, error) {
+	out := new(DismissCommunityResp)
+	err := c.cc.Invoke(ctx, GroupExt_DismissCommunity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) GetCommunitiesInfo(ctx context.Context, in *GetCommunitiesInfoReq, opts ...grpc.CallOption) (*GetCommunitiesInfoResp, error) {
+	out := new(GetCommunitiesInfoResp)
+	err := c.cc.Invoke(ctx, GroupExt_GetCommunitiesInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) InviteToCommunity(ctx context.Context, in *InviteToCommunityReq, opts ...grpc.CallOption) (*InviteToCommunityResp, error) {
+	out := new(InviteToCommunityResp)
+	err := c.cc.Invoke(ctx, GroupExt_InviteToCommunity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) KickCommunityMember(ctx context.Context, in *KickCommunityMemberReq, opts ...grpc.CallOption) (*KickCommunityMemberResp, error) {
+	out := new(KickCommunityMemberResp)
+	err := c.cc.Invoke(ctx, GroupExt_KickCommunityMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) QuitCommunity(ctx context.Context, in *QuitCommunityReq, opts ...grpc.CallOption) (*QuitCommunityResp, error) {
+	out := new(QuitCommunityResp)
+	err := c.cc.Invoke(ctx, GroupExt_QuitCommunity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) SetCommunityMemberRole(ctx context.Context, in *SetCommunityMemberRoleReq, opts ...grpc.CallOption) (*SetCommunityMemberRoleResp, error) {
+	out := new(SetCommunityMemberRoleResp)
+	err := c.cc.Invoke(ctx, GroupExt_SetCommunityMemberRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) GetCommunityMembers(ctx context.Context, in *GetCommunityMembersReq, opts ...grpc.CallOption) (*GetCommunityMembersResp, error) {
+	out := new(GetCommunityMembersResp)
+	err := c.cc.Invoke(ctx, GroupExt_GetCommunityMembers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) AddCommunityGroups(ctx context.Context, in *AddCommunityGroupsReq, opts ...grpc.CallOption) (*AddCommunityGroupsResp, error) {
+	out := new(AddCommunityGroupsResp)
+	err := c.cc.Invoke(ctx, GroupExt_AddCommunityGroups_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) RemoveCommunityGroups(ctx context.Context, in *RemoveCommunityGroupsReq, opts ...grpc.CallOption) (*RemoveCommunityGroupsResp, error) {
+	out := new(RemoveCommunityGroupsResp)
+	err := c.cc.Invoke(ctx, GroupExt_RemoveCommunityGroups_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) GetIncrementalJoinCommunity(ctx context.Context, in *GetIncrementalJoinCommunityReq, opts ...grpc.CallOption) (*GetIncrementalJoinCommunityResp, error) {
+	out := new(GetIncrementalJoinCommunityResp)
+	err := c.cc.Invoke(ctx, GroupExt_GetIncrementalJoinCommunity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) GetFullJoinCommunityIDs(ctx context.Context, in *GetFullJoinCommunityIDsReq, opts ...grpc.CallOption) (*GetFullJoinCommunityIDsResp, error) {
+	out := new(GetFullJoinCommunityIDsResp)
+	err := c.cc.Invoke(ctx, GroupExt_GetFullJoinCommunityIDs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) SetGroupJoinQuestions(ctx context.Context, in *SetGroupJoinQuestionsReq, opts ...grpc.CallOption) (*SetGroupJoinQuestionsResp, error) {
+	out := new(SetGroupJoinQuestionsResp)
+	err := c.cc.Invoke(ctx, GroupExt_SetGroupJoinQuestions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) GetGroupJoinQuestions(ctx context.Context, in *GetGroupJoinQuestionsReq, opts ...grpc.CallOption) (*GetGroupJoinQuestionsResp, error) {
+	out := new(GetGroupJoinQuestionsResp)
+	err := c.cc.Invoke(ctx, GroupExt_GetGroupJoinQuestions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) JoinGroupWithAnswers(ctx context.Context, in *JoinGroupWithAnswersReq, opts ...grpc.CallOption) (*JoinGroupWithAnswersResp, error) {
+	out := new(JoinGroupWithAnswersResp)
+	err := c.cc.Invoke(ctx, GroupExt_JoinGroupWithAnswers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) GetGroupApplicationAnswers(ctx context.Context, in *GetGroupApplicationAnswersReq, opts ...grpc.CallOption) (*GetGroupApplicationAnswersResp, error) {
+	out := new(GetGroupApplicationAnswersResp)
+	err := c.cc.Invoke(ctx, GroupExt_GetGroupApplicationAnswers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) BatchGroupApplicationResponse(ctx context.Context, in *BatchGroupApplicationResponseReq, opts ...grpc.CallOption) (*BatchGroupApplicationResponseResp, error) {
+	out := new(BatchGroupApplicationResponseResp)
+	err := c.cc.Invoke(ctx, GroupExt_BatchGroupApplicationResponse_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) ExpireGroupApplications(ctx context.Context, in *ExpireGroupApplicationsReq, opts ...grpc.CallOption) (*ExpireGroupApplicationsResp, error) {
+	out := new(ExpireGroupApplicationsResp)
+	err := c.cc.Invoke(ctx, GroupExt_ExpireGroupApplications_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) AddGroupMemberTag(ctx context.Context, in *AddGroupMemberTagReq, opts ...grpc.CallOption) (*AddGroupMemberTagResp, error) {
+	out := new(AddGroupMemberTagResp)
+	err := c.cc.Invoke(ctx, GroupExt_AddGroupMemberTag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) RemoveGroupMemberTag(ctx context.Context, in *RemoveGroupMemberTagReq, opts ...grpc.CallOption) (*RemoveGroupMemberTagResp, error) {
+	out := new(RemoveGroupMemberTagResp)
+	err := c.cc.Invoke(ctx, GroupExt_RemoveGroupMemberTag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) GetGroupTags(ctx context.Context, in *GetGroupTagsReq, opts ...grpc.CallOption) (*GetGroupTagsResp, error) {
+	out := new(GetGroupTagsResp)
+	err := c.cc.Invoke(ctx, GroupExt_GetGroupTags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) GetGroupMemberTags(ctx context.Context, in *GetGroupMemberTagsReq, opts ...grpc.CallOption) (*GetGroupMemberTagsResp, error) {
+	out := new(GetGroupMemberTagsResp)
+	err := c.cc.Invoke(ctx, GroupExt_GetGroupMemberTags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) GetTaggedMemberUserIDs(ctx context.Context, in *GetTaggedMemberUserIDsReq, opts ...grpc.CallOption) (*GetTaggedMemberUserIDsResp, error) {
+	out := new(GetTaggedMemberUserIDsResp)
+	err := c.cc.Invoke(ctx, GroupExt_GetTaggedMemberUserIDs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) SetGroupQuota(ctx context.Context, in *SetGroupQuotaReq, opts ...grpc.CallOption) (*SetGroupQuotaResp, error) {
+	out := new(SetGroupQuotaResp)
+	err := c.cc.Invoke(ctx, GroupExt_SetGroupQuota_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) GetGroupQuota(ctx context.Context, in *GetGroupQuotaReq, opts ...grpc.CallOption) (*GetGroupQuotaResp, error) {
+	out := new(GetGroupQuotaResp)
+	err := c.cc.Invoke(ctx, GroupExt_GetGroupQuota_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) SetGroupSuccession(ctx context.Context, in *SetGroupSuccessionReq, opts ...grpc.CallOption) (*SetGroupSuccessionResp, error) {
+	out := new(SetGroupSuccessionResp)
+	err := c.cc.Invoke(ctx, GroupExt_SetGroupSuccession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) GetGroupSuccession(ctx context.Context, in *GetGroupSuccessionReq, opts ...grpc.CallOption) (*GetGroupSuccessionResp, error) {
+	out := new(GetGroupSuccessionResp)
+	err := c.cc.Invoke(ctx, GroupExt_GetGroupSuccession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) QuitGroupWithSuccession(ctx context.Context, in *QuitGroupWithSuccessionReq, opts ...grpc.CallOption) (*QuitGroupWithSuccessionResp, error) {
+	out := new(QuitGroupWithSuccessionResp)
+	err := c.cc.Invoke(ctx, GroupExt_QuitGroupWithSuccession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) HandOverOwnedGroups(ctx context.Context, in *HandOverOwnedGroupsReq, opts ...grpc.CallOption) (*HandOverOwnedGroupsResp, error) {
+	out := new(HandOverOwnedGroupsResp)
+	err := c.cc.Invoke(ctx, GroupExt_HandOverOwnedGroups_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) SucceedInactiveGroupOwners(ctx context.Context, in *SucceedInactiveGroupOwnersReq, opts ...grpc.CallOption) (*SucceedInactiveGroupOwnersResp, error) {
+	out := new(SucceedInactiveGroupOwnersResp)
+	err := c.cc.Invoke(ctx, GroupExt_SucceedInactiveGroupOwners_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GroupExtServer is the server API for GroupExt service.
+// All implementations should embed UnimplementedGroupExtServer
+// for forward compatibility
+type GroupExtServer interface {
+	CreateGroupRole(context.Context, *CreateGroupRoleReq) (*CreateGroupRoleResp, error)
+	UpdateGroupRole(context.Context, *UpdateGroupRoleReq) (*UpdateGroupRoleResp, error)
+	DeleteGroupRole(context.Context, *DeleteGroupRoleReq) (*DeleteGroupRoleResp, error)
+	GetGroupRoles(context.Context, *GetGroupRolesReq) (*GetGroupRolesResp, error)
+	SetGroupMemberRole(context.Context, *SetGroupMemberRoleReq) (*SetGroupMemberRoleResp, error)
+	GetGroupMemberPermissions(context.Context, *GetGroupMemberPermissionsReq) (*GetGroupMemberPermissionsResp, error)
+	CreateGroupInviteLink(context.Context, *CreateGroupInviteLinkReq) (*CreateGroupInviteLinkResp, error)
+	GetGroupInviteLinks(context.Context, *GetGroupInviteLinksReq) (*GetGroupInviteLinksResp, error)
+	RevokeGroupInviteLink(context.Context, *RevokeGroupInviteLinkReq) (*RevokeGroupInviteLinkResp, error)
+	GetGroupInviteRedemptions(context.Context, *GetGroupInviteRedemptionsReq) (*GetGroupInviteRedemptionsResp, error)
+	JoinGroupByInvite(context.Context, *JoinGroupByInviteReq) (*JoinGroupByInviteResp, error)
+	GetChannelSubscribers(context.Context, *GetChannelSubscribersReq) (*GetChannelSubscribersResp, error)
+	CreateCommunity(context.Context, *CreateCommunityReq) (*CreateCommunityResp, error)
+	SetCommunityInfo(context.Context, *SetCommunityInfoReq) (*SetCommunityInfoResp, error)
+	DismissCommunity(context.Context, *DismissCommunityReq) (*DismissCommunityResp, error)
+	GetCommunitiesInfo(context.Context, *GetCommunitiesInfoReq) (*GetCommunitiesInfoResp, error)
+	InviteToCommunity(context.Context, *InviteToCommunityReq) (*InviteToCommunityResp, error)
+	KickCommunityMember(context.Context, *KickCommunityMemberReq) (*KickCommunityMemberResp, error)
+	QuitCommunity(context.Context, *QuitCommunityReq) (*QuitCommunityResp, error)
+	SetCommunityMemberRole(context.Context, *SetCommunityMemberRoleReq) (*SetCommunityMemberRoleResp, error)
+	GetCommunityMembers(context.Context, *GetCommunityMembersReq) (*GetCommunityMembersResp, error)
+	AddCommunityGroups(context.Context, *AddCommunityGroupsReq) (*AddCommunityGroupsResp, error)
+	RemoveCommunityGroups(context.Context, *RemoveCommunityGroupsReq) (*RemoveCommunityGroupsResp, error)
+	GetIncrementalJoinCommunity(context.Context, *GetIncrementalJoinCommunityReq) (*GetIncrementalJoinCommunityResp, error)
+	GetFullJoinCommunityIDs(context.Context, *GetFullJoinCommunityIDsReq) (*GetFullJoinCommunityIDsResp, error)
+	SetGroupJoinQuestions(context.Context, *SetGroupJoinQuestionsReq) (*SetGroupJoinQuestionsResp, error)
+	GetGroupJoinQuestions(context.Context, *GetGroupJoinQuestionsReq) (*GetGroupJoinQuestionsResp, error)
+	JoinGroupWithAnswers(context.Context, *JoinGroupWithAnswersReq) (*JoinGroupWithAnswersResp, error)
+	GetGroupApplicationAnswers(context.Context, *GetGroupApplicationAnswersReq) (*GetGroupApplicationAnswersResp, error)
+	BatchGroupApplicationResponse(context.Context, *BatchGroupApplicationResponseReq) (*BatchGroupApplicationResponseResp, error)
+	ExpireGroupApplications(context.Context, *ExpireGroupApplicationsReq) (*ExpireGroupApplicationsResp, error)
+	AddGroupMemberTag(context.Context, *AddGroupMemberTagReq) (*AddGroupMemberTagResp, error)
+	RemoveGroupMemberTag(context.Context, *RemoveGroupMemberTagReq) (*RemoveGroupMemberTagResp, error)
+	GetGroupTags(context.Context, *GetGroupTagsReq) (*GetGroupTagsResp, error)
+	GetGroupMemberTags(context.Context, *GetGroupMemberTagsReq) (*GetGroupMemberTagsResp, error)
+	GetTaggedMemberUserIDs(context.Context, *GetTaggedMemberUserIDsReq) (*GetTaggedMemberUserIDsResp, error)
+	SetGroupQuota(context.Context, *SetGroupQuotaReq) (*SetGroupQuotaResp, error)
+	GetGroupQuota(context.Context, *GetGroupQuotaReq) (*GetGroupQuotaResp, error)
+	SetGroupSuccession(context.Context, *SetGroupSuccessionReq) (*SetGroupSuccessionResp, error)
+	GetGroupSuccession(context.Context, *GetGroupSuccessionReq) (*GetGroupSuccessionResp, error)
+	QuitGroupWithSuccession(context.Context, *QuitGroupWithSuccessionReq) (*QuitGroupWithSuccessionResp, error)
+	HandOverOwnedGroups(context.Context, *HandOverOwnedGroupsReq) (*HandOverOwnedGroupsResp, error)
+	SucceedInactiveGroupOwners(context.Context, *SucceedInactiveGroupOwnersReq) (*SucceedInactiveGroupOwnersResp, error)
+}
+
+// UnimplementedGroupExtServer should be embedded to have forward compatible implementations.
+type UnimplementedGroupExtServer struct {
+}
+
+func (UnimplementedGroupExtServer) CreateGroupRole(context.Context, *CreateGroupRoleReq) (*CreateGroupRoleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroupRole not implemented")
+}
+func (UnimplementedGroupExtServer) UpdateGroupRole(context.Context, *UpdateGroupRoleReq) (*UpdateGroupRoleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupRole not implemented")
+}
+func (UnimplementedGroupExtServer) DeleteGroupRole(context.Context, *DeleteGroupRoleReq) (*DeleteGroupRoleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroupRole not implemented")
+}
+func (UnimplementedGroupExtServer) GetGroupRoles(context.Context, *GetGroupRolesReq) (*GetGroupRolesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupRoles not implemented")
+}
+func (UnimplementedGroupExtServer) SetGroupMemberRole(context.Context, *SetGroupMemberRoleReq) (*SetGroupMemberRoleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGroupMemberRole not implemented")
+}
+func (UnimplementedGroupExtServer) GetGroupMemberPermissions(context.Context, *GetGroupMemberPermissionsReq) (*GetGroupMemberPermissionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupMemberPermissions not implemented")
+}
+func (UnimplementedGroupExtServer) CreateGroupInviteLink(context.Context, *CreateGroupInviteLinkReq) (*CreateGroupInviteLinkResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroupInviteLink not implemented")
+}
+func (UnimplementedGroupExtServer) GetGroupInviteLinks(context.Context, *GetGroupInviteLinksReq) (*GetGroupInviteLinksResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupInviteLinks not implemented")
+}
+func (UnimplementedGroupExtServer) RevokeGroupInviteLink(context.Context, *RevokeGroupInviteLinkReq) (*RevokeGroupInviteLinkResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeGroupInviteLink not implemented")
+}
+func (UnimplementedGroupExtServer) GetGroupInviteRedemptions(context.Context, *GetGroupInviteRedemptionsReq) (*GetGroupInviteRedemptionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupInviteRedemptions not implemented")
+}
+func (UnimplementedGroupExtServer) JoinGroupByInvite(context.Context, *JoinGroupByInviteReq) (*JoinGroupByInviteResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinGroupByInvite not implemented")
+}
+func (UnimplementedGroupExtServer) GetChannelSubscribers(context.Context, *GetChannelSubscribersReq) (*GetChannelSubscribersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelSubscribers not implemented")
+}
+func (UnimplementedGroupExtServer) CreateCommunity(context.Context, *CreateCommunityReq) (*CreateCommunityResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCommunity not implemented")
+}
+func (UnimplementedGroupExtServer) SetCommunityInfo(context.Context, *SetCommunityInfoReq) (*SetCommunityInfoResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCommunityInfo not implemented")
+}
+func (UnimplementedGroupExtServer) DismissCommunity(context.Context, *DismissCommunityReq) (*DismissCommunityResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DismissCommunity not implemented")
+}
+func (UnimplementedGroupExtServer) GetCommunitiesInfo(context.Context, *GetCommunitiesInfoReq) (*GetCommunitiesInfoResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommunitiesInfo not implemented")
+}
+func (UnimplementedGroupExtServer) InviteToCommunity(context.Context, *InviteToCommunityReq) (*InviteToCommunityResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteToCommunity not implemented")
+}
+func (UnimplementedGroupExtServer) KickCommunityMember(context.Context, *KickCommunityMemberReq) (*KickCommunityMemberResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KickCommunityMember not implemented")
+}
+func (UnimplementedGroupExtServer) QuitCommunity(context.Context, *QuitCommunityReq) (*QuitCommunityResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuitCommunity not implemented")
+}
+func (UnimplementedGroupExtServer) SetCommunityMemberRole(context.Context, *SetCommunityMemberRoleReq) (*SetCommunityMemberRoleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCommunityMemberRole not implemented")
+}
+func (UnimplementedGroupExtServer) GetCommunityMembers(context.Context, *GetCommunityMembersReq) (*GetCommunityMembersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommunityMembers not implemented")
+}
+func (UnimplementedGroupExtServer) AddCommunityGroups(context.Context, *AddCommunityGroupsReq) (*AddCommunityGroupsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCommunityGroups not implemented")
+}
+func (UnimplementedGroupExtServer) RemoveCommunityGroups(context.Context, *RemoveCommunityGroupsReq) (*RemoveCommunityGroupsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCommunityGroups not implemented")
+}
+func (UnimplementedGroupExtServer) GetIncrementalJoinCommunity(context.Context, *GetIncrementalJoinCommunityReq) (*GetIncrementalJoinCommunityResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIncrementalJoinCommunity not implemented")
+}
+func (UnimplementedGroupExtServer) GetFullJoinCommunityIDs(context.Context, *GetFullJoinCommunityIDsReq) (*GetFullJoinCommunityIDsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFullJoinCommunityIDs not implemented")
+}
+func (UnimplementedGroupExtServer) SetGroupJoinQuestions(context.Context, *SetGroupJoinQuestionsReq) (*SetGroupJoinQuestionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGroupJoinQuestions not implemented")
+}
+func (UnimplementedGroupExtServer) GetGroupJoinQuestions(context.Context, *GetGroupJoinQuestionsReq) (*GetGroupJoinQuestionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupJoinQuestions not implemented")
+}
+func (UnimplementedGroupExtServer) JoinGroupWithAnswers(context.Context, *JoinGroupWithAnswersReq) (*JoinGroupWithAnswersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinGroupWithAnswers not implemented")
+}
+func (UnimplementedGroupExtServer) GetGroupApplicationAnswers(context.Context, *GetGroupApplicationAnswersReq) (*GetGroupApplicationAnswersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupApplicationAnswers not implemented")
+}
+func (UnimplementedGroupExtServer) BatchGroupApplicationResponse(context.Context, *BatchGroupApplicationResponseReq) (*BatchGroupApplicationResponseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGroupApplicationResponse not implemented")
+}
+func (UnimplementedGroupExtServer) ExpireGroupApplications(context.Context, *ExpireGroupApplicationsReq) (*ExpireGroupApplicationsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpireGroupApplications not implemented")
+}
+func (UnimplementedGroupExtServer) AddGroupMemberTag(context.Context, *AddGroupMemberTagReq) (*AddGroupMemberTagResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddGroupMemberTag not implemented")
+}
+func (UnimplementedGroupExtServer) RemoveGroupMemberTag(context.Context, *RemoveGroupMemberTagReq) (*RemoveGroupMemberTagResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveGroupMemberTag not implemented")
+}
+func (UnimplementedGroupExtServer) GetGroupTags(context.Context, *GetGroupTagsReq) (*GetGroupTagsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupTags not implemented")
+}
+func (UnimplementedGroupExtServer) GetGroupMemberTags(context.Context, *GetGroupMemberTagsReq) (*GetGroupMemberTagsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupMemberTags not implemented")
+}
+func (UnimplementedGroupExtServer) GetTaggedMemberUserIDs(context.Context, *GetTaggedMemberUserIDsReq) (*GetTaggedMemberUserIDsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaggedMemberUserIDs not implemented")
+}
+func (UnimplementedGroupExtServer) SetGroupQuota(context.Context, *SetGroupQuotaReq) (*SetGroupQuotaResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGroupQuota not implemented")
+}
+func (UnimplementedGroupExtServer) GetGroupQuota(context.Context, *GetGroupQuotaReq) (*GetGroupQuotaResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupQuota not implemented")
+}
+func (UnimplementedGroupExtServer) SetGroupSuccession(context.Context, *SetGroupSuccessionReq) (*SetGroupSuccessionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGroupSuccession not implemented")
+}
+func (UnimplementedGroupExtServer) GetGroupSuccession(context.Context, *GetGroupSuccessionReq) (*GetGroupSuccessionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupSuccession not implemented")
+}
+func (UnimplementedGroupExtServer) QuitGroupWithSuccession(context.Context, *QuitGroupWithSuccessionReq) (*QuitGroupWithSuccessionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuitGroupWithSuccession not implemented")
+}
+func (UnimplementedGroupExtServer) HandOverOwnedGroups(context.Context, *HandOverOwnedGroupsReq) (*HandOverOwnedGroupsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandOverOwnedGroups not implemented")
+}
+func (UnimplementedGroupExtServer) SucceedInactiveGroupOwners(context.Context, *SucceedInactiveGroupOwnersReq) (*SucceedInactiveGroupOwnersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SucceedInactiveGroupOwners not implemented")
+}
+
+// UnsafeGroupExtServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GroupExtServer will
+// result in compilation errors.
+type UnsafeGroupExtServer interface {
+	mustEmbedUnimplementedGroupExtServer()
+}
+
+func RegisterGroupExtServer(s grpc.ServiceRegistrar, srv GroupExtServer) {
+	s.RegisterService(&GroupExt_ServiceDesc, srv)
+}
+
+func _GroupExt_CreateGroupRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupRoleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).CreateGroupRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_CreateGroupRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).CreateGroupRole(ctx, req.(*CreateGroupRoleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_UpdateGroupRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGroupRoleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).UpdateGroupRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_UpdateGroupRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).UpdateGroupRole(ctx, req.(*UpdateGroupRoleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_DeleteGroupRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGroupRoleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).DeleteGroupRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_DeleteGroupRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).DeleteGroupRole(ctx, req.(*DeleteGroupRoleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_GetGroupRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupRolesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).GetGroupRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_GetGroupRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).GetGroupRoles(ctx, req.(*GetGroupRolesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_SetGroupMemberRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupMemberRoleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).SetGroupMemberRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_SetGroupMemberRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).SetGroupMemberRole(ctx, req.(*SetGroupMemberRoleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_GetGroupMemberPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupMemberPermissionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).GetGroupMemberPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_GetGroupMemberPermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).GetGroupMemberPermissions(ctx, req.(*GetGroupMemberPermissionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_CreateGroupInviteLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupInviteLinkReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).CreateGroupInviteLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_CreateGroupInviteLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).CreateGroupInviteLink(ctx, req.(*CreateGroupInviteLinkReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_GetGroupInviteLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupInviteLinksReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).GetGroupInviteLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_GetGroupInviteLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).GetGroupInviteLinks(ctx, req.(*GetGroupInviteLinksReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_RevokeGroupInviteLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeGroupInviteLinkReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).RevokeGroupInviteLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_RevokeGroupInviteLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).RevokeGroupInviteLink(ctx, req.(*RevokeGroupInviteLinkReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_GetGroupInviteRedemptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupInviteRedemptionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).GetGroupInviteRedemptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_GetGroupInviteRedemptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).GetGroupInviteRedemptions(ctx, req.(*GetGroupInviteRedemptionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_JoinGroupByInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinGroupByInviteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).JoinGroupByInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_JoinGroupByInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).JoinGroupByInvite(ctx, req.(*JoinGroupByInviteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_GetChannelSubscribers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChannelSubscribersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).GetChannelSubscribers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_GetChannelSubscribers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).GetChannelSubscribers(ctx, req.(*GetChannelSubscribersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_CreateCommunity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCommunityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).CreateCommunity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_CreateCommunity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).CreateCommunity(ctx, req.(*CreateCommunityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_SetCommunityInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCommunityInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).SetCommunityInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_SetCommunityInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).SetCommunityInfo(ctx, req.(*SetCommunityInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_DismissCommunity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DismissCommunityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).DismissCommunity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_DismissCommunity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).DismissCommunity(ctx, req.(*DismissCommunityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_GetCommunitiesInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommunitiesInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).GetCommunitiesInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_GetCommunitiesInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).GetCommunitiesInfo(ctx, req.(*GetCommunitiesInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_InviteToCommunity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteToCommunityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).InviteToCommunity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_InviteToCommunity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).InviteToCommunity(ctx, req.(*InviteToCommunityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_KickCommunityMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KickCommunityMemberReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).KickCommunityMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_KickCommunityMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).KickCommunityMember(ctx, req.(*KickCommunityMemberReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_QuitCommunity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuitCommunityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).QuitCommunity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_QuitCommunity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).QuitCommunity(ctx, req.(*QuitCommunityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_SetCommunityMemberRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCommunityMemberRoleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).SetCommunityMemberRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_SetCommunityMemberRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).SetCommunityMemberRole(ctx, req.(*SetCommunityMemberRoleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_GetCommunityMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommunityMembersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).GetCommunityMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_GetCommunityMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).GetCommunityMembers(ctx, req.(*GetCommunityMembersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_AddCommunityGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCommunityGroupsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).AddCommunityGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_AddCommunityGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).AddCommunityGroups(ctx, req.(*AddCommunityGroupsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_RemoveCommunityGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCommunityGroupsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).RemoveCommunityGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_RemoveCommunityGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).RemoveCommunityGroups(ctx, req.(*RemoveCommunityGroupsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_GetIncrementalJoinCommunity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIncrementalJoinCommunityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).GetIncrementalJoinCommunity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_GetIncrementalJoinCommunity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).GetIncrementalJoinCommunity(ctx, req.(*GetIncrementalJoinCommunityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_GetFullJoinCommunityIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFullJoinCommunityIDsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).GetFullJoinCommunityIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_GetFullJoinCommunityIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).GetFullJoinCommunityIDs(ctx, req.(*GetFullJoinCommunityIDsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_SetGroupJoinQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupJoinQuestionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).SetGroupJoinQuestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_SetGroupJoinQuestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).SetGroupJoinQuestions(ctx, req.(*SetGroupJoinQuestionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_GetGroupJoinQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupJoinQuestionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).GetGroupJoinQuestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_GetGroupJoinQuestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).GetGroupJoinQuestions(ctx, req.(*GetGroupJoinQuestionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_JoinGroupWithAnswers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinGroupWithAnswersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).JoinGroupWithAnswers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_JoinGroupWithAnswers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).JoinGroupWithAnswers(ctx, req.(*JoinGroupWithAnswersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_GetGroupApplicationAnswers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupApplicationAnswersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).GetGroupApplicationAnswers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_GetGroupApplicationAnswers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).GetGroupApplicationAnswers(ctx, req.(*GetGroupApplicationAnswersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_BatchGroupApplicationResponse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGroupApplicationResponseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).BatchGroupApplicationResponse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_BatchGroupApplicationResponse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).BatchGroupApplicationResponse(ctx, req.(*BatchGroupApplicationResponseReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_ExpireGroupApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpireGroupApplicationsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).ExpireGroupApplications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_ExpireGroupApplications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).ExpireGroupApplications(ctx, req.(*ExpireGroupApplicationsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_AddGroupMemberTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddGroupMemberTagReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).AddGroupMemberTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_AddGroupMemberTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).AddGroupMemberTag(ctx, req.(*AddGroupMemberTagReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_RemoveGroupMemberTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveGroupMemberTagReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).RemoveGroupMemberTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_RemoveGroupMemberTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).RemoveGroupMemberTag(ctx, req.(*RemoveGroupMemberTagReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_GetGroupTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupTagsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).GetGroupTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_GetGroupTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).GetGroupTags(ctx, req.(*GetGroupTagsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_GetGroupMemberTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupMemberTagsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).GetGroupMemberTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_GetGroupMemberTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).GetGroupMemberTags(ctx, req.(*GetGroupMemberTagsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_GetTaggedMemberUserIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaggedMemberUserIDsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).GetTaggedMemberUserIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_GetTaggedMemberUserIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).GetTaggedMemberUserIDs(ctx, req.(*GetTaggedMemberUserIDsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_SetGroupQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupQuotaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).SetGroupQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_SetGroupQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).SetGroupQuota(ctx, req.(*SetGroupQuotaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_GetGroupQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupQuotaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).GetGroupQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_GetGroupQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).GetGroupQuota(ctx, req.(*GetGroupQuotaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_SetGroupSuccession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupSuccessionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).SetGroupSuccession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_SetGroupSuccession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).SetGroupSuccession(ctx, req.(*SetGroupSuccessionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_GetGroupSuccession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupSuccessionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).GetGroupSuccession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_GetGroupSuccession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).GetGroupSuccession(ctx, req.(*GetGroupSuccessionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_QuitGroupWithSuccession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuitGroupWithSuccessionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).QuitGroupWithSuccession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_QuitGroupWithSuccession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).QuitGroupWithSuccession(ctx, req.(*QuitGroupWithSuccessionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_HandOverOwnedGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandOverOwnedGroupsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).HandOverOwnedGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_HandOverOwnedGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).HandOverOwnedGroups(ctx, req.(*HandOverOwnedGroupsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_SucceedInactiveGroupOwners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SucceedInactiveGroupOwnersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).SucceedInactiveGroupOwners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_SucceedInactiveGroupOwners_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).SucceedInactiveGroupOwners(ctx, req.(*SucceedInactiveGroupOwnersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GroupExt_ServiceDesc is the grpc.ServiceDesc for GroupExt service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GroupExt_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openim.groupext.GroupExt",
+	HandlerType: (*GroupExtServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateGroupRole",
+			Handler:    _GroupExt_CreateGroupRole_Handler,
+		},
+		{
+			MethodName: "UpdateGroupRole",
+			Handler:    _GroupExt_UpdateGroupRole_Handler,
+		},
+		{
+			MethodName: "DeleteGroupRole",
+			Handler:    _GroupExt_DeleteGroupRole_Handler,
+		},
+		{
+			MethodName: "GetGroupRoles",
+			Handler:    _GroupExt_GetGroupRoles_Handler,
+		},
+		{
+			MethodName: "SetGroupMemberRole",
+			Handler:    _GroupExt_SetGroupMemberRole_Handler,
+		},
+		{
+			MethodName: "GetGroupMemberPermissions",
+			Handler:    _GroupExt_GetGroupMemberPermissions_Handler,
+		},
+		{
+			MethodName: "CreateGroupInviteLink",
+			Handler:    _GroupExt_CreateGroupInviteLink_Handler,
+		},
+		{
+			MethodName: "GetGroupInviteLinks",
+			Handler:    _GroupExt_GetGroupInviteLinks_Handler,
+		},
+		{
+			MethodName: "RevokeGroupInviteLink",
+			Handler:    _GroupExt_RevokeGroupInviteLink_Handler,
+		},
+		{
+			MethodName: "GetGroupInviteRedemptions",
+			Handler:    _GroupExt_GetGroupInviteRedemptions_Handler,
+		},
+		{
+			MethodName: "JoinGroupByInvite",
+			Handler:    _GroupExt_JoinGroupByInvite_Handler,
+		},
+		{
+			MethodName: "GetChannelSubscribers",
+			Handler:    _GroupExt_GetChannelSubscribers_Handler,
+		},
+		{
+			MethodName: "CreateCommunity",
+			Handler:    _GroupExt_CreateCommunity_Handler,
+		},
+		{
+			MethodName: "SetCommunityInfo",
+			Handler:    _GroupExt_SetCommunityInfo_Handler,
+		},
+		{
+			MethodName: "DismissCommunity",
+			Handler:    _GroupExt_DismissCommunity_Handler,
+		},
+		{
+			MethodName: "GetCommunitiesInfo",
+			Handler:    _GroupExt_GetCommunitiesInfo_Handler,
+		},
+		{
+			MethodName: "InviteToCommunity",
+			Handler:    _GroupExt_InviteToCommunity_Handler,
+		},
+		{
+			MethodName: "KickCommunityMember",
+			Handler:    _GroupExt_KickCommunityMember_Handler,
+		},
+		{
+			MethodName: "QuitCommunity",
+			Handler:    _GroupExt_QuitCommunity_Handler,
+		},
+		{
+			MethodName: "SetCommunityMemberRole",
+			Handler:    _GroupExt_SetCommunityMemberRole_Handler,
+		},
+		{
+			MethodName: "GetCommunityMembers",
+			Handler:    _GroupExt_GetCommunityMembers_Handler,
+		},
+		{
+			MethodName: "AddCommunityGroups",
+			Handler:    _GroupExt_AddCommunityGroups_Handler,
+		},
+		{
+			MethodName: "RemoveCommunityGroups",
+			Handler:    _GroupExt_RemoveCommunityGroups_Handler,
+		},
+		{
+			MethodName: "GetIncrementalJoinCommunity",
+			Handler:    _GroupExt_GetIncrementalJoinCommunity_Handler,
+		},
+		{
+			MethodName: "GetFullJoinCommunityIDs",
+			Handler:    _GroupExt_GetFullJoinCommunityIDs_Handler,
+		},
+		{
+			MethodName: "SetGroupJoinQuestions",
+			Handler:    _GroupExt_SetGroupJoinQuestions_Handler,
+		},
+		{
+			MethodName: "GetGroupJoinQuestions",
+			Handler:    _GroupExt_GetGroupJoinQuestions_Handler,
+		},
+		{
+			MethodName: "JoinGroupWithAnswers",
+			Handler:    _GroupExt_JoinGroupWithAnswers_Handler,
+		},
+		{
+			MethodName: "GetGroupApplicationAnswers",
+			Handler:    _GroupExt_GetGroupApplicationAnswers_Handler,
+		},
+		{
+			MethodName: "BatchGroupApplicationResponse",
+			Handler:    _GroupExt_BatchGroupApplicationResponse_Handler,
+		},
+		{
+			MethodName: "ExpireGroupApplications",
+			Handler:    _GroupExt_ExpireGroupApplications_Handler,
+		},
+		{
+			MethodName: "AddGroupMemberTag",
+			Handler:    _GroupExt_AddGroupMemberTag_Handler,
+		},
+		{
+			MethodName: "RemoveGroupMemberTag",
+			Handler:    _GroupExt_RemoveGroupMemberTag_Handler,
+		},
+		{
+			MethodName: "GetGroupTags",
+			Handler:    _GroupExt_GetGroupTags_Handler,
+		},
+		{
+			MethodName: "GetGroupMemberTags",
+			Handler:    _GroupExt_GetGroupMemberTags_Handler,
+		},
+		{
+			MethodName: "GetTaggedMemberUserIDs",
+			Handler:    _GroupExt_GetTaggedMemberUserIDs_Handler,
+		},
+		{
+			MethodName: "SetGroupQuota",
+			Handler:    _GroupExt_SetGroupQuota_Handler,
+		},
+		{
+			MethodName: "GetGroupQuota",
+			Handler:    _GroupExt_GetGroupQuota_Handler,
+		},
+		{
+			MethodName: "SetGroupSuccession",
+			Handler:    _GroupExt_SetGroupSuccession_Handler,
+		},
+		{
+			MethodName: "GetGroupSuccession",
+			Handler:    _GroupExt_GetGroupSuccession_Handler,
+		},
+		{
+			MethodName: "QuitGroupWithSuccession",
+			Handler:    _GroupExt_QuitGroupWithSuccession_Handler,
+		},
+		{
+			MethodName: "HandOverOwnedGroups",
+			Handler:    _GroupExt_HandOverOwnedGroups_Handler,
+		},
+		{
+			MethodName: "SucceedInactiveGroupOwners",
+			Handler:    _GroupExt_SucceedInactiveGroupOwners_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "groupext/groupext.proto",
+}
@@ -0,0 +1,35 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupext
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/openimsdk/protocol/constant"
+)
+
+func TestSplitAtTags(t *testing.T) {
+	userIDs, tags := SplitAtTags([]string{"u1", AtTag("frontend"), constant.AtAllString, AtTag("on-call"), "u2"})
+	if want := []string{"u1", constant.AtAllString, "u2"}; !reflect.DeepEqual(userIDs, want) {
+		t.Errorf("SplitAtTags() userIDs = %v, want %v", userIDs, want)
+	}
+	if want := []string{"frontend", "on-call"}; !reflect.DeepEqual(tags, want) {
+		t.Errorf("SplitAtTags() tags = %v, want %v", tags, want)
+	}
+	if userIDs, tags := SplitAtTags(nil); userIDs != nil || tags != nil {
+		t.Errorf("SplitAtTags(nil) = %v, %v", userIDs, tags)
+	}
+}
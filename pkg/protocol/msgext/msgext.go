@@ -0,0 +1,201 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgext
+
+import "errors"
+
+func (x *SearchMsgReq) Check() error {
+	if x.StartTime < 0 || x.EndTime < 0 {
+		return errors.New("startTime or endTime is invalid")
+	}
+	if x.EndTime != 0 && x.EndTime <= x.StartTime {
+		return errors.New("endTime must be greater than startTime")
+	}
+	if x.Count < 0 {
+		return errors.New("count is invalid")
+	}
+	return nil
+}
+
+func (x *SearchUserMsgReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.StartTime < 0 || x.EndTime < 0 {
+		return errors.New("startTime or endTime is invalid")
+	}
+	if x.EndTime != 0 && x.EndTime <= x.StartTime {
+		return errors.New("endTime must be greater than startTime")
+	}
+	if x.Count < 0 {
+		return errors.New("count is invalid")
+	}
+	return nil
+}
+
+func (x *SetGroupReadReceiptReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	return nil
+}
+
+func (x *GetGroupMsgReadStateReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if x.Seq <= 0 {
+		return errors.New("seq is invalid")
+	}
+	return nil
+}
+
+func (x *SetGroupSensitiveWordsReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	switch x.Action {
+	case "", "block", "mask", "flag":
+	default:
+		return errors.New("action must be block, mask or flag")
+	}
+	return nil
+}
+
+func (x *GetGroupSensitiveWordsReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	return nil
+}
+
+func (x *SetGroupMuteRuleReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if x.SlowModeInterval < 0 {
+		return errors.New("slowModeInterval is negative")
+	}
+	for _, schedule := range x.Schedules {
+		if err := schedule.Check(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (x *GroupMuteSchedule) Check() error {
+	const minutesOfDay = 24 * 60
+	if x.StartMinute < 0 || x.StartMinute >= minutesOfDay || x.EndMinute < 0 || x.EndMinute >= minutesOfDay {
+		return errors.New("startMinute or endMinute is not a minute of the day")
+	}
+	if x.StartMinute == x.EndMinute {
+		return errors.New("startMinute and endMinute are equal")
+	}
+	for _, weekday := range x.Weekdays {
+		if weekday < 0 || weekday > 6 {
+			return errors.New("weekday is invalid")
+		}
+	}
+	return nil
+}
+
+func (x *GetGroupMuteRuleReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	return nil
+}
+
+func (x *SearchModerationReviewsReq) Check() error {
+	if x.Status < -1 || x.Status > 2 {
+		return errors.New("status is invalid")
+	}
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errors.New("pageNumber is invalid")
+	}
+	return nil
+}
+
+func (x *SetModerationReviewStatusReq) Check() error {
+	if len(x.ReviewIDs) == 0 {
+		return errors.New("reviewIDs is empty")
+	}
+	if x.Status != 1 && x.Status != 2 {
+		return errors.New("status must be 1 or 2")
+	}
+	return nil
+}
+
+func (x *ForwardMsgReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if len(x.Sources) == 0 {
+		return errors.New("sources is empty")
+	}
+	for _, source := range x.Sources {
+		if source.ConversationID == "" {
+			return errors.New("source conversationID is empty")
+		}
+		if len(source.Seqs) == 0 {
+			return errors.New("source seqs is empty")
+		}
+		for _, seq := range source.Seqs {
+			if seq <= 0 {
+				return errors.New("source seq is invalid")
+			}
+		}
+	}
+	if len(x.TargetConversationIDs) == 0 {
+		return errors.New("targetConversationIDs is empty")
+	}
+	return nil
+}
+
+func (x *CheckConversationSeqReq) Check() error {
+	if x.Count < 0 {
+		return errors.New("count is invalid")
+	}
+	if len(x.ConversationIDs) > 0 && x.Cursor != "" {
+		return errors.New("cursor cannot be used with conversationIDs")
+	}
+	return nil
+}
+
+func (x *GetMsgsBySeqsReq) Check() error {
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if len(x.Seqs) == 0 {
+		return errors.New("seqs is empty")
+	}
+	for _, seq := range x.Seqs {
+		if seq <= 0 {
+			return errors.New("seq is invalid")
+		}
+	}
+	return nil
+}
+
+func (x *SendMsgWithSeqReq) Check() error {
+	if x.MsgData == nil {
+		return errors.New("MsgData is empty")
+	}
+	return x.MsgData.Check()
+}
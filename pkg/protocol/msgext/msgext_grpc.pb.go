@@ -0,0 +1,625 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.27.1
+// source: msgext/msgext.proto
+
+package msgext
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	MsgExt_SearchMsg_FullMethodName                 = "/openim.msgext.MsgExt/SearchMsg"
+	MsgExt_SearchUserMsg_FullMethodName             = "/openim.msgext.MsgExt/SearchUserMsg"
+	MsgExt_SetGroupReadReceipt_FullMethodName       = "/openim.msgext.MsgExt/SetGroupReadReceipt"
+	MsgExt_GetGroupMsgReadState_FullMethodName      = "/openim.msgext.MsgExt/GetGroupMsgReadState"
+	MsgExt_SetGroupSensitiveWords_FullMethodName    = "/openim.msgext.MsgExt/SetGroupSensitiveWords"
+	MsgExt_GetGroupSensitiveWords_FullMethodName    = "/openim.msgext.MsgExt/GetGroupSensitiveWords"
+	MsgExt_SetGroupMuteRule_FullMethodName          = "/openim.msgext.MsgExt/SetGroupMuteRule"
+	MsgExt_GetGroupMuteRule_FullMethodName          = "/openim.msgext.MsgExt/GetGroupMuteRule"
+	MsgExt_SearchModerationReviews_FullMethodName   = "/openim.msgext.MsgExt/SearchModerationReviews"
+	MsgExt_SetModerationReviewStatus_FullMethodName = "/openim.msgext.MsgExt/SetModerationReviewStatus"
+	MsgExt_ForwardMsg_FullMethodName                = "/openim.msgext.MsgExt/ForwardMsg"
+	MsgExt_ArchiveMsg_FullMethodName                = "/openim.msgext.MsgExt/ArchiveMsg"
+	MsgExt_CheckConversationSeq_FullMethodName      = "/openim.msgext.MsgExt/CheckConversationSeq"
+	MsgExt_GetMsgsBySeqs_FullMethodName             = "/openim.msgext.MsgExt/GetMsgsBySeqs"
+	MsgExt_SendMsgWithSeq_FullMethodName            = "/openim.msgext.MsgExt/SendMsgWithSeq"
+)
+
+// MsgExtClient is the client API for MsgExt service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MsgExtClient interface {
+	SearchMsg(ctx context.Context, in *SearchMsgReq, opts ...grpc.CallOption) (*SearchMsgResp, error)
+	SearchUserMsg(ctx context.Context, in *SearchUserMsgReq, opts ...grpc.CallOption) (*SearchUserMsgResp, error)
+	SetGroupReadReceipt(ctx context.Context, in *SetGroupReadReceiptReq, opts ...grpc.CallOption) (*SetGroupReadReceiptResp, error)
+	GetGroupMsgReadState(ctx context.Context, in *GetGroupMsgReadStateReq, opts ...grpc.CallOption) (*GetGroupMsgReadStateResp, error)
+	SetGroupSensitiveWords(ctx context.Context, in *SetGroupSensitiveWordsReq, opts ...grpc.CallOption) (*SetGroupSensitiveWordsResp, error)
+	GetGroupSensitiveWords(ctx context.Context, in *GetGroupSensitiveWordsReq, opts ...grpc.CallOption) (*GetGroupSensitiveWordsResp, error)
+	SetGroupMuteRule(ctx context.Context, in *SetGroupMuteRuleReq, opts ...grpc.CallOption) (*SetGroupMuteRuleResp, error)
+	GetGroupMuteRule(ctx context.Context, in *GetGroupMuteRuleReq, opts ...grpc.CallOption) (*GetGroupMuteRuleResp, error)
+	SearchModerationReviews(ctx context.Context, in *SearchModerationReviewsReq, opts ...grpc.CallOption) (*SearchModerationReviewsResp, error)
+	SetModerationReviewStatus(ctx context.Context, in *SetModerationReviewStatusReq, opts ...grpc.CallOption) (*SetModerationReviewStatusResp, error)
+	ForwardMsg(ctx context.Context, in *ForwardMsgReq, opts ...grpc.CallOption) (*ForwardMsgResp, error)
+	ArchiveMsg(ctx context.Context, in *ArchiveMsgReq, opts ...grpc.CallOption) (*ArchiveMsgResp, error)
+	CheckConversationSeq(ctx context.Context, in *CheckConversationSeqReq, opts ...grpc.CallOption) (*CheckConversationSeqResp, error)
+	GetMsgsBySeqs(ctx context.Context, in *GetMsgsBySeqsReq, opts ...grpc.CallOption) (*GetMsgsBySeqsResp, error)
+	SendMsgWithSeq(ctx context.Context, in *SendMsgWithSeqReq, opts ...grpc.CallOption) (*SendMsgWithSeqResp, error)
+}
+
+type msgExtClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgExtClient(cc grpc.ClientConnInterface) MsgExtClient {
+	return &msgExtClient{cc}
+}
+
+func (c *msgExtClient) SearchMsg(ctx context.Context, in *SearchMsgReq, opts ...grpc.CallOption) (*SearchMsgResp, error) {
+	out := new(SearchMsgResp)
+	err := c.cc.Invoke(ctx, MsgExt_SearchMsg_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) SearchUserMsg(ctx context.Context, in *SearchUserMsgReq, opts ...grpc.CallOption) (*SearchUserMsgResp, error) {
+	out := new(SearchUserMsgResp)
+	err := c.cc.Invoke(ctx, MsgExt_SearchUserMsg_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) SetGroupReadReceipt(ctx context.Context, in *SetGroupReadReceiptReq, opts ...grpc.CallOption) (*SetGroupReadReceiptResp, error) {
+	out := new(SetGroupReadReceiptResp)
+	err := c.cc.Invoke(ctx, MsgExt_SetGroupReadReceipt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetGroupMsgReadState(ctx context.Context, in *GetGroupMsgReadStateReq, opts ...grpc.CallOption) (*GetGroupMsgReadStateResp, error) {
+	out := new(GetGroupMsgReadStateResp)
+	err := c.cc.Invoke(ctx, MsgExt_GetGroupMsgReadState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) SetGroupSensitiveWords(ctx context.Context, in *SetGroupSensitiveWordsReq, opts ...grpc.CallOption) (*SetGroupSensitiveWordsResp, error) {
+	out := new(SetGroupSensitiveWordsResp)
+	err := c.cc.Invoke(ctx, MsgExt_SetGroupSensitiveWords_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetGroupSensitiveWords(ctx context.Context, in *GetGroupSensitiveWordsReq, opts ...grpc.CallOption) (*GetGroupSensitiveWordsResp, error) {
+	out := new(GetGroupSensitiveWordsResp)
+	err := c.cc.Invoke(ctx, MsgExt_GetGroupSensitiveWords_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) SetGroupMuteRule(ctx context.Context, in *SetGroupMuteRuleReq, opts ...grpc.CallOption) (*SetGroupMuteRuleResp, error) {
+	out := new(SetGroupMuteRuleResp)
+	err := c.cc.Invoke(ctx, MsgExt_SetGroupMuteRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetGroupMuteRule(ctx context.Context, in *GetGroupMuteRuleReq, opts ...grpc.CallOption) (*GetGroupMuteRuleResp, error) {
+	out := new(GetGroupMuteRuleResp)
+	err := c.cc.Invoke(ctx, MsgExt_GetGroupMuteRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) SearchModerationReviews(ctx context.Context, in *SearchModerationReviewsReq, opts ...grpc.CallOption) (*SearchModerationReviewsResp, error) {
+	out := new(SearchModerationReviewsResp)
+	err := c.cc.Invoke(ctx, MsgExt_SearchModerationReviews_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) SetModerationReviewStatus(ctx context.Context, in *SetModerationReviewStatusReq, opts ...grpc.CallOption) (*SetModerationReviewStatusResp, error) {
+	out := new(SetModerationReviewStatusResp)
+	err := c.cc.Invoke(ctx, MsgExt_SetModerationReviewStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) ForwardMsg(ctx context.Context, in *ForwardMsgReq, opts ...grpc.CallOption) (*ForwardMsgResp, error) {
+	out := new(ForwardMsgResp)
+	err := c.cc.Invoke(ctx, MsgExt_ForwardMsg_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) ArchiveMsg(ctx context.Context, in *ArchiveMsgReq, opts ...grpc.CallOption) (*ArchiveMsgResp, error) {
+	out := new(ArchiveMsgResp)
+	err := c.cc.Invoke(ctx, MsgExt_ArchiveMsg_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) CheckConversationSeq(ctx context.Context, in *CheckConversationSeqReq, opts ...grpc.CallOption) (*CheckConversationSeqResp, error) {
+	out := new(CheckConversationSeqResp)
+	err := c.cc.Invoke(ctx, MsgExt_CheckConversationSeq_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetMsgsBySeqs(ctx context.Context, in *GetMsgsBySeqsReq, opts ...grpc.CallOption) (*GetMsgsBySeqsResp, error) {
+	out := new(GetMsgsBySeqsResp)
+	err := c.cc.Invoke(ctx, MsgExt_GetMsgsBySeqs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) SendMsgWithSeq(ctx context.Context, in *SendMsgWithSeqReq, opts ...grpc.CallOption) (*SendMsgWithSeqResp, error) {
+	out := new(SendMsgWithSeqResp)
+	err := c.cc.Invoke(ctx, MsgExt_SendMsgWithSeq_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgExtServer is the server API for MsgExt service.
+// All implementations should embed UnimplementedMsgExtServer
+// for forward compatibility
+type MsgExtServer interface {
+	SearchMsg(context.Context, *SearchMsgReq) (*SearchMsgResp, error)
+	SearchUserMsg(context.Context, *SearchUserMsgReq) (*SearchUserMsgResp, error)
+	SetGroupReadReceipt(context.Context, *SetGroupReadReceiptReq) (*SetGroupReadReceiptResp, error)
+	GetGroupMsgReadState(context.Context, *GetGroupMsgReadStateReq) (*GetGroupMsgReadStateResp, error)
+	SetGroupSensitiveWords(context.Context, *SetGroupSensitiveWordsReq) (*SetGroupSensitiveWordsResp, error)
+	GetGroupSensitiveWords(context.Context, *GetGroupSensitiveWordsReq) (*GetGroupSensitiveWordsResp, error)
+	SetGroupMuteRule(context.Context, *SetGroupMuteRuleReq) (*SetGroupMuteRuleResp, error)
+	GetGroupMuteRule(context.Context, *GetGroupMuteRuleReq) (*GetGroupMuteRuleResp, error)
+	SearchModerationReviews(context.Context, *SearchModerationReviewsReq) (*SearchModerationReviewsResp, error)
+	SetModerationReviewStatus(context.Context, *SetModerationReviewStatusReq) (*SetModerationReviewStatusResp, error)
+	ForwardMsg(context.Context, *ForwardMsgReq) (*ForwardMsgResp, error)
+	ArchiveMsg(context.Context, *ArchiveMsgReq) (*ArchiveMsgResp, error)
+	CheckConversationSeq(context.Context, *CheckConversationSeqReq) (*CheckConversationSeqResp, error)
+	GetMsgsBySeqs(context.Context, *GetMsgsBySeqsReq) (*GetMsgsBySeqsResp, error)
+	SendMsgWithSeq(context.Context, *SendMsgWithSeqReq) (*SendMsgWithSeqResp, error)
+}
+
+// UnimplementedMsgExtServer should be embedded to have forward compatible implementations.
+type UnimplementedMsgExtServer struct {
+}
+
+func (UnimplementedMsgExtServer) SearchMsg(context.Context, *SearchMsgReq) (*SearchMsgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchMsg not implemented")
+}
+func (UnimplementedMsgExtServer) SearchUserMsg(context.Context, *SearchUserMsgReq) (*SearchUserMsgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchUserMsg not implemented")
+}
+func (UnimplementedMsgExtServer) SetGroupReadReceipt(context.Context, *SetGroupReadReceiptReq) (*SetGroupReadReceiptResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGroupReadReceipt not implemented")
+}
+func (UnimplementedMsgExtServer) GetGroupMsgReadState(context.Context, *GetGroupMsgReadStateReq) (*GetGroupMsgReadStateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupMsgReadState not implemented")
+}
+func (UnimplementedMsgExtServer) SetGroupSensitiveWords(context.Context, *SetGroupSensitiveWordsReq) (*SetGroupSensitiveWordsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGroupSensitiveWords not implemented")
+}
+func (UnimplementedMsgExtServer) GetGroupSensitiveWords(context.Context, *GetGroupSensitiveWordsReq) (*GetGroupSensitiveWordsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupSensitiveWords not implemented")
+}
+func (UnimplementedMsgExtServer) SetGroupMuteRule(context.Context, *SetGroupMuteRuleReq) (*SetGroupMuteRuleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGroupMuteRule not implemented")
+}
+func (UnimplementedMsgExtServer) GetGroupMuteRule(context.Context, *GetGroupMuteRuleReq) (*GetGroupMuteRuleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupMuteRule not implemented")
+}
+func (UnimplementedMsgExtServer) SearchModerationReviews(context.Context, *SearchModerationReviewsReq) (*SearchModerationReviewsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchModerationReviews not implemented")
+}
+func (UnimplementedMsgExtServer) SetModerationReviewStatus(context.Context, *SetModerationReviewStatusReq) (*SetModerationReviewStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetModerationReviewStatus not implemented")
+}
+func (UnimplementedMsgExtServer) ForwardMsg(context.Context, *ForwardMsgReq) (*ForwardMsgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForwardMsg not implemented")
+}
+func (UnimplementedMsgExtServer) ArchiveMsg(context.Context, *ArchiveMsgReq) (*ArchiveMsgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveMsg not implemented")
+}
+func (UnimplementedMsgExtServer) CheckConversationSeq(context.Context, *CheckConversationSeqReq) (*CheckConversationSeqResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckConversationSeq not implemented")
+}
+func (UnimplementedMsgExtServer) GetMsgsBySeqs(context.Context, *GetMsgsBySeqsReq) (*GetMsgsBySeqsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMsgsBySeqs not implemented")
+}
+func (UnimplementedMsgExtServer) SendMsgWithSeq(context.Context, *SendMsgWithSeqReq) (*SendMsgWithSeqResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendMsgWithSeq not implemented")
+}
+
+// UnsafeMsgExtServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgExtServer will
+// result in compilation errors.
+type UnsafeMsgExtServer interface {
+	mustEmbedUnimplementedMsgExtServer()
+}
+
+func RegisterMsgExtServer(s grpc.ServiceRegistrar, srv MsgExtServer) {
+	s.RegisterService(&MsgExt_ServiceDesc, srv)
+}
+
+func _MsgExt_SearchMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).SearchMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_SearchMsg_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).SearchMsg(ctx, req.(*SearchMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_SearchUserMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUserMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).SearchUserMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_SearchUserMsg_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).SearchUserMsg(ctx, req.(*SearchUserMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_SetGroupReadReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupReadReceiptReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).SetGroupReadReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_SetGroupReadReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).SetGroupReadReceipt(ctx, req.(*SetGroupReadReceiptReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetGroupMsgReadState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupMsgReadStateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetGroupMsgReadState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_GetGroupMsgReadState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetGroupMsgReadState(ctx, req.(*GetGroupMsgReadStateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_SetGroupSensitiveWords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupSensitiveWordsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).SetGroupSensitiveWords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_SetGroupSensitiveWords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).SetGroupSensitiveWords(ctx, req.(*SetGroupSensitiveWordsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetGroupSensitiveWords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupSensitiveWordsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetGroupSensitiveWords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_GetGroupSensitiveWords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetGroupSensitiveWords(ctx, req.(*GetGroupSensitiveWordsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_SetGroupMuteRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupMuteRuleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).SetGroupMuteRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_SetGroupMuteRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).SetGroupMuteRule(ctx, req.(*SetGroupMuteRuleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetGroupMuteRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupMuteRuleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetGroupMuteRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_GetGroupMuteRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetGroupMuteRule(ctx, req.(*GetGroupMuteRuleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_SearchModerationReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchModerationReviewsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).SearchModerationReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_SearchModerationReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).SearchModerationReviews(ctx, req.(*SearchModerationReviewsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_SetModerationReviewStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetModerationReviewStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).SetModerationReviewStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_SetModerationReviewStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).SetModerationReviewStatus(ctx, req.(*SetModerationReviewStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_ForwardMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForwardMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).ForwardMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_ForwardMsg_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).ForwardMsg(ctx, req.(*ForwardMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_ArchiveMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).ArchiveMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_ArchiveMsg_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).ArchiveMsg(ctx, req.(*ArchiveMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_CheckConversationSeq_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckConversationSeqReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).CheckConversationSeq(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_CheckConversationSeq_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).CheckConversationSeq(ctx, req.(*CheckConversationSeqReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetMsgsBySeqs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMsgsBySeqsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetMsgsBySeqs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_GetMsgsBySeqs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetMsgsBySeqs(ctx, req.(*GetMsgsBySeqsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_SendMsgWithSeq_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendMsgWithSeqReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).SendMsgWithSeq(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_SendMsgWithSeq_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).SendMsgWithSeq(ctx, req.(*SendMsgWithSeqReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MsgExt_ServiceDesc is the grpc.ServiceDesc for MsgExt service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MsgExt_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openim.msgext.MsgExt",
+	HandlerType: (*MsgExtServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SearchMsg",
+			Handler:    _MsgExt_SearchMsg_Handler,
+		},
+		{
+			MethodName: "SearchUserMsg",
+			Handler:    _MsgExt_SearchUserMsg_Handler,
+		},
+		{
+			MethodName: "SetGroupReadReceipt",
+			Handler:    _MsgExt_SetGroupReadReceipt_Handler,
+		},
+		{
+			MethodName: "GetGroupMsgReadState",
+			Handler:    _MsgExt_GetGroupMsgReadState_Handler,
+		},
+		{
+			MethodName: "SetGroupSensitiveWords",
+			Handler:    _MsgExt_SetGroupSensitiveWords_Handler,
+		},
+		{
+			MethodName: "GetGroupSensitiveWords",
+			Handler:    _MsgExt_GetGroupSensitiveWords_Handler,
+		},
+		{
+			MethodName: "SetGroupMuteRule",
+			Handler:    _MsgExt_SetGroupMuteRule_Handler,
+		},
+		{
+			MethodName: "GetGroupMuteRule",
+			Handler:    _MsgExt_GetGroupMuteRule_Handler,
+		},
+		{
+			MethodName: "SearchModerationReviews",
+			Handler:    _MsgExt_SearchModerationReviews_Handler,
+		},
+		{
+			MethodName: "SetModerationReviewStatus",
+			Handler:    _MsgExt_SetModerationReviewStatus_Handler,
+		},
+		{
+			MethodName: "ForwardMsg",
+			Handler:    _MsgExt_ForwardMsg_Handler,
+		},
+		{
+			MethodName: "ArchiveMsg",
+			Handler:    _MsgExt_ArchiveMsg_Handler,
+		},
+		{
+			MethodName: "CheckConversationSeq",
+			Handler:    _MsgExt_CheckConversationSeq_Handler,
+		},
+		{
+			MethodName: "GetMsgsBySeqs",
+			Handler:    _MsgExt_GetMsgsBySeqs_Handler,
+		},
+		{
+			MethodName: "SendMsgWithSeq",
+			Handler:    _MsgExt_SendMsgWithSeq_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "msgext/msgext.proto",
+}
@@ -0,0 +1,115 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relationext
+
+import (
+	"errors"
+	"unicode/utf8"
+)
+
+// FriendGroupsChangedNotification is the content type of the notification telling the devices
+// of a user that their friend groups changed, in the range of friend notifications after
+// those of the upstream protocol.
+const FriendGroupsChangedNotification = 1230
+
+// MaxFriendGroups is how many friend groups a user can have.
+const MaxFriendGroups = 100
+
+// MaxFriendGroupNameLen is the longest a friend group name can be, in characters.
+const MaxFriendGroupNameLen = 32
+
+func checkFriendGroupName(name string) error {
+	if name == "" {
+		return errors.New("name is empty")
+	}
+	if utf8.RuneCountInString(name) > MaxFriendGroupNameLen {
+		return errors.New("name is too long")
+	}
+	return nil
+}
+
+func (x *CreateFriendGroupReq) Check() error {
+	if x.OwnerUserID == "" {
+		return errors.New("ownerUserID is empty")
+	}
+	return checkFriendGroupName(x.Name)
+}
+
+func (x *SetFriendGroupNameReq) Check() error {
+	if x.OwnerUserID == "" {
+		return errors.New("ownerUserID is empty")
+	}
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	return checkFriendGroupName(x.Name)
+}
+
+func (x *DeleteFriendGroupReq) Check() error {
+	if x.OwnerUserID == "" {
+		return errors.New("ownerUserID is empty")
+	}
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	return nil
+}
+
+func (x *SortFriendGroupsReq) Check() error {
+	if x.OwnerUserID == "" {
+		return errors.New("ownerUserID is empty")
+	}
+	return nil
+}
+
+func (x *AddFriendGroupMembersReq) Check() error {
+	if x.OwnerUserID == "" {
+		return errors.New("ownerUserID is empty")
+	}
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if len(x.FriendUserIDs) == 0 {
+		return errors.New("friendUserIDs is empty")
+	}
+	return nil
+}
+
+func (x *RemoveFriendGroupMembersReq) Check() error {
+	if x.OwnerUserID == "" {
+		return errors.New("ownerUserID is empty")
+	}
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if len(x.FriendUserIDs) == 0 {
+		return errors.New("friendUserIDs is empty")
+	}
+	return nil
+}
+
+func (x *GetFriendGroupsReq) Check() error {
+	if x.OwnerUserID == "" {
+		return errors.New("ownerUserID is empty")
+	}
+	return nil
+}
+
+func (x *GetIncrementalFriendGroupsReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tenant
+
+import "errors"
+
+func (x *CreateTenantReq) Check() error {
+	if x.Tenant == nil {
+		return errors.New("tenant is empty")
+	}
+	if x.Tenant.TenantID == "" {
+		return errors.New("tenantID is empty")
+	}
+	if x.Tenant.Secret == "" {
+		return errors.New("secret is empty")
+	}
+	if len(x.Tenant.AdminUserIDs) == 0 {
+		return errors.New("adminUserIDs is empty")
+	}
+	return nil
+}
+
+func (x *UpdateTenantReq) Check() error {
+	if x.TenantID == "" {
+		return errors.New("tenantID is empty")
+	}
+	if x.Secret != nil && x.Secret.Value == "" {
+		return errors.New("secret is empty")
+	}
+	return nil
+}
+
+func (x *DeleteTenantReq) Check() error {
+	if x.TenantID == "" {
+		return errors.New("tenantID is empty")
+	}
+	return nil
+}
+
+func (x *GetTenantReq) Check() error {
+	if x.TenantID == "" {
+		return errors.New("tenantID is empty")
+	}
+	return nil
+}
+
+func (x *GetTenantsReq) Check() error {
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	return nil
+}
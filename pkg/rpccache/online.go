@@ -3,6 +3,7 @@ package rpccache
 import (
 	"context"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/open-im-server/v3/pkg/localcache"
 	"github.com/openimsdk/open-im-server/v3/pkg/localcache/lru"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
@@ -26,15 +27,16 @@ func NewOnlineCache(user rpcclient.UserRpcClient, group *GroupLocalCache, rdb re
 	go func() {
 		ctx := mcontext.SetOperationID(context.Background(), cachekey.OnlineChannel+strconv.FormatUint(rand.Uint64(), 10))
 		for message := range rdb.Subscribe(ctx, cachekey.OnlineChannel).Channel() {
-			userID, platformIDs, err := useronline.ParseUserOnlineStatus(message.Payload)
+			userKey, platformIDs, err := useronline.ParseUserOnlineStatus(message.Payload)
 			if err != nil {
 				log.ZError(ctx, "OnlineCache setUserOnline redis subscribe parseUserOnlineStatus", err, "payload", message.Payload, "channel", message.Channel)
 				continue
 			}
-			storageCache := x.setUserOnline(userID, platformIDs)
-			log.ZDebug(ctx, "OnlineCache setUserOnline", "userID", userID, "platformIDs", platformIDs, "payload", message.Payload, "storageCache", storageCache)
+			storageCache := x.setUserOnline(userKey, platformIDs)
+			log.ZDebug(ctx, "OnlineCache setUserOnline", "userKey", userKey, "platformIDs", platformIDs, "payload", message.Payload, "storageCache", storageCache)
 			if fn != nil {
-				fn(ctx, userID, platformIDs)
+				tenantID, userID := tenant.SplitKey(userKey)
+				fn(tenant.WithID(ctx, tenantID), userID, platformIDs)
 			}
 		}
 	}()
@@ -48,7 +50,7 @@ type OnlineCache struct {
 }
 
 func (o *OnlineCache) getUserOnlinePlatform(ctx context.Context, userID string) ([]int32, error) {
-	platformIDs, err := o.local.Get(userID, func() ([]int32, error) {
+	platformIDs, err := o.local.Get(tenant.WithKey(ctx, userID), func() ([]int32, error) {
 		return o.user.GetUserOnlinePlatform(ctx, userID)
 	})
 	if err != nil {
@@ -111,6 +113,7 @@ func (o *OnlineCache) GetUserOnline(ctx context.Context, userID string) (bool, e
 //	return onlineUserIDs, nil
 //}
 
-func (o *OnlineCache) setUserOnline(userID string, platformIDs []int32) bool {
-	return o.local.SetHas(userID, platformIDs)
+// setUserOnline takes the user ID scoped to its tenant.
+func (o *OnlineCache) setUserOnline(userKey string, platformIDs []int32) bool {
+	return o.local.SetHas(userKey, platformIDs)
 }
@@ -17,6 +17,7 @@ package rpcclient
 import (
 	"context"
 
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/relationext"
 	"github.com/openimsdk/protocol/relation"
 	sdkws "github.com/openimsdk/protocol/sdkws"
 	"github.com/openimsdk/tools/discovery"
@@ -25,9 +26,10 @@ import (
 )
 
 type Friend struct {
-	conn   grpc.ClientConnInterface
-	Client relation.FriendClient
-	discov discovery.SvcDiscoveryRegistry
+	conn      grpc.ClientConnInterface
+	Client    relation.FriendClient
+	ExtClient relationext.RelationExtClient
+	discov    discovery.SvcDiscoveryRegistry
 }
 
 func NewFriend(discov discovery.SvcDiscoveryRegistry, rpcRegisterName string) *Friend {
@@ -36,7 +38,7 @@ func NewFriend(discov discovery.SvcDiscoveryRegistry, rpcRegisterName string) *F
 		program.ExitWithError(err)
 	}
 	client := relation.NewFriendClient(conn)
-	return &Friend{discov: discov, conn: conn, Client: client}
+	return &Friend{discov: discov, conn: conn, Client: client, ExtClient: relationext.NewRelationExtClient(conn)}
 }
 
 type FriendRpcClient Friend
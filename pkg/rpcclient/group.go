@@ -19,6 +19,7 @@ import (
 	"strings"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/group"
 	"github.com/openimsdk/protocol/sdkws"
@@ -28,8 +29,9 @@ import (
 )
 
 type Group struct {
-	Client group.GroupClient
-	discov discovery.SvcDiscoveryRegistry
+	Client    group.GroupClient
+	ExtClient groupext.GroupExtClient
+	discov    discovery.SvcDiscoveryRegistry
 }
 
 func NewGroup(discov discovery.SvcDiscoveryRegistry, rpcRegisterName string) *Group {
@@ -38,7 +40,7 @@ func NewGroup(discov discovery.SvcDiscoveryRegistry, rpcRegisterName string) *Gr
 		program.ExitWithError(err)
 	}
 	client := group.NewGroupClient(conn)
-	return &Group{discov: discov, Client: client}
+	return &Group{discov: discov, Client: client, ExtClient: groupext.NewGroupExtClient(conn)}
 }
 
 type GroupRpcClient Group
@@ -203,3 +205,17 @@ func (g *GroupRpcClient) NotificationUserInfoUpdate(ctx context.Context, userID
 	})
 	return err
 }
+
+// ExpandAtUserIDs returns the entries of atUserIDList with the member tags mentioned in it
+// expanded to the members under them.
+func (g *GroupRpcClient) ExpandAtUserIDs(ctx context.Context, groupID string, atUserIDList []string) ([]string, error) {
+	userIDs, tags := groupext.SplitAtTags(atUserIDList)
+	if len(tags) == 0 {
+		return atUserIDList, nil
+	}
+	resp, err := g.ExtClient.GetTaggedMemberUserIDs(ctx, &groupext.GetTaggedMemberUserIDsReq{GroupID: groupID, Tags: tags})
+	if err != nil {
+		return userIDs, err
+	}
+	return datautil.Distinct(append(userIDs, resp.UserIDs...)), nil
+}
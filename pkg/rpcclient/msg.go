@@ -18,6 +18,10 @@ import (
 	"context"
 	"encoding/json"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/relationext"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/msg"
 	"github.com/openimsdk/protocol/sdkws"
@@ -31,6 +35,7 @@ import (
 	"github.com/openimsdk/tools/utils/timeutil"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
+	"sync"
 	"time"
 )
 
@@ -57,6 +62,7 @@ func newContentTypeConf(conf *config.Notification) map[int32]config.Notification
 		constant.GroupMemberSetToOrdinaryUserNotification: conf.GroupMemberSetToOrdinary,
 		constant.GroupInfoSetAnnouncementNotification:     conf.GroupInfoSetAnnouncement,
 		constant.GroupInfoSetNameNotification:             conf.GroupInfoSetName,
+		groupext.GroupRoleChangedNotification:             conf.GroupRoleChanged,
 		// user
 		constant.UserInfoUpdatedNotification:  conf.UserInfoUpdated,
 		constant.UserStatusChangeNotification: conf.UserStatusChanged,
@@ -71,6 +77,7 @@ func newContentTypeConf(conf *config.Notification) map[int32]config.Notification
 		constant.BlackDeletedNotification:              conf.BlackDeleted,
 		constant.FriendInfoUpdatedNotification:         conf.FriendInfoUpdated,
 		constant.FriendsInfoUpdateNotification:         conf.FriendInfoUpdated, // use the same FriendInfoUpdated
+		relationext.FriendGroupsChangedNotification:    conf.FriendInfoUpdated,
 		// conversation
 		constant.ConversationChangeNotification:      conf.ConversationChanged,
 		constant.ConversationUnreadNotification:      conf.ConversationChanged,
@@ -105,6 +112,7 @@ func newSessionTypeConf() map[int32]int32 {
 		constant.GroupMemberSetToOrdinaryUserNotification: constant.ReadGroupChatType,
 		constant.GroupInfoSetAnnouncementNotification:     constant.ReadGroupChatType,
 		constant.GroupInfoSetNameNotification:             constant.ReadGroupChatType,
+		groupext.GroupRoleChangedNotification:             constant.ReadGroupChatType,
 		// user
 		constant.UserInfoUpdatedNotification:  constant.SingleChatType,
 		constant.UserStatusChangeNotification: constant.SingleChatType,
@@ -118,6 +126,7 @@ func newSessionTypeConf() map[int32]int32 {
 		constant.BlackAddedNotification:                constant.SingleChatType,
 		constant.BlackDeletedNotification:              constant.SingleChatType,
 		constant.FriendInfoUpdatedNotification:         constant.SingleChatType,
+		relationext.FriendGroupsChangedNotification:    constant.SingleChatType,
 		constant.FriendsInfoUpdateNotification:         constant.SingleChatType,
 		// conversation
 		constant.ConversationChangeNotification:      constant.SingleChatType,
@@ -129,9 +138,10 @@ func newSessionTypeConf() map[int32]int32 {
 }
 
 type Message struct {
-	conn   grpc.ClientConnInterface
-	Client msg.MsgClient
-	discov discovery.SvcDiscoveryRegistry
+	conn      grpc.ClientConnInterface
+	Client    msg.MsgClient
+	ExtClient msgext.MsgExtClient
+	discov    discovery.SvcDiscoveryRegistry
 }
 
 func NewMessage(discov discovery.SvcDiscoveryRegistry, rpcRegisterName string) *Message {
@@ -140,7 +150,7 @@ func NewMessage(discov discovery.SvcDiscoveryRegistry, rpcRegisterName string) *
 		program.ExitWithError(err)
 	}
 	client := msg.NewMsgClient(conn)
-	return &Message{discov: discov, conn: conn, Client: client}
+	return &Message{discov: discov, conn: conn, Client: client, ExtClient: msgext.NewMsgExtClient(conn)}
 }
 
 type MessageRpcClient Message
@@ -227,7 +237,9 @@ func (m *MessageRpcClient) ClearMsg(ctx context.Context, ts int64) error {
 }
 
 type NotificationSender struct {
+	conf            *config.Notification
 	contentTypeConf map[int32]config.NotificationConfig
+	tenantConf      sync.Map // tenant ID -> *tenantNotificationConf
 	sessionTypeConf map[int32]int32
 	sendMsg         func(ctx context.Context, req *msg.SendMsgReq) (*msg.SendMsgResp, error)
 	getUserInfo     func(ctx context.Context, userID string) (*sdkws.UserInfo, error)
@@ -266,7 +278,7 @@ const (
 )
 
 func NewNotificationSender(conf *config.Notification, opts ...NotificationSenderOptions) *NotificationSender {
-	notificationSender := &NotificationSender{contentTypeConf: newContentTypeConf(conf), sessionTypeConf: newSessionTypeConf()}
+	notificationSender := &NotificationSender{conf: conf, contentTypeConf: newContentTypeConf(conf), sessionTypeConf: newSessionTypeConf()}
 	for _, opt := range opts {
 		opt(notificationSender)
 	}
@@ -276,6 +288,33 @@ func NewNotificationSender(conf *config.Notification, opts ...NotificationSender
 	return notificationSender
 }
 
+type tenantNotificationConf struct {
+	data            string
+	contentTypeConf map[int32]config.NotificationConfig
+}
+
+// getContentTypeConf returns the notification config of the tenant of ctx, which overrides
+// entries of notification.yml.
+func (s *NotificationSender) getContentTypeConf(ctx context.Context) (map[int32]config.NotificationConfig, error) {
+	info, err := tenant.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if info == nil || info.Notification == "" {
+		return s.contentTypeConf, nil
+	}
+	if v, ok := s.tenantConf.Load(info.TenantID); ok && v.(*tenantNotificationConf).data == info.Notification {
+		return v.(*tenantNotificationConf).contentTypeConf, nil
+	}
+	conf, err := config.MergeNotification(s.conf, info.Notification)
+	if err != nil {
+		return nil, err
+	}
+	contentTypeConf := newContentTypeConf(conf)
+	s.tenantConf.Store(info.TenantID, &tenantNotificationConf{data: info.Notification, contentTypeConf: contentTypeConf})
+	return contentTypeConf, nil
+}
+
 type notificationOpt struct {
 	WithRpcGetUsername bool
 }
@@ -289,7 +328,7 @@ func WithRpcGetUserName() NotificationOptions {
 }
 
 func (s *NotificationSender) send(ctx context.Context, sendID, recvID string, contentType, sessionType int32, m proto.Message, opts ...NotificationOptions) {
-	ctx = mcontext.WithMustInfoCtx([]string{mcontext.GetOperationID(ctx), mcontext.GetOpUserID(ctx), mcontext.GetOpUserPlatform(ctx), mcontext.GetConnID(ctx)})
+	ctx = tenant.Inherit(mcontext.WithMustInfoCtx([]string{mcontext.GetOperationID(ctx), mcontext.GetOpUserID(ctx), mcontext.GetOpUserPlatform(ctx), mcontext.GetConnID(ctx)}), ctx)
 	ctx, cancel := context.WithTimeout(ctx, time.Second*time.Duration(5))
 	defer cancel()
 	n := sdkws.NotificationElem{Detail: jsonutil.StructToJsonString(m)}
@@ -326,7 +365,12 @@ func (s *NotificationSender) send(ctx context.Context, sendID, recvID string, co
 	}
 	msg.CreateTime = timeutil.GetCurrentTimestampByMill()
 	msg.ClientMsgID = idutil.GetMsgIDByMD5(sendID)
-	optionsConfig := s.contentTypeConf[contentType]
+	contentTypeConf, err := s.getContentTypeConf(ctx)
+	if err != nil {
+		log.ZWarn(ctx, "get notification config failed", err, "contentType", contentType)
+		return
+	}
+	optionsConfig := contentTypeConf[contentType]
 	if sendID == recvID && contentType == constant.HasReadReceipt {
 		optionsConfig.ReliabilityLevel = constant.UnreliableNotification
 	}
@@ -0,0 +1,45 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpcclient
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	pbtenant "github.com/openimsdk/open-im-server/v3/pkg/protocol/tenant"
+	"github.com/openimsdk/tools/discovery"
+	"github.com/openimsdk/tools/mcontext"
+)
+
+// NewTenantResolver resolves tenants through the tenant service of openim-rpc-user. The
+// lookups are made as the first admin of the default tenant.
+func NewTenantResolver(discov discovery.SvcDiscoveryRegistry, rpcRegisterName string, imAdminUserID []string) tenant.Resolver {
+	return tenant.NewCachedResolver(func(ctx context.Context, tenantID string) (*tenant.Info, error) {
+		conn, err := discov.GetConn(ctx, rpcRegisterName)
+		if err != nil {
+			return nil, err
+		}
+		ctx = tenant.WithID(ctx, "")
+		if len(imAdminUserID) > 0 {
+			ctx = mcontext.WithOpUserIDContext(ctx, imAdminUserID[0])
+		}
+		resp, err := pbtenant.NewTenantClient(conn).GetTenant(ctx, &pbtenant.GetTenantReq{TenantID: tenantID})
+		if err != nil {
+			return nil, err
+		}
+		return convert.TenantPb2Info(resp.Tenant), nil
+	}, tenant.CacheTTL)
+}
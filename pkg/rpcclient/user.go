@@ -20,6 +20,8 @@ import (
 
 	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/tenant"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/userext"
 	"github.com/openimsdk/protocol/sdkws"
 	"github.com/openimsdk/protocol/user"
 	"github.com/openimsdk/tools/discovery"
@@ -32,6 +34,8 @@ import (
 type User struct {
 	conn                  grpc.ClientConnInterface
 	Client                user.UserClient
+	ExtClient             userext.UserExtClient
+	TenantClient          tenant.TenantClient
 	Discov                discovery.SvcDiscoveryRegistry
 	MessageGateWayRpcName string
 	imAdminUserID         []string
@@ -46,6 +50,8 @@ func NewUser(discov discovery.SvcDiscoveryRegistry, rpcRegisterName, messageGate
 	}
 	client := user.NewUserClient(conn)
 	return &User{Discov: discov, Client: client,
+		ExtClient:             userext.NewUserExtClient(conn),
+		TenantClient:          tenant.NewTenantClient(conn),
 		conn:                  conn,
 		MessageGateWayRpcName: messageGateWayRpcName,
 		imAdminUserID:         imAdminUserID}
@@ -94,6 +100,18 @@ func (u *UserRpcClient) GetUserInfo(ctx context.Context, userID string) (*sdkws.
 	return users[0], nil
 }
 
+// CheckUserEnabled returns ErrUserDisabled when the user is disabled.
+func (u *UserRpcClient) CheckUserEnabled(ctx context.Context, userID string) error {
+	resp, err := u.ExtClient.GetUserDisabled(ctx, &userext.GetUserDisabledReq{UserID: userID})
+	if err != nil {
+		return err
+	}
+	if resp.Disabled {
+		return servererrs.ErrUserDisabled.WrapMsg("user is disabled", "userID", userID)
+	}
+	return nil
+}
+
 // GetUsersInfoMap retrieves a map of user information indexed by their user IDs.
 func (u *UserRpcClient) GetUsersInfoMap(ctx context.Context, userIDs []string) (map[string]*sdkws.UserInfo, error) {
 	users, err := u.GetUsersInfo(ctx, userIDs)
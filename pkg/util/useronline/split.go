@@ -4,8 +4,12 @@ import (
 	"errors"
 	"strconv"
 	"strings"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 )
 
+// ParseUserOnlineStatus returns the user ID scoped to its tenant, see tenant.JoinKey, and
+// the online platforms of the user.
 func ParseUserOnlineStatus(payload string) (string, []int32, error) {
 	arr := strings.Split(payload, ":")
 	if len(arr) == 0 {
@@ -15,7 +19,12 @@ func ParseUserOnlineStatus(payload string) (string, []int32, error) {
 	if userID == "" {
 		return "", nil, errors.New("userID is empty")
 	}
-	platformIDs := make([]int32, len(arr)-1)
+	arr = arr[:len(arr)-1]
+	if n := len(arr); n >= 2 && arr[n-2]+":" == tenant.KeyPrefix {
+		userID = tenant.JoinKey(arr[n-1], userID)
+		arr = arr[:n-2]
+	}
+	platformIDs := make([]int32, len(arr))
 	for i := range platformIDs {
 		platformID, err := strconv.Atoi(arr[i])
 		if err != nil {
@@ -0,0 +1,57 @@
+#!/usr/bin/env bash
+# Copyright © 2023 OpenIM. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# Sends a message through the API and waits until openim-msgtransfer has stored it, which
+# exercises the toRedis and toMongo pipelines of the configured message queue backend.
+
+set -euo pipefail
+
+API_ADDR=${API_ADDR:-http://127.0.0.1:10002}
+SECRET=${SECRET:-openIM123}
+ADMIN_USER_ID=${ADMIN_USER_ID:-imAdmin}
+MAX_ATTEMPTS=60
+
+SEND_ID="e2e_send_$(date +%s)"
+RECV_ID="e2e_recv_$(date +%s)"
+TOKEN=""
+
+call() {
+  local resp
+  resp=$(curl -sf -X POST "$API_ADDR$1" -H "Content-Type: application/json" \
+    -H "operationID: e2e_$(date +%s%N)" -H "token: $TOKEN" -d "$2")
+  if [ "$(echo "$resp" | jq -r '.errCode')" != "0" ]; then
+    echo "$1 failed: $resp" >&2
+    exit 1
+  fi
+  echo "$resp"
+}
+
+TOKEN=$(call /auth/user_token "{\"secret\":\"$SECRET\",\"platformID\":1,\"userID\":\"$ADMIN_USER_ID\"}" | jq -r '.data.token')
+
+call /user/user_register "{\"users\":[{\"userID\":\"$SEND_ID\",\"nickname\":\"send\"},{\"userID\":\"$RECV_ID\",\"nickname\":\"recv\"}]}" > /dev/null
+
+call /msg/send_msg "{\"sendID\":\"$SEND_ID\",\"recvID\":\"$RECV_ID\",\"senderPlatformID\":1,\"content\":{\"content\":\"hello\"},\"contentType\":101,\"sessionType\":1}" > /dev/null
+
+for ((i = 1; i <= MAX_ATTEMPTS; i++)); do
+  num=$(call /msg/search_msg "{\"sendID\":\"$SEND_ID\",\"recvID\":\"$RECV_ID\",\"sessionType\":1,\"pagination\":{\"pageNumber\":1,\"showNumber\":10}}" | jq -r '.data.chatLogs | length')
+  if [ "$num" -ge 1 ]; then
+    echo "message stored after $i attempts"
+    exit 0
+  fi
+  sleep 1
+done
+
+echo "message from $SEND_ID was not stored within $MAX_ATTEMPTS seconds" >&2
+exit 1
@@ -20,6 +20,9 @@ import (
 	"fmt"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/cmd"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/mq"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database/pgsql"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/dbbuild"
 	"github.com/openimsdk/tools/db/mongoutil"
 	"github.com/openimsdk/tools/db/redisutil"
 	"github.com/openimsdk/tools/discovery/etcd"
@@ -56,6 +59,10 @@ func CheckMongo(ctx context.Context, config *config.Mongo) error {
 	return mongoutil.Check(ctx, config.Build())
 }
 
+func CheckPostgres(ctx context.Context, config *config.Postgres) error {
+	return pgsql.Check(ctx, config)
+}
+
 func CheckRedis(ctx context.Context, config *config.Redis) error {
 	return redisutil.Check(ctx, config.Build())
 }
@@ -65,10 +72,14 @@ func CheckMinIO(ctx context.Context, config *config.Minio) error {
 }
 
 func CheckKafka(ctx context.Context, conf *config.Kafka) error {
-	return kafka.Check(ctx, conf.Build(), []string{conf.ToMongoTopic, conf.ToRedisTopic, conf.ToPushTopic})
+	topics := []string{conf.ToMongoTopic, conf.ToRedisTopic, conf.ToPushTopic}
+	if conf.ToMongoDeadLetterTopic != "" {
+		topics = append(topics, conf.ToMongoDeadLetterTopic)
+	}
+	return kafka.Check(ctx, conf.Build(), topics)
 }
 
-func initConfig(configDir string) (*config.Mongo, *config.Redis, *config.Kafka, *config.Minio, *config.Discovery, error) {
+func initConfig(configDir string) (*config.Mongo, *config.Postgres, *config.Redis, *config.Kafka, *config.Minio, *config.Discovery, error) {
 	var (
 		mongoConfig = &config.Mongo{}
 		redisConfig = &config.Redis{}
@@ -76,40 +87,62 @@ func initConfig(configDir string) (*config.Mongo, *config.Redis, *config.Kafka,
 		minioConfig = &config.Minio{}
 		discovery   = &config.Discovery{}
 		thirdConfig = &config.Third{}
+		mqConfig    = &config.MQ{}
+		dbConfig    = &config.Database{}
+		pgConfig    *config.Postgres
 	)
-	err := config.LoadConfig(filepath.Join(configDir, cmd.MongodbConfigFileName), cmd.ConfigEnvPrefixMap[cmd.MongodbConfigFileName], mongoConfig)
+	err := config.LoadConfig(filepath.Join(configDir, cmd.DatabaseConfigFileName), cmd.ConfigEnvPrefixMap[cmd.DatabaseConfigFileName], dbConfig)
 	if err != nil {
-		return nil, nil, nil, nil, nil, err
+		return nil, nil, nil, nil, nil, nil, err
+	}
+
+	if dbConfig.Enable == dbbuild.BackendPostgres {
+		mongoConfig = nil
+		pgConfig = &dbConfig.Postgres
+	} else {
+		err = config.LoadConfig(filepath.Join(configDir, cmd.MongodbConfigFileName), cmd.ConfigEnvPrefixMap[cmd.MongodbConfigFileName], mongoConfig)
+		if err != nil {
+			return nil, nil, nil, nil, nil, nil, err
+		}
 	}
 
 	err = config.LoadConfig(filepath.Join(configDir, cmd.RedisConfigFileName), cmd.ConfigEnvPrefixMap[cmd.RedisConfigFileName], redisConfig)
 	if err != nil {
-		return nil, nil, nil, nil, nil, err
+		return nil, nil, nil, nil, nil, nil, err
 	}
 
-	err = config.LoadConfig(filepath.Join(configDir, cmd.KafkaConfigFileName), cmd.ConfigEnvPrefixMap[cmd.KafkaConfigFileName], kafkaConfig)
+	err = config.LoadConfig(filepath.Join(configDir, cmd.MQConfigFileName), cmd.ConfigEnvPrefixMap[cmd.MQConfigFileName], mqConfig)
 	if err != nil {
-		return nil, nil, nil, nil, nil, err
+		return nil, nil, nil, nil, nil, nil, err
+	}
+
+	if mqConfig.Enable == mq.BackendRedis {
+		kafkaConfig = nil
+	} else {
+		err = config.LoadConfig(filepath.Join(configDir, cmd.KafkaConfigFileName), cmd.ConfigEnvPrefixMap[cmd.KafkaConfigFileName], kafkaConfig)
+		if err != nil {
+			return nil, nil, nil, nil, nil, nil, err
+		}
 	}
 
 	err = config.LoadConfig(filepath.Join(configDir, cmd.OpenIMRPCThirdCfgFileName), cmd.ConfigEnvPrefixMap[cmd.OpenIMRPCThirdCfgFileName], thirdConfig)
 	if err != nil {
-		return nil, nil, nil, nil, nil, err
+		return nil, nil, nil, nil, nil, nil, err
 	}
 
 	if thirdConfig.Object.Enable == "minio" {
 		err = config.LoadConfig(filepath.Join(configDir, cmd.MinioConfigFileName), cmd.ConfigEnvPrefixMap[cmd.MinioConfigFileName], minioConfig)
 		if err != nil {
-			return nil, nil, nil, nil, nil, err
+			return nil, nil, nil, nil, nil, nil, err
 		}
 	} else {
 		minioConfig = nil
 	}
 	err = config.LoadConfig(filepath.Join(configDir, cmd.DiscoveryConfigFilename), cmd.ConfigEnvPrefixMap[cmd.DiscoveryConfigFilename], discovery)
 	if err != nil {
-		return nil, nil, nil, nil, nil, err
+		return nil, nil, nil, nil, nil, nil, err
 	}
-	return mongoConfig, redisConfig, kafkaConfig, minioConfig, discovery, nil
+	return mongoConfig, pgConfig, redisConfig, kafkaConfig, minioConfig, discovery, nil
 }
 
 func main() {
@@ -122,13 +155,13 @@ func main() {
 
 	fmt.Printf("%s Index: %d, Config Path: %s\n", filepath.Base(os.Args[0]), index, configDir)
 
-	mongoConfig, redisConfig, kafkaConfig, minioConfig, zookeeperConfig, err := initConfig(configDir)
+	mongoConfig, pgConfig, redisConfig, kafkaConfig, minioConfig, zookeeperConfig, err := initConfig(configDir)
 	if err != nil {
 		program.ExitWithError(err)
 	}
 
 	ctx := context.Background()
-	err = performChecks(ctx, mongoConfig, redisConfig, kafkaConfig, minioConfig, zookeeperConfig, maxRetry)
+	err = performChecks(ctx, mongoConfig, pgConfig, redisConfig, kafkaConfig, minioConfig, zookeeperConfig, maxRetry)
 	if err != nil {
 		// Assume program.ExitWithError logs the error and exits.
 		// Replace with your error handling logic as necessary.
@@ -136,19 +169,28 @@ func main() {
 	}
 }
 
-func performChecks(ctx context.Context, mongoConfig *config.Mongo, redisConfig *config.Redis, kafkaConfig *config.Kafka, minioConfig *config.Minio, discovery *config.Discovery, maxRetry int) error {
+func performChecks(ctx context.Context, mongoConfig *config.Mongo, pgConfig *config.Postgres, redisConfig *config.Redis, kafkaConfig *config.Kafka, minioConfig *config.Minio, discovery *config.Discovery, maxRetry int) error {
 	checksDone := make(map[string]bool)
 
 	checks := map[string]func(ctx context.Context) error{
-		"Mongo": func(ctx context.Context) error {
-			return CheckMongo(ctx, mongoConfig)
-		},
 		"Redis": func(ctx context.Context) error {
 			return CheckRedis(ctx, redisConfig)
 		},
-		"Kafka": func(ctx context.Context) error {
+	}
+	if mongoConfig != nil {
+		checks["Mongo"] = func(ctx context.Context) error {
+			return CheckMongo(ctx, mongoConfig)
+		}
+	}
+	if pgConfig != nil {
+		checks["Postgres"] = func(ctx context.Context) error {
+			return CheckPostgres(ctx, pgConfig)
+		}
+	}
+	if kafkaConfig != nil {
+		checks["Kafka"] = func(ctx context.Context) error {
 			return CheckKafka(ctx, kafkaConfig)
-		},
+		}
 	}
 	if minioConfig != nil {
 		checks["MinIO"] = func(ctx context.Context) error {
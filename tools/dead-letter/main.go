@@ -0,0 +1,78 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/internal/msgtransfer"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/cmd"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/version"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/system/program"
+)
+
+// dead-letter replays the batches msg-transfer parked in the to-mongo dead-letter topic
+// once MongoDB is healthy again.
+func main() {
+	var (
+		configDir string
+		idle      int
+	)
+	flag.StringVar(&configDir, "c", filepath.Join("..", "..", "..", "..", "..", "config"), "Configuration dir")
+	flag.IntVar(&idle, "idle", 10, "stop after this many seconds without new dead-letter messages")
+	flag.Parse()
+
+	conf, logConf, err := initConfig(configDir)
+	if err != nil {
+		program.ExitWithError(err)
+	}
+	if err := log.InitConsoleLogger("dead-letter", logConf.RemainLogLevel, logConf.IsJson, version.Version); err != nil {
+		program.ExitWithError(err)
+	}
+	n, err := msgtransfer.ReplayDeadLetter(context.Background(), conf, time.Duration(idle)*time.Second)
+	fmt.Printf("replayed %d dead-letter batches\n", n)
+	if err != nil {
+		program.ExitWithError(err)
+	}
+}
+
+func initConfig(configDir string) (*msgtransfer.Config, *config.Log, error) {
+	var (
+		conf    msgtransfer.Config
+		logConf config.Log
+	)
+	files := map[string]any{
+		cmd.OpenIMMsgTransferCfgFileName: &conf.MsgTransfer,
+		cmd.RedisConfigFileName:          &conf.RedisConfig,
+		cmd.MongodbConfigFileName:        &conf.MongodbConfig,
+		cmd.DatabaseConfigFileName:       &conf.DatabaseConfig,
+		cmd.KafkaConfigFileName:          &conf.KafkaConfig,
+		cmd.MQConfigFileName:             &conf.MQConfig,
+		cmd.SearchConfigFileName:         &conf.SearchConfig,
+		cmd.LogConfigFileName:            &logConf,
+	}
+	for name, v := range files {
+		if err := config.LoadConfig(filepath.Join(configDir, name), cmd.ConfigEnvPrefixMap[name], v); err != nil {
+			return nil, nil, err
+		}
+	}
+	return &conf, &logConf, nil
+}
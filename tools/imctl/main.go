@@ -14,9 +14,13 @@
 
 package main
 
-import "fmt"
+import (
+	"github.com/openimsdk/open-im-server/v3/pkg/common/cmd"
+	"github.com/openimsdk/tools/system/program"
+)
 
 func main() {
-
-	fmt.Println("imctl")
+	if err := cmd.NewImctlCmd().Exec(); err != nil {
+		program.ExitWithError(err)
+	}
 }